@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/PiMaker/MCPC-Software/debuginfo"
 )
 
 /*
@@ -38,6 +40,21 @@ type tokenLine struct {
 	label   []string
 	command string
 	args    []string
+
+	// Origin of this line in the input file (inherited by library expansions)
+	file   string
+	line   int
+	source string
+
+	// Origin in the MSCR source, if the input was generated by the MSCR compiler
+	mscr *mscrOrigin
+}
+
+// mscrOrigin is set by ";@mscr <file>:<line> [function]" annotations in the input file
+type mscrOrigin struct {
+	file     string
+	line     int
+	function string
 }
 
 // library represents a library that was specified on the command line
@@ -49,31 +66,83 @@ type libraryEntry struct {
 	replacement string
 }
 
+// Options configures a single run of the assembler
+type Options struct {
+	File      string
+	Offset    int
+	Libraries []string
+	AutoJump  bool
+	Verbose   bool
+
+	// Receives progress messages; The standard logger is used if nil
+	Log *log.Logger
+}
+
+// Result contains the output of a successful assembler run
+type Result struct {
+	Binary   []byte
+	Debug    *debuginfo.DebugInfo
+	Warnings []*Diagnostic
+}
+
+// assembly holds the state of a single assembler run
+type assembly struct {
+	declarationMap     map[string]string
+	longestDeclaration int
+
+	log *log.Logger
+
+	diagnostics []*Diagnostic
+	warnings    []*Diagnostic
+}
+
 var libraryReplaceeRegex = regexp.MustCompile("- (\\S+) ?(\\S*?)? ?(\\S*?)? ?(\\S*?)? ?=")
 var libraryReplacementRegex = regexp.MustCompile("=(.*?)(-\\D|$)")
 var paramTypeRegex = regexp.MustCompile("\\.(reg|lit)\\d{0,2}")
 var charLiteralRegex = regexp.MustCompile("^'.+'$")
 var spaceReplaceRegex = regexp.MustCompile("\\'(.*?)\\ (.*?)\\'")
 var spaceReplaceDoubleRegex = regexp.MustCompile("\\'\\ \\ \\'")
+var mscrAnnotationRegex = regexp.MustCompile(`^;@mscr(?:\s+(.+):(\d+)(?:\s+(\S+))?)?\s*$`)
+
+// instructionArgCount is the minimum amount of arguments each base instruction needs
+var instructionArgCount = map[string]int{
+	"MOV":   2,
+	"MOVNZ": 3,
+	"MOVEZ": 3,
+	"BUS":   2,
+	"HOLD":  0,
+	"SET":   1,
+	"MEMR":  2,
+	"MEMW":  2,
+	"HALT":  0,
+}
 
-// Compile transforms a .ma assembly file to a .mb binary
-func Compile(file string, offset int, libraries []string, autoJump, verbose bool) ([]byte, []byte) {
-	log.Println("Compiling " + file)
+// Compile transforms a .ma assembly file to a .mb binary.
+// If the input cannot be assembled, the returned error is of type *Error and contains every problem found.
+func Compile(opts Options) (*Result, error) {
+	a := &assembly{
+		declarationMap: make(map[string]string),
+		log:            opts.Log,
+	}
+
+	if a.log == nil {
+		a.log = log.Default()
+	}
 
-	sym := make([]byte, 0)
+	a.log.Println("Compiling " + opts.File)
 
-	// Possibly rework this:
-	longestDeclaration = 0
-	declarationMap = make(map[string]string)
+	debug := debuginfo.New()
+	offset := opts.Offset
 
 	// Don't allow impossible auto-jump
+	autoJump := opts.AutoJump
 	if autoJump && offset < 3 {
 		autoJump = false
-		log.Println("WARNING: Auto-Jump was set, but offset is smaller than 3; Auto-Jump has been disabled")
+		a.log.Println("WARNING: Auto-Jump was set, but offset is smaller than 3; Auto-Jump has been disabled")
 	}
 
 	if offset > 0 {
-		log.Printf("Using offset: %d (Auto-Jump: %t)\n", offset, autoJump)
+		a.log.Printf("Using offset: %d (Auto-Jump: %t)\n", offset, autoJump)
 	}
 
 	if offset < 0 {
@@ -81,16 +150,23 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 	}
 
 	// Load libraries
-	libs := make([]library, len(libraries))
-	for i, libPath := range libraries {
-		libs[i] = loadLibrary(libPath)
+	libs := make([]library, len(opts.Libraries))
+	for i, libPath := range opts.Libraries {
+		libs[i] = a.loadLibrary(libPath)
+	}
+
+	if len(a.diagnostics) > 0 {
+		return nil, a.err()
 	}
 
 	// Read and parse source file
-	log.Println("Tokenizing...")
-	tokens := readFile(file)
+	a.log.Println("Tokenizing...")
+	tokens, err := a.readFile(opts.File)
+	if err != nil {
+		return nil, err
+	}
 
-	log.Println("Applying library transforms")
+	a.log.Println("Applying library transforms")
 	// Handle each library in a loop until no more replacements have occured
 	replaced := 1
 	for replaced > 0 {
@@ -103,7 +179,14 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 				for _, r := range lib {
 					if r.capture.MatchString(token.raw) {
 						rawLibReplacement := r.capture.ReplaceAllString(token.raw, r.replacement)
-						replacementTokens := tokenize(strings.NewReader(rawLibReplacement), token.raw)
+						replacementTokens := a.tokenize(strings.NewReader(rawLibReplacement), token.file, token)
+
+						if len(replacementTokens) == 0 {
+							// Expansion was invalid, diagnostic has already been reported by tokenize
+							tokens = append(tokens[:i], tokens[i+1:]...)
+							i--
+							break
+						}
 
 						// Handle labels
 						replacementTokens[0].label = token.label
@@ -122,8 +205,8 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 						// Update index
 						i += len(replacementTokens) - 1
 
-						if verbose {
-							fmt.Println("Replaced \"" + token.raw + "\" with \"" + fmt.Sprintf("%V", replacementTokens) + "\"")
+						if opts.Verbose {
+							fmt.Println("Replaced \"" + token.raw + "\" with \"" + fmt.Sprintf("%v", replacementTokens) + "\"")
 						}
 
 						replaced++
@@ -133,21 +216,19 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 		}
 	}
 
-	log.Println("Parsing labels...")
+	a.log.Println("Parsing labels...")
 
 	// Parse labels
 	labelMap := make(map[string]uint16)
 	for labelAddr, token := range tokens {
 		for _, lbl := range token.label {
-			for lblExists := range labelMap {
-				if lbl == lblExists {
-					fmt.Println("WARNING: Redefinition of label: " + lbl)
-				}
+			if _, exists := labelMap[lbl]; exists {
+				a.warnf(token, "Redefinition of label: %s", lbl)
 			}
 
 			labelMap[lbl] = uint16(labelAddr)
 
-			if verbose {
+			if opts.Verbose {
 				fmt.Println(" > Label " + lbl + " located at 0x" + strconv.FormatInt(int64(labelMap[lbl]), 16))
 			}
 		}
@@ -155,8 +236,7 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 
 	// Create symbol map (after label iteration to avoid doubles)
 	for lbl, addr := range labelMap {
-		// Add to symbol map
-		sym = append(sym, []byte(fmt.Sprintf("%04x=%s;", addr, lbl))...)
+		debug.AddLabel(addr, lbl)
 	}
 
 	// Replace labels
@@ -164,7 +244,8 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 		if token.command == "RAW" && token.raw[0] == '.' {
 			addr, ok := labelMap[token.raw]
 			if !ok {
-				log.Fatalln("ERROR: Undefined label referenced: " + token.raw)
+				a.errorf(token, "Undefined label referenced: %s", token.raw)
+				continue
 			}
 			token.raw = fmt.Sprintf("0x%x", addr)
 		}
@@ -212,6 +293,14 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 
 	for i, tkn := range tokens {
 		////fmt.Println("  COMPILE > " + tkn.raw)
+
+		addDebugWord(debug, uint16(i), tkn)
+
+		if argCount, ok := instructionArgCount[tkn.command]; ok && len(tkn.args) < argCount {
+			a.errorf(tkn, "Instruction %s requires %d parameters (found: %s)", tkn.command, argCount, tkn.raw)
+			continue
+		}
+
 		// Check which base command is used and perform according transform action
 		switch tkn.command {
 		case "RAW":
@@ -224,6 +313,9 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 				if len(output) > 1 {
 					output[i*2] = byte(content[1]) & 0x00FF
 				}
+			} else if tkn.raw[0] == '.' {
+				// Undefined label, already reported above
+				continue
 			} else {
 				n := parseHex(tkn.raw)
 				output[i*2] = byte((n & 0xFF00) >> 8)
@@ -231,22 +323,22 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 			}
 
 		case "MOV":
-			output[i*2] = ParseRegister(tkn.args[1])
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x1
+			output[i*2] = a.register(tkn, tkn.args[1])
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x1
 		case "MOVNZ":
-			output[i*2] = (ParseRegister(tkn.args[2]) << 4) | ParseRegister(tkn.args[1])
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x2
+			output[i*2] = (a.register(tkn, tkn.args[2]) << 4) | a.register(tkn, tkn.args[1])
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x2
 		case "MOVEZ":
-			output[i*2] = (ParseRegister(tkn.args[2]) << 4) | ParseRegister(tkn.args[1])
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x3
+			output[i*2] = (a.register(tkn, tkn.args[2]) << 4) | a.register(tkn, tkn.args[1])
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x3
 
 		case "BUS":
 			output[i*2] = byte(parseHex(tkn.args[1]))
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x4
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x4
 		case "HOLD":
 			output[i*2+1] = 0x5
 		case "SET":
-			output[i*2] = ParseRegister(tkn.args[0])
+			output[i*2] = a.register(tkn, tkn.args[0])
 			// This is supported now, on hardware anyway:
 			//if output[i*2] == 0xB {
 			//	log.Fatalln("ERROR: Cannot SET program counter (PC/0xB)")
@@ -254,43 +346,73 @@ func Compile(file string, offset int, libraries []string, autoJump, verbose bool
 			output[i*2+1] = 0x6
 
 		case "MEMR":
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x5
-			output[i*2] = ParseRegister(tkn.args[1])
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x5
+			output[i*2] = a.register(tkn, tkn.args[1])
 		case "MEMW":
-			output[i*2+1] = (ParseRegister(tkn.args[0]) << 4) | 0x7
-			output[i*2] = ParseRegister(tkn.args[1]) << 4
+			output[i*2+1] = (a.register(tkn, tkn.args[0]) << 4) | 0x7
+			output[i*2] = a.register(tkn, tkn.args[1]) << 4
 
 		case "AND", "OR", "XOR", "ADD", "SHFT", "MUL", "GT", "EQ":
-			aluCmd(&output, i, tkn)
+			a.aluCmd(&output, i, tkn)
 
 		case "HALT":
 			break
 
 		default:
-			log.Println("ERROR: Invalid instruction encountered: \"" + tkn.command + "\" (in \"" + tkn.raw + "\")")
+			a.errorf(tkn, "Invalid instruction encountered: \"%s\" (in \"%s\")", tkn.command, tkn.raw)
 		}
 	}
 
+	if len(a.diagnostics) > 0 {
+		return nil, a.err()
+	}
+
 	// Append HALT at end if not already present
 	if len(output) > 0 && (output[len(output)-1] != 0 || output[len(output)-2] != 0) {
 		output = append(output, []byte{0x0, 0x0}...)
 	}
 
-	log.Println("Compilation complete, " + strconv.Itoa(len(output)) + " bytes generated!")
+	a.log.Println("Compilation complete, " + strconv.Itoa(len(output)) + " bytes generated!")
+
+	debug.Sort()
 
-	if len(sym) > 0 {
-		return output, sym[:len(sym)-1]
-	} else {
-		return output, make([]byte, 0)
+	return &Result{
+		Binary:   output,
+		Debug:    debug,
+		Warnings: a.warnings,
+	}, nil
+}
+
+// Records the source location of an emitted word in the debug info (words without origin, e.g. offset padding, are skipped)
+func addDebugWord(debug *debuginfo.DebugInfo, addr uint16, tkn *tokenLine) {
+	if tkn.file == "" {
+		return
 	}
+
+	word := debuginfo.Word{
+		Addr: addr,
+		File: debug.AddFile(tkn.file, nil),
+		Line: tkn.line,
+	}
+
+	if tkn.mscr != nil {
+		word.Mscr = &debuginfo.SourceRef{
+			File:     debug.AddFile(tkn.mscr.file, nil),
+			Line:     tkn.mscr.line,
+			Function: tkn.mscr.function,
+		}
+	}
+
+	debug.AddWord(word)
 }
 
 // Transforms an ALU command token to assembly
-func aluCmd(output *[]byte, i int, tkn *tokenLine) {
+func (a *assembly) aluCmd(output *[]byte, i int, tkn *tokenLine) {
 	out := *output
 
 	if len(tkn.args) != 3 {
-		log.Fatalf("ERROR: ALU instructions require 3 parameters (found: %s)\n", tkn.raw)
+		a.errorf(tkn, "ALU instructions require 3 parameters (found: %s)", tkn.raw)
+		return
 	}
 
 	var ins byte
@@ -313,8 +435,18 @@ func aluCmd(output *[]byte, i int, tkn *tokenLine) {
 		ins = 0xF
 	}
 
-	out[i*2+1] = ins | (ParseRegister(tkn.args[0]) << 4)
-	out[i*2] = ParseRegister(tkn.args[1]) | (ParseRegister(tkn.args[2]) << 4)
+	out[i*2+1] = ins | (a.register(tkn, tkn.args[0]) << 4)
+	out[i*2] = a.register(tkn, tkn.args[1]) | (a.register(tkn, tkn.args[2]) << 4)
+}
+
+// register parses a register name in the context of a token, reporting a diagnostic if it is invalid
+func (a *assembly) register(tkn *tokenLine, reg string) byte {
+	r, err := ParseRegister(reg)
+	if err != nil {
+		a.errorf(tkn, "%s", err.Error())
+	}
+
+	return r
 }
 
 // Parses a hex encoded string with leading "0x" marker to an unsigned 16 bit integer
@@ -324,64 +456,69 @@ func parseHex(raw string) uint16 {
 }
 
 // ParseRegister parses a string representation of a register value to a machine(=MCPC)-readable integer constant
-func ParseRegister(reg string) byte {
+func ParseRegister(reg string) (byte, error) {
 	switch reg {
 	case "A":
-		return 0x0
+		return 0x0, nil
 	case "B":
-		return 0x1
+		return 0x1, nil
 	case "C":
-		return 0x2
+		return 0x2, nil
 	case "D":
-		return 0x3
+		return 0x3, nil
 	case "E":
-		return 0x4
+		return 0x4, nil
 	case "F":
-		return 0x5
+		return 0x5, nil
 	case "G":
-		return 0x6
+		return 0x6, nil
 	case "H":
-		return 0x7
+		return 0x7, nil
 	case "SCR1":
-		return 0x8
+		return 0x8, nil
 	case "SCR2":
-		return 0x9
+		return 0x9, nil
 	case "SP":
-		return 0xA
+		return 0xA, nil
 	case "PC":
-		return 0xB
+		return 0xB, nil
 	case "0":
-		return 0xC
+		return 0xC, nil
 	case "1":
-		return 0xD
+		return 0xD, nil
 	case "-1":
-		return 0xE
+		return 0xE, nil
 	case "BUS":
-		return 0xF
+		return 0xF, nil
 	default:
-		log.Fatalln("ERROR: Invalid register name encountered: " + reg)
-		return 0
+		return 0, fmt.Errorf("Invalid register name encountered: %s", reg)
 	}
 }
 
-func loadLibrary(path string) library {
+func (a *assembly) loadLibrary(path string) library {
 	for strings.HasPrefix(path, "--library=") {
 		path = path[len("--library="):] // Weirdness on parameter passing sometimes
 	}
 
-	log.Println("Loading library: " + path)
+	a.log.Println("Loading library: " + path)
 
 	file, err := os.Open(path)
 	if err != nil {
-		log.Fatalln("ERROR: Can't read library file: " + err.Error())
+		a.diagnostics = append(a.diagnostics, &Diagnostic{
+			File:    path,
+			Message: "Can't read library file: " + err.Error(),
+		})
+		return nil
 	}
 	defer file.Close()
 
 	var lib library
 
 	scanner := bufio.NewScanner(file)
-	lineNum := 1
+	lineNum := 0
 	for scanner.Scan() {
+		lineNum++
+
 		// Parse each line
 		line := strings.TrimSpace(scanner.Text())
 
@@ -392,7 +529,13 @@ func loadLibrary(path string) library {
 
 		replaceeMatch := libraryReplaceeRegex.FindStringSubmatch(line)
 		if len(replaceeMatch) == 0 {
-			log.Fatalln("ERROR: Could not load library, parser error on line: " + strconv.Itoa(lineNum))
+			a.diagnostics = append(a.diagnostics, &Diagnostic{
+				File:    path,
+				Line:    lineNum,
+				Source:  line,
+				Message: "Could not load library, parser error",
+			})
+			continue
 		}
 
 		// Remove first entry (full match)
@@ -433,12 +576,13 @@ func loadLibrary(path string) library {
 		})
 
 		//fmt.Println("Lib entry loaded: " + captureString + " transforms to " + strings.Join(replacement, ", "))
-
-		lineNum++
 	}
 
 	if err := scanner.Err(); err != nil {
-		log.Fatalln(err)
+		a.diagnostics = append(a.diagnostics, &Diagnostic{
+			File:    path,
+			Message: err.Error(),
+		})
 	}
 
 	return lib
@@ -448,31 +592,68 @@ func removeIndex(a []string, i int) []string {
 	return append(a[:i], a[i+1:]...)
 }
 
-func readFile(path string) []*tokenLine {
+func (a *assembly) readFile(path string) ([]*tokenLine, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		log.Println("ERROR: Can't read input file.")
-		os.Exit(1)
+		return nil, fmt.Errorf("Can't read input file: %s", err.Error())
 	}
 	defer file.Close()
 
-	return tokenize(file, "file://"+path)
-}
+	tokens := a.tokenize(file, path, nil)
 
-// Defined globally, not very pretty but gets the job done
-var declarationMap map[string]string
-var longestDeclaration int
+	if len(a.diagnostics) > 0 {
+		return nil, a.err()
+	}
+
+	return tokens, nil
+}
 
-func tokenize(reader io.Reader, originalSource string) []*tokenLine {
+// tokenize splits assembler code into tokenLines. If origin is set, the code is treated as a library expansion of origin,
+// and all generated tokens point back to the source line of origin instead.
+func (a *assembly) tokenize(reader io.Reader, file string, origin *tokenLine) []*tokenLine {
 	var tokens []*tokenLine
 
 	nextLabel := []string{}
 
+	var mscr *mscrOrigin
+
 	scanner := bufio.NewScanner(reader)
+	lineNum := 0
 	for scanner.Scan() {
+		lineNum++
+
 		// Parse each line
 		t := strings.TrimSpace(scanner.Text())
 
+		// MSCR source annotations (generated by the MSCR compiler, see compiler.GenerateASM)
+		if annotation := mscrAnnotationRegex.FindStringSubmatch(t); annotation != nil {
+			if annotation[1] == "" {
+				mscr = nil
+			} else {
+				line, _ := strconv.Atoi(annotation[2])
+				mscr = &mscrOrigin{
+					file:     annotation[1],
+					line:     line,
+					function: annotation[3],
+				}
+			}
+
+			continue
+		}
+
+		// Position info for diagnostics
+		pos := &tokenLine{
+			file:   file,
+			line:   lineNum,
+			source: t,
+			mscr:   mscr,
+		}
+		if origin != nil {
+			pos.line = origin.line
+			pos.source = origin.source
+			pos.mscr = origin.mscr
+		}
+
 		// Handle comments
 		t = strings.TrimSpace(strings.Split(t, ";")[0])
 		if t == "" {
@@ -502,16 +683,18 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 		// Handle declarations
 		if tspaced[0] == "#DECLARE" {
 			if len(tspaced) != 3 {
-				log.Fatalln("ERROR: Invalid #declare: " + scanner.Text())
+				a.errorf(pos, "Invalid #declare: %s", scanner.Text())
+				continue
 			}
 
 			if len(tspaced[2]) < 2 {
-				log.Fatalln("ERROR: Invalid #declare (length of replacee has to be at least 2 characters): " + scanner.Text())
+				a.errorf(pos, "Invalid #declare (length of replacee has to be at least 2 characters): %s", scanner.Text())
+				continue
 			}
 
-			declarationMap[tspaced[2]] = tspaced[1]
-			if len(tspaced[2]) > longestDeclaration {
-				longestDeclaration = len(tspaced[2])
+			a.declarationMap[tspaced[2]] = tspaced[1]
+			if len(tspaced[2]) > a.longestDeclaration {
+				a.longestDeclaration = len(tspaced[2])
 			}
 
 			continue
@@ -531,6 +714,10 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 					label:   []string{},
 					command: "RAW",
 					args:    make([]string, 0),
+					file:    pos.file,
+					line:    pos.line,
+					source:  pos.source,
+					mscr:    pos.mscr,
 				})
 				nextLabel = []string{}
 				continue
@@ -549,7 +736,12 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 
 		// Check for raw instructions
 		if len(t) < 3 {
-			log.Fatalf("ERROR: Invalid syntax in expansion of '%s': %s\n", originalSource, t)
+			if origin != nil {
+				a.errorf(pos, "Invalid syntax in expansion of '%s': %s", origin.raw, t)
+			} else {
+				a.errorf(pos, "Invalid syntax: %s", t)
+			}
+			continue
 		}
 
 		n, err := strconv.ParseInt(t[2:], 16, 17)
@@ -560,6 +752,10 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 				label:   label,
 				command: "RAW",
 				args:    make([]string, 0),
+				file:    pos.file,
+				line:    pos.line,
+				source:  pos.source,
+				mscr:    pos.mscr,
 			})
 			continue
 		}
@@ -570,6 +766,10 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 				label:   label,
 				command: "RAW",
 				args:    make([]string, 0),
+				file:    pos.file,
+				line:    pos.line,
+				source:  pos.source,
+				mscr:    pos.mscr,
 			})
 			continue
 		}
@@ -580,8 +780,8 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 			cmdArgs = tspaced[1:]
 			for i := 0; i < len(cmdArgs); i++ {
 				// Special care on iterating the declationMap to allow more complex declarations
-				for decLength := longestDeclaration; decLength > 0; decLength-- {
-					for k, v := range declarationMap {
+				for decLength := a.longestDeclaration; decLength > 0; decLength-- {
+					for k, v := range a.declarationMap {
 						if len(k) == decLength {
 							cmdArgs[i] = strings.Replace(cmdArgs[i], k, v, -1)
 						}
@@ -601,11 +801,18 @@ func tokenize(reader io.Reader, originalSource string) []*tokenLine {
 			label:   label,
 			command: tspaced[0],
 			args:    cmdArgs,
+			file:    pos.file,
+			line:    pos.line,
+			source:  pos.source,
+			mscr:    pos.mscr,
 		})
 	}
 
 	if err := scanner.Err(); err != nil {
-		log.Fatalln(err)
+		a.diagnostics = append(a.diagnostics, &Diagnostic{
+			File:    file,
+			Message: err.Error(),
+		})
 	}
 
 	return tokens
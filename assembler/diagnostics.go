@@ -0,0 +1,65 @@
+package assembler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diagnostic is a single error or warning reported by the assembler.
+// File, Line and Source always refer to the original .ma input, even for instructions generated by library expansions.
+type Diagnostic struct {
+	File    string
+	Line    int
+	Source  string
+	Message string
+}
+
+// Error is returned by Compile if the input could not be assembled, it contains every error found during the run
+type Error struct {
+	Diagnostics []*Diagnostic
+}
+
+func (d *Diagnostic) String() string {
+	pos := d.File
+	if d.Line > 0 {
+		pos = fmt.Sprintf("%s:%d", d.File, d.Line)
+	}
+
+	if d.Source == "" {
+		return fmt.Sprintf("%s: %s", pos, d.Message)
+	}
+
+	return fmt.Sprintf("%s: %s\n\t%s", pos, d.Message, d.Source)
+}
+
+func (e *Error) Error() string {
+	lines := make([]string, len(e.Diagnostics))
+	for i, d := range e.Diagnostics {
+		lines[i] = d.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (a *assembly) errorf(tkn *tokenLine, format string, params ...interface{}) {
+	a.diagnostics = append(a.diagnostics, newDiagnostic(tkn, format, params...))
+}
+
+func (a *assembly) warnf(tkn *tokenLine, format string, params ...interface{}) {
+	a.warnings = append(a.warnings, newDiagnostic(tkn, format, params...))
+}
+
+func (a *assembly) err() error {
+	return &Error{
+		Diagnostics: a.diagnostics,
+	}
+}
+
+func newDiagnostic(tkn *tokenLine, format string, params ...interface{}) *Diagnostic {
+	return &Diagnostic{
+		File:    tkn.file,
+		Line:    tkn.line,
+		Source:  tkn.source,
+		Message: fmt.Sprintf(format, params...),
+	}
+}
@@ -3,206 +3,326 @@ package autotest
 import (
 	"bytes"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"os"
-	"os/exec"
-	"path"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/PiMaker/MCPC-Software/assembler"
+	"github.com/PiMaker/MCPC-Software/debuginfo"
 	"github.com/PiMaker/MCPC-Software/interpreter"
 	"github.com/PiMaker/MCPC-Software/mscr"
+	"github.com/PiMaker/MCPC-Software/mscr/compiler"
 	"github.com/logrusorgru/aurora"
 )
 
-var regexpRegister = regexp.MustCompile(`(?m)^;autotest.*reg=(\S+).*?;`)
-var regexpExpected = regexp.MustCompile(`(?m)^;autotest.*val=(\S+).*?;`)
+// DefaultMaxSteps is the number of steps a test may execute before it fails, unless specified otherwise
+const DefaultMaxSteps = 100000
 
-// RunAutotests calls all autotests in a directory in sequence
-func RunAutotests(dir string, libraries []string, optimizeDisable bool) {
-	log.Println("Starting autotests in directory: " + dir)
+// Options for an autotest run
+type Options struct {
+	Dir       string
+	Libraries []string
 
-	files, err := ioutil.ReadDir(dir)
+	// Optimizations for compiling MSCR tests
+	Optimizations compiler.Optimizations
+
+	// Limits apply to every single test
+	Limits interpreter.ExecutionLimits
+
+	// Only files with a matching name are tested, nil tests all files
+	Filter *regexp.Regexp
+
+	// Number of tests running at the same time, 0 for one per CPU core
+	Parallel int
+
+	// If not empty, the source lines executed by the tests are recorded and reported there (LCOV and HTML)
+	CoverageDir string
+
+	// Reports written after all tests have finished, empty for none
+	JUnitFile string
+	JSONFile  string
+}
+
+// RunAutotests calls all autotests in a directory, using a pool of workers. Results are printed in file order.
+// Every test compiles and assembles in-process into its own work directory, which is only kept if the test failed.
+// The returned summary reports failed tests, an error is only returned if the tests could not be run or reported at all.
+func RunAutotests(opts Options) (*Summary, error) {
+	log.Println("Starting autotests in directory: " + opts.Dir)
+
+	files, err := ioutil.ReadDir(opts.Dir)
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
 	}
 
-	counter := -1
-	failedTotal := 0
-	perfTrace := 0
+	names := make([]string, 0)
 	for _, f := range files {
-		if !f.IsDir() {
-			counter++
-
-			stateOut := aurora.Blue("UNKN").String()
-			output := fmt.Sprintf("Test %d: ", counter+1)
-
-			if strings.HasSuffix(f.Name(), ".mscr") {
-				output = fmt.Sprintf("%s%s (MSCR", output, f.Name())
-
-				tmpFile := path.Join(os.TempDir(), "mcpc_autotest.ma")
-				success, state, mscrOut := callMscr(path.Join(dir, f.Name()), tmpFile, optimizeDisable)
-				stateOut = state
-
-				if !success {
-					if mscrOut != "" {
-						log.Printf(aurora.Bold("Test %d: vvvvv MSCR failed to compile, output log below this line vvvvv\r\n").String(), counter)
-						fmt.Println(mscrOut)
-						failedTotal++
-					}
-					output = fmt.Sprintf("%s, MSCR failure", output)
-					printTestResult(stateOut, output)
-					continue
-				}
-
-				state, testOut, inses := performAutotest(tmpFile, counter, libraries)
-				stateOut = state
-				output = fmt.Sprintf("%s, %s", output, testOut)
-
-				if inses > 0 {
-					perfTrace += inses
-				}
-
-				if state == aurora.Red("FAIL").String() { // WTF
-					failedTotal++
-
-					assemblerBackup := path.Join(os.TempDir(), f.Name()+"_autotest_compiled.ma")
-					err = copyFile(assemblerBackup, tmpFile)
-					if err == nil {
-						output = fmt.Sprintf("%s, Assembler file available as %s", output, assemblerBackup)
-					} else {
-						output = fmt.Sprintf("%s, Assembler file could not be copied for inspection, %s", output, err.Error())
-					}
-				}
-
-				printTestResult(stateOut, output)
-
-				os.Remove(tmpFile)
-
-			} else if strings.HasSuffix(f.Name(), ".ma") {
-				output = fmt.Sprintf("%s%s (Assembler", output, f.Name())
-
-				state, testOut, inses := performAutotest(path.Join(dir, f.Name()), counter, libraries)
-				stateOut = state
-				output = fmt.Sprintf("%s, %s", output, testOut)
-
-				if inses > 0 {
-					perfTrace += inses
-				}
-
-				if state == aurora.Red("FAIL").String() { // WTF 2
-					failedTotal++
-				}
-
-				printTestResult(stateOut, output)
-
-			} else {
-				output = fmt.Sprintf("%s%s (Unknown file extension)", output, f.Name())
-				stateOut = aurora.White("SKIP").String()
-				printTestResult(stateOut, output)
-			}
+		if !f.IsDir() && (opts.Filter == nil || opts.Filter.MatchString(f.Name())) {
+			names = append(names, f.Name())
+		}
+	}
+
+	workers := opts.Parallel
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	r := &runner{opts: opts}
+	if opts.CoverageDir != "" {
+		r.coverage = NewCoverage()
+	}
+
+	start := time.Now()
+
+	// Worker pool, every test signals completion on its own channel to print results in order
+	results := make([]*TestResult, len(names))
+	done := make([]chan struct{}, len(names))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	jobs := make(chan int)
+	go func() {
+		for i := range names {
+			jobs <- i
 		}
+		close(jobs)
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				results[i] = r.run(names[i])
+				close(done[i])
+			}
+		}()
+	}
+
+	summary := &Summary{
+		Dir:   opts.Dir,
+		Tests: results,
 	}
 
+	for i := range names {
+		<-done[i]
+		printTestResult(i, results[i])
+		summary.add(results[i])
+	}
+
+	summary.Duration = time.Since(start)
+
 	log.Println()
 	log.Println(aurora.Cyan(aurora.Bold("Autotest Summary:")))
-	log.Println(aurora.White(fmt.Sprintf("Tests total:  %d", counter+1)))
-	log.Println(aurora.Green(fmt.Sprintf("Tests passed: %d", (counter+1)-failedTotal)))
-	log.Println(aurora.Red(fmt.Sprintf("Tests failed: %d", failedTotal)))
-	log.Printf("Performance trace: %s\n", aurora.Bold(strconv.Itoa(perfTrace)))
+	log.Println(aurora.White(fmt.Sprintf("Tests total:  %d", summary.Total)))
+	log.Println(aurora.Green(fmt.Sprintf("Tests passed: %d", summary.Passed)))
+	log.Println(aurora.Red(fmt.Sprintf("Tests failed: %d", summary.Failed)))
+	if summary.Skipped > 0 {
+		log.Println(aurora.White(fmt.Sprintf("Tests skipped: %d", summary.Skipped)))
+	}
+	log.Printf("Performance trace: %s\n", aurora.Bold(strconv.FormatInt(summary.PerfTrace, 10)))
+	log.Printf("Duration: %s (%d workers)\n", summary.Duration.Round(time.Millisecond), workers)
 
-	if failedTotal == 0 {
+	if r.coverage != nil {
 		log.Println()
-		log.Println(aurora.BgGreen("All tests passed!"))
+		log.Println(aurora.Cyan(aurora.Bold("Coverage:")))
+		for _, line := range r.coverage.Summary() {
+			log.Println(line)
+		}
+
+		err = r.coverage.Write(opts.CoverageDir)
+		if err != nil {
+			return summary, fmt.Errorf("Could not write coverage report: %s", err.Error())
+		}
+
+		log.Println("Coverage report written to " + filepath.Join(opts.CoverageDir, "index.html") + " and " + filepath.Join(opts.CoverageDir, "lcov.info"))
+	}
 
-		os.Exit(0)
+	if opts.JUnitFile != "" {
+		err = summary.WriteJUnit(opts.JUnitFile)
+		if err != nil {
+			return summary, fmt.Errorf("Could not write JUnit report: %s", err.Error())
+		}
+	}
+
+	if opts.JSONFile != "" {
+		err = summary.WriteJSON(opts.JSONFile)
+		if err != nil {
+			return summary, fmt.Errorf("Could not write JSON report: %s", err.Error())
+		}
+	}
+
+	log.Println()
+	if summary.Failed == 0 {
+		log.Println(aurora.BgGreen("All tests passed!"))
 	} else {
-		log.Println()
 		log.Println(aurora.BgRed("Some tests failed."))
-
-		os.Exit(1)
 	}
+
+	return summary, nil
 }
 
-func printTestResult(state, output string) {
+func printTestResult(index int, result *TestResult) {
+	if result.Log != "" {
+		log.Printf(aurora.Bold("Test %d: vvvvv %s, output log below this line vvvvv\r\n").String(), index+1, result.logSource)
+		fmt.Println(result.Log)
+	}
+
+	var state aurora.Value
+	switch result.Status {
+	case StatusPass:
+		state = aurora.Green("PASS")
+	case StatusFail:
+		state = aurora.Red("FAIL")
+	case StatusInvalidHeader:
+		state = aurora.White("SK_H")
+	default:
+		state = aurora.White("SKIP")
+	}
+
+	typeName := result.Type
+	if typeName == "" {
+		typeName = "Unknown file extension"
+	}
+
+	output := fmt.Sprintf("Test %d: %s (%s", index+1, result.Name, typeName)
+	if result.Message != "" {
+		output = fmt.Sprintf("%s, %s", output, result.Message)
+	}
+
 	log.Printf("[%s] %s)\r\n", aurora.Bold(state), output)
 }
 
-func callMscr(input, output string, optimizeDisable bool) (success bool, state, mscrLog string) {
+// runner holds the state shared by all tests of a run
+type runner struct {
+	opts     Options
+	coverage *Coverage
+}
 
-	mscrLogWriterString := ""
-	mscrLogWriter := bytes.NewBufferString(mscrLogWriterString)
-	log.SetOutput(mscrLogWriter)
+func (r *runner) run(name string) *TestResult {
+	start := time.Now()
 
-	successChan := make(chan bool)
+	result := &TestResult{Name: name}
+	file := filepath.Join(r.opts.Dir, name)
 
-	go func() {
-		defer func() {
-			if p := recover(); p != nil {
-				log.Println()
-				log.Println(p)
-				successChan <- false
-			}
-		}()
-		mscr.CompileMSCR(input, output, true, false, optimizeDisable)
-		successChan <- true
-	}()
+	switch {
+	case strings.HasSuffix(name, ".mscr"):
+		result.Type = TypeMSCR
+		r.runMscr(file, result)
 
-	success = <-successChan
-	log.SetOutput(os.Stdout)
+	case strings.HasSuffix(name, ".ma"):
+		result.Type = TypeAssembler
+		r.performAutotest(file, result)
 
-	if success {
-		state = aurora.Blue("MSCR").String()
-	} else {
-		state = aurora.Red("FAIL").String()
+	default:
+		result.Status = StatusSkip
 	}
 
-	mscrLog = mscrLogWriter.String()
-
-	return
+	result.Duration = time.Since(start)
+	return result
 }
 
-func performAutotest(file string, counter int, libraries []string) (state, result string, instructions int) {
-
-	result = ""
-	instructions = -1
+// Compiles an MSCR test into its own work directory, then runs it like an assembler test
+func (r *runner) runMscr(file string, result *TestResult) {
+	workDir, err := ioutil.TempDir("", "mcpc_autotest_")
+	if err != nil {
+		result.Status = StatusFail
+		result.Message = "Could not create work directory, " + err.Error()
+		return
+	}
 
-	fileContents, err := ioutil.ReadFile(file)
+	tmpFile := filepath.Join(workDir, strings.TrimSuffix(filepath.Base(file), ".mscr")+".ma")
+	if r.coverage != nil {
+		r.coverage.ignore(tmpFile)
+	}
 
-	if err != nil {
-		log.Fatalln("Couldn't read file that existed when tests started. Check permissions and try again.")
+	// Tests may select their own optimization passes, an invalid header is reported once the compiled file is run
+	optimizations := r.opts.Optimizations
+	if contents, err := ioutil.ReadFile(file); err == nil {
+		if header, err := parseAutotestHeader(string(contents)); err == nil {
+			optimizations = header.optimizations(optimizations)
+		}
 	}
 
-	// Extract autotest header
-	validHeader, register, expected := extractAutotestHeader(string(fileContents))
+	success, mscrLog := callMscr(file, tmpFile, optimizations)
+	if !success {
+		os.RemoveAll(workDir)
 
-	if !validHeader {
-		state = aurora.White("SK_H").String()
-		result = "Invalid autotest header"
+		result.Status = StatusFail
+		result.Message = "MSCR failure"
+		result.Log = mscrLog
+		result.logSource = "MSCR failed to compile"
 		return
 	}
 
-	// Call assembler
-	tmpFile := path.Join(os.TempDir(), "mcpc_autotest.mb")
-	assemblerSuccess, mcpcLog := callMcpcAssembler(file, tmpFile, libraries)
+	r.performAutotest(tmpFile, result)
 
-	if !assemblerSuccess {
-		log.Printf(aurora.Bold("Test %d: vvvvv MCPC failed to assemble, output log below this line vvvvv\r\n").String(), counter)
-		fmt.Println(mcpcLog)
+	// Keep the compiled file of failed tests for inspection
+	if result.Status == StatusFail {
+		result.Message = fmt.Sprintf("%s, Assembler file available as %s", result.Message, tmpFile)
+	} else {
+		os.RemoveAll(workDir)
+	}
+}
+
+// Compiles an MSCR file in-process; Compiler errors (panics) and log output are captured and returned instead of being printed
+func callMscr(input, output string, optimizations compiler.Optimizations) (success bool, mscrLog string) {
+	logWriter := bytes.NewBufferString("")
+	logger := log.New(logWriter, log.Prefix(), log.Flags())
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Println()
+			logger.Println(p)
+
+			success = false
+			mscrLog = logWriter.String()
+		}
+	}()
+
+	mscr.CompileMSCR(mscr.Options{
+		Input:         input,
+		Output:        output,
+		Bootloader:    true,
+		Optimizations: optimizations,
+		Log:           logger,
+	})
 
-		result = "Assembler failure"
-		state = aurora.Red("FAIL").String()
+	return true, logWriter.String()
+}
+
+func (r *runner) performAutotest(file string, result *TestResult) {
+	fail := func(message string) {
+		result.Status = StatusFail
+		result.Message = message
+	}
 
+	fileContents, err := ioutil.ReadFile(file)
+	if err != nil {
+		fail("Could not read test file, " + err.Error())
 		return
 	}
 
-	// Read assembly
-	assembly, err := ioutil.ReadFile(tmpFile)
+	// Extract autotest header
+	header, err := parseAutotestHeader(string(fileContents))
 	if err != nil {
-		log.Fatalln("Couldn't read output file of assembler. Check permissions in temp-directory and try again.")
+		result.Status = StatusInvalidHeader
+		result.Message = "Invalid autotest header: " + err.Error()
+		return
+	}
+
+	// Call assembler
+	assembly, debug, assemblerSuccess, mcpcLog := callAssembler(file, r.opts.Libraries)
+
+	if !assemblerSuccess {
+		fail("Assembler failure")
+		result.Log = mcpcLog
+		result.logSource = "MCPC failed to assemble"
+		return
 	}
 
 	// Parse data into instruction-bounded array
@@ -213,128 +333,89 @@ func performAutotest(file string, counter int, libraries []string) (state, resul
 
 	vm := interpreter.NewVM(data16, 98, 35)
 
-	steps := 0
-	for !vm.Halted {
-		_, err := vm.Step()
-
-		if err != nil {
-			state = aurora.Red("FAIL").String()
-			result = "Error during VM step, " + err.Error()
-			return
+	var executed []int64
+	if r.coverage != nil {
+		executed = make([]int64, len(data16))
+		vm.InstructionCallback = func(addr uint16) {
+			executed[addr]++
 		}
+	}
 
-		steps++
+	// BRK has no meaning in tests
+	limits := r.opts.Limits
+	limits.Brk = interpreter.BrkIgnore
 
-		if steps > 100000 {
-			state = aurora.Red("FAIL").String()
-			result = "Timeout during VM execution"
-			return
-		}
+	// Stop right after exceeding the expected step count
+	stepLimitExpected := header.maxSteps > 0 && (limits.MaxSteps == 0 || header.maxSteps < limits.MaxSteps)
+	if stepLimitExpected {
+		limits.MaxSteps = header.maxSteps + 1
 	}
 
-	// Validate result
-	reg := interpreter.GetReg(vm, register<<4, 0x00F0)
-	if reg.Value != expected {
-		state = aurora.Red("FAIL").String()
-		result = fmt.Sprintf("Value mismatch, actual: %d, expected: %d", reg.Value, expected)
-		return
-	}
+	var keyboard interpreter.KeyboardFeeder
+	keyboard.Type(header.input)
 
-	state = aurora.Green("PASS").String()
-	result = fmt.Sprintf("Expected value (%d) matched, steps: %d", expected, steps)
-	instructions = steps
+	execution := interpreter.StartExecution(vm, limits)
 
-	return
-}
+	reason, err := execution.Run(func() bool {
+		keyboard.Feed(vm)
+		return false
+	})
+	result.Steps = execution.Steps
 
-// Call self in assemble mode to generate binary output from input file (for use with VM)
-func callMcpcAssembler(input, output string, libraries []string) (success bool, mcpcLog string) {
-	for i := 0; i < len(libraries); i++ {
-		libraries[i] = "--library=" + libraries[i]
+	if r.coverage != nil {
+		r.coverage.add(debug, data16, executed)
 	}
 
-	parameter := append([]string{"assemble", "--debug-symbols", input, output}, libraries...)
-	cmd := exec.Command(os.Args[0], parameter...)
-	out, err := cmd.CombinedOutput()
-	if err == nil {
-		mcpcLog = string(out)
-		success = true
-	} else {
-		if out == nil || len(out) == 0 {
-			mcpcLog = err.Error()
-		} else {
-			mcpcLog = string(out)
-		}
-
-		success = false
+	if err != nil {
+		fail("Error during VM step, " + err.Error())
+		return
 	}
 
-	return
-}
-
-// Extract data from "(;|//)autotest (reg|val)=(0x)?\d" header
-func extractAutotestHeader(fileContents string) (valid bool, register, expected uint16) {
-
-	registerMatch := regexpRegister.FindAllStringSubmatch(fileContents, 1)
-	if registerMatch == nil {
-		return false, 0, 0
+	if reason == interpreter.StopStepLimit && stepLimitExpected {
+		fail(fmt.Sprintf("Step count exceeded, expected at most %d steps", header.maxSteps))
+		return
 	}
 
-	expectedMatch := regexpExpected.FindAllStringSubmatch(fileContents, 1)
-	if expectedMatch == nil {
-		return false, 0, 0
+	if reason == interpreter.StopStepLimit || reason == interpreter.StopTimeout {
+		fail(fmt.Sprintf("Timeout during VM execution (%s after %d steps)", strings.ToLower(reason.String()), result.Steps))
+		return
 	}
 
-	if strings.HasPrefix(expectedMatch[0][1], "0x") {
-		regTmp, err := strconv.ParseUint(expectedMatch[0][1][2:], 16, 16)
-		if err != nil {
-			return false, 0, 0
-		} else {
-			expected = uint16(regTmp)
-		}
-	} else {
-		regTmp, err := strconv.ParseUint(expectedMatch[0][1], 10, 16)
-		if err != nil {
-			return false, 0, 0
-		} else {
-			expected = uint16(regTmp)
+	// Validate result
+	mismatches := make([]string, 0)
+	for _, a := range header.assertions {
+		if mismatch := a(vm); mismatch != "" {
+			mismatches = append(mismatches, mismatch)
 		}
 	}
 
-	if strings.HasPrefix(registerMatch[0][1], "0x") {
-		regTmp, err := strconv.ParseUint(registerMatch[0][1][2:], 16, 16)
-		if err != nil {
-			return false, 0, 0
-		} else {
-			register = uint16(regTmp)
-		}
-	} else {
-		regTmp, err := strconv.ParseUint(registerMatch[0][1], 10, 16)
-		if err != nil {
-			return false, 0, 0
-		} else {
-			register = uint16(regTmp)
-		}
+	if len(mismatches) > 0 {
+		fail(strings.Join(mismatches, "; "))
+		return
 	}
 
-	return true, register, expected
+	passed := len(header.assertions)
+	if header.maxSteps > 0 {
+		passed++
+	}
+
+	result.Status = StatusPass
+	result.Message = fmt.Sprintf("%d assertion(s) passed, steps: %d", passed, result.Steps)
 }
 
-func copyFile(dst, src string) error {
-	s, err := os.Open(src)
-	if err != nil {
-		return err
-	}
-	// no need to check errors on read only file, we already got everything
-	// we need from the filesystem, so nothing can go wrong now.
-	defer s.Close()
-	d, err := os.Create(dst)
+// Assemble input file in-process to generate binary output (for use with VM) and debug info
+func callAssembler(input string, libraries []string) (assembly []byte, debug *debuginfo.DebugInfo, success bool, mcpcLog string) {
+	logWriter := bytes.NewBufferString("")
+
+	result, err := assembler.Compile(assembler.Options{
+		File:      input,
+		Libraries: libraries,
+		Log:       log.New(logWriter, log.Prefix(), log.Flags()),
+	})
+
 	if err != nil {
-		return err
+		return nil, nil, false, logWriter.String() + err.Error()
 	}
-	if _, err := io.Copy(d, s); err != nil {
-		d.Close()
-		return err
-	}
-	return d.Close()
+
+	return result.Binary, result.Debug, true, logWriter.String()
 }
@@ -0,0 +1,275 @@
+package autotest
+
+import (
+	"fmt"
+	"html"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/PiMaker/MCPC-Software/debuginfo"
+)
+
+// Coverage collects the source lines executed by all tests of a suite, tests may report concurrently
+type Coverage struct {
+	mutex sync.Mutex
+	files map[string]*coverageFile
+
+	// Source files that only exist while a test runs (generated .ma files), they are not reported
+	ignored map[string]bool
+}
+
+type coverageFile struct {
+	path  string
+	lines []string
+
+	// Execution count per instrumented line (1-based), lines without any emitted words are not included
+	hits map[int]int64
+}
+
+// NewCoverage creates an empty coverage collection
+func NewCoverage() *Coverage {
+	return &Coverage{
+		files:   make(map[string]*coverageFile),
+		ignored: make(map[string]bool),
+	}
+}
+
+func (c *Coverage) ignore(file string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.ignored[coveragePath(file)] = true
+}
+
+func coveragePath(file string) string {
+	if abs, err := filepath.Abs(file); err == nil {
+		return abs
+	}
+
+	return filepath.Clean(file)
+}
+
+func (c *Coverage) file(info *debuginfo.DebugInfo, index int) *coverageFile {
+	if index < 0 || index >= len(info.Files) {
+		return nil
+	}
+
+	path := coveragePath(info.Files[index].Path)
+	if c.ignored[path] {
+		return nil
+	}
+
+	f, ok := c.files[path]
+	if !ok {
+		f = &coverageFile{
+			path:  path,
+			lines: info.Files[index].Lines,
+			hits:  make(map[int]int64),
+		}
+		c.files[path] = f
+	}
+
+	return f
+}
+
+// Adds the execution counts of a single test run (per EEPROM address) to the collection.
+// The immediate word of a SET counts as executed together with the SET itself. Every source line is counted with
+// the execution count of its most often executed word, so a line expanding to several instructions is not counted multiple times.
+func (c *Coverage) add(info *debuginfo.DebugInfo, program []uint16, executed []int64) {
+	counts := make([]int64, len(executed))
+	copy(counts, executed)
+
+	for addr, n := range executed {
+		if n > 0 && program[addr]&0x000F == 0x6 && addr+1 < len(counts) && counts[addr+1] < n {
+			counts[addr+1] = n
+		}
+	}
+
+	type lineKey struct {
+		file *coverageFile
+		line int
+	}
+
+	lines := make(map[lineKey]int64)
+	record := func(f *coverageFile, line int, n int64) {
+		if f == nil || line < 1 {
+			return
+		}
+
+		key := lineKey{f, line}
+		if current, ok := lines[key]; !ok || n > current {
+			lines[key] = n
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, w := range info.Words {
+		if int(w.Addr) >= len(counts) {
+			continue
+		}
+
+		n := counts[w.Addr]
+		record(c.file(info, w.File), w.Line, n)
+		if w.Mscr != nil {
+			record(c.file(info, w.Mscr.File), w.Mscr.Line, n)
+		}
+	}
+
+	for key, n := range lines {
+		key.file.hits[key.line] += n
+	}
+}
+
+func (f *coverageFile) covered() (covered, total int) {
+	for _, n := range f.hits {
+		total++
+		if n > 0 {
+			covered++
+		}
+	}
+
+	return
+}
+
+func (c *Coverage) sortedFiles() []*coverageFile {
+	files := make([]*coverageFile, 0, len(c.files))
+	for _, f := range c.files {
+		files = append(files, f)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].path < files[j].path
+	})
+
+	return files
+}
+
+func (f *coverageFile) sortedLines() []int {
+	lines := make([]int, 0, len(f.hits))
+	for line := range f.hits {
+		lines = append(lines, line)
+	}
+
+	sort.Ints(lines)
+	return lines
+}
+
+func percent(covered, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+
+	return float64(covered) * 100 / float64(total)
+}
+
+// Summary returns one line per source file with the number of covered lines, and a total
+func (c *Coverage) Summary() []string {
+	retval := make([]string, 0)
+	coveredTotal, linesTotal := 0, 0
+
+	for _, f := range c.sortedFiles() {
+		covered, total := f.covered()
+		coveredTotal += covered
+		linesTotal += total
+
+		retval = append(retval, fmt.Sprintf("%6.2f%% (%d/%d lines) %s", percent(covered, total), covered, total, f.path))
+	}
+
+	retval = append(retval, fmt.Sprintf("%6.2f%% (%d/%d lines) total", percent(coveredTotal, linesTotal), coveredTotal, linesTotal))
+	return retval
+}
+
+// Write saves the coverage report to dir, as LCOV tracefile (lcov.info) and HTML (index.html)
+func (c *Coverage) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "lcov.info"), []byte(c.lcov()), 0664); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte(c.html()), 0664)
+}
+
+func (c *Coverage) lcov() string {
+	var sb strings.Builder
+
+	sb.WriteString("TN:autotest\n")
+	for _, f := range c.sortedFiles() {
+		sb.WriteString("SF:" + f.path + "\n")
+
+		for _, line := range f.sortedLines() {
+			sb.WriteString(fmt.Sprintf("DA:%d,%d\n", line, f.hits[line]))
+		}
+
+		covered, total := f.covered()
+		sb.WriteString(fmt.Sprintf("LH:%d\nLF:%d\nend_of_record\n", covered, total))
+	}
+
+	return sb.String()
+}
+
+func (c *Coverage) html() string {
+	var sb strings.Builder
+
+	sb.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>MCPC autotest coverage</title>
+<style>
+body { font-family: sans-serif; }
+table.summary td { padding: 2px 12px 2px 0; }
+pre { margin: 0; }
+.src { border-collapse: collapse; font-family: monospace; }
+.src td { padding: 0 8px; white-space: pre; }
+.num { color: #888; text-align: right; }
+.hit { background: #d4f7d4; }
+.miss { background: #f7d4d4; }
+</style>
+</head>
+<body>
+<h1>MCPC autotest coverage</h1>
+<table class="summary">
+`)
+
+	files := c.sortedFiles()
+	for i, f := range files {
+		covered, total := f.covered()
+		sb.WriteString(fmt.Sprintf("<tr><td><a href=\"#file%d\">%s</a></td><td>%.2f%%</td><td>%d/%d lines</td></tr>\n", i, html.EscapeString(f.path), percent(covered, total), covered, total))
+	}
+
+	sb.WriteString("</table>\n")
+
+	for i, f := range files {
+		covered, total := f.covered()
+		sb.WriteString(fmt.Sprintf("<h2 id=\"file%d\">%s (%.2f%%)</h2>\n<table class=\"src\">\n", i, html.EscapeString(f.path), percent(covered, total)))
+
+		for l, text := range f.lines {
+			line := l + 1
+			class, count := "", ""
+			if n, ok := f.hits[line]; ok {
+				count = fmt.Sprintf("%d", n)
+				if n > 0 {
+					class = " class=\"hit\""
+				} else {
+					class = " class=\"miss\""
+				}
+			}
+
+			sb.WriteString(fmt.Sprintf("<tr%s><td class=\"num\">%d</td><td class=\"num\">%s</td><td>%s</td></tr>\n", class, line, count, html.EscapeString(text)))
+		}
+
+		sb.WriteString("</table>\n")
+	}
+
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
@@ -0,0 +1,385 @@
+package autotest
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PiMaker/MCPC-Software/interpreter"
+	"github.com/PiMaker/MCPC-Software/mscr/compiler"
+)
+
+// Every line starting with ";autotest" is part of the header, up to the first ';' that is not part of a quoted string.
+// Only whitespace may follow that terminating ';'.
+// A line consists of space-separated key=value pairs, values can be quoted (Go syntax, e.g. "help\n"):
+//
+//	reg=<register> val=<value>                Register (number or name, e.g. 0 or A) equals value
+//	mem=[<page>:]<addr> val=<value>[,...]     SRAM words starting at addr equal the given values (or the characters of a quoted string)
+//	vga="<text>"                              VGA output contains text (rows separated by \n, without trailing spaces)
+//	fault=<n>                                 VM halted with fault n (H = 0xFA00 | n)
+//	maxsteps=<n>                              VM halted after at most n steps
+//	maxsize=<n>                               Assembled program (including bootloader) is at most n words long
+//	input="<text>"                            Text typed on the keyboard once the program enables IRQs
+//	passes=<pass>[,<pass>...]                 Compile (MSCR only) with just these optimization passes instead of the runner's -O level, "none" for no passes
+//
+// Values are decimal (may be negative) or hexadecimal with 0x prefix. There can be any number of assertions per test.
+var regexpHeaderLine = regexp.MustCompile(`(?m)^;autotest\b(.*)$`)
+
+var headerRegisterNames = []string{"A", "B", "C", "D", "E", "F", "G", "H", "SCR1", "SCR2", "SP", "PC"}
+
+// Checks the VM state after a test run; Returns a description of the mismatch, or an empty string if the state is as expected
+type assertion func(vm *interpreter.VM) string
+
+type autotestHeader struct {
+	assertions []assertion
+
+	// Keyboard input, empty for none
+	input string
+
+	// Maximum number of steps the test may take, 0 for no limit
+	maxSteps int64
+
+	// Optimization passes to compile with, nil to use the runner's optimizations
+	passes []string
+}
+
+// Parses all autotest header lines of a test file
+func parseAutotestHeader(fileContents string) (*autotestHeader, error) {
+	header := &autotestHeader{}
+
+	lines := regexpHeaderLine.FindAllStringSubmatch(fileContents, -1)
+	if len(lines) == 0 {
+		return nil, errors.New("No autotest header")
+	}
+
+	for _, line := range lines {
+		pairs, err := splitHeaderLine(line[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if err := header.parsePairs(pairs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(header.assertions) == 0 && header.maxSteps == 0 {
+		return nil, errors.New("No assertions in autotest header")
+	}
+
+	return header, nil
+}
+
+// Splits a header line into key/value pairs, stopping at the terminating ';'
+func splitHeaderLine(line string) ([][2]string, error) {
+	pairs := make([][2]string, 0)
+	pos := 0
+
+	for {
+		for pos < len(line) && (line[pos] == ' ' || line[pos] == '\t') {
+			pos++
+		}
+
+		if pos < len(line) && line[pos] == ';' {
+			if trailing := strings.TrimSpace(line[pos+1:]); trailing != "" {
+				return nil, fmt.Errorf("Unexpected text '%s' after ';' in autotest header", trailing)
+			}
+
+			return pairs, nil
+		}
+
+		if pos >= len(line) || line[pos] == '\r' {
+			return pairs, nil
+		}
+
+		eq := strings.IndexByte(line[pos:], '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("Expected key=value in autotest header, found '%s'", strings.TrimSpace(line[pos:]))
+		}
+
+		key := strings.ToLower(line[pos : pos+eq])
+		pos += eq + 1
+
+		var value string
+		if pos < len(line) && line[pos] == '"' {
+			// Quoted string, find the closing quote
+			end := pos + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(line) {
+				return nil, fmt.Errorf("Unterminated string for '%s' in autotest header", key)
+			}
+
+			unquoted, err := strconv.Unquote(line[pos : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid string for '%s' in autotest header: %s", key, err.Error())
+			}
+
+			value = "\"" + unquoted
+			pos = end + 1
+		} else {
+			end := pos
+			for end < len(line) && line[end] != ' ' && line[end] != '\t' && line[end] != ';' && line[end] != '\r' {
+				end++
+			}
+
+			value = line[pos:end]
+			pos = end
+		}
+
+		pairs = append(pairs, [2]string{key, value})
+	}
+}
+
+// Returns the contents of a quoted value (marked with a leading '"' by splitHeaderLine)
+func quotedValue(key, value string) (string, error) {
+	if !strings.HasPrefix(value, "\"") {
+		return "", fmt.Errorf("Expected a quoted string for '%s' in autotest header", key)
+	}
+
+	return value[1:], nil
+}
+
+func (h *autotestHeader) parsePairs(pairs [][2]string) error {
+	// reg= or mem= waiting for its val=
+	var pendingKey string
+	var pendingTarget string
+
+	for _, pair := range pairs {
+		key, value := pair[0], pair[1]
+
+		if pendingKey != "" && key != "val" {
+			return fmt.Errorf("Missing val= for %s=%s in autotest header", pendingKey, pendingTarget)
+		}
+
+		switch key {
+		case "reg", "mem":
+			pendingKey, pendingTarget = key, value
+
+		case "val":
+			var a assertion
+			var err error
+			switch pendingKey {
+			case "reg":
+				a, err = registerAssertion(pendingTarget, value)
+			case "mem":
+				a, err = memoryAssertion(pendingTarget, value)
+			default:
+				return errors.New("val= without preceding reg= or mem= in autotest header")
+			}
+
+			if err != nil {
+				return err
+			}
+
+			h.assertions = append(h.assertions, a)
+			pendingKey = ""
+
+		case "vga":
+			text, err := quotedValue(key, value)
+			if err != nil {
+				return err
+			}
+
+			h.assertions = append(h.assertions, func(vm *interpreter.VM) string {
+				if !strings.Contains(vm.VgaText(), text) {
+					return fmt.Sprintf("VGA output does not contain %q", text)
+				}
+				return ""
+			})
+
+		case "fault":
+			n, err := parseHeaderValue(value)
+			if err != nil || n > 0xFF {
+				return fmt.Errorf("Invalid fault code '%s' in autotest header", value)
+			}
+
+			expected := 0xFA00 | n
+			h.assertions = append(h.assertions, func(vm *interpreter.VM) string {
+				if !vm.Halted || vm.RegDef.H.Value != expected {
+					return fmt.Sprintf("Expected fault 0x%X (H = 0x%04X), actual H: 0x%04X", n, expected, vm.RegDef.H.Value)
+				}
+				return ""
+			})
+
+		case "maxsteps":
+			n, err := strconv.ParseInt(value, 0, 64)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid step count '%s' in autotest header", value)
+			}
+
+			h.maxSteps = n
+
+		case "maxsize":
+			n, err := strconv.ParseInt(value, 0, 64)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid program size '%s' in autotest header", value)
+			}
+
+			h.assertions = append(h.assertions, func(vm *interpreter.VM) string {
+				if int64(len(vm.EEPROM)) > n {
+					return fmt.Sprintf("Program too large, expected at most %d words, actual: %d words", n, len(vm.EEPROM))
+				}
+				return ""
+			})
+
+		case "input":
+			text, err := quotedValue(key, value)
+			if err != nil {
+				return err
+			}
+
+			h.input += text
+
+		case "passes":
+			h.passes = make([]string, 0)
+			if value == "none" {
+				break
+			}
+
+			for _, name := range strings.Split(value, ",") {
+				if !isOptimizationPass(name) {
+					return fmt.Errorf("Unknown optimization pass '%s' in autotest header (available: %s)", name, strings.Join(compiler.OptimizationPassNames(), ", "))
+				}
+
+				h.passes = append(h.passes, name)
+			}
+
+		default:
+			return fmt.Errorf("Unknown key '%s' in autotest header", key)
+		}
+	}
+
+	if pendingKey != "" {
+		return fmt.Errorf("Missing val= for %s=%s in autotest header", pendingKey, pendingTarget)
+	}
+
+	return nil
+}
+
+// Returns the optimizations to compile the test with, based on those of the runner.
+// Passes disabled for the run stay disabled, so a test bound to a single pass fails if that pass is turned off.
+func (h *autotestHeader) optimizations(base compiler.Optimizations) compiler.Optimizations {
+	if h.passes == nil {
+		return base
+	}
+
+	return compiler.Optimizations{
+		Level:           0,
+		Enable:          h.passes,
+		Disable:         base.Disable,
+		InlineThreshold: base.InlineThreshold,
+	}
+}
+
+func isOptimizationPass(name string) bool {
+	for _, n := range compiler.OptimizationPassNames() {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses a decimal (optionally negative) or hexadecimal (0x prefix) 16 bit value
+func parseHeaderValue(value string) (uint16, error) {
+	if strings.HasPrefix(value, "0x") {
+		v, err := strconv.ParseUint(value[2:], 16, 16)
+		return uint16(v), err
+	}
+
+	v, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < -0x8000 || v > 0xFFFF {
+		return 0, fmt.Errorf("Value %d out of range", v)
+	}
+
+	return uint16(v), nil
+}
+
+func registerAssertion(register, value string) (assertion, error) {
+	index := -1
+	for i, name := range headerRegisterNames {
+		if strings.ToUpper(register) == name {
+			index = i
+		}
+	}
+
+	if index < 0 {
+		n, err := parseHeaderValue(register)
+		if err != nil || n > 0xF {
+			return nil, fmt.Errorf("Invalid register '%s' in autotest header", register)
+		}
+		index = int(n)
+	}
+
+	expected, err := parseHeaderValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value '%s' for register %s in autotest header", value, register)
+	}
+
+	return func(vm *interpreter.VM) string {
+		reg := interpreter.GetReg(vm, uint16(index)<<4, 0x00F0)
+		if reg.Value != expected {
+			return fmt.Sprintf("Value mismatch in register %s, actual: %d, expected: %d", register, reg.Value, expected)
+		}
+		return ""
+	}, nil
+}
+
+func memoryAssertion(location, value string) (assertion, error) {
+	page := uint16(0)
+	addrText := location
+	if colon := strings.IndexByte(location, ':'); colon >= 0 {
+		p, err := parseHeaderValue(location[:colon])
+		if err != nil || uint(p) >= interpreter.SRAMPageCount {
+			return nil, fmt.Errorf("Invalid SRAM page in '%s' in autotest header", location)
+		}
+		page = p
+		addrText = location[colon+1:]
+	}
+
+	addr, err := parseHeaderValue(addrText)
+	if err != nil || addr > interpreter.MaxSRAMValue {
+		return nil, fmt.Errorf("Invalid SRAM address '%s' in autotest header", location)
+	}
+
+	expected := make([]uint16, 0)
+	if strings.HasPrefix(value, "\"") {
+		for _, r := range value[1:] {
+			expected = append(expected, uint16(r))
+		}
+	} else {
+		for _, v := range strings.Split(value, ",") {
+			n, err := parseHeaderValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value '%s' for SRAM address %s in autotest header", v, location)
+			}
+			expected = append(expected, n)
+		}
+	}
+
+	if int(addr)+len(expected) > int(interpreter.MaxSRAMValue)+1 {
+		return nil, fmt.Errorf("SRAM range starting at %s exceeds the page in autotest header", location)
+	}
+
+	return func(vm *interpreter.VM) string {
+		base := uint(page)<<16 | uint(addr)
+		for i, v := range expected {
+			if actual := vm.SRAM[base+uint(i)]; actual != v {
+				return fmt.Sprintf("Value mismatch in SRAM at %X:%04X, actual: %d, expected: %d", page, uint(addr)+uint(i), actual, v)
+			}
+		}
+		return ""
+	}, nil
+}
@@ -0,0 +1,186 @@
+package autotest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+)
+
+// Test types, by file extension
+const (
+	TypeMSCR      = "MSCR"
+	TypeAssembler = "Assembler"
+)
+
+// TestStatus is the outcome of a single test
+type TestStatus string
+
+// Possible test outcomes; Tests with an invalid header and files that are not tests are skipped
+const (
+	StatusPass          TestStatus = "pass"
+	StatusFail          TestStatus = "fail"
+	StatusInvalidHeader TestStatus = "invalid_header"
+	StatusSkip          TestStatus = "skip"
+)
+
+// TestResult is the outcome of a single test file
+type TestResult struct {
+	Name    string     `json:"name"`
+	Type    string     `json:"type"`
+	Status  TestStatus `json:"status"`
+	Message string     `json:"message"`
+	Steps   int64      `json:"steps"`
+
+	Duration time.Duration `json:"-"`
+
+	// Output of the compiler or assembler, only set if the test could not be built
+	Log string `json:"log,omitempty"`
+
+	// Describes the build step Log belongs to
+	logSource string
+}
+
+// Summary holds the results of all tests of an autotest run, in file order
+type Summary struct {
+	Dir string
+
+	Total   int
+	Passed  int
+	Failed  int
+	Skipped int
+
+	// Number of steps executed by all passed tests
+	PerfTrace int64
+
+	Duration time.Duration
+	Tests    []*TestResult
+}
+
+func (s *Summary) add(result *TestResult) {
+	s.Total++
+
+	switch result.Status {
+	case StatusPass:
+		s.Passed++
+		s.PerfTrace += result.Steps
+	case StatusFail:
+		s.Failed++
+	default:
+		s.Skipped++
+	}
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnit saves the results as JUnit XML report, with one test suite for the test directory
+func (s *Summary) WriteJUnit(file string) error {
+	suite := junitTestSuite{
+		Name:     filepath.Base(filepath.Clean(s.Dir)),
+		Tests:    s.Total,
+		Failures: s.Failed,
+		Skipped:  s.Skipped,
+		Time:     junitSeconds(s.Duration),
+		Cases:    make([]junitTestCase, 0, len(s.Tests)),
+	}
+
+	for _, t := range s.Tests {
+		c := junitTestCase{
+			Name:      t.Name,
+			Classname: "autotest." + t.Type,
+			Time:      junitSeconds(t.Duration),
+			SystemOut: t.Log,
+		}
+
+		if t.Type == "" {
+			c.Classname = "autotest"
+		}
+
+		switch t.Status {
+		case StatusFail:
+			c.Failure = &junitMessage{Message: t.Message, Text: t.Message}
+		case StatusInvalidHeader, StatusSkip:
+			c.Skipped = &junitMessage{Message: t.Message}
+		}
+
+		suite.Cases = append(suite.Cases, c)
+	}
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, append([]byte(xml.Header), append(data, '\n')...), 0664)
+}
+
+// WriteJSON saves the results as JSON report; Durations are given in seconds
+func (s *Summary) WriteJSON(file string) error {
+	type jsonTest struct {
+		*TestResult
+		Time float64 `json:"time"`
+	}
+
+	report := struct {
+		Dir       string     `json:"dir"`
+		Total     int        `json:"total"`
+		Passed    int        `json:"passed"`
+		Failed    int        `json:"failed"`
+		Skipped   int        `json:"skipped"`
+		PerfTrace int64      `json:"perf_trace"`
+		Time      float64    `json:"time"`
+		Tests     []jsonTest `json:"tests"`
+	}{
+		Dir:       s.Dir,
+		Total:     s.Total,
+		Passed:    s.Passed,
+		Failed:    s.Failed,
+		Skipped:   s.Skipped,
+		PerfTrace: s.PerfTrace,
+		Time:      s.Duration.Seconds(),
+		Tests:     make([]jsonTest, 0, len(s.Tests)),
+	}
+
+	for _, t := range s.Tests {
+		report.Tests = append(report.Tests, jsonTest{t, t.Duration.Seconds()})
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, append(data, '\n'), 0664)
+}
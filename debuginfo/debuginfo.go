@@ -0,0 +1,292 @@
+package debuginfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Version of the debug info format written by the assembler
+const Version = 2
+
+// DebugInfo maps emitted words of an MCPC binary back to the source lines they were generated from.
+// It is stored as JSON next to the binary (.msym). Version 1 .msym files ("addr=label;" pairs) can still be loaded, but only contain labels.
+type DebugInfo struct {
+	Version int     `json:"version"`
+	Files   []*File `json:"files"`
+	Labels  []Label `json:"labels"`
+	Words   []Word  `json:"words"`
+
+	wordIndex  map[uint16]*Word
+	labelIndex map[uint16][]string
+}
+
+// File is a source file referenced by the debug info, including its contents at the time of assembling
+type File struct {
+	Path  string   `json:"path"`
+	Lines []string `json:"lines,omitempty"`
+}
+
+// Label is a single assembler label
+type Label struct {
+	Addr uint16 `json:"addr"`
+	Name string `json:"name"`
+}
+
+// Word describes the origin of a single emitted word (EEPROM address)
+type Word struct {
+	Addr uint16 `json:"addr"`
+
+	// .ma origin (index into Files)
+	File int `json:"file"`
+	Line int `json:"line"`
+
+	// .mscr origin, only set if the .ma file was generated by the MSCR compiler
+	Mscr *SourceRef `json:"mscr,omitempty"`
+}
+
+// SourceRef points to a line of a higher-level source file (index into Files)
+type SourceRef struct {
+	File     int    `json:"file"`
+	Line     int    `json:"line"`
+	Function string `json:"function,omitempty"`
+}
+
+// New creates an empty DebugInfo instance
+func New() *DebugInfo {
+	return &DebugInfo{
+		Version: Version,
+		Files:   make([]*File, 0),
+		Labels:  make([]Label, 0),
+		Words:   make([]Word, 0),
+	}
+}
+
+// Load reads a debug info file, supporting both the current and the legacy (label only) format
+func Load(path string) (*DebugInfo, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes debug info data, supporting both the current and the legacy (label only) format
+func Parse(data []byte) (*DebugInfo, error) {
+	trimmed := strings.TrimSpace(string(data))
+
+	if !strings.HasPrefix(trimmed, "{") {
+		return parseLegacy(trimmed), nil
+	}
+
+	info := &DebugInfo{}
+	err := json.Unmarshal([]byte(trimmed), info)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Version > Version {
+		return nil, fmt.Errorf("Unsupported debug info version %d (supported: <= %d)", info.Version, Version)
+	}
+
+	info.buildIndex()
+	return info, nil
+}
+
+// Legacy format: "addr=label;addr=label"
+func parseLegacy(data string) *DebugInfo {
+	info := New()
+	info.Version = 1
+
+	for _, symEntry := range strings.Split(data, ";") {
+		symEntrySplit := strings.Split(symEntry, "=")
+		if len(symEntrySplit) != 2 {
+			continue
+		}
+
+		parsedAddr, err := strconv.ParseUint(symEntrySplit[0], 16, 16)
+		if err == nil {
+			info.Labels = append(info.Labels, Label{
+				Addr: uint16(parsedAddr),
+				Name: symEntrySplit[1],
+			})
+		}
+	}
+
+	info.buildIndex()
+	return info
+}
+
+// Save writes the debug info to a file in the current format
+func (info *DebugInfo) Save(path string) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0664)
+}
+
+// AddFile registers a source file and returns its index. Files are only added once, lines are read from disk if not given.
+func (info *DebugInfo) AddFile(path string, lines []string) int {
+	for i, f := range info.Files {
+		if f.Path == path {
+			return i
+		}
+	}
+
+	if lines == nil {
+		if data, err := ioutil.ReadFile(path); err == nil {
+			lines = strings.Split(strings.Replace(string(data), "\r\n", "\n", -1), "\n")
+		}
+	}
+
+	info.Files = append(info.Files, &File{
+		Path:  path,
+		Lines: lines,
+	})
+
+	return len(info.Files) - 1
+}
+
+// AddLabel adds a label at a given address
+func (info *DebugInfo) AddLabel(addr uint16, name string) {
+	info.Labels = append(info.Labels, Label{
+		Addr: addr,
+		Name: name,
+	})
+	info.labelIndex = nil
+}
+
+// AddWord adds source information for a single emitted word
+func (info *DebugInfo) AddWord(w Word) {
+	info.Words = append(info.Words, w)
+	info.wordIndex = nil
+}
+
+// Sort orders labels and words by address (and labels by name for equal addresses) for stable output
+func (info *DebugInfo) Sort() {
+	sort.SliceStable(info.Labels, func(i, j int) bool {
+		if info.Labels[i].Addr == info.Labels[j].Addr {
+			return info.Labels[i].Name < info.Labels[j].Name
+		}
+		return info.Labels[i].Addr < info.Labels[j].Addr
+	})
+	sort.SliceStable(info.Words, func(i, j int) bool {
+		return info.Words[i].Addr < info.Words[j].Addr
+	})
+	info.buildIndex()
+}
+
+func (info *DebugInfo) buildIndex() {
+	info.wordIndex = make(map[uint16]*Word, len(info.Words))
+	for i := range info.Words {
+		info.wordIndex[info.Words[i].Addr] = &info.Words[i]
+	}
+
+	info.labelIndex = make(map[uint16][]string)
+	for _, l := range info.Labels {
+		info.labelIndex[l.Addr] = append(info.labelIndex[l.Addr], l.Name)
+	}
+}
+
+// LabelsAt returns all labels located at the given address
+func (info *DebugInfo) LabelsAt(addr uint16) []string {
+	if info.labelIndex == nil {
+		info.buildIndex()
+	}
+
+	return info.labelIndex[addr]
+}
+
+// LabelAddr resolves a label name (case-insensitive, leading '.' optional) to its address
+func (info *DebugInfo) LabelAddr(name string) (uint16, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, ".") {
+		name = "." + name
+	}
+
+	for _, l := range info.Labels {
+		if strings.ToUpper(l.Name) == name {
+			return l.Addr, true
+		}
+	}
+
+	return 0, false
+}
+
+// NearestLabel returns the closest label at or before the given address
+func (info *DebugInfo) NearestLabel(addr uint16) (Label, bool) {
+	var best Label
+	found := false
+
+	for _, l := range info.Labels {
+		if l.Addr <= addr && (!found || l.Addr > best.Addr) {
+			best = l
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// Lookup returns the source information for the word at the given address, or nil if none is available
+func (info *DebugInfo) Lookup(addr uint16) *Word {
+	if info.wordIndex == nil {
+		info.buildIndex()
+	}
+
+	return info.wordIndex[addr]
+}
+
+// FilePath returns the path of the file with the given index
+func (info *DebugInfo) FilePath(file int) string {
+	if file < 0 || file >= len(info.Files) {
+		return "?"
+	}
+
+	return info.Files[file].Path
+}
+
+// SourceLine returns the text of line (1-based) in the given file, or an empty string if unavailable
+func (info *DebugInfo) SourceLine(file, line int) string {
+	if file < 0 || file >= len(info.Files) {
+		return ""
+	}
+
+	lines := info.Files[file].Lines
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+
+	return lines[line-1]
+}
+
+// Location returns a short human-readable description of the source location of addr,
+// preferring the MSCR origin if available (e.g. "entry.mscr:40 (main)")
+func (info *DebugInfo) Location(addr uint16) string {
+	w := info.Lookup(addr)
+	if w == nil {
+		return ""
+	}
+
+	if w.Mscr != nil {
+		return fmt.Sprintf("%s:%d (%s)", baseName(info.FilePath(w.Mscr.File)), w.Mscr.Line, w.Mscr.Function)
+	}
+
+	return fmt.Sprintf("%s:%d", baseName(info.FilePath(w.File)), w.Line)
+}
+
+func baseName(path string) string {
+	i := strings.LastIndexAny(path, "/"+string(os.PathSeparator))
+	if i == -1 {
+		return path
+	}
+
+	return path[i+1:]
+}
@@ -0,0 +1,483 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mileusna/conditional"
+)
+
+// Breakpoint on an instruction address, optionally only triggering if a condition over the registers is met
+type breakpoint struct {
+	id        int
+	addr      uint16
+	label     string
+	condition *bpCondition
+	enabled   bool
+	hits      int
+}
+
+// Watchpoint on a range of SRAM or CFG (including VGA) addresses
+type watchpoint struct {
+	id      int
+	from    uint32
+	to      uint32
+	cfg     bool
+	read    bool
+	write   bool
+	text    string
+	enabled bool
+	hits    int
+}
+
+// Table of all breakpoints and watchpoints of a debugging session; IDs are shared between both kinds
+type breakpointTable struct {
+	breakpoints []*breakpoint
+	watchpoints []*watchpoint
+	nextID      int
+
+	// Description of the last watchpoint hit, reset via takeHit
+	hit string
+}
+
+func newBreakpointTable() *breakpointTable {
+	return &breakpointTable{
+		breakpoints: make([]*breakpoint, 0),
+		watchpoints: make([]*watchpoint, 0),
+		nextID:      1,
+	}
+}
+
+// Adds a breakpoint; "location" is a hex address or a label, "condition" may be empty
+func (t *breakpointTable) addBreakpoint(location, condition string) (*breakpoint, error) {
+	addr, label, err := parseLocation(location)
+	if err != nil {
+		return nil, err
+	}
+
+	bp := &breakpoint{
+		id:      t.nextID,
+		addr:    addr,
+		label:   label,
+		enabled: true,
+	}
+
+	if strings.TrimSpace(condition) != "" {
+		bp.condition, err = parseCondition(condition)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.nextID++
+	t.breakpoints = append(t.breakpoints, bp)
+
+	return bp, nil
+}
+
+// Adds a watchpoint; "location" is an address ([page:]addr for SRAM, page 0 if omitted; CFG/VGA addresses are >= 0x8000)
+// or a range of addresses (from-to), "mode" is one of r, w or rw
+func (t *breakpointTable) addWatchpoint(location, mode string) (*watchpoint, error) {
+	wp := &watchpoint{
+		id:      t.nextID,
+		text:    location,
+		enabled: true,
+	}
+
+	switch strings.ToLower(mode) {
+	case "r":
+		wp.read = true
+	case "", "w":
+		wp.write = true
+	case "rw", "wr":
+		wp.read = true
+		wp.write = true
+	default:
+		return nil, fmt.Errorf("Invalid watchpoint mode '%s' (expected r, w or rw)", mode)
+	}
+
+	from, to := location, location
+	if split := strings.SplitN(location, "-", 2); len(split) == 2 {
+		from, to = split[0], split[1]
+	}
+
+	var fromCfg, toCfg bool
+	var err error
+
+	wp.from, fromCfg, err = parseMemoryAddress(from)
+	if err != nil {
+		return nil, err
+	}
+
+	wp.to, toCfg, err = parseMemoryAddress(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromCfg != toCfg || wp.to < wp.from {
+		return nil, fmt.Errorf("Invalid watchpoint range '%s'", location)
+	}
+
+	wp.cfg = fromCfg
+
+	t.nextID++
+	t.watchpoints = append(t.watchpoints, wp)
+
+	return wp, nil
+}
+
+// Deletes the breakpoint or watchpoint with the given ID
+func (t *breakpointTable) remove(id int) bool {
+	for i, bp := range t.breakpoints {
+		if bp.id == id {
+			t.breakpoints = append(t.breakpoints[:i], t.breakpoints[i+1:]...)
+			return true
+		}
+	}
+
+	for i, wp := range t.watchpoints {
+		if wp.id == id {
+			t.watchpoints = append(t.watchpoints[:i], t.watchpoints[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Enables or disables the breakpoint or watchpoint with the given ID
+func (t *breakpointTable) setEnabled(id int, enabled bool) bool {
+	for _, bp := range t.breakpoints {
+		if bp.id == id {
+			bp.enabled = enabled
+			return true
+		}
+	}
+
+	for _, wp := range t.watchpoints {
+		if wp.id == id {
+			wp.enabled = enabled
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the enabled breakpoint at the current PC whose condition is met (and counts the hit), or nil
+func (t *breakpointTable) hitBreakpoint(vm *VM) *breakpoint {
+	pc := vm.Registers().PC.Value
+	for _, bp := range t.breakpoints {
+		if bp.enabled && bp.addr == pc && (bp.condition == nil || bp.condition.eval(vm.Registers())) {
+			bp.hits++
+			return bp
+		}
+	}
+
+	return nil
+}
+
+// Returns whether there is a (enabled or disabled) breakpoint at the given address, and if it is enabled
+func (t *breakpointTable) breakpointState(addr uint16) (bool, bool) {
+	found := false
+	for _, bp := range t.breakpoints {
+		if bp.addr == addr {
+			if bp.enabled {
+				return true, true
+			}
+			found = true
+		}
+	}
+
+	return found, false
+}
+
+// MemoryAccessCallback of the VM, records the first watchpoint hit of a step
+func (t *breakpointTable) memoryAccess(vm *VM, addr uint32, cfg, write bool, value uint16) {
+	if t.hit != "" {
+		return
+	}
+
+	if wp := t.watchpointAt(addr, cfg, write); wp != nil {
+		wp.hits++
+		t.hit = fmt.Sprintf("Watchpoint %d (%s): %s %s at PC=0x%04X", wp.id, wp.text,
+			conditional.String(write, "write", "read"), formatAccess(addr, cfg, write, value), vm.Registers().PC.Value)
+	}
+}
+
+func (t *breakpointTable) watchpointAt(addr uint32, cfg, write bool) *watchpoint {
+	for _, wp := range t.watchpoints {
+		if wp.enabled && wp.cfg == cfg && addr >= wp.from && addr <= wp.to && ((write && wp.write) || (!write && wp.read)) {
+			return wp
+		}
+	}
+
+	return nil
+}
+
+// Checks whether the given undone step wrote to a watched address (used when running backwards, only SRAM and VGA writes are recorded)
+func (t *breakpointTable) recordHit(rec *stepRecord) string {
+	for _, w := range rec.sramWrites {
+		if wp := t.watchpointAt(w.addr, false, true); wp != nil {
+			return fmt.Sprintf("Watchpoint %d (%s): write to SRAM[%d:0x%04X] (old value 0x%04X)", wp.id, wp.text, w.addr>>16, w.addr&0xFFFF, w.old)
+		}
+	}
+
+	for _, w := range rec.vgaWrites {
+		if wp := t.watchpointAt(0xE000+w.addr, true, true); wp != nil {
+			return fmt.Sprintf("Watchpoint %d (%s): write to CFG[0x%04X] (old value 0x%04X)", wp.id, wp.text, 0xE000+w.addr, w.old)
+		}
+	}
+
+	return ""
+}
+
+// Returns and resets the description of the last watchpoint hit
+func (t *breakpointTable) takeHit() string {
+	hit := t.hit
+	t.hit = ""
+	return hit
+}
+
+// Human readable list of all breakpoints and watchpoints
+func (t *breakpointTable) String() string {
+	if len(t.breakpoints) == 0 && len(t.watchpoints) == 0 {
+		return "No breakpoints or watchpoints set."
+	}
+
+	lines := make([]string, 0)
+	for _, bp := range t.breakpoints {
+		line := fmt.Sprintf("#%d break 0x%04X", bp.id, bp.addr)
+		if bp.label != "" {
+			line += " (" + bp.label + ")"
+		}
+		if bp.condition != nil {
+			line += " if " + bp.condition.text
+		}
+		lines = append(lines, line+fmt.Sprintf(" [%s, %d hits]", conditional.String(bp.enabled, "enabled", "disabled"), bp.hits))
+	}
+
+	for _, wp := range t.watchpoints {
+		mode := conditional.String(wp.read, "r", "") + conditional.String(wp.write, "w", "")
+		lines = append(lines, fmt.Sprintf("#%d watch %s %s [%s, %d hits]", wp.id, wp.text, mode, conditional.String(wp.enabled, "enabled", "disabled"), wp.hits))
+	}
+
+	return strings.Join(lines, " :: ")
+}
+
+func formatAccess(addr uint32, cfg, write bool, value uint16) string {
+	target := fmt.Sprintf("SRAM[%d:0x%04X]", addr>>16, addr&0xFFFF)
+	if cfg {
+		target = fmt.Sprintf("CFG[0x%04X]", addr)
+	}
+
+	return target + conditional.String(write, " <- ", " == ") + fmt.Sprintf("0x%04X", value)
+}
+
+// Parses an instruction address, either as a label from the loaded debug symbols or as hex number (0x prefix optional).
+// Returns the address and the label name if it was resolved from a label.
+func parseLocation(location string) (uint16, string, error) {
+	hex := strings.HasPrefix(strings.ToLower(location), "0x")
+
+	// Labels take precedence over numbers without 0x prefix (e.g. "add")
+	if debugInfo != nil && !hex {
+		if labelAddr, ok := debugInfo.LabelAddr(location); ok {
+			return labelAddr, location, nil
+		}
+	}
+
+	addr, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(location), "0x"), 16, 16)
+	if err == nil {
+		return uint16(addr), "", nil
+	}
+
+	if debugInfo == nil {
+		return 0, "", fmt.Errorf("'%s' is not a valid address, and no symbols are loaded to resolve it as a label", location)
+	}
+
+	return 0, "", fmt.Errorf("'%s' is neither a valid address nor a known label", location)
+}
+
+// Parses a memory address ([page:]addr or label); Returns the absolute SRAM address or the CFG address (if the address is >= 0x8000)
+func parseMemoryAddress(text string) (uint32, bool, error) {
+	page := uint64(0)
+	if split := strings.SplitN(text, ":", 2); len(split) == 2 {
+		var err error
+		page, err = strconv.ParseUint(strings.TrimPrefix(strings.ToLower(split[0]), "0x"), 16, 16)
+		if err != nil || page >= uint64(SRAMPageCount) {
+			return 0, false, fmt.Errorf("Invalid SRAM page '%s'", split[0])
+		}
+
+		text = split[1]
+	}
+
+	addr, _, err := parseLocation(text)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if (addr & 0x8000) != 0 {
+		if page != 0 {
+			return 0, false, fmt.Errorf("CFG address 0x%04X can not have an SRAM page", addr)
+		}
+
+		return uint32(addr), true, nil
+	}
+
+	return uint32(page)<<16 | uint32(addr), false, nil
+}
+
+// Breakpoint condition over the registers of the current register set, e.g. "A == 0x10 && SP < 0x7F00"
+type bpCondition struct {
+	text string
+	eval func(reg *Registers) bool
+}
+
+var regexpConditionToken = regexp.MustCompile(`^\s*(&&|\|\||==|!=|<=|>=|<|>|\(|\)|[A-Za-z0-9_]+)`)
+
+// Names of the registers usable in conditions, in order of registerList
+var conditionRegisterNames = []string{"A", "B", "C", "D", "E", "F", "G", "H", "SCR1", "SCR2", "SP", "PC"}
+
+// Parses a breakpoint condition. Comparisons (==, !=, <, >, <=, >=) are unsigned, && binds stronger than ||.
+func parseCondition(text string) (*bpCondition, error) {
+	tokens := make([]string, 0)
+	rest := text
+	for strings.TrimSpace(rest) != "" {
+		match := regexpConditionToken.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf("Invalid condition '%s': unexpected '%s'", text, strings.TrimSpace(rest))
+		}
+
+		tokens = append(tokens, match[1])
+		rest = rest[len(match[0]):]
+	}
+
+	p := &conditionParser{tokens: tokens}
+	eval, err := p.or()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected '%s'", p.tokens[p.pos])
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Invalid condition '%s': %s", text, err.Error())
+	}
+
+	return &bpCondition{text: strings.TrimSpace(text), eval: eval}, nil
+}
+
+type conditionParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *conditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *conditionParser) or() (func(*Registers) bool, error) {
+	left, err := p.and()
+	for err == nil && p.peek() == "||" {
+		p.pos++
+
+		var right func(*Registers) bool
+		right, err = p.and()
+
+		l := left
+		left = func(reg *Registers) bool { return l(reg) || right(reg) }
+	}
+
+	return left, err
+}
+
+func (p *conditionParser) and() (func(*Registers) bool, error) {
+	left, err := p.comparison()
+	for err == nil && p.peek() == "&&" {
+		p.pos++
+
+		var right func(*Registers) bool
+		right, err = p.comparison()
+
+		l := left
+		left = func(reg *Registers) bool { return l(reg) && right(reg) }
+	}
+
+	return left, err
+}
+
+func (p *conditionParser) comparison() (func(*Registers) bool, error) {
+	if p.peek() == "(" {
+		p.pos++
+		inner, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != ")" {
+			return nil, errors.New("missing ')'")
+		}
+
+		p.pos++
+		return inner, nil
+	}
+
+	left, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	p.pos++
+
+	right, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "==":
+		return func(reg *Registers) bool { return left(reg) == right(reg) }, nil
+	case "!=":
+		return func(reg *Registers) bool { return left(reg) != right(reg) }, nil
+	case "<":
+		return func(reg *Registers) bool { return left(reg) < right(reg) }, nil
+	case ">":
+		return func(reg *Registers) bool { return left(reg) > right(reg) }, nil
+	case "<=":
+		return func(reg *Registers) bool { return left(reg) <= right(reg) }, nil
+	case ">=":
+		return func(reg *Registers) bool { return left(reg) >= right(reg) }, nil
+	}
+
+	return nil, fmt.Errorf("expected comparison operator, found '%s'", op)
+}
+
+// A register or a hex number (0x prefix optional), like addresses; Register names take precedence, e.g. 0xA for the number
+func (p *conditionParser) value() (func(*Registers) uint16, error) {
+	token := p.peek()
+	p.pos++
+
+	for i, name := range conditionRegisterNames {
+		if strings.ToUpper(token) == name {
+			index := i
+			return func(reg *Registers) uint16 { return registerList(reg)[index].Value }, nil
+		}
+	}
+
+	value, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(token), "0x"), 16, 16)
+	if err != nil {
+		if token == "" {
+			return nil, errors.New("unexpected end of condition")
+		}
+
+		return nil, fmt.Errorf("'%s' is neither a register nor a number", token)
+	}
+
+	return func(*Registers) uint16 { return uint16(value) }, nil
+}
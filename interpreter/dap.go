@@ -0,0 +1,886 @@
+package interpreter
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/PiMaker/MCPC-Software/debuginfo"
+)
+
+// Number of VM steps executed between checks for new requests while running
+const dapRunChunkSize = 4096
+
+// Maximum number of stack words shown in the "Stack" scope
+const dapMaxStackWords = 256
+
+// Instruction word of "STOR PC SP" (MEMW SP PC), which is only emitted by the CALL macro.
+// CALL stores the address of this instruction on the stack, RET returns to the address two words after it.
+const dapCallMarker uint16 = 0xB0A7
+
+// Variable references of the scopes, see scopes request
+const (
+	dapScopeRegisters = 1
+	dapScopeStack     = 2
+)
+
+// Base message of the Debug Adapter Protocol, requests are decoded into this directly
+type dapMessage struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command,omitempty"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+type dapResponse struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+type dapEvent struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+type dapSource struct {
+	Name            string `json:"name,omitempty"`
+	Path            string `json:"path,omitempty"`
+	SourceReference int    `json:"sourceReference,omitempty"`
+}
+
+type dapBreakpoint struct {
+	Verified bool       `json:"verified"`
+	Message  string     `json:"message,omitempty"`
+	Source   *dapSource `json:"source,omitempty"`
+	Line     int        `json:"line,omitempty"`
+}
+
+type dapStackFrame struct {
+	ID                          int        `json:"id"`
+	Name                        string     `json:"name"`
+	Source                      *dapSource `json:"source,omitempty"`
+	Line                        int        `json:"line"`
+	Column                      int        `json:"column"`
+	InstructionPointerReference string     `json:"instructionPointerReference,omitempty"`
+}
+
+type dapVariable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// Execution modes of the VM while it is running
+type dapRunMode int
+
+const (
+	dapModeContinue dapRunMode = iota
+	dapModeStepIn
+	dapModeStepOver
+	dapModeStepOut
+	dapModeStepInstruction
+)
+
+// Source location used for source-level stepping (MSCR line if available, assembler line otherwise)
+type dapLocation struct {
+	file, line int
+	known      bool
+}
+
+type dapServer struct {
+	in  chan *dapMessage
+	out *bufio.Writer
+	seq int
+
+	vm      *VM
+	console *textConsole
+
+	// Breakpoint addresses by source path, as set by the client
+	sourceBreakpoints map[string][]uint16
+	breakpoints       map[uint16]bool
+
+	// Source file paths (index into debug info files), resolved to the local file system
+	sourcePaths []string
+
+	stopOnEntry bool
+	running     bool
+	mode        dapRunMode
+	terminated  bool
+
+	// State at the start of a step request
+	stepLocation dapLocation
+	stepDepth    int
+	stepInIrq    bool
+}
+
+// DAPServer runs a Debug Adapter Protocol server on stdin/stdout, allowing editors to debug binaries in a virtual MCPC.
+// Source-level breakpoints and stepping use the debug symbols (.msym) of the launched program.
+func DAPServer() {
+	server := &dapServer{
+		in:                make(chan *dapMessage, 16),
+		out:               bufio.NewWriter(os.Stdout),
+		sourceBreakpoints: make(map[string][]uint16),
+		breakpoints:       make(map[uint16]bool),
+	}
+
+	go server.readRequests(os.Stdin)
+
+	for !server.terminated {
+		if server.running {
+			select {
+			case req, ok := <-server.in:
+				if !ok {
+					return
+				}
+				server.handle(req)
+			default:
+				server.runChunk()
+			}
+		} else {
+			req, ok := <-server.in
+			if !ok {
+				return
+			}
+			server.handle(req)
+		}
+	}
+}
+
+// Reads requests in "Content-Length" framing, runs as goroutine until EOF
+func (s *dapServer) readRequests(r io.Reader) {
+	defer close(s.in)
+
+	reader := bufio.NewReader(r)
+	for {
+		length := -1
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				if err != io.EOF {
+					log.Println("ERROR: Reading DAP request failed: " + err.Error())
+				}
+				return
+			}
+
+			line = strings.TrimSpace(line)
+			if line == "" {
+				break
+			}
+
+			if strings.HasPrefix(line, "Content-Length:") {
+				length, err = strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+				if err != nil {
+					length = -1
+				}
+			}
+		}
+
+		if length < 0 {
+			log.Println("ERROR: DAP request without valid Content-Length")
+			continue
+		}
+
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			log.Println("ERROR: Reading DAP request failed: " + err.Error())
+			return
+		}
+
+		msg := &dapMessage{}
+		if err := json.Unmarshal(body, msg); err != nil {
+			log.Println("ERROR: Invalid DAP request: " + err.Error())
+			continue
+		}
+
+		if msg.Type == "request" {
+			s.in <- msg
+		}
+	}
+}
+
+func (s *dapServer) send(msg interface{}) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Println("ERROR: Encoding DAP message failed: " + err.Error())
+		return
+	}
+
+	fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n", len(data))
+	s.out.Write(data)
+	s.out.Flush()
+}
+
+func (s *dapServer) respond(req *dapMessage, body interface{}) {
+	s.seq++
+	s.send(&dapResponse{
+		Seq:        s.seq,
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    true,
+		Command:    req.Command,
+		Body:       body,
+	})
+}
+
+func (s *dapServer) respondError(req *dapMessage, message string) {
+	s.seq++
+	s.send(&dapResponse{
+		Seq:        s.seq,
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    false,
+		Command:    req.Command,
+		Message:    message,
+	})
+}
+
+func (s *dapServer) event(event string, body interface{}) {
+	s.seq++
+	s.send(&dapEvent{
+		Seq:   s.seq,
+		Type:  "event",
+		Event: event,
+		Body:  body,
+	})
+}
+
+func (s *dapServer) output(category, text string) {
+	s.event("output", map[string]interface{}{
+		"category": category,
+		"output":   text,
+	})
+}
+
+// io.Writer for the VGA text console, every line is sent as output event
+type dapConsoleWriter struct {
+	server *dapServer
+}
+
+func (w *dapConsoleWriter) Write(p []byte) (int, error) {
+	w.server.output("stdout", string(p))
+	return len(p), nil
+}
+
+func (s *dapServer) handle(req *dapMessage) {
+	// Every request that modifies the VM requires a launched program
+	if s.vm == nil {
+		switch req.Command {
+		case "initialize", "launch", "disconnect", "terminate", "setExceptionBreakpoints", "threads":
+		default:
+			s.respondError(req, "No program launched")
+			return
+		}
+	}
+
+	switch req.Command {
+	case "initialize":
+		s.respond(req, map[string]interface{}{
+			"supportsConfigurationDoneRequest": true,
+			"supportsSetVariable":              true,
+			"supportsSteppingGranularity":      true,
+			"supportsTerminateRequest":         true,
+		})
+
+	case "launch":
+		var args struct {
+			Program     string `json:"program"`
+			Symbols     string `json:"symbols"`
+			StopOnEntry bool   `json:"stopOnEntry"`
+		}
+
+		if err := json.Unmarshal(req.Arguments, &args); err != nil || args.Program == "" {
+			s.respondError(req, "Missing \"program\" (path to .mb file)")
+			return
+		}
+
+		if err := s.launch(args.Program, args.Symbols); err != nil {
+			s.respondError(req, err.Error())
+			return
+		}
+
+		s.stopOnEntry = args.StopOnEntry
+		s.respond(req, nil)
+		s.event("initialized", nil)
+
+	case "setBreakpoints":
+		var args struct {
+			Source      dapSource `json:"source"`
+			Breakpoints []struct {
+				Line int `json:"line"`
+			} `json:"breakpoints"`
+
+			// Deprecated, only used if breakpoints is not given
+			Lines []int `json:"lines"`
+		}
+
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			s.respondError(req, "Invalid arguments: "+err.Error())
+			return
+		}
+
+		if args.Breakpoints != nil {
+			args.Lines = make([]int, 0, len(args.Breakpoints))
+			for _, bp := range args.Breakpoints {
+				args.Lines = append(args.Lines, bp.Line)
+			}
+		}
+
+		s.respond(req, map[string]interface{}{
+			"breakpoints": s.setBreakpoints(args.Source, args.Lines),
+		})
+
+	case "setExceptionBreakpoints":
+		s.respond(req, map[string]interface{}{
+			"breakpoints": []dapBreakpoint{},
+		})
+
+	case "configurationDone":
+		s.respond(req, nil)
+
+		if s.stopOnEntry {
+			s.stopped("entry", "")
+		} else {
+			s.resume(dapModeContinue)
+		}
+
+	case "threads":
+		s.respond(req, map[string]interface{}{
+			"threads": []map[string]interface{}{
+				{"id": 1, "name": "MCPC"},
+			},
+		})
+
+	case "stackTrace":
+		frames := s.stackFrames()
+		s.respond(req, map[string]interface{}{
+			"stackFrames": frames,
+			"totalFrames": len(frames),
+		})
+
+	case "scopes":
+		s.respond(req, map[string]interface{}{
+			"scopes": []map[string]interface{}{
+				{"name": "Registers", "variablesReference": dapScopeRegisters, "expensive": false},
+				{"name": "Stack", "variablesReference": dapScopeStack, "expensive": false},
+			},
+		})
+
+	case "variables":
+		var args struct {
+			VariablesReference int `json:"variablesReference"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+
+		s.respond(req, map[string]interface{}{
+			"variables": s.variables(args.VariablesReference),
+		})
+
+	case "setVariable":
+		var args struct {
+			VariablesReference int    `json:"variablesReference"`
+			Name               string `json:"name"`
+			Value              string `json:"value"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+
+		value, err := s.setVariable(args.VariablesReference, args.Name, args.Value)
+		if err != nil {
+			s.respondError(req, err.Error())
+			return
+		}
+
+		s.respond(req, map[string]interface{}{
+			"value": value,
+		})
+
+	case "source":
+		var args struct {
+			SourceReference int `json:"sourceReference"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+
+		if debugInfo == nil || args.SourceReference < 1 || args.SourceReference > len(debugInfo.Files) {
+			s.respondError(req, "Source not available")
+			return
+		}
+
+		s.respond(req, map[string]interface{}{
+			"content": strings.Join(debugInfo.Files[args.SourceReference-1].Lines, "\n"),
+		})
+
+	case "continue":
+		s.respond(req, map[string]interface{}{
+			"allThreadsContinued": true,
+		})
+		s.resume(dapModeContinue)
+
+	case "next", "stepIn", "stepOut":
+		var args struct {
+			Granularity string `json:"granularity"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+
+		s.respond(req, nil)
+
+		switch {
+		case args.Granularity == "instruction" && req.Command != "stepOut":
+			s.resume(dapModeStepInstruction)
+		case req.Command == "next":
+			s.resume(dapModeStepOver)
+		case req.Command == "stepIn":
+			s.resume(dapModeStepIn)
+		default:
+			s.resume(dapModeStepOut)
+		}
+
+	case "pause":
+		s.respond(req, nil)
+		if s.running {
+			s.stopped("pause", "")
+		}
+
+	case "disconnect", "terminate":
+		s.respond(req, nil)
+		if req.Command == "terminate" {
+			s.event("terminated", nil)
+		}
+		s.terminated = true
+
+	default:
+		s.respondError(req, "Unsupported request: "+req.Command)
+	}
+}
+
+// Loads the program and its debug symbols (defaults to <program>.msym, optional)
+func (s *dapServer) launch(program, symbols string) error {
+	data16, err := loadBinary(program)
+	if err != nil {
+		return errors.New("An error occured reading the program: " + err.Error())
+	}
+
+	debugInfo = nil
+	if symbols == "" {
+		symbols = program + ".msym"
+		if _, err := os.Stat(symbols); err != nil {
+			symbols = ""
+			s.output("console", "No debug symbols found, source-level debugging is not available (assemble with --debug-symbols)\n")
+		}
+	}
+
+	if symbols != "" {
+		info, err := debuginfo.Load(symbols)
+		if err != nil {
+			return errors.New("An error occured reading the debug symbols: " + err.Error())
+		}
+
+		debugInfo = info
+		s.resolveSourcePaths(filepath.Dir(program))
+	}
+
+	s.vm = NewVM(data16, 120, 65)
+	s.console = newTextConsole(s.vm, &dapConsoleWriter{s})
+	s.vm.VgaChangeCallback = func(addr, x, y, old, new uint16) {
+		s.console.write(int(x), int(y), new)
+	}
+
+	return nil
+}
+
+// Source paths in debug info are relative to the directory the program was assembled in;
+// Paths that can not be found from the current directory are looked up relative to the program.
+func (s *dapServer) resolveSourcePaths(programDir string) {
+	s.sourcePaths = make([]string, len(debugInfo.Files))
+
+	for i, f := range debugInfo.Files {
+		candidates := []string{f.Path}
+		if !filepath.IsAbs(f.Path) {
+			candidates = append(candidates, filepath.Join(programDir, f.Path))
+		}
+
+		for _, c := range candidates {
+			if _, err := os.Stat(c); err == nil {
+				if abs, err := filepath.Abs(c); err == nil {
+					s.sourcePaths[i] = abs
+					break
+				}
+			}
+		}
+	}
+}
+
+// Returns the debug info file index of a source path given by the client, or -1 if unknown
+func (s *dapServer) sourceFile(source dapSource) int {
+	if debugInfo == nil {
+		return -1
+	}
+
+	if source.SourceReference > 0 && source.SourceReference <= len(debugInfo.Files) {
+		return source.SourceReference - 1
+	}
+
+	path, err := filepath.Abs(source.Path)
+	if err != nil {
+		path = source.Path
+	}
+
+	for i, p := range s.sourcePaths {
+		if p != "" && p == path {
+			return i
+		}
+	}
+
+	// Fall back to matching file names
+	for i, f := range debugInfo.Files {
+		if filepath.Base(f.Path) == filepath.Base(source.Path) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *dapServer) source(file int) *dapSource {
+	if s.sourcePaths[file] != "" {
+		return &dapSource{
+			Name: filepath.Base(s.sourcePaths[file]),
+			Path: s.sourcePaths[file],
+		}
+	}
+
+	// Not found on disk, the client can request the content stored in the debug info instead
+	return &dapSource{
+		Name:            filepath.Base(debugInfo.FilePath(file)),
+		SourceReference: file + 1,
+	}
+}
+
+// Source location of the given address
+func dapLocationOf(addr uint16) dapLocation {
+	if debugInfo == nil {
+		return dapLocation{}
+	}
+
+	w := debugInfo.Lookup(addr)
+	if w == nil {
+		return dapLocation{}
+	}
+
+	if w.Mscr != nil {
+		return dapLocation{w.Mscr.File, w.Mscr.Line, true}
+	}
+
+	return dapLocation{w.File, w.Line, true}
+}
+
+// Returns the lowest address generated from the given source line, or false if there is none
+func lineAddress(file, line int) (uint16, bool) {
+	var addr uint16
+	found := false
+
+	for _, w := range debugInfo.Words {
+		if (w.Mscr != nil && w.Mscr.File == file && w.Mscr.Line == line) || (w.Mscr == nil && w.File == file && w.Line == line) {
+			if !found || w.Addr < addr {
+				addr = w.Addr
+				found = true
+			}
+		}
+	}
+
+	return addr, found
+}
+
+// Replaces all breakpoints of a source file; Lines without code are moved to the next line that has code
+func (s *dapServer) setBreakpoints(source dapSource, lines []int) []dapBreakpoint {
+	retval := make([]dapBreakpoint, 0, len(lines))
+	addrs := make([]uint16, 0, len(lines))
+
+	file := s.sourceFile(source)
+	for _, line := range lines {
+		if file == -1 {
+			retval = append(retval, dapBreakpoint{Verified: false, Message: "No debug symbols for this file", Line: line})
+			continue
+		}
+
+		bp := dapBreakpoint{Verified: false, Message: "No code generated for this line", Line: line}
+		for l := line; l <= len(debugInfo.Files[file].Lines); l++ {
+			if addr, ok := lineAddress(file, l); ok {
+				addrs = append(addrs, addr)
+				bp = dapBreakpoint{Verified: true, Source: s.source(file), Line: l}
+				break
+			}
+		}
+
+		retval = append(retval, bp)
+	}
+
+	key := source.Path
+	if key == "" {
+		key = strconv.Itoa(source.SourceReference)
+	}
+	s.sourceBreakpoints[key] = addrs
+
+	s.breakpoints = make(map[uint16]bool)
+	for _, addrs := range s.sourceBreakpoints {
+		for _, addr := range addrs {
+			s.breakpoints[addr] = true
+		}
+	}
+
+	return retval
+}
+
+// Returns the stack addresses of all return entries (CALL) on the current stack, innermost first.
+// Stack contents that happen to look like a return address are indistinguishable from actual calls.
+func (s *dapServer) callFrames() []uint16 {
+	retval := make([]uint16, 0)
+
+	page := uint(s.vm.SRAMPage()&SRAMPageMask) << 16
+	for addr := s.vm.Registers().SP.Value; addr > 0 && addr < 0x7FFF; addr++ {
+		value := s.vm.SRAM[page|uint(addr)]
+		if int(value) < len(s.vm.EEPROM) && s.vm.EEPROM[value] == dapCallMarker {
+			retval = append(retval, addr)
+		}
+	}
+
+	// RET jumps to the end of CALL, which pops the return address; The call has already returned at this point
+	pc := s.vm.Registers().PC.Value
+	if len(retval) > 0 && pc >= 2 && int(pc) < len(s.vm.EEPROM) && s.vm.EEPROM[pc-2] == dapCallMarker {
+		retval = retval[1:]
+	}
+
+	return retval
+}
+
+func (s *dapServer) stackFrames() []dapStackFrame {
+	pcs := []uint16{s.vm.Registers().PC.Value}
+
+	page := uint(s.vm.SRAMPage()&SRAMPageMask) << 16
+	for _, addr := range s.callFrames() {
+		pcs = append(pcs, s.vm.SRAM[page|uint(addr)])
+	}
+
+	frames := make([]dapStackFrame, 0, len(pcs))
+	for i, pc := range pcs {
+		frame := dapStackFrame{
+			ID:                          i,
+			Name:                        dapFunctionName(pc),
+			InstructionPointerReference: fmt.Sprintf("0x%04X", pc),
+		}
+
+		if loc := dapLocationOf(pc); loc.known {
+			frame.Source = s.source(loc.file)
+			frame.Line = loc.line
+			frame.Column = 1
+		}
+
+		frames = append(frames, frame)
+	}
+
+	if s.vm.InIrq {
+		frames[len(frames)-1].Name += " [IRQ]"
+	}
+
+	return frames
+}
+
+// Name of the function containing addr (MSCR function, nearest label or the address itself)
+func dapFunctionName(addr uint16) string {
+	if debugInfo != nil {
+		if w := debugInfo.Lookup(addr); w != nil && w.Mscr != nil && w.Mscr.Function != "" {
+			return w.Mscr.Function
+		}
+
+		if label, ok := debugInfo.NearestLabel(addr); ok {
+			return label.Name
+		}
+	}
+
+	return fmt.Sprintf("0x%04X", addr)
+}
+
+func (s *dapServer) variables(ref int) []dapVariable {
+	retval := make([]dapVariable, 0)
+
+	switch ref {
+	case dapScopeRegisters:
+		reg := s.vm.Registers()
+		for _, r := range registerList(reg)[:12] {
+			retval = append(retval, dapVariable{
+				Name:  registerName(r.Address),
+				Value: fmt.Sprintf("0x%04X (%d)", r.Value, int16(r.Value)),
+			})
+		}
+
+		retval = append(retval,
+			dapVariable{Name: "IRQ", Value: fmt.Sprintf("%t", s.vm.InIrq)},
+			dapVariable{Name: "SRAM page", Value: fmt.Sprintf("0x%X", s.vm.SRAMPage())},
+			dapVariable{Name: "Steps", Value: fmt.Sprintf("%d", s.vm.StepCounter)},
+		)
+
+	case dapScopeStack:
+		page := uint(s.vm.SRAMPage()&SRAMPageMask) << 16
+		addr := s.vm.Registers().SP.Value
+		for i := 0; i < dapMaxStackWords && addr > 0 && addr < 0x7FFF; i++ {
+			value := s.vm.SRAM[page|uint(addr)]
+			text := fmt.Sprintf("0x%04X (%d)", value, int16(value))
+			if int(value) < len(s.vm.EEPROM) && s.vm.EEPROM[value] == dapCallMarker {
+				text += " return to " + dapFunctionName(value)
+			}
+
+			retval = append(retval, dapVariable{
+				Name:  fmt.Sprintf("0x%04X", addr),
+				Value: text,
+			})
+			addr++
+		}
+	}
+
+	return retval
+}
+
+// Sets a register in the "Registers" scope; Values are parsed like literals (e.g. 0x10, 16)
+func (s *dapServer) setVariable(ref int, name, value string) (string, error) {
+	if ref != dapScopeRegisters {
+		return "", errors.New("Only registers can be modified")
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(value), 0, 32)
+	if err != nil || parsed < -0x8000 || parsed > 0xFFFF {
+		return "", fmt.Errorf("Invalid value \"%s\"", value)
+	}
+
+	for _, r := range registerList(s.vm.Registers())[:12] {
+		if registerName(r.Address) == name {
+			r.Value = uint16(parsed)
+			return fmt.Sprintf("0x%04X (%d)", r.Value, int16(r.Value)), nil
+		}
+	}
+
+	return "", fmt.Errorf("Unknown register \"%s\"", name)
+}
+
+func registerName(addr byte) string {
+	switch addr {
+	case 0x8:
+		return "SCR1"
+	case 0x9:
+		return "SCR2"
+	case 0xA:
+		return "SP"
+	case 0xB:
+		return "PC"
+	}
+
+	return string(rune('A' + addr))
+}
+
+func (s *dapServer) resume(mode dapRunMode) {
+	s.mode = mode
+	s.running = true
+
+	s.stepLocation = dapLocationOf(s.vm.Registers().PC.Value)
+	s.stepDepth = len(s.callFrames())
+	s.stepInIrq = s.vm.InIrq
+}
+
+func (s *dapServer) stopped(reason, description string) {
+	s.running = false
+
+	body := map[string]interface{}{
+		"reason":            reason,
+		"threadId":          1,
+		"allThreadsStopped": true,
+	}
+
+	if description != "" {
+		body["description"] = description
+		body["text"] = description
+	}
+
+	s.event("stopped", body)
+}
+
+// Executes up to dapRunChunkSize steps, until the current run or step request is done
+func (s *dapServer) runChunk() {
+	for i := 0; i < dapRunChunkSize; i++ {
+		brk, err := s.vm.Step()
+
+		if s.vm.Halted {
+			s.console.close()
+			s.running = false
+			s.event("exited", map[string]interface{}{
+				"exitCode": s.vm.RegDef.H.Value,
+			})
+			s.event("terminated", nil)
+			return
+		}
+
+		if err != nil {
+			s.output("stderr", "VM ERROR: "+err.Error()+"\n")
+			s.stopped("exception", err.Error())
+			return
+		}
+
+		if brk {
+			s.stopped("breakpoint", "BRK")
+			return
+		}
+
+		pc := s.vm.Registers().PC.Value
+		if s.breakpoints[pc] {
+			s.stopped("breakpoint", "")
+			return
+		}
+
+		if s.stepDone(pc) {
+			s.stopped("step", "")
+			return
+		}
+	}
+}
+
+// Returns true if the current step request has been completed
+func (s *dapServer) stepDone(pc uint16) bool {
+	if s.mode == dapModeContinue {
+		return false
+	}
+
+	if s.mode == dapModeStepInstruction || debugInfo == nil {
+		return true
+	}
+
+	// Stepping does not stop in IRQ handlers that happen to interrupt the stepped code
+	if s.vm.InIrq != s.stepInIrq {
+		return false
+	}
+
+	loc := dapLocationOf(pc)
+	if !loc.known || loc == s.stepLocation {
+		return false
+	}
+
+	switch s.mode {
+	case dapModeStepIn:
+		return true
+	case dapModeStepOver:
+		return len(s.callFrames()) <= s.stepDepth
+	case dapModeStepOut:
+		return len(s.callFrames()) < s.stepDepth
+	}
+
+	return false
+}
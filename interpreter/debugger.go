@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,16 +16,17 @@ import (
 	"github.com/rivo/tview"
 )
 
-var symbolMap map[int16]string
-
 func handleError(err error) {
 	if err != nil {
 		log.Fatalln("ERROR (UART, auto-handled): " + err.Error())
 	}
 }
 
-// Interpret runs the MCPC debugger
-func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
+// Number of steps the "run" command of the debugger executes at most, unless specified otherwise
+const DebuggerMaxSteps = 10000
+
+// Interpret runs the MCPC debugger; limits apply to every "run" command
+func Interpret(file string, attach bool, limits ExecutionLimits, symbolOverride string) {
 	var dev *Device
 
 	if attach {
@@ -162,38 +162,7 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 	// Try to read symbol file
 	symbolsFound := false
 	if symbolOverride != "" || !attach {
-		symbolPath := conditional.String(symbolOverride == "", file+".msym", symbolOverride)
-		symbolMap = make(map[int16]string)
-		if _, err := os.Stat(symbolPath); err == nil {
-			symData, err := ioutil.ReadFile(symbolPath)
-			if err != nil {
-				log.Fatalln("ERROR: Symbol file found, but an error occured reading it: " + err.Error())
-			}
-
-			// Parse msym format
-			symSplit := strings.Split(string(symData), ";")
-			for _, symEntry := range symSplit {
-				if symEntry != "" {
-					symEntrySplit := strings.Split(symEntry, "=")
-					if len(symEntrySplit) == 2 {
-						parsedAddr, err := strconv.ParseInt(symEntrySplit[0], 16, 16)
-						if err == nil {
-							toAdd := symEntrySplit[1]
-
-							existing, ok := symbolMap[int16(parsedAddr)]
-							if ok {
-								toAdd = existing + ", " + toAdd
-							}
-
-							symbolMap[int16(parsedAddr)] = toAdd
-						}
-					}
-				}
-			}
-
-			symbolsFound = true
-			log.Println("Symbol file found and loaded!")
-		}
+		symbolsFound = loadSymbols(conditional.String(symbolOverride == "", file+".msym", symbolOverride))
 	}
 
 	// Parse data into instruction-bounded array
@@ -204,13 +173,35 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 
 	// Run with GUI
 	vm := NewVM(data16, 98, 35)
+	debugVM(vm, file, dev, "Not started"+conditional.String(symbolsFound, " (msym loaded!)", ""), limits)
+
+	if attach && dev != nil {
+		dev.closeConnection()
+	}
+}
+
+// Runs the debugger UI for the given VM (which may have been running already) until the user quits.
+// dev is the attached hardware device (nil if not attached), state the initially shown VM state.
+func debugVM(vm *VM, file string, dev *Device, state string, limits ExecutionLimits) {
+	attach := dev != nil
+	data16 := vm.EEPROM
+
+	// Record an undo log for going back, not possible with a real device attached
+	if !attach {
+		vm.EnableHistory(0)
+	}
+
+	breakpoints := newBreakpointTable()
+	vm.MemoryAccessCallback = func(addr uint32, cfg, write bool, value uint16) {
+		breakpoints.memoryAccess(vm, addr, cfg, write, value)
+	}
 
 	plength := fmt.Sprintf("0x%04X", len(data16))
 
 	// Set up GUI elements
 	root := tview.NewGrid()
 	root.SetTitle("MCPC debugger (" + file + ")")
-	root.SetRows(4, 18, -3, -1, 2).SetColumns(0, 50)
+	root.SetRows(6, 18, -3, -1, 2).SetColumns(0, 50)
 	root.SetBorder(true)
 
 	cmdField := tview.NewInputField().SetFieldWidth(0).SetLabel("Command: ")
@@ -222,13 +213,20 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 	disassemblyView.SetTitle("Disassembly")
 	disassemblyView.SetDynamicColors(true)
 	disassemblyView.SetRegions(true)
-	root.AddItem(disassemblyView, 0, 0, 4, 1, 0, 0, false)
+	root.AddItem(disassemblyView, 0, 0, 3, 1, 0, 0, false)
+
+	sourceView := tview.NewTextView()
+	sourceView.SetBorder(true)
+	sourceView.SetTitle("Source")
+	sourceView.SetDynamicColors(true)
+	sourceView.SetText(getSourceText(vm.Registers().PC.Value, 2))
+	root.AddItem(sourceView, 3, 0, 1, 1, 0, 0, false)
 
 	// Set up sidebar sections
 	stateView := tview.NewTextView()
 	stateView.SetBorder(true)
 	stateView.SetTitle("VM State")
-	stateView.SetText(fmt.Sprintf("State: Not started%s\nPC: 0x0000/%s", conditional.String(symbolsFound, " (msym loaded!)", ""), plength))
+	stateView.SetText(getStateText(state, vm, plength))
 	root.AddItem(stateView, 0, 1, 1, 1, 0, 0, false)
 
 	registerView := tview.NewTextView()
@@ -320,12 +318,36 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 
 			disassemblyView.Highlight(fmt.Sprintf("0x%04X", virtualPC))
 			disassemblyView.ScrollToHighlight()
+			sourceView.SetText(getSourceText(virtualPC, 2))
 			app.Draw()
 		}
 
 		return retval
 	})
 
+	// Refreshes the disassembly (e.g. breakpoint markers) without moving the view
+	refreshDisassembly := func() {
+		disassemblyView.SetText(toDisassembly(data16, vm, breakpoints, disassemblyView))
+		disassemblyView.Highlight(fmt.Sprintf("0x%04X", virtualPC))
+	}
+
+	// Refreshes all views after the VM state has changed
+	updateViews := func() {
+		disassemblyView.SetText(toDisassembly(data16, vm, breakpoints, disassemblyView))
+		disassemblyView.Highlight(fmt.Sprintf("0x%04X", vm.Registers().PC.Value))
+		virtualPC = vm.Registers().PC.Value
+		disassemblyView.ScrollToHighlight()
+		sourceView.SetText(getSourceText(vm.Registers().PC.Value, 2))
+		if vm.Halted {
+			stateView.SetText(getStateText("Halted", vm, plength))
+		} else {
+			stateView.SetText(getStateText("Debugging/Paused", vm, plength))
+		}
+		registerView.SetText(getRegisterText(vm.Registers(), vm.Registers()))
+		setSRAMTable(vm, sramView)
+		terminalView.SetText(getStackText(terminalView, vm))
+	}
+
 	// Set up behaviours
 	cmdField.SetDoneFunc(func(key tcell.Key) {
 		if key == tcell.KeyEscape {
@@ -339,31 +361,28 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 			}
 			switch strings.ToLower(split[0]) {
 			case "help":
-				messageBox("MCPC Debugger Help", "Arrow keys to move around in disassembly, press HOME to return to current instruction; Available commands: step = Executes a single instruction step (default, use <ENTER> to call with no command in input) :: run <to> = Executes instructions until HALT, BRK or specified PC address <to> is encountered :: runfor <num> = Executes <num> instructions :: exit, quit = Quits the MCPC debugger", app, modal, root)
+				messageBox("MCPC Debugger Help", "Arrow keys to move around in disassembly, press HOME to return to current instruction; Available commands: step = Executes a single instruction step (default, use <ENTER> to call with no command in input) :: run <to> = Executes instructions until HALT, BRK, a breakpoint, a watchpoint or specified PC address/label <to> is encountered :: runfor <num> = Executes <num> instructions :: back <num> = Reverts the last instruction step (or <num> steps) :: rrun <to> = Runs backwards until the previous BRK, breakpoint, write to a watched address or specified PC address/label <to> is encountered :: break <addr|label> [if <cond>] = Sets a breakpoint, optionally with a condition over registers (e.g. 'A == 0x10 && SP < 7F00', numbers are hex like addresses) :: watch <addr>[-<addr>] [r|w|rw] = Watches SRAM ([page:]addr) or CFG/VGA (>= 0x8000) addresses for reads/writes (default: w) :: delete [<id>], disable <id>, enable <id> = Manages breakpoints and watchpoints (delete without ID removes all) :: list = Lists breakpoints and watchpoints :: save <file> = Saves the entire VM state to a snapshot file :: load <file> = Restores the VM state from a snapshot file :: exit, quit = Quits the MCPC debugger", app, modal, root)
 			case "", "step":
 				// Backup values for comparison
 				regBck := cloneRegisters(vm.Registers())
-				sramBck := make([]uint16, len(vm.SRAM))
-				copier.Copy(sramBck, vm.SRAM)
+				stepBck := vm.StepCounter
 				// Step VM
 				_, err := vm.Step()
 				// Update view after step
-				disassemblyView.SetText(toDisassembly(data16, vm, disassemblyView))
+				disassemblyView.SetText(toDisassembly(data16, vm, breakpoints, disassemblyView))
 				disassemblyView.Highlight(fmt.Sprintf("0x%04X", vm.Registers().PC.Value))
 				virtualPC = vm.Registers().PC.Value
 				disassemblyView.ScrollToHighlight()
+				sourceView.SetText(getSourceText(vm.Registers().PC.Value, 2))
 				if vm.Halted {
-					stateView.SetText(fmt.Sprintf("State: Halted\nPC: 0x%04X/%s", vm.Registers().PC.Value, plength))
+					stateView.SetText(getStateText("Halted", vm, plength))
 				} else {
-					stateView.SetText(fmt.Sprintf("State: Debugging/Paused\nPC: 0x%04X/%s", vm.Registers().PC.Value, plength))
+					stateView.SetText(getStateText("Debugging/Paused", vm, plength))
 				}
 				registerView.SetText(getRegisterText(vm.Registers(), regBck))
 				setSRAMTable(vm, sramView)
-				for sramI := 0; sramI < len(sramBck); sramI++ {
-					if sramBck[sramI] != vm.SRAM[sramI] {
-						sramView.Select(sramI/3+1, 0)
-						break
-					}
+				if rec := vm.lastStepRecord(); rec != nil && rec.stepCounter == stepBck && len(rec.sramWrites) > 0 {
+					sramView.Select(int(rec.sramWrites[0].addr)/3+1, 0)
 				}
 				//terminalText += output
 				terminalView.SetText(getStackText(terminalView, vm))
@@ -391,42 +410,41 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 				}
 
 			case "run", "runfor":
-				// Run until BRK, timeout or match
-				stateView.SetText(fmt.Sprintf("State: Running\nPC: -"))
+				// Run until BRK, a breakpoint, the step or time limit or match
+				stateView.SetText(fmt.Sprintf("State: Running\nPC: -\nCycles: -"))
 				app.Draw()
 
-				timeout := 0
-				maxTimeout := 10000
+				runLimits := limits
+				runLimits.Brk = BrkPause
 				match := -1
 				if len(split) > 1 {
 					if split[0] == "run" {
-						m, cerr := strconv.ParseInt(split[1], 16, 17)
+						m, _, cerr := parseLocation(split[1])
 						if cerr == nil {
 							match = int(m)
 						} else {
-							m, cerr = strconv.ParseInt(split[1][2:], 16, 17)
-							if cerr == nil {
-								match = int(m)
-							} else {
-								messageBox("Warning", "You passed a parameter to run, however it could not be parsed as a hex number. It will be ignored.", app, modal, root)
-							}
+							messageBox("Warning", "You passed a parameter to run, however it could not be parsed as a hex number or label. It will be ignored.", app, modal, root)
 						}
 					} else if split[0] == "runfor" {
-						m, cerr := strconv.ParseInt(split[1], 10, 32)
+						m, cerr := strconv.ParseInt(split[1], 10, 64)
 						if cerr == nil {
-							maxTimeout = int(m)
+							runLimits.MaxSteps = m
 						} else {
 							messageBox("Warning", "You passed a parameter to runfor, however it could not be parsed as a number. It will be ignored.", app, modal, root)
 						}
 					}
 				}
 
-				for !vm.Halted && timeout < maxTimeout {
-					brk, err := vm.Step()
-					if err != nil {
-						messageBox("VM Error", fmt.Sprintf("A VM error occured during step 0x%X (at PC=0x%X): %s", vm.EEPROM[vm.Registers().PC.Value-1], vm.Registers().PC.Value-1, err.Error()), app, modal, root)
-					}
+				// BRK only stops "run" without a target
+				if match != -1 || split[0] == "runfor" {
+					runLimits.Brk = BrkIgnore
+				}
 
+				// Forget watchpoint hits of previous single steps
+				breakpoints.takeHit()
+				stopReason := ""
+
+				reason, err := StartExecution(vm, runLimits).Run(func() bool {
 					// Compare with device if running in attached mode
 					if attach {
 						handleError(dev.step())
@@ -443,33 +461,212 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 
 							messageBox("Device inconsistency", toShow, app, modal, root)
 
-							break
+							return true
+						}
+					}
+
+					if split[0] == "run" {
+						if hit := breakpoints.takeHit(); hit != "" {
+							stopReason = hit
+							return true
+						}
+
+						if bp := breakpoints.hitBreakpoint(vm); bp != nil {
+							stopReason = fmt.Sprintf("Breakpoint %d", bp.id)
+							return true
 						}
 					}
 
-					if (match == -1 && brk && split[0] == "run") || int(vm.Registers().PC.Value) == match {
+					return int(vm.Registers().PC.Value) == match
+				})
+
+				switch {
+				case reason == StopError:
+					messageBox("VM Error", fmt.Sprintf("A VM error occured during step 0x%X (at PC=0x%X): %s", vm.EEPROM[vm.Registers().PC.Value-1], vm.Registers().PC.Value-1, err.Error()), app, modal, root)
+				case reason == StopStepLimit && split[0] == "run":
+					messageBox("Timeout", fmt.Sprintf("Execution paused because the step limit was reached (%d steps).", runLimits.MaxSteps), app, modal, root)
+				case reason == StopTimeout:
+					messageBox("Timeout", fmt.Sprintf("Execution paused because the time limit was reached (%s).", runLimits.Timeout), app, modal, root)
+				case reason == StopBrk:
+					stopReason = reason.String()
+				}
+
+				// Update view after steps
+				updateViews()
+				if stopReason != "" {
+					stateView.SetText(getStateText(stopReason, vm, plength))
+				}
+			case "back":
+				if attach {
+					messageBox("Invalid command", "Going back is not possible while attached to a device.", app, modal, root)
+					break
+				}
+
+				count := 1
+				if len(split) > 1 {
+					c, cerr := strconv.ParseInt(split[1], 10, 32)
+					if cerr != nil || c < 1 {
+						messageBox("Invalid command", "Usage: back [<num>]", app, modal, root)
 						break
 					}
-					timeout++
+					count = int(c)
 				}
 
-				if timeout == 10000 && split[0] == "run" {
-					messageBox("Timeout", "Execution paused because a timeout was reached (10000 steps).", app, modal, root)
+				undone := 0
+				for undone < count && vm.StepBack() {
+					undone++
 				}
 
-				// Update view after steps
-				disassemblyView.SetText(toDisassembly(data16, vm, disassemblyView))
-				disassemblyView.Highlight(fmt.Sprintf("0x%04X", vm.Registers().PC.Value))
-				virtualPC = vm.Registers().PC.Value
-				disassemblyView.ScrollToHighlight()
-				if vm.Halted {
-					stateView.SetText(fmt.Sprintf("State: Halted\nPC: 0x%04X/%s", vm.Registers().PC.Value, plength))
+				if undone < count {
+					messageBox("History exhausted", fmt.Sprintf("Reached the beginning of the recorded history after going back %d step(s).", undone), app, modal, root)
+				}
+
+				updateViews()
+			case "rrun":
+				if attach {
+					messageBox("Invalid command", "Going back is not possible while attached to a device.", app, modal, root)
+					break
+				}
+
+				// Run backwards until a breakpoint, a write to a watched address, the state right after a BRK or the specified PC address is reached
+				match := -1
+				if len(split) > 1 {
+					m, _, cerr := parseLocation(split[1])
+					if cerr == nil {
+						match = int(m)
+					} else {
+						messageBox("Warning", "You passed a parameter to rrun, however it could not be parsed as a hex number or label. It will be ignored.", app, modal, root)
+					}
+				}
+
+				stopReason := ""
+				for {
+					undone := vm.lastStepRecord()
+					if !vm.StepBack() {
+						messageBox("History exhausted", "Reached the beginning of the recorded history.", app, modal, root)
+						break
+					}
+
+					if hit := breakpoints.recordHit(undone); hit != "" {
+						stopReason = hit
+						break
+					}
+
+					if bp := breakpoints.hitBreakpoint(vm); bp != nil {
+						stopReason = fmt.Sprintf("Breakpoint %d", bp.id)
+						break
+					}
+
+					if rec := vm.lastStepRecord(); (match == -1 && rec != nil && rec.brk) || int(vm.Registers().PC.Value) == match {
+						break
+					}
+				}
+
+				updateViews()
+				if stopReason != "" {
+					stateView.SetText(getStateText(stopReason, vm, plength))
+				}
+			case "break":
+				condition := ""
+				if len(split) > 2 {
+					if strings.ToLower(split[2]) == "if" {
+						condition = strings.Join(split[3:], " ")
+					}
+
+					if condition == "" {
+						messageBox("Invalid command", "Usage: break <addr|label> [if <condition>]", app, modal, root)
+						break
+					}
+				} else if len(split) < 2 {
+					messageBox("Invalid command", "Usage: break <addr|label> [if <condition>]", app, modal, root)
+					break
+				}
+
+				bp, err := breakpoints.addBreakpoint(split[1], condition)
+				if err != nil {
+					messageBox("Invalid breakpoint", err.Error(), app, modal, root)
+					break
+				}
+
+				refreshDisassembly()
+				messageBox("Breakpoint set", fmt.Sprintf("Breakpoint %d set at 0x%04X.", bp.id, bp.addr), app, modal, root)
+			case "watch":
+				if len(split) < 2 || len(split) > 3 {
+					messageBox("Invalid command", "Usage: watch <addr>[-<addr>] [r|w|rw]", app, modal, root)
+					break
+				}
+
+				mode := ""
+				if len(split) > 2 {
+					mode = split[2]
+				}
+
+				wp, err := breakpoints.addWatchpoint(split[1], mode)
+				if err != nil {
+					messageBox("Invalid watchpoint", err.Error(), app, modal, root)
+					break
+				}
+
+				messageBox("Watchpoint set", fmt.Sprintf("Watchpoint %d set on %s.", wp.id, wp.text), app, modal, root)
+			case "delete", "disable", "enable":
+				if len(split) < 2 {
+					if split[0] == "delete" {
+						breakpoints = newBreakpointTable()
+						refreshDisassembly()
+					} else {
+						messageBox("Invalid command", "Usage: "+split[0]+" <id>", app, modal, root)
+					}
+					break
+				}
+
+				id, cerr := strconv.Atoi(split[1])
+				ok := false
+				if cerr == nil {
+					if split[0] == "delete" {
+						ok = breakpoints.remove(id)
+					} else {
+						ok = breakpoints.setEnabled(id, split[0] == "enable")
+					}
+				}
+
+				if !ok {
+					messageBox("Invalid command", fmt.Sprintf("No breakpoint or watchpoint with ID '%s'.", split[1]), app, modal, root)
+				}
+
+				refreshDisassembly()
+			case "list":
+				messageBox("Breakpoints and watchpoints", breakpoints.String(), app, modal, root)
+			case "save":
+				if len(split) < 2 {
+					messageBox("Invalid command", "Usage: save <file>", app, modal, root)
+					break
+				}
+
+				if err := vm.SaveSnapshot(split[1]); err != nil {
+					messageBox("Snapshot Error", "Could not save snapshot: "+err.Error(), app, modal, root)
 				} else {
-					stateView.SetText(fmt.Sprintf("State: Debugging/Paused\nPC: 0x%04X/%s", vm.Registers().PC.Value, plength))
+					messageBox("Snapshot saved", fmt.Sprintf("VM state saved to '%s' (step %d).", split[1], vm.StepCounter), app, modal, root)
 				}
-				registerView.SetText(getRegisterText(vm.Registers(), vm.Registers()))
-				setSRAMTable(vm, sramView)
-				terminalView.SetText(getStackText(terminalView, vm))
+			case "load":
+				if len(split) < 2 {
+					messageBox("Invalid command", "Usage: load <file>", app, modal, root)
+					break
+				}
+
+				if attach {
+					messageBox("Snapshot Error", "Snapshots can not be loaded while attached to a device.", app, modal, root)
+					break
+				}
+
+				if err := vm.LoadSnapshot(split[1]); err != nil {
+					messageBox("Snapshot Error", "Could not load snapshot: "+err.Error(), app, modal, root)
+					break
+				}
+
+				// Snapshots contain the program they were taken of
+				data16 = vm.EEPROM
+				plength = fmt.Sprintf("0x%04X", len(data16))
+				updateViews()
 			case "exit", "quit":
 				app.Stop()
 			default:
@@ -482,7 +679,7 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 
 	// Set disassembly text last to avoid width glitching for label offsets
 	// Nevermind, doesn't work either way
-	disassemblyView.SetText(toDisassembly(data16, vm, disassemblyView))
+	disassemblyView.SetText(toDisassembly(data16, vm, breakpoints, disassemblyView))
 	disassemblyView.Highlight(fmt.Sprintf("0x%04X", virtualPC))
 
 	// Run GUI app
@@ -490,10 +687,6 @@ func Interpret(file string, attach bool, maxSteps int, symbolOverride string) {
 	if err := app.Run(); err != nil {
 		log.Fatalln(err)
 	}
-
-	if attach && dev != nil {
-		dev.closeConnection()
-	}
 }
 
 func messageBox(title, text string, app *tview.Application, modal *tview.Modal, root *tview.Grid) {
@@ -513,6 +706,17 @@ func messageBox(title, text string, app *tview.Application, modal *tview.Modal,
 	app.SetFocus(modal)
 }
 
+func getStateText(state string, vm *VM, plength string) string {
+	pc := vm.Registers().PC.Value
+	retval := fmt.Sprintf("State: %s\nPC: 0x%04X/%s\nCycles: %d", state, pc, plength, vm.CycleCounter)
+
+	if location := sourceLocation(pc); location != "" {
+		retval += "\nSource: " + location
+	}
+
+	return retval
+}
+
 func getRegisterText(reg, regOld *Registers) string {
 	retval := ""
 	retval += "[gray]0x0[" + colorRegister + "]    A[white] = " + fmt.Sprintf("%s0x%04X%s\n", conditional.String(reg.A.Value == regOld.A.Value, "", "[red]"), reg.A.Value, fmt.Sprintf(" <> 0x%04X", regOld.A.Value))
@@ -542,7 +746,7 @@ const colorRegister = "yellow"
 
 var formatRemoverRegex = regexp.MustCompile(`\[.*?\]`)
 
-func toDisassembly(raw []uint16, vm *VM, view *tview.TextView) string {
+func toDisassembly(raw []uint16, vm *VM, breakpoints *breakpointTable, view *tview.TextView) string {
 	retval := ""
 	skip := false
 
@@ -551,7 +755,7 @@ func toDisassembly(raw []uint16, vm *VM, view *tview.TextView) string {
 
 		if skip {
 			skip = false
-			retval += fmt.Sprintf("[\"%s\"][%s]%s  ...\n", addr, colorPCAddr, addr)
+			retval += fmt.Sprintf("[\"%s\"]  [%s]%s  ...\n", addr, colorPCAddr, addr)
 			continue
 		}
 
@@ -569,7 +773,13 @@ func toDisassembly(raw []uint16, vm *VM, view *tview.TextView) string {
 		for t := 0; t < 5-len(cmd); t++ {
 			tabs += " "
 		}
-		retval += fmt.Sprintf("[\"%s\"][%s]%s  [%s]0x%04X  [%s]%s%s[white]%s", addr, colorPCAddr, addr, colorRawIns, ins, colorCmd, cmd, tabs, params)
+		// Breakpoint marker
+		marker := "  "
+		if set, enabled := breakpoints.breakpointState(uint16(i)); set {
+			marker = conditional.String(enabled, "[red]* ", "[gray]o ")
+		}
+
+		retval += fmt.Sprintf("[\"%s\"]%s[%s]%s  [%s]0x%04X  [%s]%s%s[white]%s", addr, marker, colorPCAddr, addr, colorRawIns, ins, colorCmd, cmd, tabs, params)
 
 		if note != "" {
 			if params != "" {
@@ -578,7 +788,7 @@ func toDisassembly(raw []uint16, vm *VM, view *tview.TextView) string {
 			retval += fmt.Sprintf("[%s](%s)[white]", colorNotes, note)
 		}
 
-		label, ok := symbolMap[int16(i)]
+		label, ok := symbolAt(uint16(i))
 		if ok {
 			// Symbol found, append right justified
 			_, _, width, _ := view.GetRect()
@@ -619,7 +829,7 @@ func decodeAssembly(c uint16, vm *VM) (cmd, params, note string, set bool) {
 			cmd = "JMP"
 			params = fmt.Sprintf("to 0x%04x", valueToMove)
 
-			label, ok := symbolMap[int16(valueToMove)]
+			label, ok := symbolAt(valueToMove)
 			if ok {
 				note = fmt.Sprintf("label: [blue]%s[%s]", label, colorNotes)
 			}
@@ -645,7 +855,7 @@ func decodeAssembly(c uint16, vm *VM) (cmd, params, note string, set bool) {
 			note = fmt.Sprintf("FALSE, would do: set %s to 0x%04X", decodeRegister(byte((c&0x0F00)>>8), colorNotes), valueToMove)
 		}
 
-		label, ok := symbolMap[int16(valueToMove)]
+		label, ok := symbolAt(valueToMove)
 		if ok {
 			note += fmt.Sprintf("; label: [blue]%s[%s]", label, colorNotes)
 		}
@@ -664,7 +874,7 @@ func decodeAssembly(c uint16, vm *VM) (cmd, params, note string, set bool) {
 			note = fmt.Sprintf("FALSE, would do: set %s to 0x%04X", decodeRegister(byte((c&0x0F00)>>8), colorNotes), valueToMove)
 		}
 
-		label, ok := symbolMap[int16(valueToMove)]
+		label, ok := symbolAt(valueToMove)
 		if ok {
 			note += fmt.Sprintf("; label: [blue]%s[%s]", label, colorNotes)
 		}
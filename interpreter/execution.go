@@ -0,0 +1,176 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BrkPolicy determines how a BRK instruction is handled during execution
+type BrkPolicy int
+
+const (
+	// BrkIgnore continues execution after a BRK
+	BrkIgnore BrkPolicy = iota
+
+	// BrkPause stops execution after a BRK
+	BrkPause
+
+	// BrkDebug stops execution after a BRK and hands the VM over to the debugger (where available, otherwise like BrkPause)
+	BrkDebug
+)
+
+// ParseBrkPolicy parses a BRK policy name ("ignore", "pause" or "debug")
+func ParseBrkPolicy(policy string) (BrkPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "ignore":
+		return BrkIgnore, nil
+	case "pause":
+		return BrkPause, nil
+	case "debug":
+		return BrkDebug, nil
+	}
+
+	return BrkIgnore, fmt.Errorf("Invalid BRK policy '%s' (expected ignore, pause or debug)", policy)
+}
+
+// ExecutionLimits bound a single run of a VM. The zero value allows unlimited execution and ignores BRK.
+type ExecutionLimits struct {
+	// Maximum number of steps, 0 for no limit
+	MaxSteps int64
+
+	// Maximum wall-clock time, 0 for no limit
+	Timeout time.Duration
+
+	Brk BrkPolicy
+
+	// Target clock frequency in Hz, execution is slowed down to match it based on the VM's cycle counter. 0 runs at full speed.
+	ClockHz int64
+}
+
+// StopReason describes why execution has stopped
+type StopReason int
+
+const (
+	// StopNone means execution can continue
+	StopNone StopReason = iota
+	StopHalted
+	StopBrk
+	StopStepLimit
+	StopTimeout
+	StopError
+
+	// StopRequested means execution has been stopped by the caller (see Execution.Run)
+	StopRequested
+)
+
+func (r StopReason) String() string {
+	switch r {
+	case StopNone:
+		return "Running"
+	case StopHalted:
+		return "Halted"
+	case StopBrk:
+		return "Paused (BRK)"
+	case StopStepLimit:
+		return "Step limit reached"
+	case StopTimeout:
+		return "Timeout reached"
+	case StopError:
+		return "VM error"
+	case StopRequested:
+		return "Stopped"
+	}
+
+	return "Unknown"
+}
+
+// The wall-clock timeout is only checked every this many steps
+const executionTimeCheckInterval = 1024
+
+// Execution tracks the step and time budget of a single run of a VM
+type Execution struct {
+	VM     *VM
+	Limits ExecutionLimits
+
+	// Number of steps executed so far
+	Steps int64
+
+	start    time.Time
+	throttle *clockThrottle
+}
+
+// StartExecution starts a new run of the VM with the given limits, the time budget starts now
+func StartExecution(vm *VM, limits ExecutionLimits) *Execution {
+	e := &Execution{
+		VM:     vm,
+		Limits: limits,
+		start:  time.Now(),
+	}
+
+	if limits.ClockHz > 0 {
+		e.throttle = newClockThrottle(vm, limits.ClockHz)
+	}
+
+	return e
+}
+
+// Step executes a single VM step; Returns StopNone if execution can continue, or the reason why it has to stop otherwise
+func (e *Execution) Step() (StopReason, error) {
+	if e.VM.Halted {
+		return StopHalted, nil
+	}
+
+	if e.Limits.MaxSteps > 0 && e.Steps >= e.Limits.MaxSteps {
+		return StopStepLimit, nil
+	}
+
+	if e.Limits.Timeout > 0 && e.Steps%executionTimeCheckInterval == 0 && time.Since(e.start) >= e.Limits.Timeout {
+		return StopTimeout, nil
+	}
+
+	brk, err := e.VM.Step()
+	e.Steps++
+
+	if e.throttle != nil {
+		e.throttle.wait(e.VM)
+	}
+
+	if err != nil {
+		return StopError, err
+	}
+
+	if e.VM.Halted {
+		return StopHalted, nil
+	}
+
+	if brk && e.Limits.Brk != BrkIgnore {
+		return StopBrk, nil
+	}
+
+	return StopNone, nil
+}
+
+// Run executes steps until execution has to stop; stop is called after every step (if not nil) and ends execution if it returns true
+func (e *Execution) Run(stop func() bool) (StopReason, error) {
+	for {
+		reason, err := e.Step()
+		if reason != StopNone {
+			return reason, err
+		}
+
+		if stop != nil && stop() {
+			return StopRequested, nil
+		}
+	}
+}
+
+// Restart resets the step and time budget, e.g. after execution has been paused
+func (e *Execution) Restart() {
+	e.Steps = 0
+	e.start = time.Now()
+
+	if e.throttle != nil {
+		e.throttle.reset(e.VM)
+	}
+}
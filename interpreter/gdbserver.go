@@ -0,0 +1,634 @@
+package interpreter
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Memory layout as seen by GDB. Addresses are word addresses (one addressable unit is 16 bit, transferred big endian):
+//
+//	0x00000000-0x0000FFFF  EEPROM (program), i.e. addresses are equal to PC values
+//	0x01000000-0x010F7FFF  SRAM, 0x01000000 + (page << 16 | addr) for addr < 0x8000
+//	0x01008000-0x0100FFFF  CFG (VGA buffer, IRQ and VGA configuration), regardless of page
+const (
+	gdbSRAMBase uint64 = 0x01000000
+	gdbSRAMEnd         = gdbSRAMBase + uint64(SRAMPageCount<<16)
+)
+
+// Number of VM steps between checks for an interrupt (Ctrl-C) from GDB while running
+const gdbInterruptCheckInterval = 4096
+
+// Register layout: DEF bank (0-15), IRQ bank (16-31), PC of the currently active bank (32) and IN_IRQ flag (33, read-only)
+const (
+	gdbRegCount   = 34
+	gdbRegPC      = 32
+	gdbRegInIrq   = 33
+	gdbSignalTrap = 5
+	gdbSignalInt  = 2
+	gdbSignalIll  = 4
+)
+
+var gdbRegisterNames = []string{"a", "b", "c", "d", "e", "f", "g", "h", "scr1", "scr2", "sp", "pc", "zero", "one", "negone", "bus"}
+
+// Packet or interrupt received from GDB
+type gdbInput struct {
+	packet    string
+	interrupt bool
+}
+
+type gdbServer struct {
+	vm          *VM
+	conn        net.Conn
+	writeLock   sync.Mutex
+	breakpoints map[uint16]bool
+	input       chan gdbInput
+
+	// Closed when the session ends, stops the reader goroutine
+	done chan struct{}
+}
+
+// GDBServer runs the given binary in a virtual MCPC, controlled by a GDB client via the GDB remote serial protocol on a local TCP port.
+// The VM is created once, clients can disconnect and reconnect to continue debugging.
+func GDBServer(file string, port int) {
+	data16, err := loadBinary(file)
+	if err != nil {
+		log.Fatalln("ERROR: An error occured reading the input file: " + err.Error())
+	}
+
+	vm := NewVM(data16, 120, 65)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		log.Fatalln("ERROR: Could not start gdbserver: " + err.Error())
+	}
+	defer listener.Close()
+
+	log.Printf("gdbserver listening on %s (connect with \"target remote %s\")\n", listener.Addr(), listener.Addr())
+
+	breakpoints := make(map[uint16]bool)
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Fatalln("ERROR: " + err.Error())
+		}
+
+		log.Println("GDB client connected: " + conn.RemoteAddr().String())
+
+		server := &gdbServer{
+			vm:          vm,
+			conn:        conn,
+			breakpoints: breakpoints,
+			input:       make(chan gdbInput, 16),
+			done:        make(chan struct{}),
+		}
+
+		kill := server.serve()
+		conn.Close()
+
+		if kill {
+			log.Println("GDB client requested kill, exiting")
+			return
+		}
+
+		log.Println("GDB client disconnected")
+	}
+}
+
+// Handles a client connection until it disconnects; Returns true if the client requested the VM to be killed
+func (s *gdbServer) serve() bool {
+	defer close(s.done)
+	go s.readInput()
+
+	for in := range s.input {
+		if in.interrupt {
+			// Interrupt while not running, report current state
+			s.send(s.stopReply(gdbSignalInt))
+			continue
+		}
+
+		switch {
+		case in.packet == "k":
+			return true
+
+		case in.packet == "D" || strings.HasPrefix(in.packet, "D;"):
+			s.send("OK")
+			return false
+
+		default:
+			s.send(s.handle(in.packet))
+		}
+	}
+
+	return false
+}
+
+// Reads packets from the connection and acknowledges them, runs as goroutine until the connection is closed or the session ends
+func (s *gdbServer) readInput() {
+	defer close(s.input)
+
+	reader := bufio.NewReader(s.conn)
+
+	// Owned by this goroutine, packets following QStartNoAckMode (possibly sent in the same write) are not acknowledged anymore
+	noAck := false
+	for {
+		c, err := reader.ReadByte()
+		if err != nil {
+			return
+		}
+
+		switch c {
+		case 0x03:
+			if !s.deliver(gdbInput{interrupt: true}) {
+				return
+			}
+
+		case '$':
+			data, err := reader.ReadString('#')
+			if err != nil {
+				return
+			}
+
+			checksum := make([]byte, 2)
+			if _, err := reader.Read(checksum[:1]); err != nil {
+				return
+			}
+			if _, err := reader.Read(checksum[1:]); err != nil {
+				return
+			}
+
+			data = data[:len(data)-1]
+			expected, err := strconv.ParseUint(string(checksum), 16, 8)
+			if !noAck {
+				if err != nil || byte(expected) != gdbChecksum(data) {
+					s.write("-")
+					continue
+				}
+
+				s.write("+")
+			}
+
+			packet := gdbUnescape(data)
+			if packet == "QStartNoAckMode" {
+				noAck = true
+			}
+
+			if !s.deliver(gdbInput{packet: packet}) {
+				return
+			}
+
+		default:
+			// Acknowledgements ('+'/'-') and noise are ignored, replies are never retransmitted
+		}
+	}
+}
+
+// Passes input to serve; Returns false if the session has ended and nobody is receiving anymore
+func (s *gdbServer) deliver(in gdbInput) bool {
+	select {
+	case s.input <- in:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+func (s *gdbServer) write(data string) {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.conn.Write([]byte(data))
+}
+
+func (s *gdbServer) send(packet string) {
+	s.write(fmt.Sprintf("$%s#%02x", packet, gdbChecksum(packet)))
+}
+
+// Handles a single packet and returns the reply; An empty reply means "not supported"
+func (s *gdbServer) handle(packet string) string {
+	if packet == "" {
+		return ""
+	}
+
+	args := packet[1:]
+
+	switch packet[0] {
+	case '?':
+		return s.stopReply(gdbSignalTrap)
+
+	case 'g':
+		retval := ""
+		for i := 0; i < gdbRegCount; i++ {
+			retval += fmt.Sprintf("%04x", s.readRegister(i))
+		}
+		return retval
+
+	case 'G':
+		for i := 0; i < gdbRegCount && len(args) >= (i+1)*4; i++ {
+			value, err := strconv.ParseUint(args[i*4:(i+1)*4], 16, 16)
+			if err != nil {
+				return "E01"
+			}
+			s.writeRegister(i, uint16(value))
+		}
+		return "OK"
+
+	case 'p':
+		reg, err := strconv.ParseUint(args, 16, 8)
+		if err != nil || reg >= gdbRegCount {
+			return "E01"
+		}
+		return fmt.Sprintf("%04x", s.readRegister(int(reg)))
+
+	case 'P':
+		split := strings.SplitN(args, "=", 2)
+		if len(split) != 2 {
+			return "E01"
+		}
+
+		reg, err1 := strconv.ParseUint(split[0], 16, 8)
+		value, err2 := strconv.ParseUint(split[1], 16, 16)
+		if err1 != nil || err2 != nil || reg >= gdbRegCount {
+			return "E01"
+		}
+
+		s.writeRegister(int(reg), uint16(value))
+		return "OK"
+
+	case 'm':
+		addr, length, _, err := gdbParseMemoryArgs(args, false)
+		if err != nil {
+			return "E01"
+		}
+
+		retval := ""
+		for i := uint64(0); i < length; i++ {
+			value, ok := s.readMemory(addr + i)
+			if !ok {
+				if i == 0 {
+					return "E02"
+				}
+				break
+			}
+			retval += fmt.Sprintf("%04x", value)
+		}
+		return retval
+
+	case 'M':
+		addr, length, data, err := gdbParseMemoryArgs(args, true)
+		if err != nil || uint64(len(data)) != length*2 {
+			return "E01"
+		}
+
+		for i := uint64(0); i < length; i++ {
+			if !s.writeMemory(addr+i, uint16(data[i*2])<<8|uint16(data[i*2+1])) {
+				return "E02"
+			}
+		}
+		return "OK"
+
+	case 's', 'c':
+		if args != "" {
+			addr, err := strconv.ParseUint(args, 16, 16)
+			if err != nil {
+				return "E01"
+			}
+			s.vm.Registers().PC.Value = uint16(addr)
+		}
+
+		return s.run(packet[0] == 's')
+
+	case 'Z', 'z':
+		// Software and hardware breakpoints are treated the same, watchpoints are not supported
+		split := strings.Split(args, ",")
+		if len(split) < 2 || (split[0] != "0" && split[0] != "1") {
+			return ""
+		}
+
+		addr, err := strconv.ParseUint(split[1], 16, 16)
+		if err != nil {
+			return "E01"
+		}
+
+		if packet[0] == 'Z' {
+			s.breakpoints[uint16(addr)] = true
+		} else {
+			delete(s.breakpoints, uint16(addr))
+		}
+		return "OK"
+
+	case 'H', 'T':
+		// Single thread only
+		return "OK"
+
+	case 'q', 'Q':
+		return s.handleQuery(packet)
+
+	case 'v':
+		if strings.HasPrefix(packet, "vCont?") {
+			return "vCont;c;s"
+		}
+		if strings.HasPrefix(packet, "vCont;") {
+			action := strings.SplitN(strings.TrimPrefix(packet, "vCont;"), ";", 2)[0]
+			if strings.HasPrefix(action, "s") || strings.HasPrefix(action, "c") {
+				return s.run(action[0] == 's')
+			}
+			return "E01"
+		}
+		return ""
+	}
+
+	return ""
+}
+
+func (s *gdbServer) handleQuery(packet string) string {
+	switch {
+	case strings.HasPrefix(packet, "qSupported"):
+		return "PacketSize=4000;qXfer:features:read+;QStartNoAckMode+;swbreak+;hwbreak+"
+
+	case packet == "QStartNoAckMode":
+		// Acknowledgements are switched off by readInput once it has acknowledged this packet
+		return "OK"
+
+	case packet == "qAttached":
+		return "1"
+
+	case packet == "qC":
+		return "QC1"
+
+	case packet == "qfThreadInfo":
+		return "m1"
+
+	case packet == "qsThreadInfo":
+		return "l"
+
+	case strings.HasPrefix(packet, "qXfer:features:read:target.xml:"):
+		split := strings.Split(strings.TrimPrefix(packet, "qXfer:features:read:target.xml:"), ",")
+		if len(split) != 2 {
+			return "E01"
+		}
+
+		offset, err1 := strconv.ParseUint(split[0], 16, 32)
+		length, err2 := strconv.ParseUint(split[1], 16, 32)
+		if err1 != nil || err2 != nil {
+			return "E01"
+		}
+
+		xml := gdbTargetDescription()
+		if offset >= uint64(len(xml)) {
+			return "l"
+		}
+
+		end := offset + length
+		if end >= uint64(len(xml)) {
+			return "l" + gdbEscape(xml[offset:])
+		}
+		return "m" + gdbEscape(xml[offset:end])
+	}
+
+	return ""
+}
+
+// Executes a single step or runs until a breakpoint, BRK, HALT or an interrupt from GDB; Returns the stop reply
+func (s *gdbServer) run(single bool) string {
+	for steps := 1; ; steps++ {
+		if s.vm.Halted {
+			return s.stopReply(gdbSignalTrap)
+		}
+
+		brk, err := s.vm.Step()
+		if err != nil {
+			log.Println("VM ERROR: " + err.Error())
+			return s.stopReply(gdbSignalIll)
+		}
+
+		if single || brk || s.vm.Halted || s.breakpoints[s.vm.Registers().PC.Value] {
+			return s.stopReply(gdbSignalTrap)
+		}
+
+		if steps%gdbInterruptCheckInterval == 0 {
+			select {
+			case in, ok := <-s.input:
+				if !ok {
+					// Client disconnected while running
+					return ""
+				}
+
+				// GDB does not send packets other than interrupts while the target is running (all-stop mode)
+				if in.interrupt {
+					return s.stopReply(gdbSignalInt)
+				}
+			default:
+			}
+		}
+	}
+}
+
+// Stop reply for the current state, halting is reported as process exit with the H register as status
+func (s *gdbServer) stopReply(signal int) string {
+	if s.vm.Halted {
+		return fmt.Sprintf("W%02x", s.vm.RegDef.H.Value&0xFF)
+	}
+
+	reason := ""
+	if signal == gdbSignalTrap && s.breakpoints[s.vm.Registers().PC.Value] {
+		reason = "swbreak:;"
+	}
+
+	return fmt.Sprintf("T%02x%02x:%04x;%sthread:1;", signal, gdbRegPC, s.vm.Registers().PC.Value, reason)
+}
+
+func (s *gdbServer) readRegister(reg int) uint16 {
+	switch {
+	case reg < 16:
+		return registerList(s.vm.RegDef)[reg].Value
+	case reg < 32:
+		return registerList(s.vm.RegIrq)[reg-16].Value
+	case reg == gdbRegPC:
+		return s.vm.Registers().PC.Value
+	case reg == gdbRegInIrq:
+		if s.vm.InIrq {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Writes to read-only registers (constants, BUS and IN_IRQ) are ignored
+func (s *gdbServer) writeRegister(reg int, value uint16) {
+	var target *Register
+	switch {
+	case reg < 16:
+		target = registerList(s.vm.RegDef)[reg]
+	case reg < 32:
+		target = registerList(s.vm.RegIrq)[reg-16]
+	case reg == gdbRegPC:
+		target = s.vm.Registers().PC
+	}
+
+	if target != nil && target.Writeable {
+		target.Value = value
+	}
+}
+
+// Reads a word from the GDB address space, CFG reads have no side effects (only VGA buffer and configuration values are readable)
+func (s *gdbServer) readMemory(addr uint64) (uint16, bool) {
+	switch {
+	case addr < uint64(len(s.vm.EEPROM)):
+		return s.vm.EEPROM[addr], true
+
+	case addr >= gdbSRAMBase && addr < gdbSRAMEnd:
+		word := uint16(addr)
+		if (word & 0x8000) == 0 {
+			return s.vm.SRAM[addr-gdbSRAMBase], true
+		}
+
+		return s.readCfg(word), true
+	}
+
+	return 0, false
+}
+
+func (s *gdbServer) readCfg(addr uint16) uint16 {
+	switch {
+	case addr >= 0xE000 && addr < uint16(0xE000+len(s.vm.VgaBuffer)):
+		return s.vm.VgaBuffer[addr-0xE000]
+	case addr == 0x8000:
+		return 0x8001
+	case addr == 0xDFFF:
+		return 0xE000 + s.vm.VgaWidth*s.vm.VgaHeight - 1
+	case addr == 0xDFFD:
+		return s.vm.VgaWidth
+	case addr == 0xDFFE:
+		return s.vm.VgaHeight
+	case addr == 0x8800:
+		return s.vm.SRAMPage()
+	case addr == 0x9000:
+		return s.vm.IrqHandler
+	}
+
+	return 0
+}
+
+// Writes a word to the GDB address space; EEPROM and SRAM are writable, as well as the VGA buffer
+func (s *gdbServer) writeMemory(addr uint64, value uint16) bool {
+	switch {
+	case addr < uint64(len(s.vm.EEPROM)):
+		s.vm.EEPROM[addr] = value
+		return true
+
+	case addr >= gdbSRAMBase && addr < gdbSRAMEnd:
+		word := uint16(addr)
+		if (word & 0x8000) == 0 {
+			s.vm.SRAM[addr-gdbSRAMBase] = value
+			return true
+		}
+
+		if word >= 0xE000 && word < uint16(0xE000+len(s.vm.VgaBuffer)) {
+			s.vm.VgaBuffer[word-0xE000] = value
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parses "addr,length" (or "addr,length:data" for writes)
+func gdbParseMemoryArgs(args string, withData bool) (uint64, uint64, []byte, error) {
+	var data []byte
+	if withData {
+		split := strings.SplitN(args, ":", 2)
+		if len(split) != 2 {
+			return 0, 0, nil, errors.New("missing data")
+		}
+
+		var err error
+		data, err = hex.DecodeString(split[1])
+		if err != nil {
+			return 0, 0, nil, err
+		}
+
+		args = split[0]
+	}
+
+	split := strings.Split(args, ",")
+	if len(split) != 2 {
+		return 0, 0, nil, errors.New("invalid memory arguments")
+	}
+
+	addr, err := strconv.ParseUint(split[0], 16, 64)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	length, err := strconv.ParseUint(split[1], 16, 16)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return addr, length, data, nil
+}
+
+func gdbTargetDescription() string {
+	var xml strings.Builder
+	xml.WriteString(`<?xml version="1.0"?><!DOCTYPE target SYSTEM "gdb-target.dtd"><target version="1.0"><feature name="org.mcpc.core">`)
+
+	for i, name := range gdbRegisterNames {
+		xml.WriteString(fmt.Sprintf(`<reg name="%s" bitsize="16" regnum="%d" type="uint16"/>`, name, i))
+	}
+	for i, name := range gdbRegisterNames {
+		xml.WriteString(fmt.Sprintf(`<reg name="irq_%s" bitsize="16" regnum="%d" type="uint16"/>`, name, i+16))
+	}
+
+	xml.WriteString(fmt.Sprintf(`<reg name="active_pc" bitsize="16" regnum="%d" type="code_ptr"/>`, gdbRegPC))
+	xml.WriteString(fmt.Sprintf(`<reg name="in_irq" bitsize="16" regnum="%d" type="uint16"/>`, gdbRegInIrq))
+	xml.WriteString(`</feature></target>`)
+
+	return xml.String()
+}
+
+func gdbChecksum(data string) byte {
+	var sum byte
+	for i := 0; i < len(data); i++ {
+		sum += data[i]
+	}
+
+	return sum
+}
+
+// Escapes characters with special meaning in packets ('#', '$', '}' and '*')
+func gdbEscape(data string) string {
+	var retval strings.Builder
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '#', '$', '}', '*':
+			retval.WriteByte('}')
+			retval.WriteByte(data[i] ^ 0x20)
+		default:
+			retval.WriteByte(data[i])
+		}
+	}
+
+	return retval.String()
+}
+
+func gdbUnescape(data string) string {
+	var retval strings.Builder
+	for i := 0; i < len(data); i++ {
+		if data[i] == '}' && i+1 < len(data) {
+			i++
+			retval.WriteByte(data[i] ^ 0x20)
+		} else {
+			retval.WriteByte(data[i])
+		}
+	}
+
+	return retval.String()
+}
@@ -0,0 +1,191 @@
+package interpreter
+
+// Default number of steps that can be undone in the debugger
+const defaultHistoryLimit = 100000
+
+// Undo information of a single VM step, i.e. the state that has been overwritten by it
+type stepRecord struct {
+	regDef, regIrq [16]uint16
+
+	halted       bool
+	sramPageDef  uint16
+	sramPageIrq  uint16
+	irqEn        bool
+	inIrq        bool
+	irqHandler   uint16
+	irqDataBuf   uint32
+	stepCounter  int64
+	cycleCounter int64
+
+	// IRQ queue contents before the step (nil if empty)
+	irqQueue []uint32
+
+	// Old values of all memory cells written by the step, in order of writing
+	sramWrites []memoryWrite
+	vgaWrites  []memoryWrite
+
+	// Whether the step was a debug break
+	brk bool
+}
+
+type memoryWrite struct {
+	addr uint32
+	old  uint16
+}
+
+// Undo log of the most recent steps of a VM
+type stepHistory struct {
+	records []*stepRecord
+	limit   int
+
+	// Record of the step currently being executed
+	current *stepRecord
+}
+
+// EnableHistory starts recording an undo log of every step, which allows going back up to "limit" steps via StepBack.
+// A limit of 0 or less uses the default limit.
+func (vm *VM) EnableHistory(limit int) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	vm.history = &stepHistory{
+		records: make([]*stepRecord, 0),
+		limit:   limit,
+	}
+}
+
+// HistoryLength returns the number of steps that can currently be undone
+func (vm *VM) HistoryLength() int {
+	if vm.history == nil {
+		return 0
+	}
+
+	return len(vm.history.records)
+}
+
+// StepBack reverts the most recent step; Returns false if there is no history left
+func (vm *VM) StepBack() bool {
+	if vm.history == nil || len(vm.history.records) == 0 {
+		return false
+	}
+
+	rec := vm.history.records[len(vm.history.records)-1]
+	vm.history.records = vm.history.records[:len(vm.history.records)-1]
+
+	// Memory writes are reverted in reverse order, in case a cell was written more than once
+	for i := len(rec.sramWrites) - 1; i >= 0; i-- {
+		vm.SRAM[rec.sramWrites[i].addr] = rec.sramWrites[i].old
+	}
+
+	for i := len(rec.vgaWrites) - 1; i >= 0; i-- {
+		relAddr := uint16(rec.vgaWrites[i].addr)
+		current := vm.VgaBuffer[relAddr]
+		vm.VgaBuffer[relAddr] = rec.vgaWrites[i].old
+
+		if vm.VgaChangeCallback != nil {
+			vm.VgaChangeCallback(relAddr, relAddr%vm.VgaWidth, relAddr/vm.VgaWidth, current, rec.vgaWrites[i].old)
+		}
+	}
+
+	restoreRegisterValues(vm.RegDef, rec.regDef)
+	restoreRegisterValues(vm.RegIrq, rec.regIrq)
+
+	vm.Halted = rec.halted
+	vm.SRAMPageDef = rec.sramPageDef
+	vm.SRAMPageIrq = rec.sramPageIrq
+	vm.IrqEn = rec.irqEn
+	vm.InIrq = rec.inIrq
+	vm.IrqHandler = rec.irqHandler
+	vm.irqDataBuf = rec.irqDataBuf
+	vm.StepCounter = rec.stepCounter
+	vm.CycleCounter = rec.cycleCounter
+
+	// IRQs injected after the step are dropped as well
+	vm.setIrqQueue(rec.irqQueue)
+
+	return true
+}
+
+// Returns the undo record of the most recently executed step, or nil if there is none
+func (vm *VM) lastStepRecord() *stepRecord {
+	if vm.history == nil || len(vm.history.records) == 0 {
+		return nil
+	}
+
+	return vm.history.records[len(vm.history.records)-1]
+}
+
+// Starts recording a step, called before any state is modified
+func (h *stepHistory) begin(vm *VM) {
+	rec := &stepRecord{
+		regDef:       registerValues(vm.RegDef),
+		regIrq:       registerValues(vm.RegIrq),
+		halted:       vm.Halted,
+		sramPageDef:  vm.SRAMPageDef,
+		sramPageIrq:  vm.SRAMPageIrq,
+		irqEn:        vm.IrqEn,
+		inIrq:        vm.InIrq,
+		irqHandler:   vm.IrqHandler,
+		irqDataBuf:   vm.irqDataBuf,
+		stepCounter:  vm.StepCounter,
+		cycleCounter: vm.CycleCounter,
+		irqQueue:     vm.queuedIRQs(),
+	}
+
+	h.current = rec
+}
+
+// Finishes recording a step and appends it to the history, dropping the oldest record if the limit is reached
+func (h *stepHistory) end(brk bool) {
+	h.current.brk = brk
+
+	if len(h.records) >= h.limit {
+		h.records = h.records[1:]
+	}
+
+	h.records = append(h.records, h.current)
+	h.current = nil
+}
+
+// Records the old value of an SRAM cell before it is written
+func (vm *VM) recordSRAMWrite(addr uint) {
+	if vm.history != nil {
+		vm.history.current.sramWrites = append(vm.history.current.sramWrites, memoryWrite{uint32(addr), vm.SRAM[addr]})
+	}
+}
+
+// Records the old value of a VGA buffer cell (addr relative to VgaBuffer) before it is written
+func (vm *VM) recordVgaWrite(relAddr uint16) {
+	if vm.history != nil {
+		vm.history.current.vgaWrites = append(vm.history.current.vgaWrites, memoryWrite{uint32(relAddr), vm.VgaBuffer[relAddr]})
+	}
+}
+
+func (vm *VM) endHistoryStep(brk bool) {
+	if vm.history != nil {
+		vm.history.end(brk)
+	}
+}
+
+// Drops all recorded steps, e.g. after the state has been replaced
+func (vm *VM) clearHistory() {
+	if vm.history != nil {
+		vm.history.records = make([]*stepRecord, 0)
+	}
+}
+
+func registerValues(reg *Registers) [16]uint16 {
+	var retval [16]uint16
+	for i, r := range registerList(reg) {
+		retval[i] = r.Value
+	}
+
+	return retval
+}
+
+func restoreRegisterValues(reg *Registers, values [16]uint16) {
+	for i, r := range registerList(reg) {
+		r.Value = values[i]
+	}
+}
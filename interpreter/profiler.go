@@ -0,0 +1,565 @@
+package interpreter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Word written by "STOR PC SP" (MEMW SP PC), the instruction in the middle of the CALL macro that stores the return address
+const callMarkerWord uint16 = 0xB0A7
+
+// ProfileOptions controls the output of Profile
+type ProfileOptions struct {
+	// Path to write folded stacks to (one "func1;func2;func3 <cycles>" line per stack), empty to skip
+	FoldedFile string
+
+	// Number of entries shown per table, 0 to show all
+	Top int
+}
+
+type profileCounts struct {
+	Steps  int64
+	Cycles int64
+}
+
+func (c *profileCounts) add(cycles int64) {
+	c.Steps++
+	c.Cycles += cycles
+}
+
+// Node of the call tree, every distinct call stack has its own node
+type callNode struct {
+	name     string
+	parent   *callNode
+	children map[string]*callNode
+	self     profileCounts
+}
+
+func (n *callNode) child(name string) *callNode {
+	if c, ok := n.children[name]; ok {
+		return c
+	}
+
+	if n.children == nil {
+		n.children = make(map[string]*callNode)
+	}
+
+	c := &callNode{name: name, parent: n}
+	n.children[name] = c
+	return c
+}
+
+// Returns the function names from the outermost frame down to n
+func (n *callNode) stack() []string {
+	stack := make([]string, 0)
+	for ; n.parent != nil; n = n.parent {
+		stack = append(stack, n.name)
+	}
+
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+
+	return stack
+}
+
+// Shadow call stack entry, pushed on CALL or IRQ entry
+type profileFrame struct {
+	// Call tree node of the calling function
+	caller *callNode
+
+	// Address RET jumps back to (address of the CALL's "STOR PC SP" + 2)
+	returnAddr uint16
+	inIrq      bool
+
+	// Frame pushed on IRQ entry, popped on IRQ exit together with all calls made by the handler
+	irq bool
+}
+
+type callEdge struct {
+	caller, callee string
+}
+
+type profiler struct {
+	vm *VM
+
+	root   *callNode
+	frames []profileFrame
+
+	addresses map[uint16]*profileCounts
+	calls     map[callEdge]int64
+	total     profileCounts
+
+	// Frame of the CALL currently being executed, pushed once the jump to the callee has been performed
+	pendingCall *profileFrame
+
+	// Function name cache per address
+	functions map[uint16]string
+
+	// Targets of all CALLs seen so far (sorted), used to name functions if no symbols are available
+	callTargets []uint16
+}
+
+func newProfiler(vm *VM) *profiler {
+	return &profiler{
+		vm:        vm,
+		root:      &callNode{},
+		addresses: make(map[uint16]*profileCounts),
+		calls:     make(map[callEdge]int64),
+		functions: make(map[uint16]string),
+	}
+}
+
+// Returns the call tree node for the current call stack, up to (but excluding) the currently executing function
+func (p *profiler) current() *callNode {
+	if len(p.frames) == 0 {
+		return p.root
+	}
+
+	return p.frames[len(p.frames)-1].caller
+}
+
+// Returns the name of the function the given address belongs to;
+// Prefers the MSCR function, then the closest label and finally the closest CALL target seen so far
+func (p *profiler) functionAt(addr uint16) string {
+	if name, ok := p.functions[addr]; ok {
+		return name
+	}
+
+	name := ""
+	if debugInfo != nil {
+		if w := debugInfo.Lookup(addr); w != nil && w.Mscr != nil && w.Mscr.Function != "" {
+			name = w.Mscr.Function
+		} else if label, ok := debugInfo.NearestLabel(addr); ok {
+			name = strings.TrimPrefix(label.Name, ".")
+		}
+	}
+
+	if name == "" {
+		i := sort.Search(len(p.callTargets), func(i int) bool { return p.callTargets[i] > addr })
+		if i > 0 {
+			name = fmt.Sprintf("sub_%04X", p.callTargets[i-1])
+		} else {
+			name = "start"
+		}
+	}
+
+	p.functions[addr] = name
+	return name
+}
+
+func (p *profiler) addCallTarget(addr uint16) {
+	i := sort.Search(len(p.callTargets), func(i int) bool { return p.callTargets[i] >= addr })
+	if i < len(p.callTargets) && p.callTargets[i] == addr {
+		return
+	}
+
+	p.callTargets = append(p.callTargets, 0)
+	copy(p.callTargets[i+1:], p.callTargets[i:])
+	p.callTargets[i] = addr
+
+	// Names derived from call targets might have changed
+	if debugInfo == nil {
+		p.functions = make(map[uint16]string)
+	}
+}
+
+// Executes a single step of the given execution and attributes it to the call stack
+func (p *profiler) step(execution *Execution) (StopReason, error) {
+	vm := p.vm
+
+	// Mirror the VM's IRQ entry to know which instruction is going to be executed
+	pc := vm.Registers().PC.Value
+	inIrq := vm.InIrq
+	if !inIrq && vm.IrqEn && len(vm.IrqQueue) > 0 {
+		p.frames = append(p.frames, profileFrame{
+			caller: p.current().child(p.functionAt(pc)),
+			irq:    true,
+		})
+
+		pc = vm.IrqHandler
+		inIrq = true
+	}
+
+	isCall := int(pc) < len(vm.EEPROM) && vm.EEPROM[pc] == callMarkerWord
+	cycles := vm.CycleCounter
+
+	reason, err := execution.Step()
+	if reason == StopStepLimit || reason == StopTimeout || reason == StopError {
+		return reason, err
+	}
+
+	cycles = vm.CycleCounter - cycles
+	p.total.add(cycles)
+
+	counts, ok := p.addresses[pc]
+	if !ok {
+		counts = &profileCounts{}
+		p.addresses[pc] = counts
+	}
+	counts.add(cycles)
+
+	caller := p.current()
+	caller.child(p.functionAt(pc)).self.add(cycles)
+
+	newPC := vm.Registers().PC.Value
+
+	switch {
+	case p.pendingCall != nil:
+		// Step after the return address has been stored: Jump to the callee
+		p.addCallTarget(newPC)
+		p.calls[callEdge{p.pendingCall.caller.name, p.functionAt(newPC)}]++
+		p.frames = append(p.frames, *p.pendingCall)
+		p.pendingCall = nil
+
+	case isCall:
+		p.pendingCall = &profileFrame{
+			caller:     caller.child(p.functionAt(pc)),
+			returnAddr: pc + 2,
+			inIrq:      vm.InIrq,
+		}
+
+	case inIrq && !vm.InIrq:
+		// IRQ exit, drop the handler's frames
+		for len(p.frames) > 0 {
+			frame := p.frames[len(p.frames)-1]
+			p.frames = p.frames[:len(p.frames)-1]
+			if frame.irq {
+				break
+			}
+		}
+
+	case len(p.frames) > 0:
+		top := p.frames[len(p.frames)-1]
+		if !top.irq && top.inIrq == vm.InIrq && newPC == top.returnAddr {
+			p.frames = p.frames[:len(p.frames)-1]
+		}
+	}
+
+	return reason, err
+}
+
+// Profile runs the given binary in a virtual MCPC without any IO and reports where instructions and cycles have been spent:
+// A flat profile per function, the hottest source lines and addresses, as well as a call graph derived from CALL/RET patterns.
+func Profile(file, symbolOverride string, limits ExecutionLimits, options ProfileOptions) {
+	data16, err := loadBinary(file)
+	if err != nil {
+		log.Fatalln("ERROR: An error occured reading the input file: " + err.Error())
+	}
+
+	symbolPath := file + ".msym"
+	if symbolOverride != "" {
+		symbolPath = symbolOverride
+	}
+
+	if !loadSymbols(symbolPath) {
+		log.Println("No symbol file found, functions are named after their addresses")
+	}
+
+	vm := NewVM(data16, 120, 65)
+	p := newProfiler(vm)
+
+	limits.Brk = BrkIgnore
+	execution := StartExecution(vm, limits)
+
+	log.Println("Profiling...")
+
+	var reason StopReason
+	for {
+		reason, err = p.step(execution)
+		if reason != StopNone {
+			break
+		}
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	switch reason {
+	case StopHalted:
+		fmt.Fprintf(out, "Profile of %s: %d steps, %d cycles (halted, H = 0x%04X)\n", file, p.total.Steps, p.total.Cycles, vm.RegDef.H.Value)
+	case StopError:
+		fmt.Fprintf(out, "Profile of %s: %d steps, %d cycles (VM error at PC=0x%04X: %s)\n", file, p.total.Steps, p.total.Cycles, vm.Registers().PC.Value, err.Error())
+	default:
+		fmt.Fprintf(out, "Profile of %s: %d steps, %d cycles (%s)\n", file, p.total.Steps, p.total.Cycles, reason)
+	}
+
+	p.writeFlat(out, options.Top)
+	p.writeLines(out, options.Top)
+	p.writeAddresses(out, options.Top)
+	p.writeCallGraph(out, options.Top)
+
+	if options.FoldedFile != "" {
+		f, err := os.Create(options.FoldedFile)
+		if err != nil {
+			out.Flush()
+			log.Fatalln("ERROR: Could not write folded stacks: " + err.Error())
+		}
+
+		w := bufio.NewWriter(f)
+		p.writeFolded(w)
+		w.Flush()
+		f.Close()
+	}
+}
+
+type profileEntry struct {
+	name  string
+	self  profileCounts
+	total profileCounts
+	extra string
+}
+
+// Sorts entries by cycles (total if available, self otherwise) and name, and limits them to top entries
+func sortProfileEntries(entries []*profileEntry, byTotal bool, top int) []*profileEntry {
+	key := func(e *profileEntry) int64 {
+		if byTotal {
+			return e.total.Cycles
+		}
+		return e.self.Cycles
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if key(entries[i]) != key(entries[j]) {
+			return key(entries[i]) > key(entries[j])
+		}
+		return entries[i].name < entries[j].name
+	})
+
+	if top > 0 && len(entries) > top {
+		entries = entries[:top]
+	}
+
+	return entries
+}
+
+func (p *profiler) percent(cycles int64) float64 {
+	if p.total.Cycles == 0 {
+		return 0
+	}
+
+	return float64(cycles) * 100 / float64(p.total.Cycles)
+}
+
+// Walks the call tree, calling f for every node (except the root)
+func (p *profiler) walk(f func(n *callNode)) {
+	var visit func(n *callNode)
+	visit = func(n *callNode) {
+		if n != p.root {
+			f(n)
+		}
+
+		for _, c := range n.children {
+			visit(c)
+		}
+	}
+
+	visit(p.root)
+}
+
+// Self and total (including callees) counts per function; Recursive calls are only counted once towards the total
+func (p *profiler) functionCounts() map[string]*profileEntry {
+	functions := make(map[string]*profileEntry)
+	get := func(name string) *profileEntry {
+		e, ok := functions[name]
+		if !ok {
+			e = &profileEntry{name: name}
+			functions[name] = e
+		}
+		return e
+	}
+
+	p.walk(func(n *callNode) {
+		if n.self.Steps == 0 {
+			return
+		}
+
+		get(n.name).self.Steps += n.self.Steps
+		get(n.name).self.Cycles += n.self.Cycles
+
+		seen := make(map[string]bool)
+		for _, name := range n.stack() {
+			if !seen[name] {
+				seen[name] = true
+				get(name).total.Steps += n.self.Steps
+				get(name).total.Cycles += n.self.Cycles
+			}
+		}
+	})
+
+	return functions
+}
+
+func (p *profiler) writeFlat(out io.Writer, top int) {
+	entries := make([]*profileEntry, 0)
+	for _, e := range p.functionCounts() {
+		entries = append(entries, e)
+	}
+
+	fmt.Fprintln(out, "\nFlat profile (by self cycles):")
+	fmt.Fprintf(out, "%12s %7s %12s %12s %7s %12s  %s\n", "self cycles", "%", "self steps", "total cycles", "%", "total steps", "function")
+	for _, e := range sortProfileEntries(entries, false, top) {
+		fmt.Fprintf(out, "%12d %6.2f%% %12d %12d %6.2f%% %12d  %s\n", e.self.Cycles, p.percent(e.self.Cycles), e.self.Steps, e.total.Cycles, p.percent(e.total.Cycles), e.total.Steps, e.name)
+	}
+}
+
+func (p *profiler) writeLines(out io.Writer, top int) {
+	if debugInfo == nil {
+		return
+	}
+
+	lines := make(map[string]*profileEntry)
+	for addr, counts := range p.addresses {
+		location := debugInfo.Location(addr)
+		if location == "" {
+			location = fmt.Sprintf("0x%04X", addr)
+		}
+
+		e, ok := lines[location]
+		if !ok {
+			e = &profileEntry{name: location, extra: sourceLineText(addr)}
+			lines[location] = e
+		}
+
+		e.self.Steps += counts.Steps
+		e.self.Cycles += counts.Cycles
+	}
+
+	entries := make([]*profileEntry, 0, len(lines))
+	for _, e := range lines {
+		entries = append(entries, e)
+	}
+
+	fmt.Fprintln(out, "\nHotspot lines:")
+	fmt.Fprintf(out, "%12s %7s %12s  %-32s %s\n", "cycles", "%", "steps", "location", "source")
+	for _, e := range sortProfileEntries(entries, false, top) {
+		fmt.Fprintf(out, "%12d %6.2f%% %12d  %-32s %s\n", e.self.Cycles, p.percent(e.self.Cycles), e.self.Steps, e.name, e.extra)
+	}
+}
+
+func (p *profiler) writeAddresses(out io.Writer, top int) {
+	entries := make([]*profileEntry, 0, len(p.addresses))
+	for addr, counts := range p.addresses {
+		ins := p.vm.EEPROM[addr]
+		e := &profileEntry{
+			name:  fmt.Sprintf("0x%04X", addr),
+			self:  *counts,
+			extra: fmt.Sprintf("0x%04X %-5s %s", ins, cycleTableNames[ins&0x000F], p.functionAt(addr)),
+		}
+
+		if debugInfo != nil {
+			if w := debugInfo.Lookup(addr); w != nil {
+				e.extra += " :: " + strings.TrimSpace(debugInfo.SourceLine(w.File, w.Line))
+			}
+		}
+
+		entries = append(entries, e)
+	}
+
+	fmt.Fprintln(out, "\nHotspot addresses:")
+	fmt.Fprintf(out, "%12s %7s %12s  %-6s  %s\n", "cycles", "%", "steps", "addr", "instruction")
+	for _, e := range sortProfileEntries(entries, false, top) {
+		fmt.Fprintf(out, "%12d %6.2f%% %12d  %-6s  %s\n", e.self.Cycles, p.percent(e.self.Cycles), e.self.Steps, e.name, e.extra)
+	}
+}
+
+// Writes a gprof-style call graph: For every function its callers and callees with call counts,
+// as well as the cycles spent in the callee (including its callees) on behalf of the function
+func (p *profiler) writeCallGraph(out io.Writer, top int) {
+	functions := p.functionCounts()
+
+	// Cycles spent below each caller -> callee edge
+	edges := make(map[callEdge]*profileCounts)
+	p.walk(func(n *callNode) {
+		if n.self.Steps == 0 {
+			return
+		}
+
+		stack := n.stack()
+		seen := make(map[callEdge]bool)
+		for i := 0; i+1 < len(stack); i++ {
+			edge := callEdge{stack[i], stack[i+1]}
+			if seen[edge] {
+				continue
+			}
+			seen[edge] = true
+
+			counts, ok := edges[edge]
+			if !ok {
+				counts = &profileCounts{}
+				edges[edge] = counts
+			}
+			counts.Steps += n.self.Steps
+			counts.Cycles += n.self.Cycles
+		}
+	})
+
+	entries := make([]*profileEntry, 0, len(functions))
+	for _, e := range functions {
+		entries = append(entries, e)
+	}
+
+	fmt.Fprintln(out, "\nCall graph (by total cycles; callers above, callees below each function):")
+	fmt.Fprintf(out, "%12s %7s %12s %10s  %s\n", "total cycles", "%", "self cycles", "calls", "function")
+
+	for _, e := range sortProfileEntries(entries, true, top) {
+		fmt.Fprintln(out, strings.Repeat("-", 80))
+
+		for _, edge := range sortedEdges(edges, func(edge callEdge) bool { return edge.callee == e.name }) {
+			fmt.Fprintf(out, "%12d %7s %12s %10d      %s\n", edges[edge].Cycles, "", "", p.calls[edge], edge.caller)
+		}
+
+		calls := int64(0)
+		for edge, n := range p.calls {
+			if edge.callee == e.name {
+				calls += n
+			}
+		}
+
+		fmt.Fprintf(out, "%12d %6.2f%% %12d %10d  %s\n", e.total.Cycles, p.percent(e.total.Cycles), e.self.Cycles, calls, e.name)
+
+		for _, edge := range sortedEdges(edges, func(edge callEdge) bool { return edge.caller == e.name }) {
+			fmt.Fprintf(out, "%12d %7s %12s %10d      %s\n", edges[edge].Cycles, "", "", p.calls[edge], edge.callee)
+		}
+	}
+}
+
+// Returns all edges matching the filter, sorted by cycles
+func sortedEdges(edges map[callEdge]*profileCounts, filter func(edge callEdge) bool) []callEdge {
+	retval := make([]callEdge, 0)
+	for edge := range edges {
+		if filter(edge) {
+			retval = append(retval, edge)
+		}
+	}
+
+	sort.Slice(retval, func(i, j int) bool {
+		a, b := edges[retval[i]].Cycles, edges[retval[j]].Cycles
+		if a != b {
+			return a > b
+		}
+		return retval[i].caller+retval[i].callee < retval[j].caller+retval[j].callee
+	})
+
+	return retval
+}
+
+// Writes one line per call stack in the folded format used by flamegraph tools (e.g. flamegraph.pl, speedscope), weighted by cycles
+func (p *profiler) writeFolded(out io.Writer) {
+	lines := make([]string, 0)
+	p.walk(func(n *callNode) {
+		if n.self.Cycles > 0 {
+			lines = append(lines, fmt.Sprintf("%s %d", strings.Join(n.stack(), ";"), n.self.Cycles))
+		}
+	})
+
+	sort.Strings(lines)
+	for _, line := range lines {
+		fmt.Fprintln(out, line)
+	}
+}
@@ -0,0 +1,304 @@
+package interpreter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Magic bytes at the start of every snapshot file
+const snapshotMagic = "MCPCSNAP"
+
+// Version of the snapshot format, increase on every incompatible change
+const snapshotVersion uint16 = 2
+
+// SRAM is stored in blocks of this many words, blocks that only contain zeroes are omitted
+const snapshotSRAMBlockSize = 0x1000
+
+// Snapshot writes the entire machine state (registers, memory, VGA and IRQ state, as well as the program itself) to w.
+// The format consists of a short uncompressed header (magic and version), followed by the gzip-compressed state.
+func (vm *VM) Snapshot(w io.Writer) error {
+	if _, err := io.WriteString(w, snapshotMagic); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, binary.BigEndian, snapshotVersion); err != nil {
+		return err
+	}
+
+	zw, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+
+	sw := &snapshotWriter{w: bufio.NewWriter(zw)}
+
+	sw.registers(vm.RegDef)
+	sw.registers(vm.RegIrq)
+	sw.write(vm.Halted, vm.SRAMPageDef, vm.SRAMPageIrq, vm.StepCounter, vm.CycleCounter)
+
+	sw.words(vm.EEPROM)
+
+	sw.write(vm.VgaWidth, vm.VgaHeight)
+	sw.words(vm.VgaBuffer)
+
+	queue := vm.queuedIRQs()
+	sw.write(vm.IrqEn, vm.InIrq, vm.IrqHandler, vm.irqDataBuf, uint32(len(queue)), queue)
+
+	// Sparse SRAM
+	blocks := make([]uint32, 0)
+	for i := 0; i < len(vm.SRAM); i += snapshotSRAMBlockSize {
+		for _, word := range sramBlockAt(vm.SRAM, i) {
+			if word != 0 {
+				blocks = append(blocks, uint32(i))
+				break
+			}
+		}
+	}
+
+	sw.write(uint32(len(vm.SRAM)), uint32(len(blocks)))
+	for _, start := range blocks {
+		sw.write(start)
+		sw.words(sramBlockAt(vm.SRAM, int(start)))
+	}
+
+	if sw.err != nil {
+		return sw.err
+	}
+
+	if err := sw.w.Flush(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// Restore replaces the machine state with a snapshot previously written by Snapshot.
+// The VM is only modified if the entire snapshot could be read successfully. Callbacks are kept as they are.
+func (vm *VM) Restore(r io.Reader) error {
+	header := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(r, header); err != nil || string(header) != snapshotMagic {
+		return errors.New("Not an MCPC snapshot file")
+	}
+
+	var version uint16
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+
+	if version != snapshotVersion {
+		return fmt.Errorf("Unsupported snapshot version %d (expected %d)", version, snapshotVersion)
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	sr := &snapshotReader{r: bufio.NewReader(zr)}
+
+	restored := VM{
+		RegDef: sr.registers(),
+		RegIrq: sr.registers(),
+	}
+
+	sr.read(&restored.Halted, &restored.SRAMPageDef, &restored.SRAMPageIrq, &restored.StepCounter, &restored.CycleCounter)
+
+	restored.EEPROM = sr.words()
+
+	sr.read(&restored.VgaWidth, &restored.VgaHeight)
+	restored.VgaBuffer = sr.words()
+
+	var queueLength uint32
+	sr.read(&restored.IrqEn, &restored.InIrq, &restored.IrqHandler, &restored.irqDataBuf, &queueLength)
+	if sr.err == nil && queueLength > uint32(cap(vm.IrqQueue)) {
+		return fmt.Errorf("Invalid snapshot: IRQ queue too long (%d entries)", queueLength)
+	}
+
+	queue := make([]uint32, queueLength)
+	sr.read(queue)
+
+	var sramLength, blockCount uint32
+	sr.read(&sramLength, &blockCount)
+	if sr.err == nil && sramLength != uint32(len(vm.SRAM)) {
+		return fmt.Errorf("Invalid snapshot: SRAM size mismatch (h%X words, expected h%X)", sramLength, len(vm.SRAM))
+	}
+
+	// Blocks are collected first, SRAM is only cleared once the snapshot turned out to be valid
+	type sramBlock struct {
+		start uint32
+		data  []uint16
+	}
+
+	blocks := make([]sramBlock, 0, blockCount)
+	for i := uint32(0); i < blockCount && sr.err == nil; i++ {
+		var block sramBlock
+		sr.read(&block.start)
+		block.data = sr.words()
+
+		if sr.err == nil && uint64(block.start)+uint64(len(block.data)) > uint64(sramLength) {
+			return fmt.Errorf("Invalid snapshot: SRAM block at h%X out of range", block.start)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	if sr.err != nil {
+		return errors.New("Invalid snapshot: " + sr.err.Error())
+	}
+
+	if len(restored.VgaBuffer) != int(restored.VgaWidth)*int(restored.VgaHeight) {
+		return errors.New("Invalid snapshot: VGA buffer does not match VGA size")
+	}
+
+	// Snapshot is valid, apply state
+	vm.RegDef = restored.RegDef
+	vm.RegIrq = restored.RegIrq
+	vm.Halted = restored.Halted
+	vm.SRAMPageDef = restored.SRAMPageDef
+	vm.SRAMPageIrq = restored.SRAMPageIrq
+	vm.StepCounter = restored.StepCounter
+	vm.CycleCounter = restored.CycleCounter
+	vm.EEPROM = restored.EEPROM
+	vm.VgaWidth = restored.VgaWidth
+	vm.VgaHeight = restored.VgaHeight
+	vm.VgaBuffer = restored.VgaBuffer
+	vm.IrqEn = restored.IrqEn
+	vm.InIrq = restored.InIrq
+	vm.IrqHandler = restored.IrqHandler
+	vm.irqDataBuf = restored.irqDataBuf
+
+	vm.setIrqQueue(queue)
+
+	for i := range vm.SRAM {
+		vm.SRAM[i] = 0
+	}
+	for _, block := range blocks {
+		copy(vm.SRAM[block.start:], block.data)
+	}
+
+	// Steps taken before restoring can not be undone anymore
+	vm.clearHistory()
+
+	return nil
+}
+
+// Returns the SRAM block starting at the given address (the last block may be shorter)
+func sramBlockAt(sram []uint16, start int) []uint16 {
+	end := start + snapshotSRAMBlockSize
+	if end > len(sram) {
+		end = len(sram)
+	}
+
+	return sram[start:end]
+}
+
+// SaveSnapshot writes a snapshot of the VM to the given file (see Snapshot)
+func (vm *VM) SaveSnapshot(file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+
+	err = vm.Snapshot(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// LoadSnapshot restores the VM from the given snapshot file (see Restore)
+func (vm *VM) LoadSnapshot(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return vm.Restore(f)
+}
+
+// Binary writer for snapshots; The first error is kept and all subsequent writes are ignored
+type snapshotWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (sw *snapshotWriter) write(values ...interface{}) {
+	for _, v := range values {
+		if sw.err == nil {
+			sw.err = binary.Write(sw.w, binary.BigEndian, v)
+		}
+	}
+}
+
+// Writes a length-prefixed slice of words
+func (sw *snapshotWriter) words(data []uint16) {
+	sw.write(uint32(len(data)), data)
+}
+
+// Only register values are stored, addresses and write permissions are fixed
+func (sw *snapshotWriter) registers(reg *Registers) {
+	for _, r := range registerList(reg) {
+		sw.write(r.Value)
+	}
+}
+
+// Binary reader for snapshots; The first error is kept and all subsequent reads are ignored
+type snapshotReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (sr *snapshotReader) read(values ...interface{}) {
+	for _, v := range values {
+		if sr.err == nil {
+			sr.err = binary.Read(sr.r, binary.BigEndian, v)
+		}
+	}
+}
+
+// Upper bound for length-prefixed word slices, guards against allocating huge amounts of memory for corrupt files
+const snapshotMaxWords = 0x10000
+
+func (sr *snapshotReader) words() []uint16 {
+	var length uint32
+	sr.read(&length)
+
+	if sr.err != nil {
+		return nil
+	}
+
+	if length > snapshotMaxWords {
+		sr.err = fmt.Errorf("data block too long (h%X words)", length)
+		return nil
+	}
+
+	data := make([]uint16, length)
+	sr.read(data)
+
+	return data
+}
+
+func (sr *snapshotReader) registers() *Registers {
+	reg := generateEmptyRegisterSet(0)
+	for _, r := range registerList(reg) {
+		sr.read(&r.Value)
+	}
+
+	return reg
+}
+
+// Returns all registers of a register set in order of their addresses
+func registerList(reg *Registers) []*Register {
+	return []*Register{
+		reg.A, reg.B, reg.C, reg.D, reg.E, reg.F, reg.G, reg.H,
+		reg.SCR1, reg.SCR2, reg.SP, reg.PC, reg.Zero, reg.One, reg.NegOne, reg.BUS,
+	}
+}
@@ -0,0 +1,117 @@
+package interpreter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/PiMaker/MCPC-Software/debuginfo"
+	"github.com/mileusna/conditional"
+	"github.com/rivo/tview"
+)
+
+// Debug info for the currently loaded program, nil if no symbol file has been loaded
+var debugInfo *debuginfo.DebugInfo
+
+// Loads debug info (.msym) from the given path if it exists; Returns true if symbols have been loaded
+func loadSymbols(symbolPath string) bool {
+	if _, err := os.Stat(symbolPath); err != nil {
+		return false
+	}
+
+	info, err := debuginfo.Load(symbolPath)
+	if err != nil {
+		log.Fatalln("ERROR: Symbol file found, but an error occured reading it: " + err.Error())
+	}
+
+	debugInfo = info
+	log.Println("Symbol file found and loaded!")
+
+	return true
+}
+
+// Returns all labels at the given address as a comma-separated string
+func symbolAt(addr uint16) (string, bool) {
+	if debugInfo == nil {
+		return "", false
+	}
+
+	labels := debugInfo.LabelsAt(addr)
+	if len(labels) == 0 {
+		return "", false
+	}
+
+	return strings.Join(labels, ", "), true
+}
+
+// Returns the short source location of the given address (e.g. "entry.mscr:40 (main)"), or an empty string if unknown
+func sourceLocation(addr uint16) string {
+	if debugInfo == nil {
+		return ""
+	}
+
+	return debugInfo.Location(addr)
+}
+
+// Returns the (trimmed) source line the given address was generated from, preferring the MSCR source if available
+func sourceLineText(addr uint16) string {
+	if debugInfo == nil {
+		return ""
+	}
+
+	w := debugInfo.Lookup(addr)
+	if w == nil {
+		return ""
+	}
+
+	if w.Mscr != nil {
+		return strings.TrimSpace(debugInfo.SourceLine(w.Mscr.File, w.Mscr.Line))
+	}
+
+	return strings.TrimSpace(debugInfo.SourceLine(w.File, w.Line))
+}
+
+// Formatted source view (tview color tags) for the given address, showing MSCR and assembler source with some context
+func getSourceText(addr uint16, context int) string {
+	if debugInfo == nil {
+		return "No debug info loaded"
+	}
+
+	w := debugInfo.Lookup(addr)
+	if w == nil {
+		return fmt.Sprintf("No source information for 0x%04X", addr)
+	}
+
+	retval := ""
+
+	if w.Mscr != nil {
+		retval += fmt.Sprintf("[blue]%s:%d[white] (%s)\n", debugInfo.FilePath(w.Mscr.File), w.Mscr.Line, w.Mscr.Function)
+		retval += sourceContext(w.Mscr.File, w.Mscr.Line, context)
+		retval += "\n"
+	}
+
+	retval += fmt.Sprintf("[blue]%s:%d[white]\n", debugInfo.FilePath(w.File), w.Line)
+	retval += sourceContext(w.File, w.Line, conditional.Int(w.Mscr == nil, context, 0))
+
+	return retval
+}
+
+func sourceContext(file, line, context int) string {
+	retval := ""
+
+	for l := line - context; l <= line+context; l++ {
+		if l < 1 {
+			continue
+		}
+
+		text := tview.Escape(debugInfo.SourceLine(file, l))
+		if l == line {
+			retval += fmt.Sprintf("[%s]%5d > %s[white]\n", colorCmd, l, text)
+		} else {
+			retval += fmt.Sprintf("[%s]%5d   %s[white]\n", colorPCAddr, l, text)
+		}
+	}
+
+	return retval
+}
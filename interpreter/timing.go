@@ -0,0 +1,156 @@
+package interpreter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CycleTable holds the number of clock cycles every instruction takes on the hardware
+type CycleTable struct {
+	// Cost per opcode (lower 4 bits of the instruction word), includes the instruction fetch
+	Opcode [16]int64
+
+	// Additional cost of entering an IRQ handler (register bank switch and IRQ FIFO read)
+	IrqEnter int64
+}
+
+// Mnemonics used for the opcodes in cycle table files, indexed by opcode
+var cycleTableNames = [16]string{"HALT", "MOV", "MOVNZ", "MOVEZ", "BUS", "MEMR", "SET", "MEMW", "AND", "OR", "XOR", "ADD", "SHFT", "MUL", "GT", "EQ"}
+
+// DefaultCycleTable is used for every new VM; Approximates the FPGA build: Two cycles for fetch and decode,
+// one more for reading the SET immediate from EEPROM and two more for the SRAM/CFG bus handshake of MEMR/MEMW.
+var DefaultCycleTable = CycleTable{
+	Opcode:   [16]int64{2, 3, 3, 3, 3, 5, 4, 5, 3, 3, 3, 3, 3, 3, 3, 3},
+	IrqEnter: 4,
+}
+
+// LoadCycleTable reads a cycle table file, starting out from the default table.
+// Every line has the form "<mnemonic> <cycles>" (e.g. "MEMR 6"), IRQ sets the cost of entering an IRQ handler; '#' starts a comment.
+func LoadCycleTable(file string) (CycleTable, error) {
+	table := DefaultCycleTable
+
+	f, err := os.Open(file)
+	if err != nil {
+		return table, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		line := scanner.Text()
+		if comment := strings.Index(line, "#"); comment >= 0 {
+			line = line[:comment]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 2 {
+			return table, fmt.Errorf("Line %d: Expected '<mnemonic> <cycles>'", lineNumber)
+		}
+
+		cycles, err := strconv.ParseInt(fields[1], 0, 64)
+		if err != nil || cycles < 0 {
+			return table, fmt.Errorf("Line %d: Invalid cycle count '%s'", lineNumber, fields[1])
+		}
+
+		name := strings.ToUpper(fields[0])
+		if name == "IRQ" {
+			table.IrqEnter = cycles
+			continue
+		}
+
+		found := false
+		for opcode, opcodeName := range cycleTableNames {
+			if opcodeName == name {
+				table.Opcode[opcode] = cycles
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return table, fmt.Errorf("Line %d: Unknown mnemonic '%s'", lineNumber, fields[0])
+		}
+	}
+
+	return table, scanner.Err()
+}
+
+// ParseClock parses a clock frequency, either in Hz or with a unit (e.g. "25MHz", "500kHz", "1000")
+func ParseClock(clock string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(clock))
+	multiplier := 1.0
+
+	for _, unit := range []struct {
+		suffix     string
+		multiplier float64
+	}{{"mhz", 1e6}, {"khz", 1e3}, {"hz", 1}} {
+		if strings.HasSuffix(value, unit.suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	hz, err := strconv.ParseFloat(value, 64)
+	if err != nil || hz*multiplier < 1 {
+		return 0, fmt.Errorf("Invalid clock frequency '%s' (e.g. 25MHz, 500kHz, 1000)", clock)
+	}
+
+	return int64(hz * multiplier), nil
+}
+
+// If execution falls behind the target clock by more than this (e.g. while paused or on a slow host), the throttle starts over
+// instead of running at full speed until it has caught up
+const throttleMaxLag = 100 * time.Millisecond
+
+// clockThrottle slows execution down to a given clock frequency based on the cycle counter of the VM
+type clockThrottle struct {
+	hz int64
+
+	start       time.Time
+	startCycles int64
+
+	// Cycle count at which the next check happens, checks are done about once per millisecond of VM time
+	nextCheck int64
+}
+
+func newClockThrottle(vm *VM, hz int64) *clockThrottle {
+	t := &clockThrottle{hz: hz}
+	t.reset(vm)
+	return t
+}
+
+func (t *clockThrottle) reset(vm *VM) {
+	t.start = time.Now()
+	t.startCycles = vm.CycleCounter
+	t.nextCheck = vm.CycleCounter
+}
+
+// Sleeps until wall-clock time has caught up with the cycles executed since the throttle was (re)started
+func (t *clockThrottle) wait(vm *VM) {
+	if vm.CycleCounter < t.nextCheck {
+		return
+	}
+
+	t.nextCheck = vm.CycleCounter + t.hz/1000 + 1
+
+	target := time.Duration(float64(vm.CycleCounter-t.startCycles) / float64(t.hz) * float64(time.Second))
+	elapsed := time.Since(t.start)
+
+	if target > elapsed {
+		time.Sleep(target - elapsed)
+	} else if elapsed-target > throttleMaxLag {
+		t.reset(vm)
+	}
+}
@@ -3,6 +3,7 @@ package interpreter
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"unsafe"
 )
 
@@ -33,11 +34,29 @@ type VM struct {
 	IrqQueue   chan uint32
 	irqDataBuf uint32
 
+	// Contents of IrqQueue in FIFO order, so the queue can be inspected without draining the channel
+	irqMirror     []uint32
+	irqMirrorLock sync.Mutex
+
 	// addr relative to VgaBuffer (0 is x=y=0)
 	VgaChangeCallback func(addr, x, y, old, new uint16)
 
 	TraceCallback func(msg string, step int64)
 	StepCounter   int64
+
+	// Clock cycles executed so far, according to Cycles
+	CycleCounter int64
+	Cycles       CycleTable
+
+	// Called on every memory read (MEMR) and write (MEMW), after the access has been performed.
+	// addr is either the absolute SRAM address (including the page in the upper bits) or the CFG address if cfg is true.
+	MemoryAccessCallback func(addr uint32, cfg, write bool, value uint16)
+
+	// Called before every instruction is executed, with the EEPROM address of the instruction
+	InstructionCallback func(addr uint16)
+
+	// Undo log, nil unless enabled via EnableHistory
+	history *stepHistory
 }
 
 // Registers includes all registers of an MCPC instance
@@ -85,6 +104,15 @@ func (vm *VM) SRAMPage() uint16 {
 	return vm.SRAMPageDef
 }
 
+// Resolves a memory address as used by MEMR/MEMW; Returns the absolute SRAM address (with the current page), or the address itself for CFG accesses
+func (vm *VM) memoryAddress(addr uint16) (uint32, bool) {
+	if (addr & 0x8000) != 0 {
+		return uint32(addr), true
+	}
+
+	return uint32(vm.SRAMPage()&SRAMPageMask)<<16 | uint32(addr), false
+}
+
 // NewVM creates a new MCPC virtual machine instance
 func NewVM(program []uint16, vgaWidth, vgaHeight uint16) *VM {
 
@@ -116,6 +144,7 @@ func NewVM(program []uint16, vgaWidth, vgaHeight uint16) *VM {
 		IrqQueue:   make(chan uint32, 256),
 
 		StepCounter: -1,
+		Cycles:      DefaultCycleTable,
 	}
 
 	return &vm
@@ -127,6 +156,10 @@ func (vm *VM) Step() (bool, error) {
 		return false, nil
 	}
 
+	if vm.history != nil {
+		vm.history.begin(vm)
+	}
+
 	vm.StepCounter++
 
 	brk := false
@@ -136,19 +169,26 @@ func (vm *VM) Step() (bool, error) {
 	if vm.IrqEn && !vm.InIrq && len(vm.IrqQueue) > 0 {
 		vm.RegIrq = generateEmptyRegisterSet(vm.IrqHandler)
 		vm.InIrq = true
-		vm.irqDataBuf = <-vm.IrqQueue
+		vm.irqDataBuf = vm.dequeueIRQ()
 		vm.SRAMPageIrq = 0
+		vm.CycleCounter += vm.Cycles.IrqEnter
 
 		vm.t("IRQ enter, buf=h%08X, irq_reg_pc=h%04X (irq_handler=h%04X), #irq_q=%d", vm.irqDataBuf, vm.RegIrq.PC.Value, vm.IrqHandler, len(vm.IrqQueue))
 	}
 
 	if int(vm.Registers().PC.Value) >= len(vm.EEPROM) {
 		vm.t("Invalid EEPROM address: pc=h%04X, len(EEPROM)=h%X", vm.Registers().PC.Value, len(vm.EEPROM))
+		vm.endHistoryStep(false)
 		return false, errors.New("Invalid EEPROM address, PC out of range")
 	}
 
+	if vm.InstructionCallback != nil {
+		vm.InstructionCallback(vm.Registers().PC.Value)
+	}
+
 	ins := vm.EEPROM[vm.Registers().PC.Value]
 	instruction := ins & 0x000F
+	vm.CycleCounter += vm.Cycles.Opcode[instruction]
 
 	vm.t("Ins: EEPROM[h%04X]=h%04X (irq=%t)", vm.Registers().PC.Value, ins, vm.InIrq)
 	vm.tReg()
@@ -210,6 +250,7 @@ func (vm *VM) Step() (bool, error) {
 	case 0x5:
 		addrReg := GetReg(vm, ins, regFrom)
 		writeToReg := GetReg(vm, ins, regTo)
+		accessAddr, accessCfg := vm.memoryAddress(addrReg.Value)
 
 		vm.t("MEMR:")
 
@@ -284,6 +325,10 @@ func (vm *VM) Step() (bool, error) {
 			err = fmt.Errorf("Write to non-writable register %X", writeToReg.Address)
 		}
 
+		if vm.MemoryAccessCallback != nil && err == nil {
+			vm.MemoryAccessCallback(accessAddr, accessCfg, false, writeToReg.Value)
+		}
+
 	case 0x6:
 		vm.Registers().PC.Value++
 		reg := GetReg(vm, ins, regTo)
@@ -301,16 +346,19 @@ func (vm *VM) Step() (bool, error) {
 	case 0x7:
 		addrReg := GetReg(vm, ins, regFrom)
 		dataReg := GetReg(vm, ins, regIf)
+		accessAddr, accessCfg := vm.memoryAddress(addrReg.Value)
 
 		vm.t("MEMW:")
 
 		if (addrReg.Value & 0x8000) == 0 {
+			vm.recordSRAMWrite(uint(vm.SRAMPage()&SRAMPageMask)<<16 | uint(addrReg.Value))
 			vm.SRAM[uint(vm.SRAMPage()&SRAMPageMask)<<16|uint(addrReg.Value)] = dataReg.Value
 			vm.t("Direct, SRAM[h%07X] <- h%04X", uint(vm.SRAMPage()&SRAMPageMask)<<16|uint(addrReg.Value), dataReg.Value)
 		} else if addrReg.Value >= 0xE000 && addrReg.Value < uint16(0xE000+len(vm.VgaBuffer)) {
 			// VGA
 			relAddr := addrReg.Value - 0xE000
 			old := vm.VgaBuffer[relAddr]
+			vm.recordVgaWrite(relAddr)
 			vm.VgaBuffer[relAddr] = dataReg.Value
 			vm.t("CFG, VGA, write to addr=h%04X (rel=h%04X), x=%d y=%d rune=%v/('%s')", addrReg.Value, relAddr, relAddr%vm.VgaWidth, relAddr/vm.VgaWidth, rune(dataReg.Value), string(rune(dataReg.Value)))
 			if vm.VgaChangeCallback != nil {
@@ -331,9 +379,7 @@ func (vm *VM) Step() (bool, error) {
 			if dataReg.Value == 0 {
 				// Empty IRQ queue on IRQ disable
 				vm.t("IRQ disable, %d queued IRQs dropped", len(vm.IrqQueue))
-				for len(vm.IrqQueue) > 0 {
-					<-vm.IrqQueue
-				}
+				vm.setIrqQueue(nil)
 			}
 		} else if addrReg.Value == 0x9002 {
 			if dataReg.Value == 0 {
@@ -360,6 +406,10 @@ func (vm *VM) Step() (bool, error) {
 			vm.t("Unknown CFG, addr=h%04X", addrReg.Value)
 		}
 
+		if vm.MemoryAccessCallback != nil {
+			vm.MemoryAccessCallback(accessAddr, accessCfg, true, dataReg.Value)
+		}
+
 	case 0x8, 0x9, 0xA, 0xB, 0xC, 0xD, 0xE, 0xF:
 		registerTo := GetReg(vm, ins, regTo)
 		if registerTo.Writeable {
@@ -423,20 +473,61 @@ func (vm *VM) Step() (bool, error) {
 
 	vm.tReg()
 
+	vm.endHistoryStep(brk)
+
 	return brk, err
 }
 
 // InjectIRQ writes the given IRQ payload into the VM's IRQ FIFO
 func (vm *VM) InjectIRQ(irqData uint32) {
+	vm.irqMirrorLock.Lock()
+	defer vm.irqMirrorLock.Unlock()
+
 	// Discard IRQ if queue full or IRQ disabled
 	if vm.IrqEn && len(vm.IrqQueue) < cap(vm.IrqQueue) {
 		vm.t("> Interrupt enqueued: h%08X", irqData)
 		vm.IrqQueue <- irqData
+		vm.irqMirror = append(vm.irqMirror, irqData)
 	} else {
 		vm.t("> Interrupt discarded: h%08X", irqData)
 	}
 }
 
+// Takes the oldest IRQ from the queue, the queue must not be empty
+func (vm *VM) dequeueIRQ() uint32 {
+	vm.irqMirrorLock.Lock()
+	defer vm.irqMirrorLock.Unlock()
+
+	if len(vm.irqMirror) > 0 {
+		vm.irqMirror = vm.irqMirror[1:]
+	}
+
+	return <-vm.IrqQueue
+}
+
+// Returns a copy of the queued IRQs in FIFO order, without modifying the queue
+func (vm *VM) queuedIRQs() []uint32 {
+	vm.irqMirrorLock.Lock()
+	defer vm.irqMirrorLock.Unlock()
+
+	return append([]uint32(nil), vm.irqMirror...)
+}
+
+// Replaces the queued IRQs, e.g. when restoring a previous state; queue must not exceed the capacity of IrqQueue
+func (vm *VM) setIrqQueue(queue []uint32) {
+	vm.irqMirrorLock.Lock()
+	defer vm.irqMirrorLock.Unlock()
+
+	for len(vm.IrqQueue) > 0 {
+		<-vm.IrqQueue
+	}
+	for _, irq := range queue {
+		vm.IrqQueue <- irq
+	}
+
+	vm.irqMirror = append([]uint32(nil), queue...)
+}
+
 // GetReg extracts details about a register from an instruction in the context of a VM
 func GetReg(vm *VM, ins uint16, reg uint16) *Register {
 	addr := ins & reg
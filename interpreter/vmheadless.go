@@ -0,0 +1,298 @@
+package interpreter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+// Minimum number of VM steps between two keyboard IRQs injected by a KeyboardFeeder.
+// Piped input arrives a lot faster than a human could type, this gives the program time to consume its IRQ FIFO.
+const headlessKeyIrqInterval = 2000
+
+// KeyboardFeeder injects keyboard IRQs for typed text into a VM, one at a time and only once the program can handle them.
+// The zero value is an empty feeder ready to use.
+type KeyboardFeeder struct {
+	irqs     []uint32
+	nextStep int64
+}
+
+// Type queues the keyboard IRQs for typing the given text; LF is sent as enter, CR is ignored
+func (f *KeyboardFeeder) Type(text string) {
+	for _, r := range text {
+		f.TypeRune(r)
+	}
+}
+
+// TypeRune queues the keyboard IRQs for typing a single character
+func (f *KeyboardFeeder) TypeRune(r rune) {
+	// Ignore CR of CRLF line endings, LF is sent as enter
+	if r == '\r' {
+		return
+	}
+
+	if keyCode, ok := keycodeLookupControlRunes[r]; ok {
+		f.irqs = append(f.irqs, keyIRQs(keyCode, true)...)
+	} else {
+		f.irqs = append(f.irqs, runeKeyIRQs(r)...)
+	}
+}
+
+// Pending returns the number of queued IRQs that have not been injected yet
+func (f *KeyboardFeeder) Pending() int {
+	return len(f.irqs)
+}
+
+// Feed injects the next queued IRQ if the VM is ready for it, has to be called before every step.
+// IRQs are only injected if they can be handled right away, InjectIRQ would discard them while IRQs are disabled.
+func (f *KeyboardFeeder) Feed(vm *VM) {
+	if len(f.irqs) > 0 && vm.IrqEn && !vm.InIrq && len(vm.IrqQueue) == 0 && vm.StepCounter >= f.nextStep {
+		vm.InjectIRQ(f.irqs[0])
+		f.irqs = f.irqs[1:]
+		f.nextStep = vm.StepCounter + headlessKeyIrqInterval
+	}
+}
+
+// VMRunHeadless executes the given file in a virtual MCPC without a TUI.
+// Text written to the VGA framebuffer is printed to stdout line by line, characters read from stdin are sent as keyboard IRQs.
+// Once the VM halts, the process exits with the value of the H register as status code.
+// Reaching a step or time limit is treated as an error, BRK is always ignored.
+func VMRunHeadless(file string, limits ExecutionLimits) {
+	data16, err := loadBinary(file)
+	if err != nil {
+		log.Fatalln("ERROR: An error occured reading the input file: " + err.Error())
+	}
+
+	// Always use the largest supported VGA size, lines are trimmed on output anyway
+	vm := NewVM(data16, 120, 65)
+
+	console := newTextConsole(vm, os.Stdout)
+	vm.VgaChangeCallback = func(addr, x, y, old, new uint16) {
+		console.write(int(x), int(y), new)
+	}
+
+	// Stdin reader (goroutine)
+	stdinChan := make(chan rune, 1024)
+	go func() {
+		reader := bufio.NewReader(os.Stdin)
+		for {
+			r, _, err := reader.ReadRune()
+			if err != nil {
+				if err != io.EOF {
+					log.Println("WARNING: Error reading stdin: " + err.Error())
+				}
+				return
+			}
+
+			stdinChan <- r
+		}
+	}()
+
+	limits.Brk = BrkIgnore
+	execution := StartExecution(vm, limits)
+
+	var keyboard KeyboardFeeder
+	for !vm.Halted {
+		for len(stdinChan) > 0 {
+			keyboard.TypeRune(<-stdinChan)
+		}
+		keyboard.Feed(vm)
+
+		reason, err := execution.Step()
+		if err != nil {
+			console.close()
+			log.Fatalln("VM ERROR: " + err.Error())
+		}
+
+		if reason == StopStepLimit || reason == StopTimeout {
+			console.close()
+			log.Fatalf("ERROR: Execution stopped after %d steps: %s\n", execution.Steps, reason)
+		}
+	}
+
+	console.close()
+
+	h := vm.RegDef.H.Value
+	if h&0xFF00 == 0xFA00 {
+		log.Printf("Program faulted with code 0x%02X (H = 0x%04X)\n", h&0xFF, h)
+	}
+
+	os.Exit(headlessExitCode(h))
+}
+
+// Maps the H register of a halted VM to a process exit status. Exit statuses only have 8 bits, so failures must not be truncated to 0:
+// 0 only if H is 0, otherwise the low byte of H, or 1 if that is 0 (e.g. H = 0x0100, or FAULT 0x0 with H = 0xFA00).
+// Faults (H = 0xFA00 | n) thus exit with their fault code n.
+func headlessExitCode(h uint16) int {
+	if h == 0 {
+		return 0
+	}
+
+	if h&0xFF == 0 {
+		return 1
+	}
+
+	return int(h & 0xFF)
+}
+
+// textConsole converts VGA framebuffer writes into a stream of text lines.
+// Only the row that is currently written to is tracked; it is printed once the program moves on to a later row,
+// or once it is copied to the row above (i.e. the console has been scrolled). Other writes to earlier rows are ignored,
+// unless everything below them is empty, in which case the program has started over at the top (e.g. after clearing the screen).
+type textConsole struct {
+	vm  *VM
+	out io.Writer
+
+	// Row currently written to, -1 before the first write
+	row  int
+	line []uint16
+
+	// Whether line has changed since it was last printed
+	pending bool
+
+	// Set once a blank is written in front of other text on the current row, the row is being cleared from this point on.
+	// Text that is cleared is kept in line to be printed later, whereas erasing the last character (backspace) removes it.
+	clearing bool
+}
+
+func newTextConsole(vm *VM, out io.Writer) *textConsole {
+	return &textConsole{
+		vm:   vm,
+		out:  out,
+		row:  -1,
+		line: make([]uint16, vm.VgaWidth),
+	}
+}
+
+// Characters that do not produce visible output (space and control characters)
+func isBlankCell(cell uint16) bool {
+	return cell&0x00FF <= ' '
+}
+
+func (c *textConsole) write(x, y int, new uint16) {
+	// Scrolling copies the current row upwards, it is lost afterwards
+	if y == c.row-1 && c.pending && rowsEqual(c.vgaRow(y), c.vgaRow(c.row)) {
+		c.flush()
+	}
+
+	switch {
+	case y == c.row:
+		if !isBlankCell(new) {
+			copy(c.line, c.vgaRow(y))
+			c.clearing = false
+		} else if c.clearing || !c.rowBlank(c.vgaRow(y)[x:]) {
+			c.clearing = true
+			return
+		} else {
+			c.line[x] = new
+		}
+
+		c.pending = true
+
+	case isBlankCell(new):
+		// Clearing other rows never starts a new line
+
+	case y > c.row:
+		if c.row >= 0 {
+			c.flush()
+			for r := c.row + 1; r < y; r++ {
+				c.print(c.vgaRow(r))
+			}
+		}
+
+		c.setRow(y)
+
+	default:
+		if c.vgaBlankFrom(y + 1) {
+			c.flush()
+			c.setRow(y)
+		}
+	}
+}
+
+// Prints the remaining output, has to be called before exiting
+func (c *textConsole) close() {
+	if !c.rowBlank(c.line) {
+		c.flush()
+	}
+}
+
+// Prints the current row, unless it has already been printed
+func (c *textConsole) flush() {
+	if c.pending {
+		c.print(c.line)
+		c.pending = false
+	}
+}
+
+func (c *textConsole) setRow(y int) {
+	c.row = y
+	copy(c.line, c.vgaRow(y))
+	c.pending = true
+	c.clearing = false
+}
+
+func (c *textConsole) vgaRow(y int) []uint16 {
+	width := int(c.vm.VgaWidth)
+	return c.vm.VgaBuffer[y*width : (y+1)*width]
+}
+
+// Returns true if all rows starting with row y are empty
+func (c *textConsole) vgaBlankFrom(y int) bool {
+	return c.rowBlank(c.vm.VgaBuffer[y*int(c.vm.VgaWidth):])
+}
+
+func rowsEqual(a, b []uint16) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *textConsole) rowBlank(cells []uint16) bool {
+	for _, cell := range cells {
+		if !isBlankCell(cell) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *textConsole) print(cells []uint16) {
+	fmt.Fprintln(c.out, vgaCellText(cells))
+}
+
+// Converts VGA cells to text, without trailing spaces
+func vgaCellText(cells []uint16) string {
+	var text strings.Builder
+	for _, cell := range cells {
+		r := rune(cell & 0x00FF)
+
+		// Treat special characters like spaces
+		if r < ' ' {
+			r = ' '
+		}
+
+		text.WriteRune(r)
+	}
+
+	return strings.TrimRight(text.String(), " ")
+}
+
+// VgaText returns the text currently shown on the VGA display, one line per row; Trailing spaces and empty rows at the end are removed
+func (vm *VM) VgaText() string {
+	rows := make([]string, vm.VgaHeight)
+	width := int(vm.VgaWidth)
+	for y := range rows {
+		rows[y] = vgaCellText(vm.VgaBuffer[y*width : (y+1)*width])
+	}
+
+	return strings.TrimRight(strings.Join(rows, "\n"), "\n")
+}
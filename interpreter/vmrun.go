@@ -6,9 +6,11 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 	"unicode"
 
+	"github.com/mileusna/conditional"
 	"github.com/nsf/termbox-go"
 )
 
@@ -112,17 +114,31 @@ var (
 		termbox.KeySpace:      0x29,
 	}
 
+	// Control characters of a (piped) text stream, used by the headless runner
+	keycodeLookupControlRunes = map[rune]uint32{
+		'\b':   0x66,
+		'\x7F': 0x66,
+		'\n':   0x5A,
+		'\x1B': 0x76,
+		'\t':   0x0D,
+		' ':    0x29,
+	}
+
 	keycodeBreak     = uint32(0x00F00000)
 	keycodeLSHFT     = uint32(0x00120000)
 	keyboardIrqNum   = uint32(0xA)
 	invalidKeyIrqNum = uint32(0xB)
 )
 
-// VMRun executes the given file in a virtual MCPC
-func VMRun(file, traceFile string) {
+// VMRun executes the given file in a virtual MCPC.
+// Execution stops once a limit is reached; BRK is handled according to the policy, BrkDebug hands the VM over to the debugger.
+func VMRun(file, traceFile, symbolOverride string, limits ExecutionLimits) {
 
 	log.Println("Starting VM...")
 
+	// Symbols are used for annotating the trace and by the debugger (see BrkDebug)
+	loadSymbols(conditional.String(symbolOverride == "", file+".msym", symbolOverride))
+
 	// Termbox init
 	err := termbox.Init()
 	if err != nil {
@@ -172,6 +188,7 @@ func VMRun(file, traceFile string) {
 
 	// Event loop (goroutine)
 	closeChan := make(chan bool, 1)
+	resumeChan := make(chan bool, 1)
 	cpuIrqChan := make(chan uint32, 256)
 	go func() {
 		for {
@@ -185,82 +202,44 @@ func VMRun(file, traceFile string) {
 				closeChan <- true
 			}
 
+			// F5 continues after a BRK (pause policy), it is not sent to the VM
+			if event.Type == termbox.EventKey && event.Ch == 0 && event.Key == termbox.KeyF5 {
+				select {
+				case resumeChan <- true:
+				default:
+				}
+				continue
+			}
+
 			if event.Type == termbox.EventKey {
 				// Send keyboard irq
+				var irqs []uint32
 				if event.Ch == 0 {
 					// Special key
 					keyCode, ok := keycodeLookupTermbox[event.Key]
-					keyCode = keyCode << 16
-					if ok {
-						// Send MAKE
-						cpuIrqChan <- keyCode | keyboardIrqNum
-
-						// Send BREAK
-						cpuIrqChan <- keycodeBreak | keyboardIrqNum
-						cpuIrqChan <- keyCode | keyboardIrqNum
-					} else {
-						// Invalid key interrupt
-						cpuIrqChan <- invalidKeyIrqNum
-					}
+					irqs = keyIRQs(keyCode, ok)
 				} else {
 					// Character key
-					letter := event.Ch
-					sentShift := false
-
-					uppercase := unicode.IsUpper(letter)
-					if uppercase {
-						// Uppercase letter, send shift first
-						cpuIrqChan <- keycodeLSHFT | keyboardIrqNum
-						sentShift = true
-						letter = unicode.ToLower(letter)
-					}
-
-					// Check for and perform special SHIFT handling
-					if _, ok := keycodeLookupRunesSpecialShifted[letter]; ok {
-						cpuIrqChan <- keycodeLSHFT | keyboardIrqNum
-						sentShift = true
-					}
-
-					keyCode, ok := keycodeLookupRunes[letter]
-					keyCode = keyCode << 16
-					if ok {
-						// Send MAKE
-						cpuIrqChan <- keyCode | keyboardIrqNum
-
-						// Send BREAK
-						cpuIrqChan <- keycodeBreak | keyboardIrqNum
-						cpuIrqChan <- keyCode | keyboardIrqNum
-					} else {
-						// Invalid key interrupt
-						cpuIrqChan <- invalidKeyIrqNum
-					}
-
-					// Send SHFT BREAK in case of uppercase or special shift handling
-					if sentShift {
-						cpuIrqChan <- keycodeBreak | keyboardIrqNum
-						cpuIrqChan <- keycodeLSHFT | keyboardIrqNum
-					}
+					irqs = runeKeyIRQs(event.Ch)
+				}
+
+				for _, irq := range irqs {
+					cpuIrqChan <- irq
 				}
 			}
 		}
 	}()
 
 	// Load data from file
-	data, err := ioutil.ReadFile(file)
+	data16, err := loadBinary(file)
 	if err != nil {
 		termbox.Close()
 		log.Fatalln("ERROR: An error occured reading the input file: " + err.Error())
 	}
 
-	// Parse data into instruction-bounded array
-	data16 := make([]uint16, len(data)/2)
-	for i := 0; i < len(data16); i++ {
-		data16[i] = uint16(data[i*2])<<8 | uint16(data[i*2+1])
-	}
-
 	// VM init
 	vm := NewVM(data16, uint16(width-2), uint16(height-4))
-	writeVMState(vm, height, -1)
+	writeVMState(vm, height, -1, -1, StopNone)
 
 	// Trace-handler
 	var f *os.File
@@ -272,6 +251,13 @@ func VMRun(file, traceFile string) {
 
 		f.WriteString(time.Now().Format(time.RFC3339) + " CPU tracing started. VM loaded file: " + file + "\n")
 		vm.TraceCallback = func(msg string, step int64) {
+			if strings.HasPrefix(msg, "Ins:") {
+				// Annotate instruction fetch with source location
+				if location := sourceLocation(vm.Registers().PC.Value); location != "" {
+					msg += " @ " + location + " :: " + sourceLineText(vm.Registers().PC.Value)
+				}
+			}
+
 			f.WriteString(time.Now().Format(time.RFC3339) + " [" + strconv.FormatInt(step, 10) + "] " + msg + "\n")
 		}
 
@@ -301,6 +287,13 @@ func VMRun(file, traceFile string) {
 	lastSecond := -1
 	currentSpeed := -1
 
+	// Clock rate counter (cycles per second)
+	lastCycles := vm.CycleCounter
+	currentClock := int64(-1)
+
+	execution := StartExecution(vm, limits)
+	stopped := StopNone
+
 	// Event loop
 	for {
 		// Inject IRQ if needed
@@ -310,7 +303,15 @@ func VMRun(file, traceFile string) {
 
 		flushTerminal := false
 
-		if !vm.Halted {
+		if len(resumeChan) > 0 {
+			<-resumeChan
+			if stopped == StopBrk {
+				stopped = StopNone
+				flushTerminal = true
+			}
+		}
+
+		if !vm.Halted && stopped == StopNone {
 			// Check for changes in tracked states
 			preH := vm.RegDef.H.Value
 			preHirq := vm.RegIrq.H.Value
@@ -318,8 +319,7 @@ func VMRun(file, traceFile string) {
 			preInIrq := vm.InIrq
 
 			// Perform VM step
-			// TODO: Implement debug BRK?
-			_, err := vm.Step()
+			reason, err := execution.Step()
 
 			if err != nil {
 				termbox.Close()
@@ -329,6 +329,23 @@ func VMRun(file, traceFile string) {
 				log.Fatalln("VM ERROR: " + err.Error())
 			}
 
+			switch reason {
+			case StopBrk:
+				if limits.Brk == BrkDebug {
+					// Hand the running machine over to the debugger, the VM view is not resumed afterwards
+					termbox.Close()
+					vm.VgaChangeCallback = nil
+					debugVM(vm, file, nil, "Paused (BRK in VM mode)", ExecutionLimits{MaxSteps: DebuggerMaxSteps})
+					return
+				}
+
+				stopped = reason
+				flushTerminal = true
+			case StopStepLimit, StopTimeout:
+				stopped = reason
+				flushTerminal = true
+			}
+
 			// Changecheck 2
 			if preH != vm.RegDef.H.Value {
 				flushTerminal = true
@@ -352,11 +369,13 @@ func VMRun(file, traceFile string) {
 				lastSecond = time.Now().Second()
 				currentSpeed = stepCounter
 				stepCounter = 0
+				currentClock = vm.CycleCounter - lastCycles
+				lastCycles = vm.CycleCounter
 				flushTerminal = true
 			}
 		}
 
-		writeVMState(vm, height, currentSpeed)
+		writeVMState(vm, height, currentSpeed, currentClock, stopped)
 
 		// Sync terminal output
 		if flushTerminal || vgaChanged || vm.Halted {
@@ -370,10 +389,14 @@ func VMRun(file, traceFile string) {
 	}
 }
 
-func writeVMState(vm *VM, height, speed int) {
+func writeVMState(vm *VM, height, speed int, clock int64, stopped StopReason) {
 	state := ""
 	if vm.Halted {
 		state += " Halted "
+	} else if stopped == StopBrk {
+		state += " " + stopped.String() + ", <F5> to continue "
+	} else if stopped != StopNone {
+		state += " " + stopped.String() + " "
 	} else {
 		state += " <Running>"
 	}
@@ -393,6 +416,14 @@ func writeVMState(vm *VM, height, speed int) {
 		state += fmt.Sprintf(" | <%7d>  IPS", speed)
 	}
 
+	if clock > 1000000 {
+		state += fmt.Sprintf(" | <%7.3f> MHz", float64(clock)/1000000)
+	} else if clock > 1000 {
+		state += fmt.Sprintf(" | <%7.3f> kHz", float64(clock)/1000)
+	} else {
+		state += fmt.Sprintf(" | <%7d>  Hz", clock)
+	}
+
 	state += fmt.Sprintf(" | con <%d>x<%d>", vm.VgaWidth, vm.VgaHeight)
 
 	fg := termbox.ColorWhite
@@ -409,3 +440,62 @@ func writeVMState(vm *VM, height, speed int) {
 		}
 	}
 }
+
+// Reads a binary (.mb) file into an instruction-bounded (big endian) array
+func loadBinary(file string) ([]uint16, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	data16 := make([]uint16, len(data)/2)
+	for i := 0; i < len(data16); i++ {
+		data16[i] = uint16(data[i*2])<<8 | uint16(data[i*2+1])
+	}
+
+	return data16, nil
+}
+
+// Returns the keyboard IRQs (MAKE and BREAK) for a keycode, or an invalid key IRQ if the key is unknown (ok == false)
+func keyIRQs(keyCode uint32, ok bool) []uint32 {
+	if !ok {
+		return []uint32{invalidKeyIrqNum}
+	}
+
+	keyCode = keyCode << 16
+	return []uint32{
+		keyCode | keyboardIrqNum,
+		keycodeBreak | keyboardIrqNum,
+		keyCode | keyboardIrqNum,
+	}
+}
+
+// Returns the keyboard IRQs for typing a character, including SHIFT MAKE/BREAK for uppercase letters and shifted special characters
+func runeKeyIRQs(letter rune) []uint32 {
+	retval := make([]uint32, 0, 7)
+	sentShift := false
+
+	uppercase := unicode.IsUpper(letter)
+	if uppercase {
+		// Uppercase letter, send shift first
+		retval = append(retval, keycodeLSHFT|keyboardIrqNum)
+		sentShift = true
+		letter = unicode.ToLower(letter)
+	}
+
+	// Check for and perform special SHIFT handling
+	if _, ok := keycodeLookupRunesSpecialShifted[letter]; ok {
+		retval = append(retval, keycodeLSHFT|keyboardIrqNum)
+		sentShift = true
+	}
+
+	keyCode, ok := keycodeLookupRunes[letter]
+	retval = append(retval, keyIRQs(keyCode, ok)...)
+
+	// Send SHFT BREAK in case of uppercase or special shift handling
+	if sentShift {
+		retval = append(retval, keycodeBreak|keyboardIrqNum, keycodeLSHFT|keyboardIrqNum)
+	}
+
+	return retval
+}
@@ -7,6 +7,9 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/docopt/docopt.go"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/PiMaker/MCPC-Software/autotest"
 	"github.com/PiMaker/MCPC-Software/constants"
 	"github.com/PiMaker/MCPC-Software/interpreter"
+	"github.com/PiMaker/MCPC-Software/mscr/compiler"
 
 	"github.com/pkg/profile"
 )
@@ -23,11 +27,15 @@ func main() {
 
 Usage:
   mcpc assemble <file> <output> [--library=<library>...] [--debug-symbols] [--offset=<offset>] [--enable-offset-jump] [--ascii] [--hex] [--length=<length>] [--verbose]
-  mcpc mscr <input.mscr> <output.ma> [--bootloader] [--optimizedisable] [--verbose]
-  mcpc debug <file> [--symbols=<msym>]
-  mcpc vm <file> [--trace=<file>]
-  mcpc attach <port> [--symbols=<msym>]
-  mcpc autotest <directory> [--library=<library>...] [--optimizedisable]
+  mcpc mscr <input.mscr> <output.ma> [--include=<dir>...] [--bootloader] [-O <level>] [--enable-pass=<pass>...] [--disable-pass=<pass>...] [--inline-threshold=<n>] [--dump-ir] [--verbose]
+  mcpc debug <file> [--symbols=<msym>] [--max-steps=<steps>] [--timeout=<duration>] [--clock=<freq>] [--cycles=<file>]
+  mcpc vm <file> [--trace=<file>] [--symbols=<msym>] [--max-steps=<steps>] [--timeout=<duration>] [--brk=<policy>] [--clock=<freq>] [--cycles=<file>]
+  mcpc run <file> [--max-steps=<steps>] [--timeout=<duration>] [--clock=<freq>] [--cycles=<file>]
+  mcpc profile <file> [--symbols=<msym>] [--max-steps=<steps>] [--timeout=<duration>] [--cycles=<file>] [--folded=<file>] [--top=<n>]
+  mcpc gdbserver <file> [--port=<port>]
+  mcpc dap
+  mcpc attach <port> [--symbols=<msym>] [--max-steps=<steps>] [--timeout=<duration>]
+  mcpc autotest <directory> [--library=<library>...] [-O <level>] [--enable-pass=<pass>...] [--disable-pass=<pass>...] [--inline-threshold=<n>] [--max-steps=<steps>] [--timeout=<duration>] [--coverage] [--coverage-dir=<dir>] [--run=<regex>] [--parallel=<n>] [--junit=<file>] [--json=<file>]
   mcpc -h | --help
   mcpc --version
 
@@ -36,20 +44,43 @@ Options:
   mscr                    Compiles an M-Script file to M-Assembler to be further processed via "mcpc assemble".
   debug                   Uses a virtual MCPC to run the specified binary file and shows a TUI interface for debugging purposes.
   vm                      Run a specified binary (.mb format) on a virtual MCPC. Supports user IO.
+  run                     Run a specified binary (.mb format) on a virtual MCPC without a TUI. VGA output is written to stdout line by line, stdin is sent as keyboard input. Exits once the VM halts, with status 0 if H is 0, otherwise the low byte of H, or 1 if that is 0. Faults (H = 0xFA00 | n) are reported on stderr and exit with n (1 for FAULT 0x0).
+  profile                 Run a specified binary (.mb format) on a virtual MCPC without IO and print a flat profile, hotspot lines/addresses and a call graph (derived from CALL/RET) of the executed instructions and cycles.
+  gdbserver               Run a specified binary (.mb format) on a virtual MCPC and let a GDB client control it via the GDB remote serial protocol on localhost:<port>.
+  dap                     Run a Debug Adapter Protocol server on stdin/stdout for graphical debugging in editors (e.g. VS Code with the mcpc-code extension).
   attach                  Attaches to a physical MCPC device at <port> (e.g. /dev/ttyUSB0) and launches the hardware debugger.
   autotest                Runs the autotest test-suite on all files in the specified directory.
   --library=<library>     Includes a library, specified in mlib format, which allows higher-level instructions to be compiled down.
-  --debug-symbols         Writes a symbol file to use with the MCPC debugger next to the output file (will overwrite existing symbol files!)
-  --symbols=<msym>        Path to .msym debug symbol file. "debug" mode has <file>.msym as default, attach mode requires manual specification if symbols are wanted.
+  --debug-symbols         Writes a debug info file (labels and source line mapping) to use with the MCPC debugger next to the output file (will overwrite existing symbol files!)
+  --symbols=<msym>        Path to .msym debug symbol file. "debug" and "vm" mode have <file>.msym as default, attach mode requires manual specification if symbols are wanted.
   --offset=<offset>       Specifies an offset that will be applied to the binary file [default: 0].
   --enable-offset-jump    If enabled, a 'jmp' instruction will be inserted at the beginning, jumping to the offset position. If the offset is smaller than 3, this flag will be ignored.
   --ascii                 Outputs the ascii binary format for use with the hneemann/Digital circuit simulator.
   --hex                   Outputs raw binary in Verilog HEX format.
   --length=<length>       Length of hex output in bytes (one instruction word is 2 bytes!) [default: 4096].
+  --include=<dir>         Adds a directory to the search path for #include directives in MSCR files (searched after the directory of the including file).
   --bootloader            Compile .mscr input file in bootloader mode (includes bootloader init preamble).
-  --optimizedisable       Disable all MSCR optimizations.
+  -O <level>              MSCR optimization level: 0 disables all optimizations, 1 enables the basic peephole passes, 2 enables all passes [default: 2].
+  --enable-pass=<pass>    Enables an MSCR optimization pass regardless of the optimization level. Passes: inline (2), regalloc (2), pushpop (1), selfmov (1), doublemov (1), deadcode (1), jumpnext (1), heapslot (2), constfold (2).
+  --disable-pass=<pass>   Disables an MSCR optimization pass regardless of the optimization level.
+  --inline-threshold=<n>  Functions with at most n IR instructions are inlined even if not declared 'func inline', 0 only inlines those [default: 8].
+  --dump-ir               Print the intermediate representation (basic blocks and control flow graph) of every MSCR function.
   --verbose               Print verbose messages for debugging.
+  --port=<port>           TCP port for gdbserver mode [default: 2331].
   --trace=<file>          Write out a CPU trace file in VM mode. NOTE: This will decrease VM performance drastically.
+  --max-steps=<steps>     Step limit: per "run" command in the debugger (default: 10000), per test in autotest mode (default: 100000), for the whole execution in vm and run mode (default: unlimited). 0 disables the limit.
+  --timeout=<duration>    Wall-clock limit with the same scope as --max-steps (e.g. 10s, 500ms). No limit by default.
+  --brk=<policy>          What happens when the VM executes a BRK instruction in vm mode: ignore, pause (<F5> continues) or debug (hands the running VM over to the debugger) [default: debug].
+  --clock=<freq>          Throttles the VM to the given clock frequency based on its cycle counter (e.g. 25MHz, 500kHz). Runs at full speed by default.
+  --cycles=<file>         Cycle cost table for the VM, one "<mnemonic> <cycles>" pair per line (e.g. "MEMR 6", IRQ for entering an IRQ handler). Opcodes not listed keep their default cost.
+  --folded=<file>         Write the call stacks of profile mode in folded format (one "main;func;callee <cycles>" line per stack) for flamegraph tools.
+  --top=<n>               Number of entries per table in profile mode, 0 shows all [default: 20].
+  --coverage              Record the source lines (.mscr/.ma) executed by the autotests and write a coverage report (HTML and LCOV).
+  --coverage-dir=<dir>    Output directory for the coverage report [default: coverage].
+  --run=<regex>           Only run the autotests whose file name matches the regular expression.
+  --parallel=<n>          Number of autotests running at the same time, 0 uses one per CPU core [default: 0].
+  --junit=<file>          Write the autotest results as JUnit XML report.
+  --json=<file>           Write the autotest results as JSON report.
   -h --help               Show this screen.
   --version               Show version.`
 
@@ -62,7 +93,19 @@ Options:
 		defer profile.Start().Stop()
 	}
 
-	fmt.Println(preamble)
+	// Keep stdout clean for the VM's output in headless mode
+	if !argBool(args, "run") && !argBool(args, "dap") && !argBool(args, "profile") {
+		fmt.Println(preamble)
+	}
+
+	// Cycle cost table for all VM modes
+	if cycles := argStringWithDefault(args, "--cycles", ""); cycles != "" {
+		table, err := interpreter.LoadCycleTable(cycles)
+		if err != nil {
+			log.Fatalln("ERROR: Could not read cycle table: " + err.Error())
+		}
+		interpreter.DefaultCycleTable = table
+	}
 
 	// Choose function to call based on arguments
 	if argBool(args, "assemble") {
@@ -74,7 +117,23 @@ Options:
 		// Compile
 		offset := argInt(args, "--offset")
 		output := argString(args, "<output>")
-		assembly, debugSymbols := assembler.Compile(argString(args, "<file>"), offset, argStrings(args, "--library"), argBool(args, "--enable-offset-jump"), argBool(args, "--verbose"))
+		result, err := assembler.Compile(assembler.Options{
+			File:      argString(args, "<file>"),
+			Offset:    offset,
+			Libraries: argStrings(args, "--library"),
+			AutoJump:  argBool(args, "--enable-offset-jump"),
+			Verbose:   argBool(args, "--verbose"),
+		})
+
+		if err != nil {
+			log.Fatalln("ERROR: Assembling failed:\n" + err.Error())
+		}
+
+		for _, w := range result.Warnings {
+			log.Println("WARNING: " + w.String())
+		}
+
+		assembly := result.Binary
 
 		if argBool(args, "--ascii") {
 			log.Println("Converting to ASCII format...")
@@ -86,29 +145,91 @@ Options:
 		ioutil.WriteFile(output, assembly, 0664)
 
 		if argBool(args, "--debug-symbols") {
-			symbolFile := output + ".msym"
-			ioutil.WriteFile(symbolFile, debugSymbols, 0664)
+			err = result.Debug.Save(output + ".msym")
+			if err != nil {
+				log.Fatalln("ERROR: Could not write debug symbols: " + err.Error())
+			}
 		}
 
 	} else if argBool(args, "mscr") || argBool(args, "attach") {
 
 		// Compile MSCR code
-		mscr.CompileMSCR(argString(args, "<input.mscr>"), argString(args, "<output.ma>"), argBool(args, "--bootloader"), argBool(args, "--verbose"), argBool(args, "--optimizedisable"))
+		mscr.CompileMSCR(mscr.Options{
+			Input:         argString(args, "<input.mscr>"),
+			Output:        argString(args, "<output.ma>"),
+			IncludePaths:  argStrings(args, "--include"),
+			Bootloader:    argBool(args, "--bootloader"),
+			Verbose:       argBool(args, "--verbose"),
+			DumpIR:        argBool(args, "--dump-ir"),
+			Optimizations: argOptimizations(args),
+		})
 
 	} else if argBool(args, "debug") || argBool(args, "attach") {
 
 		// Interpret/Debug
-		interpreter.Interpret(argStringWithDefault(args, "<file>", argStringWithDefault(args, "<port>", "")), argBool(args, "attach"), argInt(args, "--max-steps"), argStringWithDefault(args, "--symbols", ""))
+		interpreter.Interpret(argStringWithDefault(args, "<file>", argStringWithDefault(args, "<port>", "")), argBool(args, "attach"), argLimits(args, interpreter.DebuggerMaxSteps), argStringWithDefault(args, "--symbols", ""))
 
 	} else if argBool(args, "autotest") {
 
 		// Run autotests
-		autotest.RunAutotests(argString(args, "<directory>"), argStrings(args, "--library"), argBool(args, "--optimizedisable"))
+		opts := autotest.Options{
+			Dir:           argString(args, "<directory>"),
+			Libraries:     argStrings(args, "--library"),
+			Optimizations: argOptimizations(args),
+			Limits:        argLimits(args, autotest.DefaultMaxSteps),
+			Parallel:      argInt(args, "--parallel"),
+			JUnitFile:     argStringWithDefault(args, "--junit", ""),
+			JSONFile:      argStringWithDefault(args, "--json", ""),
+		}
+
+		if argBool(args, "--coverage") {
+			opts.CoverageDir = argString(args, "--coverage-dir")
+		}
+
+		if filter := argStringWithDefault(args, "--run", ""); filter != "" {
+			regex, err := regexp.Compile(filter)
+			if err != nil {
+				log.Fatalln("ERROR: Invalid --run expression: " + err.Error())
+			}
+			opts.Filter = regex
+		}
+
+		summary, err := autotest.RunAutotests(opts)
+		if err != nil {
+			log.Fatalln("ERROR: " + err.Error())
+		}
+
+		if summary.Failed > 0 {
+			os.Exit(1)
+		}
 
 	} else if argBool(args, "vm") {
 
 		// Run virtual MCPC
-		interpreter.VMRun(argString(args, "<file>"), argStringWithDefault(args, "--trace", ""))
+		interpreter.VMRun(argString(args, "<file>"), argStringWithDefault(args, "--trace", ""), argStringWithDefault(args, "--symbols", ""), argLimits(args, 0))
+
+	} else if argBool(args, "run") {
+
+		// Run virtual MCPC headless
+		interpreter.VMRunHeadless(argString(args, "<file>"), argLimits(args, 0))
+
+	} else if argBool(args, "profile") {
+
+		// Profile virtual MCPC
+		interpreter.Profile(argString(args, "<file>"), argStringWithDefault(args, "--symbols", ""), argLimits(args, 0), interpreter.ProfileOptions{
+			FoldedFile: argStringWithDefault(args, "--folded", ""),
+			Top:        argInt(args, "--top"),
+		})
+
+	} else if argBool(args, "gdbserver") {
+
+		// Run virtual MCPC controlled by GDB
+		interpreter.GDBServer(argString(args, "<file>"), argInt(args, "--port"))
+
+	} else if argBool(args, "dap") {
+
+		// Debug Adapter Protocol server
+		interpreter.DAPServer()
 
 	} else {
 		log.Println("Invalid command, use -h for help")
@@ -161,6 +282,73 @@ func argInt(args docopt.Opts, key string) int {
 	return v
 }
 
+// Reads the execution limits (--max-steps, --timeout, --brk, --clock); defaultSteps applies if no step limit is given (0 = unlimited)
+func argLimits(args docopt.Opts, defaultSteps int64) interpreter.ExecutionLimits {
+	limits := interpreter.ExecutionLimits{
+		MaxSteps: defaultSteps,
+	}
+
+	if steps := argStringWithDefault(args, "--max-steps", ""); steps != "" {
+		v, err := strconv.ParseInt(steps, 10, 64)
+		if err != nil || v < 0 {
+			log.Fatalln("ERROR: Invalid step limit \"" + steps + "\"")
+		}
+		limits.MaxSteps = v
+	}
+
+	if timeout := argStringWithDefault(args, "--timeout", ""); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d < 0 {
+			log.Fatalln("ERROR: Invalid timeout \"" + timeout + "\" (e.g. 10s, 500ms)")
+		}
+		limits.Timeout = d
+	}
+
+	if policy := argStringWithDefault(args, "--brk", ""); policy != "" {
+		brk, err := interpreter.ParseBrkPolicy(policy)
+		if err != nil {
+			log.Fatalln("ERROR: " + err.Error())
+		}
+		limits.Brk = brk
+	}
+
+	if clock := argStringWithDefault(args, "--clock", ""); clock != "" {
+		hz, err := interpreter.ParseClock(clock)
+		if err != nil {
+			log.Fatalln("ERROR: " + err.Error())
+		}
+		limits.ClockHz = hz
+	}
+
+	return limits
+}
+
+// Reads the MSCR optimization level and pass selection (-O, --enable-pass, --disable-pass, --inline-threshold)
+func argOptimizations(args docopt.Opts) compiler.Optimizations {
+	level, err := strconv.Atoi(argStringWithDefault(args, "-O", strconv.Itoa(compiler.DefaultOptimizationLevel)))
+	if err != nil {
+		log.Fatalln("ERROR: Invalid optimization level \"" + argString(args, "-O") + "\"")
+	}
+
+	threshold, err := strconv.Atoi(argStringWithDefault(args, "--inline-threshold", strconv.Itoa(compiler.DefaultInlineThreshold)))
+	if err != nil {
+		log.Fatalln("ERROR: Invalid inline threshold \"" + argString(args, "--inline-threshold") + "\"")
+	}
+
+	opts := compiler.Optimizations{
+		Level:           level,
+		Enable:          argStrings(args, "--enable-pass"),
+		Disable:         argStrings(args, "--disable-pass"),
+		InlineThreshold: threshold,
+	}
+
+	if err := opts.Validate(); err != nil {
+		log.Fatalln("ERROR: " + err.Error())
+	}
+
+	return opts
+}
+
 func toASCIIFormat(data []byte) []byte {
 	header := []byte("v2.0 raw\n")
 	retval := make([]byte, len(header)+len(data)*3)
@@ -1,18 +1,10 @@
 package compiler
 
 import (
-	"bytes"
 	"fmt"
-	"log"
-	"os"
-	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
-
-	"github.com/davecgh/go-spew/spew"
-
-	"github.com/PiMaker/MCPC-Software/mscr/yard"
 )
 
 // Careful here, we want to match base 10, 16, but not variables
@@ -25,11 +17,14 @@ var calcTypeRegexLiteralRegexp = regexp.MustCompile(CalcTypeRegexLiteral)
 var calcTypeRegexMathRegexp = regexp.MustCompile(CalcTypeRegexMath)
 var calcTypeRegexAsmRegexp = regexp.MustCompile(CalcTypeRegexAsm)
 
+var regexpCalcArrayAccess = regexp.MustCompile(`(@?)((?:[a-zA-Z_$][a-zA-Z0-9_$]*\.)*[a-zA-Z_$][a-zA-Z0-9_$]*)#\(`)
+var regexpCalcMemberAccess = regexp.MustCompile(`^(?:\.[a-zA-Z_$][a-zA-Z0-9_$]*)+`)
+
 // Wrapper around resolveCalcInternal that additionally prints debug information if --verbose was passed
 // (and performs some additional post-processing on generated asm)
 func resolveCalc(calc string, scope string, state *asmTransformState) []*asmCmd {
 	if state.verbose {
-		log.Println("DEBUG OUTPUT: Calc expression \"" + calc + "\" resulted in following meta-asm:")
+		state.log.Println("DEBUG OUTPUT: Calc expression \"" + calc + "\" resulted in following meta-asm:")
 	}
 
 	output := resolveCalcInternal(calc, scope, state)
@@ -50,209 +45,197 @@ func resolveCalc(calc string, scope string, state *asmTransformState) []*asmCmd
 	return output
 }
 
+// Calc parameters are only generated while resolving (e.g. for addresses of globals), expressions are built into IR instead (see buildCalc)
 func resolveCalcInternal(calc string, scope string, state *asmTransformState) []*asmCmd {
 	// Remove square brackets, they are just indicators that this is a calc value string
 	calc = strings.Replace(calc, "[", "", -1)
 	calc = strings.Replace(calc, "]", "", -1)
 	calc = strings.Trim(calc, " \t")
 
-	// Match type of expression using regex
-	if calcTypeRegexAsmRegexp.MatchString(calc) {
+	if calcTypeRegexLiteralRegexp.MatchString(calc) {
+		return setRegToLiteralFromString(calc, "F") // F is calc out register
+	}
 
-		// Assume developer knows what they are doing
-		// Put asm verbosely and hope if fills up F
-		return toRawAsm("_" + calc) // Note: underscore (_) is not used in calc (e.g. "asm", not "_asm"), to not confuse the parser
+	panic("ERROR: Unsupported calc string: " + calc)
+}
 
-	} else if calcTypeRegexLiteralRegexp.MatchString(calc) {
+// Replaces every operator applied only to literals (including nested sub-expressions) by its result, e.g. "4 * 16 + x" is
+// turned into "64 + x". Results follow the 16-bit ALU of the VM (see evalALU), so they wrap around and comparisons are signed.
+// Division by zero is left to the runtime routine, function calls are never evaluated.
+func foldYardTokens(shunted []*YardToken) []*YardToken {
+	retval := make([]*YardToken, 0, len(shunted))
+
+	// Value of the n-th last output token, if it is a literal. Operands of an operator always directly precede it in RPN,
+	// unless they are the result of another operator or a function call (which are not literals then).
+	literal := func(n int) (uint16, bool) {
+		if len(retval) < n || retval[len(retval)-n].tokenType != "OPRND" || !calcTypeRegexLiteralRegexp.MatchString(retval[len(retval)-n].value) {
+			return 0, false
+		}
 
-		return setRegToLiteralFromString(calc, "F") // F is calc out register
+		v, err := parseCalcLiteral(retval[len(retval)-n].value)
+		return v, err == nil
+	}
 
-	} else if calcTypeRegexMathRegexp.MatchString(calc) {
-
-		// Math/Function parsing
-		ensureShuntingYardParser()
-		shunted := callShuntingYardParser(calc)
-		output := make([]*asmCmd, 0)
-
-		// Function call temp vars
-		var funcFunct string
-		funcStackOffset := 0
-		var funcFunargLast int
-		var lastVar string
-
-		for i, token := range shunted {
-			switch token.tokenType {
-			case "FUNCT":
-				funcFunct = token.value
-			case "FUNARG":
-				funcFunarg, _ := strconv.Atoi(token.value)
-				// Offset pop count because called function will pop values from stack for us
-				funcStackOffset -= funcFunarg
-				funcFunargLast = funcFunarg
-
-			case "SYS":
-				switch token.value {
-				case "INVOKE":
-					// Check for $$ invocation mistakes
-					if funcFunct == "$$" && (i < 3 || shunted[i-3].tokenType != "OPRND" || calcTypeRegexLiteralRegexp.MatchString(shunted[i-3].value)) {
-						panic("ERROR: Tried calling special function $$ on anything else than a variable name (Note: $$ does not support nesting or addressing literals)")
-					}
-
-					// Call function and push return value to stack
-					output = append(output, callCalcFunc(funcFunct, funcFunargLast, state, lastVar)...)
-
-					// Special functions include a "POP", fix the stack counter for them by increasing the internal counter for what it was decreased earlier
-					if funcFunct == "$" || funcFunct == "$$" {
-						funcStackOffset += funcFunargLast // Will always be 1, since $ and $$ both require exactly one argument (or they fatalln)
-					}
-				}
+	for _, token := range shunted {
+		if token.tokenType != "OPER" {
+			retval = append(retval, token)
+			continue
+		}
 
-			case "OPRND":
-				// First, put operand in F
-				if calcTypeRegexLiteralRegexp.MatchString(token.value) {
-					output = append(output, setRegToLiteralFromString(token.value, "F")...)
-				} else {
-					// Assume variable or global
-					cmd := &asmCmd{
-						ins: "MOV",
-						params: []*asmParam{
-							&asmParam{
-								asmParamType: asmParamTypeVarRead,
-								value:        token.value,
-							},
-							rawAsmParam("F"),
-						},
-						comment: " CALC: var " + token.value,
-					}
-
-					lastVar = token.value
-
-					// Take care of globals and string addresses
-					cmd.fixGlobalAndStringParamTypes(state)
-
-					output = append(output, cmd)
+		var result uint16
+		operands := 2
+		folded := false
+
+		switch token.value {
+		case ".-", ".~", "~":
+			operands = 1
+			if a, ok := literal(1); ok {
+				result, folded = ^a, true
+				if token.value == ".-" {
+					result++
 				}
+			}
 
-				// Then, push F onto stack
-				output = append(output, &asmCmd{
-					ins: "PUSH",
-					params: []*asmParam{
-						rawAsmParam("F"),
-					},
-					comment: " CALC: push operand",
-				})
-
-			case "OPER":
-				switch token.value {
-				case "+", "*", "-", "&", "|", "^", "==", "<", ">", "<=", ">=", "!=", ">>", "<<":
-					// Pop twice then calculate then push again
-					output = append(output, &asmCmd{
-						ins: "POP",
-						params: []*asmParam{
-							rawAsmParam("E"),
-						},
-					})
-					output = append(output, &asmCmd{
-						ins: "POP",
-						params: []*asmParam{
-							rawAsmParam("F"),
-						},
-					})
-
-					aluIns := symbolToALUFuncName(token.value)
-					output = append(output, &asmCmd{
-						ins: aluIns,
-						params: []*asmParam{
-							rawAsmParam("F"), // Input 1
-							rawAsmParam("F"), // Output
-							rawAsmParam("E"), // Input 2
-						},
-						comment: " CALC: operator " + aluIns,
-					})
-
-					output = append(output, &asmCmd{
-						ins: "PUSH",
-						params: []*asmParam{
-							rawAsmParam("F"),
-						},
-					})
-
-				case ".-", ".~", "~":
-					output = append(output, &asmCmd{
-						ins: "POP",
-						params: []*asmParam{
-							rawAsmParam("F"),
-						},
-					})
-
-					aluIns := "COM"
-					if token.value == ".-" {
-						aluIns = "NEG"
-					}
-
-					output = append(output, &asmCmd{
-						ins: aluIns,
-						params: []*asmParam{
-							rawAsmParam("F"),
-							rawAsmParam("F"),
-						},
-					})
-
-					output = append(output, &asmCmd{
-						ins: "PUSH",
-						params: []*asmParam{
-							rawAsmParam("F"),
-						},
-					})
-
-				default:
-					panic("ERROR: Unsupported tokenType returned from shunting yard parser. This calc feature is probably not implemented yet. (" + token.tokenType + " = " + token.value + ")")
+		case "/", "%":
+			a, okA := literal(2)
+			b, okB := literal(1)
+			if okA && okB && b != 0 {
+				result, folded = a/b, true
+				if token.value == "%" {
+					result = a % b
 				}
 			}
+
+		default:
+			a, okA := literal(2)
+			b, okB := literal(1)
+			if okA && okB {
+				result, folded = evalALU(symbolToALUFuncName(token.value), a, b)
+			}
 		}
 
-		output = append(output, &asmCmd{
-			ins: "POP",
-			params: []*asmParam{
-				rawAsmParam("F"),
-			},
+		if !folded {
+			retval = append(retval, token)
+			continue
+		}
+
+		retval = append(retval[:len(retval)-operands], &YardToken{
+			value:     strconv.FormatUint(uint64(result), 10),
+			tokenType: "OPRND",
 		})
+	}
 
-		// Validate result to preserve stack correctness
-		stackValue := 0
-		for _, c := range output {
-			if c.ins == "PUSH" {
-				stackValue++
-			} else if c.ins == "POP" {
-				stackValue--
-			}
+	return retval
+}
+
+// Evaluates a calc expression consisting only of literals (and #defines, which are literals after preprocessing) at compile time
+func evalConstCalc(calc string) (uint16, bool) {
+	calc = strings.Trim(strings.NewReplacer("[", "", "]", "").Replace(calc), " \t")
+
+	shunted, err := parseIntoYardTokens(calc)
+	if err != nil {
+		return 0, false
+	}
+
+	shunted = foldYardTokens(shunted)
+	if len(shunted) != 1 || shunted[0].tokenType != "OPRND" || !calcTypeRegexLiteralRegexp.MatchString(shunted[0].value) {
+		return 0, false
+	}
+
+	v, err := parseCalcLiteral(shunted[0].value)
+	return v, err == nil
+}
+
+// Replaces array accesses (rewritten to "name#(index).member" before parsing, see rewriteArrayAccesses) with pointer arithmetic,
+// e.g. "$(name + (index) * 2 + 1)" for an array of structs with two members. Accesses prefixed with '@' yield the element address instead.
+func expandArrayAccesses(calc string, scope string, state *asmTransformState) string {
+	for {
+		loc := regexpCalcArrayAccess.FindStringSubmatchIndex(calc)
+		if loc == nil {
+			return calc
+		}
+
+		addressOnly := loc[3] > loc[2]
+		name := calc[loc[4]:loc[5]]
+
+		arrayType := getAccessorType(name, scope, state)
+		if arrayType == nil {
+			arrayType = state.globalArrayTypes[name]
+		}
+
+		if !isArrayType(arrayType) {
+			panic(fmt.Sprintf("ERROR: Cannot index '%s' of type '%s', it is not an array (scope: %s)", name, typeName(arrayType), scope))
 		}
 
-		// Function calls modify the stack without push-es or pop-s
-		stackValue += funcStackOffset
+		// Walk indices and member accesses following the array name
+		address := name
+		elemType := arrayType
+		end := loc[5]
+		for end < len(calc) {
+			if strings.HasPrefix(calc[end:], "#(") {
+				closing := matchingBracket(calc, end+1)
+				if closing == -1 {
+					panic(fmt.Sprintf("ERROR: Missing closing bracket for index of array '%s' (calc: %s, scope: %s)", name, calc, scope))
+				}
+
+				if !isArrayType(elemType) {
+					panic(fmt.Sprintf("ERROR: Cannot index '%s' of type '%s', it is not an array (scope: %s)", name, elemType.name, scope))
+				}
+
+				index := expandArrayAccesses(calc[end+2:closing], scope, state)
+				elemType = elemType.elem
+				if elemType.size == 1 {
+					address = fmt.Sprintf("(%s + (%s))", address, index)
+				} else {
+					address = fmt.Sprintf("(%s + (%s) * %d)", address, index, elemType.size)
+				}
+
+				end = closing + 1
+			} else if member := regexpCalcMemberAccess.FindString(calc[end:]); member != "" && !isArrayType(elemType) {
+				memberType := getMemberType(elemType, member[1:], scope)
+				offset, _ := getMemberInfo(elemType.name+member, elemType, scope)
+				elemType = memberType
+				if offset != 0 {
+					address = fmt.Sprintf("(%s + %d)", address, offset)
+				}
 
-		if stackValue != 0 {
-			log.Println("ERROR: In calc resolving, RPN attached hereafter:")
-			spew.Dump(shunted)
-			panic("ERROR: Calc-resoved instructions would produce invalid stack. This is either a compiler bug or an invalid calc-string (e.g. invalid operators or function calls). (Stack value: " + strconv.Itoa(stackValue) + "; should be 0)")
+				end += len(member)
+			} else {
+				break
+			}
 		}
 
-		// Set scope of "parent" (calc instruction) on all generated "child" instructions
-		for _, a := range output {
-			a.scope = scope
+		replacement := address
+		if isStructType(elemType) {
+			panic(fmt.Sprintf("ERROR: Elements of type '%s' of array '%s' can only be accessed member by member (scope: %s)", elemType.name, name, scope))
+		} else if addressOnly && isArrayType(elemType) {
+			panic(fmt.Sprintf("ERROR: Cannot assign to array of type '%s' in array '%s', assign to its elements instead (scope: %s)", elemType.name, name, scope))
+		} else if !addressOnly && !isArrayType(elemType) {
+			replacement = "$(" + address + ")"
 		}
 
-		// Shortcut: If last two instructions are "PUSH F", "POP F", leaving them out will still put result in F
-		if len(output) > 1 && output[len(output)-2].ins == "PUSH" && len(output[len(output)-2].params) == 1 &&
-			output[len(output)-2].params[0].asmParamType == asmParamTypeRaw && output[len(output)-2].params[0].value == "F" {
+		calc = calc[:loc[0]] + replacement + calc[end:]
+	}
+}
 
-			return output[0 : len(output)-2]
+// Returns the type of a (possibly nested) member of the given type, e.g. "pos.x"
+func getMemberType(t *asmType, chain string, scope string) *asmType {
+	for _, member := range strings.Split(chain, ".") {
+		found := false
+		for _, typeMember := range t.members {
+			if typeMember.name == member {
+				t = typeMember.asmType
+				found = true
+				break
+			}
 		}
 
-		return output
+		if !found {
+			panic(fmt.Sprintf("ERROR: Type '%s' does not contain a member called '%s' (scope: %s)", t.name, member, scope))
+		}
 	}
 
-	panic("ERROR: Unsupported calc string: " + calc)
+	return t
 }
 
 func symbolToALUFuncName(oper string) string {
@@ -290,15 +273,23 @@ func symbolToALUFuncName(oper string) string {
 	}
 }
 
-func setRegToLiteralFromString(calc, reg string) []*asmCmd {
+// Parses a calc literal (decimal or hexadecimal), values that do not fit into 16 bits are clamped to 0xFFFF and return an error
+func parseCalcLiteral(calc string) (uint16, error) {
 	var calcValue uint64
+	var err error
 	if strings.Index(calc, "0x") == 0 || strings.Index(calc, "0X") == 0 {
-		// Error ignored, format is validated at this point
-		calcValue, _ = strconv.ParseUint(calc[2:], 16, 16)
+		calcValue, err = strconv.ParseUint(calc[2:], 16, 16)
 	} else {
-		calcValue, _ = strconv.ParseUint(calc, 10, 16)
+		calcValue, err = strconv.ParseUint(calc, 10, 16)
 	}
 
+	return uint16(calcValue), err
+}
+
+func setRegToLiteralFromString(calc, reg string) []*asmCmd {
+	// Error ignored, format is validated at this point
+	calcValue, _ := parseCalcLiteral(calc)
+
 	// Shortcuts for 1, 0, -1 registers
 	if calcValue == 1 {
 		return []*asmCmd{
@@ -340,200 +331,9 @@ func setRegToLiteralFromString(calc, reg string) []*asmCmd {
 			ins: "SETREG",
 			params: []*asmParam{
 				rawAsmParam(reg),
-				rawAsmParam("0x" + strconv.FormatUint(calcValue, 16)),
+				rawAsmParam("0x" + strconv.FormatUint(uint64(calcValue), 16)),
 			},
 			comment: " CALC: literal " + calc,
 		},
 	}
 }
-
-func callCalcFunc(funcName string, paramCount int, state *asmTransformState, lastVarName string) []*asmCmd {
-	retval := make([]*asmCmd, 0)
-
-	if funcName == "$" {
-
-		if paramCount != 1 {
-			panic("ERROR: Special function $ requires exactly 1 argument, " + strconv.Itoa(paramCount) + " given")
-		}
-
-		// Special function $ -> Dereference (get value behind address)
-
-		// Retrieve address value
-		retval = append(retval, &asmCmd{
-			ins: "POP",
-			params: []*asmParam{
-				rawAsmParam("F"),
-			},
-		})
-
-		// Perform memory access
-		retval = append(retval, &asmCmd{
-			ins: "LOAD",
-			params: []*asmParam{
-				rawAsmParam("F"),
-				rawAsmParam("F"),
-			},
-		})
-
-		// Push result back
-		retval = append(retval, &asmCmd{
-			ins: "PUSH",
-			params: []*asmParam{
-				rawAsmParam("F"),
-			},
-		})
-
-		retval[1].fixGlobalAndStringParamTypes(state)
-
-	} else if funcName == "$$" {
-
-		if paramCount != 1 {
-			panic("ERROR: Special function $$ requires exactly 1 argument, " + strconv.Itoa(paramCount) + " given")
-		}
-
-		// Special function $$ -> Reference (create pointer)
-
-		// This POP is technically useless, but needed to keep the stack sane. It will automatically be optimized out later.
-		// (Sadly, the variable access getting us this value probably won't, but hey, I'm trying.)
-		retval = append(retval, &asmCmd{
-			ins: "POP",
-			params: []*asmParam{
-				rawAsmParam("F"),
-			},
-		})
-
-		// Mark value as directly-addressed since we never know when someone is going to dereference this pointer
-		// Note: This only does something for variables, globals are always directly-addressed
-		retval = append(retval, &asmCmd{
-			ins:                 "__SET_DIRECT",
-			scopeAnnotationName: lastVarName,
-		})
-
-		retval = append(retval, &asmCmd{
-			ins: "MOV",
-			params: []*asmParam{
-				&asmParam{
-					asmParamType: asmParamTypeVarAddr,
-					value:        lastVarName,
-				},
-				rawAsmParam("F"),
-			},
-		})
-
-		// Push result back
-		retval = append(retval, &asmCmd{
-			ins: "PUSH",
-			params: []*asmParam{
-				rawAsmParam("F"),
-			},
-		})
-
-		retval[2].fixGlobalAndStringParamTypes(state)
-
-	} else {
-
-		// Regular function
-
-		// Scope handling should still work in calc context, recursive resolving is really quite something huh?
-		retval = append(retval, &asmCmd{
-			ins: "__FLUSHSCOPE",
-		})
-
-		retval = append(retval, &asmCmd{
-			ins: "__CLEARSCOPE",
-		})
-
-		fLabel := getFuncLabelSpecific(funcName, paramCount)
-		function := ""
-		for _, f := range state.functionTable {
-			if f.label == fLabel {
-				function = f.label
-
-				if f.returnType == nil {
-					panic(fmt.Sprintf("ERROR: Tried calling a void function in a calc context: Function '%s' with %d parameters\n", funcName, paramCount))
-				}
-
-				break
-			}
-		}
-
-		if function == "" {
-			log.Printf("WARNING: Cannot find function to call (calc): Function '%s' with %d parameters (Assuming extern function)\n", funcName, paramCount)
-			function = fLabel
-		}
-
-		retval = append(retval, &asmCmd{
-			ins: "CALL",
-			params: []*asmParam{
-				rawAsmParam("." + function),
-			},
-		})
-
-		// Push returned value to stack for further calculations
-		retval = append(retval, &asmCmd{
-			ins: "PUSH",
-			params: []*asmParam{
-				rawAsmParam("A"),
-			},
-		})
-
-		retval = append(retval, &asmCmd{
-			ins: "__CLEARSCOPE",
-		})
-
-	}
-
-	return retval
-}
-
-var parserExtracted = false
-var dijkstraPath = ""
-
-func ensureShuntingYardParser() {
-	if parserExtracted && dijkstraPath != "" {
-		return
-	}
-
-	// Extract parser from go-bindata
-	dijkstraPath = os.TempDir() + string(os.PathSeparator)
-	err := yard.RestoreAssets(dijkstraPath, "dijkstra-shunting-yard")
-
-	if err != nil {
-		panic("ERROR: Could not extract dijkstra parser: " + err.Error())
-	}
-
-	dijkstraPath += "dijkstra-shunting-yard" + string(os.PathSeparator)
-	parserExtracted = true
-}
-
-func callShuntingYardParser(calc string) []*YardToken {
-	cmd := exec.Command(dijkstraPath + "shunt.sh")
-
-	var out bytes.Buffer
-	in := bytes.NewBufferString(calc + " ") // Space is needed, trust me
-
-	cmd.Stdin = in
-	cmd.Stdout = &out
-
-	err := cmd.Run()
-
-	output := string(out.Bytes())
-
-	if err != nil {
-		log.Println("Debug log of shunt.sh:")
-		fmt.Println(output)
-
-		panic("ERROR: While executing shunting yard parser: " + err.Error())
-	}
-
-	// Check for error output
-	for _, line := range strings.Split(output, "\n") {
-		if strings.HasPrefix(line, shuntSplit+"error") {
-			log.Println("ERROR: Shunting yard parser encountered an error on a calc expression:")
-			log.Println("Calc: " + calc)
-			panic("Error: " + line)
-		}
-	}
-
-	return parseIntoYardTokens(string(out.Bytes()))
-}
@@ -2,7 +2,6 @@ package compiler
 
 import (
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -161,61 +160,129 @@ func toRawAsm(asm string) []*asmCmd {
 	return newAsm
 }
 
-func callFunc(funcName string, parameters []*RuntimeValue, state *asmTransformState) []*asmCmd {
-	retval := make([]*asmCmd, 0)
-
-	// Push parameters to stack
-	for i := 0; i < len(parameters); i++ {
-		if parameters[i].Variable != nil {
-			asmVar, _ := getAsmVar(*parameters[i].Variable, state.currentFunction, state)
-			// FIXME: Add type checking, also for returned value
-			//if asmVar.asmType !=
-			if asmVar.asmType.size != 1 {
-				panic(fmt.Sprintf("ERROR: Only types with size 1 can be passed as parameter (tried passing type '%s' which has size %d as parameter %d to function '%s' in scope '%s')", asmVar.asmType.name, asmVar.asmType.size, i, funcName, state.currentFunction))
-			}
+// Checks the types of the arguments passed to a function (nil meaning word), structs have to match exactly
+func checkCallArguments(f *asmFunc, argTypes []*asmType, scope string) {
+	for i, param := range f.params {
+		if isStructType(param.asmType) != isStructType(argTypes[i]) || (isStructType(param.asmType) && param.asmType != argTypes[i]) {
+			panic(fmt.Sprintf("ERROR: Type mismatch in call to function '%s': parameter '%s' has type '%s', but '%s' was given (scope: %s)",
+				f.name, param.name, typeName(param.asmType), typeName(argTypes[i]), scope))
+		}
+	}
+}
+
+// Copies a struct argument into the function's scope, its address is popped from the stack (see callFunc)
+func structFromStack(varName string, t *asmType) []*asmCmd {
+	retval := []*asmCmd{
+		&asmCmd{
+			ins: "POP",
+			params: []*asmParam{
+				rawAsmParam("F"),
+			},
+			comment: " struct parameter " + varName,
+		},
+	}
+
+	for i, accessor := range wordAccessors(varName, t) {
+		if i > 0 {
+			retval = append(retval, &asmCmd{
+				ins: "INC",
+				params: []*asmParam{
+					rawAsmParam("F"),
+				},
+			})
 		}
 
-		paramAsAsmCalc := runtimeValueToAsmParam(parameters[i])
 		retval = append(retval, &asmCmd{
-			ins: "PUSH",
+			ins: "LOAD",
 			params: []*asmParam{
-				paramAsAsmCalc,
+				&asmParam{
+					asmParamType: asmParamTypeVarWrite,
+					value:        accessor,
+				},
+				rawAsmParam("F"),
 			},
 		})
 	}
 
-	retval = append(retval, &asmCmd{
-		ins: "__FLUSHSCOPE",
-	})
+	// Members might be accessed with different access chains later on (e.g. "v.member" and "v.member.alias"), write them back
+	return append(retval, structScopeBarrier()...)
+}
 
-	retval = append(retval, &asmCmd{
-		ins: "__CLEARSCOPE",
+// Copies a struct variable to the address given in the (word) variable ptrName
+func structToPointer(varName string, t *asmType, ptrName string) []*asmCmd {
+	retval := append(structScopeBarrier(), &asmCmd{
+		ins: "MOV",
+		params: []*asmParam{
+			&asmParam{
+				asmParamType: asmParamTypeVarRead,
+				value:        ptrName,
+			},
+			rawAsmParam("F"),
+		},
+		comment: " copy struct " + varName,
 	})
 
-	fLabel := getFuncLabelSpecific(funcName, len(parameters))
-	function := ""
-	for _, f := range state.functionTable {
-		if f.label == fLabel {
-			function = f.label
-			break
+	for i, accessor := range wordAccessors(varName, t) {
+		if i > 0 {
+			retval = append(retval, &asmCmd{
+				ins: "INC",
+				params: []*asmParam{
+					rawAsmParam("F"),
+				},
+			})
 		}
+
+		retval = append(retval, &asmCmd{
+			ins: "STOR",
+			params: []*asmParam{
+				&asmParam{
+					asmParamType: asmParamTypeVarRead,
+					value:        accessor,
+				},
+				rawAsmParam("F"),
+			},
+		})
 	}
 
-	if function == "" {
-		log.Printf("WARNING: Cannot find function to call: Function '%s' with %d parameters (Assuming extern function)\n", funcName, len(parameters))
-		function = fLabel
+	return retval
+}
+
+// Copies struct variable src to dest (both of type t) word by word
+func structCopy(dest, src string, t *asmType) []*asmCmd {
+	retval := structScopeBarrier()
+
+	destAccessors := wordAccessors(dest, t)
+	for i, accessor := range wordAccessors(src, t) {
+		retval = append(retval, &asmCmd{
+			ins: "MOV",
+			params: []*asmParam{
+				&asmParam{
+					asmParamType: asmParamTypeVarRead,
+					value:        accessor,
+				},
+				&asmParam{
+					asmParamType: asmParamTypeVarWrite,
+					value:        destAccessors[i],
+				},
+			},
+			comment: fmt.Sprintf(" copy struct %s to %s", src, dest),
+		})
 	}
 
-	retval = append(retval, &asmCmd{
-		ins: "CALL",
-		params: []*asmParam{
-			rawAsmParam("." + function),
-		},
-	})
+	return append(retval, structScopeBarrier()...)
+}
 
-	return append(retval, &asmCmd{
-		ins: "__CLEARSCOPE",
-	})
+// Checked out registers are tracked per access chain, so a struct member might be checked out under a different name than the one
+// used for copying it. Flushing and clearing the scope makes sure memory and registers are consistent.
+func structScopeBarrier() []*asmCmd {
+	return []*asmCmd{
+		&asmCmd{
+			ins: "__FLUSHSCOPE",
+		},
+		&asmCmd{
+			ins: "__CLEARSCOPE",
+		},
+	}
 }
 
 func funcPushState(state *asmTransformState) []*asmCmd {
@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,37 +14,110 @@ import (
 
 var regexpAsmExtract = regexp.MustCompile(`(?s)_asm\s*\{(.*?)\}`)
 var regexpAsmExtractCmds = regexp.MustCompile(`\s*(\S+)\s*`)
+var regexpLabelInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+var regexpPlainAccessor = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*(?:\.[a-zA-Z0-9_$]+)*$`)
+var regexpCalcFunctionCall = regexp.MustCompile(`(?s)^([a-zA-Z_$][a-zA-Z0-9_$]*)\s*\((.*)\)$`)
 
-func runtimeValueToAsmParam(val *RuntimeValue) *asmParam {
-	// TODO: Maybe add more shortcut options?
-	valueString := ""
-
+// Returns the calc string of a runtime value, every value is built as calc expression (see buildCalc)
+func runtimeValueToCalc(val *RuntimeValue) string {
 	if val.Eval != nil {
-		valueString = *val.Eval
+		return *val.Eval
 	} else if val.FunctionCall != nil {
-		valueString = val.FunctionCall.FunctionName + "("
+		valueString := val.FunctionCall.FunctionName + "("
 		for i, parmesan := range val.FunctionCall.Parameters {
 			if i > 0 {
 				valueString += ","
 			}
-			valueString += runtimeValueToAsmParam(parmesan).value
+			valueString += runtimeValueToCalc(parmesan)
 		}
-		valueString += ")"
+		return valueString + ")"
 	} else if val.Number != nil {
-		valueString = strconv.Itoa(*val.Number)
+		return strconv.Itoa(*val.Number)
 	} else if val.Variable != nil {
-		// Shortcut for variables
-		return &asmParam{
-			asmParamType: asmParamTypeVarRead,
-			value:        *val.Variable,
+		return *val.Variable
+	}
+
+	return ""
+}
+
+// Returns the variable (or struct member access chain) a runtime value consists of, nil if it is anything else.
+// Values are usually wrapped in calc brackets by autoCalcBracket, so a plain variable might also be given as calc.
+func runtimeValueVariable(val *RuntimeValue) *string {
+	if val.Variable != nil {
+		return val.Variable
+	}
+
+	if val.Eval != nil {
+		calc := strings.TrimSpace(strings.NewReplacer("[", "", "]", "").Replace(*val.Eval))
+		if regexpPlainAccessor.MatchString(calc) {
+			return &calc
 		}
 	}
 
-	// For now, make everything a calc, because simplicity and fucking over my future self who'll have to implement calc parsing
-	return &asmParam{
-		asmParamType: asmParamTypeCalc,
-		value:        valueString,
+	return nil
+}
+
+// Returns the function call a runtime value consists of, nil if it is anything else (e.g. a calc expression containing a call).
+// As with runtimeValueVariable, calls wrapped into a calc are recognized as well, their parameters are returned as calcs.
+func runtimeValueFunctionCall(val *RuntimeValue) *RVFunctionCall {
+	if val.FunctionCall != nil {
+		return val.FunctionCall
 	}
+
+	if val.Eval == nil {
+		return nil
+	}
+
+	calc := strings.TrimSpace(strings.NewReplacer("[", "", "]", "").Replace(*val.Eval))
+	match := regexpCalcFunctionCall.FindStringSubmatch(calc)
+	if match == nil {
+		return nil
+	}
+
+	call := &RVFunctionCall{
+		Pos:          val.Pos,
+		FunctionName: match[1],
+		Parameters:   make([]*RuntimeValue, 0),
+	}
+
+	// Split parameters on top-level commas, bail if the closing bracket at the end does not belong to the call (e.g. "f(a) + (b)")
+	depth := 0
+	paramStart := 0
+	for i := 0; i <= len(match[2]); i++ {
+		if i < len(match[2]) {
+			switch match[2][i] {
+			case '(':
+				depth++
+				continue
+			case ')':
+				depth--
+				if depth < 0 {
+					return nil
+				}
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+
+		param := strings.TrimSpace(match[2][paramStart:i])
+		paramStart = i + 1
+		if param == "" && i == len(match[2]) && len(call.Parameters) == 0 {
+			// No parameters
+			break
+		}
+
+		call.Parameters = append(call.Parameters, &RuntimeValue{
+			Pos:  val.Pos,
+			Eval: &param,
+		})
+	}
+
+	return call
 }
 
 func addVariable(varName string, varType string, state *asmTransformState) {
@@ -54,9 +128,11 @@ func addVariable(varName string, varType string, state *asmTransformState) {
 		panic(fmt.Sprintf("ERROR: Invalid type '%s' given to variable '%s' (scope: %s)", varType, varName, state.currentFunction))
 	}
 
+	// Variables are addressed as VarHeap pointer - orderNumber + offset, so a variable with size n
+	// occupies the n words up to and including H - orderNumber + (n - 1)
 	newVar := &asmVar{
 		name:        varName,
-		orderNumber: 0,
+		orderNumber: asmType.size - 1,
 		asmType:     asmType,
 	}
 
@@ -67,7 +143,7 @@ func addVariable(varName string, varType string, state *asmTransformState) {
 				panic(fmt.Sprintf("ERROR: Redefinition of variable '%s' in scope '%s'", varName, state.currentFunction))
 			}
 
-			if v.orderNumber >= newVar.orderNumber {
+			if v.orderNumber+newVar.asmType.size > newVar.orderNumber {
 				newVar.orderNumber = v.orderNumber + newVar.asmType.size
 			}
 		}
@@ -80,17 +156,13 @@ func addVariable(varName string, varType string, state *asmTransformState) {
 		}
 	}
 
-	state.currentScopeVariableCount++
 }
 
-func makeAsmExpression(asm string) *Expression {
-	asm = fmt.Sprintf("_asm { %s }", asm)
-	return &Expression{
-		Asm: &asm,
-		Pos: lexer.Position{
-			Filename: "Meta",
-		},
-	}
+// Adds a compiler-generated variable to the current scope and returns its name
+func addTempVariable(varType *asmType, state *asmTransformState) string {
+	name := fmt.Sprintf("mscr_temp_%d", len(state.variableMap[state.currentFunction]))
+	addVariable(name, varType.name, state)
+	return name
 }
 
 func isResolved(cmds []*asmCmd) bool {
@@ -122,6 +194,16 @@ func containsInt(slice []int, value int) bool {
 	return false
 }
 
+func containsString(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getNameForRegister(reg int, state *asmTransformState) *string {
 	for name, assignedReg := range state.scopeRegisterAssignment {
 		if assignedReg == reg {
@@ -156,6 +238,97 @@ func getMemberInfo(chain string, baseType *asmType, scope string) (offset int, s
 	panic(fmt.Sprintf("ERROR: Type '%s' does not contain a member called '%s' (scope: %s)", baseType.name, chain[1], scope))
 }
 
+// Returns the type of a (local) variable or struct member access chain, nil if the name does not refer to a local variable (e.g. globals)
+func getAccessorType(name string, scope string, state *asmTransformState) *asmType {
+	nameSplit := strings.Split(name, ".")
+
+	var retval *asmType
+	for _, v := range state.variableMap[scope] {
+		if v.name == nameSplit[0] {
+			retval = v.asmType
+			break
+		}
+	}
+
+	if retval == nil {
+		return nil
+	}
+
+	for _, member := range nameSplit[1:] {
+		found := false
+		for _, typeMember := range retval.members {
+			if typeMember.name == member {
+				retval = typeMember.asmType
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			panic(fmt.Sprintf("ERROR: Type '%s' does not contain a member called '%s' (scope: %s)", retval.name, member, scope))
+		}
+	}
+
+	return retval
+}
+
+// Structs with size != 1 can not be checked out into registers and are passed around by address instead.
+// nil (as returned by getAccessorType for globals) is treated as word.
+func isStructType(t *asmType) bool {
+	return t != nil && t.size != 1 && t.elem == nil
+}
+
+func isArrayType(t *asmType) bool {
+	return t != nil && t.elem != nil
+}
+
+// Returns the type of an array of the given element type and length, array types are created on first use
+func getArrayType(elem *asmType, length int, state *asmTransformState) *asmType {
+	if length <= 0 {
+		panic(fmt.Sprintf("ERROR: Invalid array length %d for array of type '%s'", length, elem.name))
+	}
+
+	name := fmt.Sprintf("%s[%d]", elem.name, length)
+	if t, ok := state.typeMap[name]; ok {
+		return t
+	}
+
+	t := &asmType{
+		name:    name,
+		size:    elem.size * length,
+		builtin: false,
+		members: make([]asmTypeMember, 0),
+		elem:    elem,
+		length:  length,
+	}
+
+	state.typeMap[name] = t
+	return t
+}
+
+func typeName(t *asmType) string {
+	if t == nil {
+		return "word"
+	}
+
+	return t.name
+}
+
+// Returns the access chains of all words making up a variable of the given type, in memory order
+// (e.g. "v.x", "v.y" for a struct v with two word members). Members with size 1 are treated as words.
+func wordAccessors(name string, t *asmType) []string {
+	if t.size == 1 {
+		return []string{name}
+	}
+
+	retval := make([]string, 0, t.size)
+	for _, member := range t.members {
+		retval = append(retval, wordAccessors(name+"."+member.name, member.asmType)...)
+	}
+
+	return retval
+}
+
 func getAsmVar(name string, scope string, state *asmTransformState) (*asmVar, int) {
 	nameSplit := strings.Split(name, ".")
 
@@ -280,20 +453,54 @@ func getFuncLabelSpecific(functionName string, parameters int) string {
 	return fmt.Sprintf("mscr_function_%s_params_%d", functionName, parameters)
 }
 
+// Returns the function with the given name and parameter count, or nil if it is not declared (i.e. extern)
+func findFunc(functionName string, parameters int, state *asmTransformState) *asmFunc {
+	fLabel := getFuncLabelSpecific(functionName, parameters)
+	for i := range state.functionTable {
+		if state.functionTable[i].label == fLabel {
+			return &state.functionTable[i]
+		}
+	}
+
+	return nil
+}
+
+// Unique label suffix for an AST node, source file names are reduced to valid label characters
+func getPosLabelSuffix(pos lexer.Position) string {
+	file := ""
+	if pos.Filename != "" {
+		file = regexpLabelInvalidChars.ReplaceAllString(filepath.Base(pos.Filename), "_")
+	}
+
+	return fmt.Sprintf("%s_%d_%d_%d", file, pos.Line, pos.Column, pos.Offset)
+}
+
 func getConditionalLabelEnd(cond Conditional) string {
-	return fmt.Sprintf("mscr_cond_end_%s_%d_%d_%d", cond.Pos.Filename, cond.Pos.Line, cond.Pos.Column, cond.Pos.Offset)
+	return "mscr_cond_end_" + getPosLabelSuffix(cond.Pos)
 }
 
 func getConditionalLabelElse(cond Conditional) string {
-	return fmt.Sprintf("mscr_cond_else_%s_%d_%d_%d", cond.Pos.Filename, cond.Pos.Line, cond.Pos.Column, cond.Pos.Offset)
+	return "mscr_cond_else_" + getPosLabelSuffix(cond.Pos)
 }
 
 func getWhileLoopLabelStart(cond WhileLoop) string {
-	return fmt.Sprintf("mscr_while_start_%s_%d_%d_%d", cond.Pos.Filename, cond.Pos.Line, cond.Pos.Column, cond.Pos.Offset)
+	return "mscr_while_start_" + getPosLabelSuffix(cond.Pos)
 }
 
 func getWhileLoopLabelEnd(cond WhileLoop) string {
-	return fmt.Sprintf("mscr_while_end_%s_%d_%d_%d", cond.Pos.Filename, cond.Pos.Line, cond.Pos.Column, cond.Pos.Offset)
+	return "mscr_while_end_" + getPosLabelSuffix(cond.Pos)
+}
+
+func getForLoopLabelStart(loop ForLoop) string {
+	return "mscr_for_start_" + getPosLabelSuffix(loop.Pos)
+}
+
+func getForLoopLabelContinue(loop ForLoop) string {
+	return "mscr_for_continue_" + getPosLabelSuffix(loop.Pos)
+}
+
+func getForLoopLabelEnd(loop ForLoop) string {
+	return "mscr_for_end_" + getPosLabelSuffix(loop.Pos)
 }
 
 var rnumLookup = []string{
@@ -1,24 +1,251 @@
 package compiler
 
-import "log"
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// DefaultOptimizationLevel enables all optimization passes
+const DefaultOptimizationLevel = 2
+
+// DefaultInlineThreshold is the size (in IR instructions) up to which functions are inlined without being declared 'func inline'
+const DefaultInlineThreshold = 8
+
+// Optimizations selects the optimization passes performed by GenerateASM
+type Optimizations struct {
+	// 0 disables all passes, 1 enables the basic peephole passes, 2 enables everything (see optimizationPasses)
+	Level int
+
+	// Passes enabled or disabled by name, regardless of Level (Disable wins)
+	Enable  []string
+	Disable []string
+
+	// Functions with at most this many IR instructions are inlined even if not declared 'func inline', 0 only inlines those (see ir_inline.go)
+	InlineThreshold int
+}
+
+// Matches a peephole pattern at the start of cmds; Returns the number of matched commands and their replacement, or 0 if the pattern does not apply
+type peepholeMatcher func(cmds []*asmCmd) (int, []*asmCmd)
+
+type optimizationPass struct {
+	name        string
+	level       int
+	description string
+
+	// Pattern for the peephole engine, nil for passes performed elsewhere (see GenerateASM)
+	match peepholeMatcher
+}
+
+// Registry of all optimization passes, peephole patterns are tried in this order at every instruction
+var optimizationPasses = []optimizationPass{
+	{"inline", 2, "inline calls to 'func inline' functions and functions below the inline threshold (see ir_inline.go)", nil},
+	{"regalloc", 2, "keep local variables in registers for their entire lifetime (see asm_regalloc.go)", nil},
+	{"pushpop", 1, "PUSH X, POP Y to MOV X Y", peepholePushPop},
+	{"selfmov", 1, "remove MOV X X", peepholeSelfMov},
+	{"doublemov", 1, "MOV X Y, MOV Y X to MOV X Y", peepholeDoubleMov},
+	{"deadcode", 1, "remove unreachable instructions after RET/HALT/FAULT/JMP up to the next label", peepholeDeadCode},
+	{"jumpnext", 1, "remove jumps to the immediately following label", peepholeJumpNext},
+	{"heapslot", 2, "remove redundant loads/stores of the same VarHeap slot", peepholeHeapSlot},
+	{"constfold", 2, "evaluate ALU instructions on constant registers at compile time", peepholeConstFold},
+}
+
+// OptimizationPassNames returns the names of all optimization passes, in the order they are applied
+func OptimizationPassNames() []string {
+	names := make([]string, 0, len(optimizationPasses))
+	for _, p := range optimizationPasses {
+		names = append(names, p.name)
+	}
+	return names
+}
+
+// Validate checks the optimization level and pass names
+func (o Optimizations) Validate() error {
+	if o.Level < 0 || o.Level > DefaultOptimizationLevel {
+		return fmt.Errorf("Invalid optimization level %d (0-%d)", o.Level, DefaultOptimizationLevel)
+	}
+
+	if o.InlineThreshold < 0 {
+		return fmt.Errorf("Invalid inline threshold %d", o.InlineThreshold)
+	}
+
+	for _, name := range append(append([]string{}, o.Enable...), o.Disable...) {
+		if findOptimizationPass(name) == nil {
+			return fmt.Errorf("Unknown optimization pass '%s' (available: %s)", name, strings.Join(OptimizationPassNames(), ", "))
+		}
+	}
+
+	return nil
+}
+
+func findOptimizationPass(name string) *optimizationPass {
+	for i := range optimizationPasses {
+		if optimizationPasses[i].name == name {
+			return &optimizationPasses[i]
+		}
+	}
+	return nil
+}
+
+func (o Optimizations) enabled(name string) bool {
+	for _, n := range o.Disable {
+		if n == name {
+			return false
+		}
+	}
+
+	for _, n := range o.Enable {
+		if n == name {
+			return true
+		}
+	}
+
+	return findOptimizationPass(name).level <= o.Level
+}
+
+func optimizeAsmAll(input []*asmCmd, opts Optimizations, logger *log.Logger, verbose bool) []*asmCmd {
+	passes := make([]optimizationPass, 0)
+	for _, p := range optimizationPasses {
+		if p.match != nil && opts.enabled(p.name) {
+			passes = append(passes, p)
+		}
+	}
+
+	if len(passes) == 0 {
+		logger.Println("Optimization disabled.")
+		return input
+	}
 
-func optimizeAsmAll(input []*asmCmd) []*asmCmd {
 	if len(input) <= 1 {
 		return input
 	}
 
-	retval := input
+	logger.Println("Performing asm optimizations...")
 
-	log.Println("Performing asm optimizations...")
+	retval, stats := runPeepholes(input, passes)
 
-	// Optimization passes
-	retval = optimizePushPop(retval)
-	retval = optimizeMov(retval)
-	retval = optimizeDoubleMov(retval) // Has to happen *after* optimizeMov to avoid removal of "copy"-movs
+	if verbose {
+		for _, p := range passes {
+			logger.Printf("Peephole pass '%s': %d replacement(s)\n", p.name, stats[p.name])
+		}
+	}
 
 	return retval
 }
 
+// Applies the given peephole patterns until none of them matches anymore. Meta-instructions are dropped beforehand,
+// they do not generate any output and would only separate otherwise matching instructions.
+// Instructions of user _asm blocks are emitted as written, patterns never match them.
+func runPeepholes(input []*asmCmd, passes []optimizationPass) ([]*asmCmd, map[string]int) {
+	stats := make(map[string]int)
+
+	asm := make([]*asmCmd, 0, len(input))
+	for _, cmd := range input {
+		if !strings.HasPrefix(cmd.ins, "__") {
+			asm = append(asm, cmd)
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		retval := make([]*asmCmd, 0, len(asm))
+
+		for i := 0; i < len(asm); {
+			matched := false
+			for _, p := range passes {
+				n, replacement := p.match(asm[i:])
+				if n == 0 || containsInlineAsm(asm[i:i+n]) {
+					continue
+				}
+
+				retval = append(retval, replacement...)
+				i += n
+				stats[p.name]++
+				matched = true
+				changed = true
+				break
+			}
+
+			if !matched {
+				retval = append(retval, asm[i])
+				i++
+			}
+		}
+
+		asm = retval
+	}
+
+	return asm, stats
+}
+
+func containsInlineAsm(cmds []*asmCmd) bool {
+	for _, cmd := range cmds {
+		if cmd.inlineAsm {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns mnemonic and operands of a resolved command (e.g. "JMP .label" is stored both with and without params)
+func cmdFields(cmd *asmCmd) []string {
+	fields := strings.Fields(cmd.ins)
+	for _, p := range cmd.params {
+		fields = append(fields, p.value)
+	}
+	return fields
+}
+
+// Returns true if cmd matches the given mnemonic and operands, "" matches any operand
+func cmdMatches(cmd *asmCmd, fields ...string) bool {
+	actual := cmdFields(cmd)
+	if len(actual) != len(fields) {
+		return false
+	}
+
+	for i := range fields {
+		if fields[i] != "" && fields[i] != actual[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the mnemonic of a resolved command, or an empty string if there is none
+func cmdMnemonic(cmd *asmCmd) string {
+	if f := strings.Fields(cmd.ins); len(f) > 0 {
+		return f[0]
+	}
+	return ""
+}
+
+// Labels may be followed by an instruction in raw asm (".label ADD A A 1")
+func isLabelCmd(cmd *asmCmd) bool {
+	return strings.HasPrefix(cmd.ins, ".")
+}
+
+// Creates a command replacing orig, keeping its scope, source position and comment
+func replacementCmd(orig *asmCmd, ins string, params ...string) *asmCmd {
+	cmd := &asmCmd{
+		ins:                  ins,
+		params:               make([]*asmParam, 0, len(params)),
+		scope:                orig.scope,
+		comment:              orig.comment,
+		printIndent:          orig.printIndent,
+		originalAsmCmdString: orig.originalAsmCmdString,
+		file:                 orig.file,
+		line:                 orig.line,
+	}
+
+	for _, p := range params {
+		cmd.params = append(cmd.params, rawAsmParam(p))
+	}
+
+	return cmd
+}
+
 /*
 	Reduce constellations like
 		PUSH A
@@ -26,27 +253,15 @@ func optimizeAsmAll(input []*asmCmd) []*asmCmd {
 	to
 		MOV A B
 
-	(Note: "PUSH A, POP A" will be optimized to "MOV A A", which is then subsequently optimized away in optimizeMov)
+	(Note: "PUSH A, POP A" will be optimized to "MOV A A", which is then subsequently optimized away by selfmov)
 */
-func optimizePushPop(input []*asmCmd) []*asmCmd {
-	retval := make([]*asmCmd, 1)
-	retval[0] = input[0]
-
-	for i := 1; i < len(input); i++ {
-		if input[i-1].ins == "PUSH" && input[i].ins == "POP" {
-			// Optimizible constelation detected
-			retval[len(retval)-1].ins = "MOV"
-			retval[len(retval)-1].params = []*asmParam{
-				// This is... not fully safe. But since the asmCmds here all generated directly by the compiler, we *should* be good...
-				rawAsmParam(input[i-1].params[0].value),
-				rawAsmParam(input[i].params[0].value),
-			}
-		} else {
-			retval = append(retval, input[i])
-		}
+func peepholePushPop(cmds []*asmCmd) (int, []*asmCmd) {
+	if len(cmds) < 2 || !cmdMatches(cmds[0], "PUSH", "") || !cmdMatches(cmds[1], "POP", "") {
+		return 0, nil
 	}
 
-	return retval
+	// This is... not fully safe. But since the asmCmds here all generated directly by the compiler, we *should* be good...
+	return 2, []*asmCmd{replacementCmd(cmds[0], "MOV", cmdFields(cmds[0])[1], cmdFields(cmds[1])[1])}
 }
 
 /*
@@ -55,16 +270,12 @@ func optimizePushPop(input []*asmCmd) []*asmCmd {
 	or
 		MOV F F
 */
-func optimizeMov(input []*asmCmd) []*asmCmd {
-	retval := make([]*asmCmd, 0)
-
-	for _, cmd := range input {
-		if !(cmd.ins == "MOV" && cmd.params[0].value == cmd.params[1].value) {
-			retval = append(retval, cmd)
-		}
+func peepholeSelfMov(cmds []*asmCmd) (int, []*asmCmd) {
+	if f := cmdFields(cmds[0]); len(f) == 3 && f[0] == "MOV" && f[1] == f[2] {
+		return 1, nil
 	}
 
-	return retval
+	return 0, nil
 }
 
 /*
@@ -75,16 +286,336 @@ func optimizeMov(input []*asmCmd) []*asmCmd {
 		MOV A B
 	which has the same effect on register content
 */
-func optimizeDoubleMov(input []*asmCmd) []*asmCmd {
-	retval := make([]*asmCmd, 1)
-	retval[0] = input[0]
+func peepholeDoubleMov(cmds []*asmCmd) (int, []*asmCmd) {
+	if len(cmds) < 2 || !cmdMatches(cmds[0], "MOV", "", "") {
+		return 0, nil
+	}
 
-	for i := 1; i < len(input); i++ {
-		if !(input[i-1].ins == "MOV" && input[i].ins == "MOV" &&
-			input[i-1].params[0].value == input[i].params[1].value &&
-			input[i-1].params[1].value == input[i].params[0].value) {
-			retval = append(retval, input[i])
+	f := cmdFields(cmds[0])
+	if cmdMatches(cmds[1], "MOV", f[2], f[1]) {
+		return 2, cmds[:1]
+	}
+
+	return 0, nil
+}
+
+/*
+	Remove instructions that can never be executed, like the FAULT in
+		RET
+		FAULT 0x0
+	Everything after an unconditional jump, return or halt is unreachable up to the next label.
+*/
+func peepholeDeadCode(cmds []*asmCmd) (int, []*asmCmd) {
+	if len(cmds) < 2 || isLabelCmd(cmds[1]) {
+		return 0, nil
+	}
+
+	switch cmdMnemonic(cmds[0]) {
+	case "RET", "HALT", "FAULT", "JMP":
+		return 2, cmds[:1]
+	}
+
+	return 0, nil
+}
+
+/*
+	Remove jumps to the next instruction, e.g.
+		JMP .mscr_cond_end
+		.mscr_cond_end __LABEL_SET
+	Conditional jumps are removed as well, evaluating their condition has no side effects.
+*/
+func peepholeJumpNext(cmds []*asmCmd) (int, []*asmCmd) {
+	f := cmdFields(cmds[0])
+	if !strings.HasPrefix(cmdMnemonic(cmds[0]), "JMP") || len(f) < 2 || !strings.HasPrefix(f[1], ".") {
+		return 0, nil
+	}
+
+	for _, next := range cmds[1:] {
+		if !isLabelCmd(next) {
+			break
+		}
+
+		if cmdMnemonic(next) == f[1] {
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+/*
+	Remove redundant accesses to the same VarHeap slot, like
+		SETREG G 0x3
+		SUB H G G
+		STOR A G
+		SETREG G 0x3
+		SUB H G G
+		LOAD B G
+	which is reduced to
+		SETREG G 0x3
+		SUB H G G
+		STOR A G
+		MOV A B
+	G still holds the address of the slot after the first access, so recalculating it is not necessary either.
+	Only VarHeap slots are considered, globals might be views of memory mapped devices.
+*/
+func peepholeHeapSlot(cmds []*asmCmd) (int, []*asmCmd) {
+	if len(cmds) < 6 ||
+		!cmdMatches(cmds[0], "SETREG", "G", "") || !cmdMatches(cmds[1], "SUB", "H", "G", "G") ||
+		!cmdMatches(cmds[3], "SETREG", "G", cmdFields(cmds[0])[2]) || !cmdMatches(cmds[4], "SUB", "H", "G", "G") {
+		return 0, nil
+	}
+
+	first, second := cmdFields(cmds[2]), cmdFields(cmds[5])
+	if len(first) != 3 || len(second) != 3 || first[2] != "G" || second[2] != "G" {
+		return 0, nil
+	}
+
+	// Loading into G or H would change the address
+	if first[1] == "G" || first[1] == "H" {
+		return 0, nil
+	}
+
+	retval := append([]*asmCmd{}, cmds[:3]...)
+
+	switch {
+	case (first[0] == "STOR" || first[0] == "LOAD") && second[0] == "LOAD":
+		// Value is already in a register
+		if second[1] != first[1] {
+			retval = append(retval, replacementCmd(cmds[5], "MOV", first[1], second[1]))
 		}
+
+	case first[0] == "LOAD" && second[0] == "STOR" && second[1] == first[1]:
+		// Storing the value that was just loaded
+
+	case first[0] == "STOR" && second[0] == "STOR":
+		// First store is overwritten right away
+		retval = append(retval[:2], cmds[5])
+
+	case first[0] == "LOAD" && second[0] == "STOR":
+		retval = append(retval, cmds[5])
+
+	default:
+		return 0, nil
+	}
+
+	return 6, retval
+}
+
+// Values of the constant registers
+var constantRegisters = map[string]uint16{
+	"0":  0,
+	"1":  1,
+	"-1": 0xFFFF,
+}
+
+// Registers constant folding may write to
+var foldableRegisters = map[string]bool{
+	"A": true, "B": true, "C": true, "D": true, "E": true, "F": true, "G": true, "H": true,
+}
+
+// Returns the value of a literal (decimal, hexadecimal or negative), ok is false for labels and out-of-range values
+func parseLiteral(lit string) (uint16, bool) {
+	v, err := strconv.ParseInt(lit, 0, 32)
+	if err != nil || v < -0x8000 || v > 0xFFFF {
+		return 0, false
+	}
+
+	return uint16(v), true
+}
+
+// Returns the command loading a constant into a register (constant registers are cheaper than SETREG)
+func constantLoadCmd(orig *asmCmd, register string, value uint16) *asmCmd {
+	for name, v := range constantRegisters {
+		if v == value {
+			return replacementCmd(orig, "MOV", name, register)
+		}
+	}
+
+	return replacementCmd(orig, "SETREG", register, fmt.Sprintf("0x%x", value))
+}
+
+// Evaluates an ALU instruction or library macro thereof (see assembler-libs/base.mlib) like the VM does
+func evalALU(op string, a, b uint16) (uint16, bool) {
+	boolValue := func(v bool) uint16 {
+		if v {
+			return 0xFFFF
+		}
+		return 0
+	}
+
+	switch op {
+	case "AND":
+		return a & b, true
+	case "OR":
+		return a | b, true
+	case "XOR":
+		return a ^ b, true
+	case "ADD":
+		return a + b, true
+	case "SUB":
+		return a - b, true
+	case "MUL":
+		return a * b, true
+	case "SHFT":
+		if b&0xFF00 == 0 {
+			return a >> (b & 0x00FF), true
+		}
+		return a << (b & 0x00FF), true
+	case "SHFR":
+		return evalALU("SHFT", a, b)
+	case "SHFL":
+		return evalALU("SHFT", a, b|0xFF00)
+	case "GT":
+		return boolValue(int16(a) > int16(b)), true
+	case "LT":
+		return boolValue(int16(a) < int16(b)), true
+	case "GTOE":
+		return boolValue(int16(a) >= int16(b)), true
+	case "LTOE":
+		return boolValue(int16(a) <= int16(b)), true
+	case "EQ":
+		return boolValue(a == b), true
+	case "NEQ":
+		return boolValue(a != b), true
+	}
+
+	return 0, false
+}
+
+/*
+	Evaluate ALU instructions whose operands are known constants, e.g.
+		MOV 1 F
+		PUSH F
+		SETREG F 0x3
+		MOV F E
+		POP F
+		SHFL F F E
+	is replaced with
+		SETREG E 0x3
+		SETREG F 0x8
+	Constants are tracked from a constant load (SETREG or constant register) through MOVs and the stack, up to the end of the basic block.
+	If everything up to the folded instruction only computes constants (no memory access, balanced stack), it is replaced by loads
+	of the final register values, otherwise only the folded instruction itself is.
+*/
+func peepholeConstFold(cmds []*asmCmd) (int, []*asmCmd) {
+	known := make(map[string]uint16)
+	stack := make([]*uint16, 0)
+
+	// Registers written so far, in order of their first write
+	written := make([]string, 0)
+	memory := false
+
+	value := func(operand string) (uint16, bool) {
+		if v, ok := constantRegisters[operand]; ok {
+			return v, true
+		}
+		v, ok := known[operand]
+		return v, ok
+	}
+
+	set := func(register string, v uint16, ok bool) bool {
+		// Writes to SP, PC or scratch registers change control flow or the stack
+		if !foldableRegisters[register] {
+			return false
+		}
+
+		if !containsString(written, register) {
+			written = append(written, register)
+		}
+
+		if ok {
+			known[register] = v
+		} else {
+			delete(known, register)
+		}
+
+		return true
+	}
+
+	for i, cmd := range cmds {
+		f := cmdFields(cmd)
+		tracked := true
+
+		switch {
+		case len(f) == 3 && f[0] == "SETREG":
+			v, ok := parseLiteral(f[2])
+			tracked = set(f[1], v, ok)
+
+		case len(f) == 3 && f[0] == "MOV":
+			v, ok := value(f[1])
+			tracked = set(f[2], v, ok)
+
+		case len(f) == 2 && f[0] == "PUSH":
+			if v, ok := value(f[1]); ok {
+				stack = append(stack, &v)
+			} else {
+				stack = append(stack, nil)
+			}
+
+		case len(f) == 2 && f[0] == "POP":
+			if len(stack) == 0 {
+				return 0, nil
+			}
+
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if top != nil {
+				tracked = set(f[1], *top, true)
+			} else {
+				tracked = set(f[1], 0, false)
+			}
+
+		case len(f) == 3 && (f[0] == "LOAD" || f[0] == "STOR"):
+			memory = true
+			if f[0] == "LOAD" {
+				tracked = set(f[1], 0, false)
+			}
+
+		case len(f) == 4:
+			a, okA := value(f[1])
+			b, okB := value(f[3])
+			result, isALU := evalALU(f[0], a, b)
+			if !isALU || !set(f[2], result, okA && okB) {
+				return 0, nil
+			}
+
+			if okA && okB {
+				if replacement := constantSummary(cmd, known, written); !memory && len(stack) == 0 && replacement != nil && len(replacement) <= i {
+					return i + 1, replacement
+				}
+
+				return i + 1, append(append([]*asmCmd{}, cmds[:i]...), constantLoadCmd(cmd, f[2], result))
+			}
+
+		default:
+			// Labels, jumps, calls and everything else end the basic block
+			return 0, nil
+		}
+
+		if !tracked {
+			return 0, nil
+		}
+
+		// Only start tracking at a constant load
+		if i == 0 && len(known) == 0 {
+			return 0, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Loads the final values of all written registers, nil if any of them is not a known constant
+func constantSummary(template *asmCmd, known map[string]uint16, written []string) []*asmCmd {
+	retval := make([]*asmCmd, 0, len(written))
+	for _, register := range written {
+		v, ok := known[register]
+		if !ok {
+			return nil
+		}
+
+		retval = append(retval, constantLoadCmd(template, register, v))
 	}
 
 	return retval
@@ -0,0 +1,475 @@
+package compiler
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Register allocation for function local variables
+//
+// By default, variables live on the VarHeap and are only checked out into registers A-D on demand (see asm_resolver.go),
+// which means they are written back and reloaded around every label, jump and call. The allocator below instead assigns
+// registers to word-sized locals for their entire lifetime, based on a liveness analysis over the control flow graph of each function.
+// Allocated variables never touch the VarHeap, their parameters are simply rewritten to raw registers before resolving.
+// The registers they occupy are marked on every command in their live range, so the on-demand checkout leaves them alone.
+//
+// Functions using _reg_assign or inline assembly are skipped entirely, since we cannot know which registers they depend on.
+
+var regexpFunctionLabel = regexp.MustCompile(`^\.mscr_function_\S+ __LABEL_SET$`)
+
+// Bitset of variables (by index into the candidate list of a function)
+type varSet []uint64
+
+func newVarSet(n int) varSet {
+	return make(varSet, (n+63)/64)
+}
+
+func (s varSet) add(i int) {
+	s[i/64] |= 1 << uint(i%64)
+}
+
+func (s varSet) remove(i int) {
+	s[i/64] &^= 1 << uint(i%64)
+}
+
+func (s varSet) contains(i int) bool {
+	return s[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Sets s to s | other, returns true if s changed
+func (s varSet) union(other varSet) bool {
+	changed := false
+	for i := range s {
+		n := s[i] | other[i]
+		if n != s[i] {
+			s[i] = n
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Live range of a variable, in command indices relative to the start of its function
+type liveInterval struct {
+	variable   int
+	start, end int
+
+	// Registers the variable may not be allocated to (used as raw register while the variable is live)
+	forbidden int
+
+	reg int
+}
+
+// Per-command information for the dataflow analysis
+type regAllocNode struct {
+	succ []int
+	use  varSet
+	def  varSet
+
+	liveIn  varSet
+	liveOut varSet
+
+	// Raw registers A-D mentioned by the command (bit n for register n)
+	rawRegisters int
+
+	// Call to a function that does not preserve A-D
+	clobbering bool
+
+	// Number of variable parameters, each of them may need a register of its own during resolving
+	varParams int
+}
+
+// Assigns registers to the local variables of all functions in asm, where possible.
+// Expects meta-asm without calc parameters, as generated from the IR (see ir_lowering.go).
+func allocateRegisters(asm []*asmCmd, state *asmTransformState) {
+	start := -1
+	for i, cmd := range asm {
+		if regexpFunctionLabel.MatchString(cmd.ins) {
+			if start >= 0 {
+				allocateFunctionRegisters(asm[start:i], state)
+			}
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		allocateFunctionRegisters(asm[start:], state)
+	}
+}
+
+func allocateFunctionRegisters(asm []*asmCmd, state *asmTransformState) {
+	scope := asm[0].scope
+
+	// Candidates are word-sized locals, as long as their address is never taken
+	candidates := make([]string, 0)
+	candidateIndex := make(map[string]int)
+	excluded := make(map[string]bool)
+	for _, cmd := range asm {
+		if cmd.ins == "__FORCESCOPE" || cmd.ins == "__ASSUMESCOPE" || cmd.inlineAsm {
+			return
+		}
+
+		if cmd.ins == "__SET_DIRECT" {
+			excluded[cmd.scopeAnnotationName] = true
+		}
+
+		for _, p := range cmd.params {
+			if p.asmParamType == asmParamTypeVarAddr {
+				excluded[strings.Split(p.value, ".")[0]] = true
+			}
+		}
+	}
+
+	for _, v := range state.variableMap[scope] {
+		if v.isGlobal || excluded[v.name] || v.asmType.size != 1 || isStructType(v.asmType) || isArrayType(v.asmType) {
+			continue
+		}
+
+		candidateIndex[v.name] = len(candidates)
+		candidates = append(candidates, v.name)
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	nodes, ok := buildRegAllocNodes(asm, candidateIndex, len(candidates))
+	if !ok {
+		return
+	}
+
+	computeLiveness(nodes)
+
+	// Variables live across calls would be overwritten by the callee
+	for _, n := range nodes {
+		if n.clobbering {
+			for v := range candidates {
+				if n.liveOut.contains(v) {
+					excluded[candidates[v]] = true
+				}
+			}
+		}
+	}
+
+	// Leave enough registers for the variables checked out on demand
+	maxVarParams := 0
+	for _, n := range nodes {
+		if n.varParams > maxVarParams {
+			maxVarParams = n.varParams
+		}
+	}
+
+	available := AssigneableRegisters - maxVarParams
+	if available <= 0 {
+		return
+	}
+
+	intervals := buildLiveIntervals(nodes, candidates, excluded)
+	allocated := linearScan(intervals, available)
+	if len(allocated) == 0 {
+		return
+	}
+
+	// Rewrite parameters and reserve registers
+	registers := make(map[string]int)
+	for _, interval := range allocated {
+		registers[candidates[interval.variable]] = interval.reg
+		for i := interval.start; i <= interval.end; i++ {
+			asm[i].allocatedRegisters |= 1 << uint(interval.reg)
+		}
+	}
+
+	for _, cmd := range asm {
+		for _, p := range cmd.params {
+			if p.asmParamType != asmParamTypeVarRead && p.asmParamType != asmParamTypeVarWrite {
+				continue
+			}
+
+			if reg, ok := registers[p.value]; ok {
+				cmd.comment += fmt.Sprintf(" (reg_alloc: %s allocated to %s)", p.value, toReg(reg))
+				p.asmParamType = asmParamTypeRaw
+				p.value = toReg(reg)
+			}
+		}
+	}
+
+	if state.verbose {
+		names := make([]string, 0, len(allocated))
+		for _, interval := range allocated {
+			names = append(names, fmt.Sprintf("%s=%s", candidates[interval.variable], toReg(interval.reg)))
+		}
+		sort.Strings(names)
+		state.log.Printf("Register allocation for '%s': %s\n", scope, strings.Join(names, ", "))
+	}
+}
+
+// Returns the label set by a command, or an empty string if it does not set one
+// (Labels from raw asm keep __LABEL_SET as parameter)
+func labelName(cmd *asmCmd) string {
+	if !strings.HasPrefix(cmd.ins, ".") {
+		return ""
+	}
+
+	if strings.HasSuffix(cmd.ins, " __LABEL_SET") {
+		return strings.TrimSuffix(cmd.ins, " __LABEL_SET")
+	}
+
+	if len(cmd.params) == 1 && cmd.params[0].value == "__LABEL_SET" {
+		return cmd.ins
+	}
+
+	return ""
+}
+
+// Returns the target label of a jump or call command, or an empty string if there is none
+func branchTarget(cmd *asmCmd) string {
+	if fields := strings.Fields(cmd.ins); len(fields) > 1 {
+		return fields[1]
+	}
+
+	if len(cmd.params) > 0 && cmd.params[0].asmParamType == asmParamTypeRaw {
+		return cmd.params[0].value
+	}
+
+	return ""
+}
+
+// Builds the control flow graph of a function, together with the variable uses and definitions of every command.
+// Returns false if the function cannot be analyzed (i.e. it jumps to a label outside of itself).
+func buildRegAllocNodes(asm []*asmCmd, candidateIndex map[string]int, count int) ([]*regAllocNode, bool) {
+	labels := make(map[string]int)
+	for i, cmd := range asm {
+		if label := labelName(cmd); label != "" {
+			labels[label] = i
+		}
+	}
+
+	nodes := make([]*regAllocNode, len(asm))
+	for i, cmd := range asm {
+		n := &regAllocNode{
+			use:     newVarSet(count),
+			def:     newVarSet(count),
+			liveIn:  newVarSet(count),
+			liveOut: newVarSet(count),
+		}
+		nodes[i] = n
+
+		for _, p := range cmd.params {
+			switch p.asmParamType {
+			case asmParamTypeVarRead, asmParamTypeVarWrite, asmParamTypeGlobalRead, asmParamTypeGlobalWrite:
+				n.varParams++
+
+				if v, ok := candidateIndex[p.value]; ok {
+					if p.asmParamType == asmParamTypeVarRead {
+						n.use.add(v)
+					} else if p.asmParamType == asmParamTypeVarWrite {
+						n.def.add(v)
+					}
+				}
+
+			case asmParamTypeRaw:
+				for reg := 0; reg < AssigneableRegisters; reg++ {
+					if p.value == toReg(reg) {
+						n.rawRegisters |= 1 << uint(reg)
+					}
+				}
+			}
+		}
+
+		// A variable read and written by the same command is still live before it
+		for v := 0; v < count; v++ {
+			if n.use.contains(v) {
+				n.def.remove(v)
+			}
+		}
+
+		op := strings.Fields(cmd.ins + " ")[0]
+		switch {
+		case op == "RET" || op == "HALT" || op == "FAULT":
+			// No successors
+
+		case strings.HasPrefix(op, "JMP"):
+			target, ok := labels[branchTarget(cmd)]
+			if !ok {
+				return nil, false
+			}
+
+			n.succ = append(n.succ, target)
+			if op != "JMP" && i+1 < len(asm) {
+				n.succ = append(n.succ, i+1)
+			}
+
+		default:
+			if op == "CALL" && !strings.HasPrefix(branchTarget(cmd), ".mscr_runtime_") {
+				n.clobbering = true
+			}
+
+			if i+1 < len(asm) {
+				n.succ = append(n.succ, i+1)
+			}
+		}
+	}
+
+	return nodes, true
+}
+
+// Standard backwards dataflow analysis: liveIn = use | (liveOut - def), liveOut = union of liveIn of all successors
+func computeLiveness(nodes []*regAllocNode) {
+	changed := true
+	for changed {
+		changed = false
+		for i := len(nodes) - 1; i >= 0; i-- {
+			n := nodes[i]
+			for _, s := range n.succ {
+				if n.liveOut.union(nodes[s].liveIn) {
+					changed = true
+				}
+			}
+
+			in := newVarSet(len(n.liveIn) * 64)
+			for w := range in {
+				in[w] = (n.liveOut[w] &^ n.def[w]) | n.use[w]
+			}
+
+			if n.liveIn.union(in) {
+				changed = true
+			}
+		}
+	}
+}
+
+// A variable occupies its register from its first to its last definition or use, including all points it is live in between
+func buildLiveIntervals(nodes []*regAllocNode, candidates []string, excluded map[string]bool) []*liveInterval {
+	intervals := make([]*liveInterval, 0)
+	for v, name := range candidates {
+		if excluded[name] {
+			continue
+		}
+
+		interval := &liveInterval{variable: v, start: -1}
+		for i, n := range nodes {
+			if n.liveIn.contains(v) || n.liveOut.contains(v) || n.def.contains(v) {
+				if interval.start < 0 {
+					interval.start = i
+				}
+				interval.end = i
+				interval.forbidden |= n.rawRegisters
+			}
+		}
+
+		if interval.start >= 0 {
+			intervals = append(intervals, interval)
+		}
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].start < intervals[j].start
+	})
+
+	return intervals
+}
+
+// Linear scan allocation of at most available registers at a time, spilling the interval that ends last.
+// Returns the intervals that have been assigned a register.
+func linearScan(intervals []*liveInterval, available int) []*liveInterval {
+	allocated := make([]*liveInterval, 0)
+	active := make([]*liveInterval, 0)
+
+	for _, current := range intervals {
+		// Expire intervals that have ended
+		stillActive := active[:0]
+		for _, a := range active {
+			if a.end >= current.start {
+				stillActive = append(stillActive, a)
+			}
+		}
+		active = stillActive
+
+		used := 0
+		for _, a := range active {
+			used |= 1 << uint(a.reg)
+		}
+
+		current.reg = -1
+		if len(active) < available {
+			for reg := 0; reg < AssigneableRegisters; reg++ {
+				if (used|current.forbidden)&(1<<uint(reg)) == 0 {
+					current.reg = reg
+					break
+				}
+			}
+		}
+
+		if current.reg < 0 {
+			// Spill whichever interval ends last, if its register can be used by the current one
+			var spill *liveInterval
+			for _, a := range active {
+				if a.end > current.end && current.forbidden&(1<<uint(a.reg)) == 0 && (spill == nil || a.end > spill.end) {
+					spill = a
+				}
+			}
+
+			if spill == nil {
+				continue
+			}
+
+			current.reg = spill.reg
+			spill.reg = -1
+			for i, a := range active {
+				if a == spill {
+					active = append(active[:i], active[i+1:]...)
+					break
+				}
+			}
+		}
+
+		active = append(active, current)
+		allocated = append(allocated, current)
+	}
+
+	retval := make([]*liveInterval, 0, len(allocated))
+	for _, a := range allocated {
+		if a.reg >= 0 {
+			retval = append(retval, a)
+		}
+	}
+
+	return retval
+}
+
+// Returns the registers occupied by allocated variables while this command executes
+func (cmd *asmCmd) reservedRegisters() []int {
+	retval := make([]int, 0, AssigneableRegisters)
+	for reg := 0; reg < AssigneableRegisters; reg++ {
+		if cmd.allocatedRegisters&(1<<uint(reg)) != 0 {
+			retval = append(retval, reg)
+		}
+	}
+
+	return retval
+}
+
+// Removes variables checked out on demand from the registers reserved for allocated variables, writing them back if dirty
+func evictReservedRegisters(cmd *asmCmd, state *asmTransformState) []*asmCmd {
+	output := make([]*asmCmd, 0)
+
+	for _, reg := range cmd.reservedRegisters() {
+		name := getNameForRegister(reg, state)
+		if name == nil {
+			continue
+		}
+
+		if state.scopeRegisterDirty[reg] {
+			evictionAsm := evictRegister(reg, cmd.scope, state)
+			evictionAsm[0].comment += " (reg_alloc: register reserved for allocated variable)"
+			output = append(output, evictionAsm...)
+		}
+
+		delete(state.scopeRegisterAssignment, *name)
+		state.scopeRegisterDirty[reg] = false
+	}
+
+	return output
+}
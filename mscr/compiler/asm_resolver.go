@@ -211,42 +211,17 @@ func (cmd *asmCmd) resolve(initAsm []*asmCmd, state *asmTransformState) []*asmCm
 
 	// Handle calc parameters first to avoid glitches with scoped variable assignment later on
 	// Self-recursive resolving will take care of the rest
-	processedCalc := false
-	for _, p := range cmd.params {
-		if p.asmParamType == asmParamTypeCalc {
-			if processedCalc {
-				// This would be very bad to allow, since a calc expression prepended to a regular asmCmd assumes full control over calc registers (especially F)
-				// Thus, two calc-resolvings for one asmCmd would overwrite register F with whichever paramTypeCalc parameters is resolved later
-				// This scenario should however never happen (in theory anyway)
-				panic("ERROR: Two calc parameters found in one meta-assembly instruction, invalid state.")
-			}
-
-			// Resolve calculation to assembly
-			// This will put result in "F"
-			calcAsm := resolveCalc(p.value, cmd.scope, state)
-			output = append(output, calcAsm...)
-
-			p.asmParamType = asmParamTypeRaw
-			p.value = "F" // F is calcOut register
-			processedCalc = true
-		}
+	if calcAsm, processedCalc := cmd.resolveCalcParams(state); processedCalc {
+		return calcAsm
 	}
 
-	// Calc found - exit early, recursive resolving will save the day as always
-	if processedCalc {
-		// Special case of SETREG which doesn't accept registers, but could be used to set a "calc literal" to a register
-		if cmd.ins == "SETREG" {
-			cmd.ins = "MOV"
-			cmd.params[0], cmd.params[1] = cmd.params[1], cmd.params[0]
-		}
-
-		return append(output, cmd)
-	}
+	// Variables allocated to registers by the register allocator occupy these registers, no other variable may be checked out into them
+	output = append(output, evictReservedRegisters(cmd, state)...)
 
 	postCmdAsm := make([]*asmCmd, 0)
 
 	// Parameter translation (meta asm (variables/calc expressions)->real asm (registers/literals))
-	cmdAssignedRegisters := make([]int, 0)
+	cmdAssignedRegisters := cmd.reservedRegisters()
 	for paramNum, p := range cmd.params {
 		switch p.asmParamType {
 		case asmParamTypeScopeVarCount:
@@ -338,6 +313,9 @@ func (cmd *asmCmd) resolve(initAsm []*asmCmd, state *asmTransformState) []*asmCm
 
 						cmd.comment += fmt.Sprintf(" (reg_alloc: var found checked out in %d)", varReg)
 
+						// Other parameters of this command must not be checked out into the same register
+						cmdAssignedRegisters = append(cmdAssignedRegisters, varReg)
+
 						// Mark dirty on write
 						if p.asmParamType == asmParamTypeVarWrite || p.asmParamType == asmParamTypeGlobalWrite {
 							// We know it's not a directly-assigned var or global, since otherwise we wouldn't even bother searching
@@ -438,3 +416,42 @@ func (cmd *asmCmd) resolve(initAsm []*asmCmd, state *asmTransformState) []*asmCm
 	// Note to self: Any change above here but below for-loop should probably be reflected in early exits as well (especially calc early-out)
 	return append(output, append([]*asmCmd{cmd}, postCmdAsm...)...)
 }
+
+// Resolves the calc parameter of a command (if any) to meta-asm computing its value in F, followed by the command itself
+func (cmd *asmCmd) resolveCalcParams(state *asmTransformState) ([]*asmCmd, bool) {
+	output := make([]*asmCmd, 0)
+
+	processedCalc := false
+	for _, p := range cmd.params {
+		if p.asmParamType == asmParamTypeCalc {
+			if processedCalc {
+				// This would be very bad to allow, since a calc expression prepended to a regular asmCmd assumes full control over calc registers (especially F)
+				// Thus, two calc-resolvings for one asmCmd would overwrite register F with whichever paramTypeCalc parameters is resolved later
+				// This scenario should however never happen (in theory anyway)
+				panic("ERROR: Two calc parameters found in one meta-assembly instruction, invalid state.")
+			}
+
+			// Resolve calculation to assembly
+			// This will put result in "F"
+			calcAsm := resolveCalc(p.value, cmd.scope, state)
+			output = append(output, calcAsm...)
+
+			p.asmParamType = asmParamTypeRaw
+			p.value = "F" // F is calcOut register
+			processedCalc = true
+		}
+	}
+
+	// Calc found - exit early, recursive resolving will save the day as always
+	if processedCalc {
+		// Special case of SETREG which doesn't accept registers, but could be used to set a "calc literal" to a register
+		if cmd.ins == "SETREG" {
+			cmd.ins = "MOV"
+			cmd.params[0], cmd.params[1] = cmd.params[1], cmd.params[0]
+		}
+
+		return append(output, cmd), true
+	}
+
+	return nil, false
+}
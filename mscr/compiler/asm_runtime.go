@@ -0,0 +1,128 @@
+package compiler
+
+import (
+	"sort"
+	"strings"
+)
+
+// Runtime routines are hand-written asm functions provided by the compiler for operations the ALU cannot perform.
+// They are only appended to the output if a calc expression actually uses them (see requireRuntime).
+//
+// Calling convention (differs from regular MSCR functions, so calls do not need to flush the scope):
+// Operands in F and E, results in F (and E), all other registers except G and SCR1/SCR2 are preserved.
+var runtimeRoutines = map[string]string{
+	// Unsigned division, F = F / E, E = F % E (restoring shift-subtract, 16 iterations)
+	"divu": `
+; MSCR runtime: unsigned division (F = F / E, E = F % E)
+.mscr_runtime_divu __LABEL_SET
+JMPEZ .mscr_runtime_div_by_zero E
+PUSH A
+PUSH B
+PUSH C
+PUSH D
+MOV F A ; Dividend, shifted left into the quotient
+MOV E B ; Divisor
+MOV 0 C ; Remainder
+SETREG D 0x10 ; Bit counter
+.mscr_runtime_divu_loop __LABEL_SET
+SETREG G 0xF
+SHFR C E G ; Remember MSB of remainder, shifting it out means remainder > divisor
+SHFR A G G
+SHFL C C 1
+OR C C G
+SHFL A A 1
+JMPNZ .mscr_runtime_divu_sub E
+SETREG G 0x8000 ; Unsigned compare (flip sign bits for signed GTOE)
+XOR C E G
+XOR B G G
+GTOE E G G
+JMPEZ .mscr_runtime_divu_next G
+.mscr_runtime_divu_sub __LABEL_SET
+SUB C C B
+OR A A 1
+.mscr_runtime_divu_next __LABEL_SET
+DEC D
+JMPNZ .mscr_runtime_divu_loop D
+MOV A F
+MOV C E
+POP D
+POP C
+POP B
+POP A
+RET
+.mscr_runtime_div_by_zero __LABEL_SET
+FAULT ` + FAULT_DIVISION_BY_ZERO + `
+`,
+
+	// Signed division (truncating), F = F / E, E = F % E (remainder has the sign of the dividend)
+	"divs": `
+; MSCR runtime: signed division (F = F / E, E = F % E)
+.mscr_runtime_divs __LABEL_SET
+PUSH A
+PUSH B
+MOV F A
+MOV E B
+SETREG G 0xF
+SHFR F G G
+JMPEZ .mscr_runtime_divs_dividend_pos G
+NEG F F
+.mscr_runtime_divs_dividend_pos __LABEL_SET
+SETREG G 0xF
+SHFR E G G
+JMPEZ .mscr_runtime_divs_divisor_pos G
+NEG E E
+.mscr_runtime_divs_divisor_pos __LABEL_SET
+CALL .mscr_runtime_divu
+SETREG G 0xF
+SHFR A G G
+JMPEZ .mscr_runtime_divs_remainder_pos G
+NEG E E
+.mscr_runtime_divs_remainder_pos __LABEL_SET
+XOR A G B
+SETREG A 0xF
+SHFR G G A
+JMPEZ .mscr_runtime_divs_quotient_pos G
+NEG F F
+.mscr_runtime_divs_quotient_pos __LABEL_SET
+POP B
+POP A
+RET
+`,
+}
+
+// Other runtime routines a routine calls into
+var runtimeDependencies = map[string][]string{
+	"divs": []string{"divu"},
+}
+
+// Marks a runtime routine (and its dependencies) as used and returns its label
+func requireRuntime(name string, state *asmTransformState) string {
+	if _, ok := runtimeRoutines[name]; !ok {
+		panic("ERROR: Unknown runtime routine: " + name)
+	}
+
+	state.runtimeRequired[name] = true
+	for _, dep := range runtimeDependencies[name] {
+		requireRuntime(dep, state)
+	}
+
+	return ".mscr_runtime_" + name
+}
+
+// Generates the asm of all runtime routines used by the program
+func runtimeAsm(state *asmTransformState) string {
+	names := make([]string, 0, len(state.runtimeRequired))
+	for name := range state.runtimeRequired {
+		names = append(names, name)
+	}
+
+	// Deterministic output order
+	sort.Strings(names)
+
+	var retval strings.Builder
+	for _, name := range names {
+		retval.WriteString(runtimeRoutines[name])
+	}
+
+	return retval.String()
+}
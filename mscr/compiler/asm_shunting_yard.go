@@ -1,32 +1,280 @@
 package compiler
 
 import (
-	"log"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
+// YardToken is a single token of a calc expression in reverse polish notation.
+// tokenType is one of OPRND, OPER, FUNCT, FUNARG or SYS (the latter only ever with value INVOKE).
+// A function call is always emitted as FUNCT <name>, FUNARG <argument count>, SYS INVOKE after its arguments.
 type YardToken struct {
 	value     string
 	tokenType string
 }
 
-const shuntSplit = "·"
+// Prefix used for unary operators (e.g. ".-" for negation) to distinguish them from their binary counterparts
+const yardUnaryPrefix = "."
+
+// Operator precedence, higher binds tighter. All binary operators are left-associative.
+var yardPrecedence = map[string]int{
+	"|":  30,
+	"&":  30,
+	"==": 40,
+	"!=": 40,
+	"<":  40,
+	">":  40,
+	"<=": 40,
+	">=": 40,
+	"+":  50,
+	"-":  50,
+	"<<": 50,
+	">>": 50,
+	".-": 60,
+	".~": 60,
+	"*":  70,
+	"/":  70,
+	"%":  70,
+	"^":  80,
+}
+
+type yardLexType int
+
+const (
+	yardLexOperand yardLexType = iota
+	yardLexFunction
+	yardLexOperator
+	yardLexBracketLeft
+	yardLexBracketRight
+	yardLexComma
+)
+
+type yardLexToken struct {
+	lexType yardLexType
+	value   string
+	column  int
+}
+
+// Entry on the operator stack, "argc" is only used for function calls
+type yardStackEntry struct {
+	yardLexToken
+	argc int
+}
+
+// A calc expression that could not be parsed, column is 1-based
+type yardError struct {
+	calc    string
+	column  int
+	message string
+}
+
+func (e *yardError) Error() string {
+	return fmt.Sprintf("Invalid calc expression at column %d: %s\n\t%s\n\t%s^", e.column, e.message, e.calc, strings.Repeat(" ", e.column-1))
+}
+
+func isYardOperandChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '.' || c == '$'
+}
+
+// Splits a calc expression into operands, function names, operators, brackets and commas.
+// An operand directly followed by an opening bracket is a function call, the bracket is consumed with it.
+func lexYardTokens(calc string) ([]yardLexToken, error) {
+	retval := make([]yardLexToken, 0)
+
+	for i := 0; i < len(calc); {
+		c := calc[i]
+		column := i + 1
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\v' || c == '\f':
+			i++
+
+		case isYardOperandChar(c):
+			start := i
+			for i < len(calc) && isYardOperandChar(calc[i]) {
+				i++
+			}
+
+			if i < len(calc) && calc[i] == '(' {
+				retval = append(retval, yardLexToken{yardLexFunction, calc[start:i], column})
+				i++
+			} else {
+				retval = append(retval, yardLexToken{yardLexOperand, calc[start:i], column})
+			}
+
+		case c == '(':
+			retval = append(retval, yardLexToken{yardLexBracketLeft, "(", column})
+			i++
+
+		case c == ')':
+			retval = append(retval, yardLexToken{yardLexBracketRight, ")", column})
+			i++
+
+		case c == ',':
+			retval = append(retval, yardLexToken{yardLexComma, ",", column})
+			i++
+
+		default:
+			if i+1 < len(calc) {
+				if _, ok := yardPrecedence[calc[i:i+2]]; ok {
+					retval = append(retval, yardLexToken{yardLexOperator, calc[i : i+2], column})
+					i += 2
+					continue
+				}
+			}
+
+			if _, ok := yardPrecedence[string(c)]; ok || c == '~' {
+				retval = append(retval, yardLexToken{yardLexOperator, string(c), column})
+				i++
+				continue
+			}
+
+			return nil, &yardError{calc, column, "unexpected character '" + string(c) + "'"}
+		}
+	}
+
+	return retval, nil
+}
+
+// Converts an infix calc expression into reverse polish notation using Dijkstra's shunting yard algorithm,
+// extended to handle function calls and unary operators
+func parseIntoYardTokens(calc string) ([]*YardToken, error) {
+	tokens, err := lexYardTokens(calc)
+	if err != nil {
+		return nil, err
+	}
 
-func parseIntoYardTokens(rawOutput string) []*YardToken {
-	lines := strings.Split(rawOutput, "\n")
 	retval := make([]*YardToken, 0)
+	stack := make([]*yardStackEntry, 0)
+
+	// Whether the next token has to start an operand (i.e. we are at the start, after an operator, bracket or comma)
+	expectOperand := true
+
+	output := func(tokenType, value string) {
+		retval = append(retval, &YardToken{
+			value:     value,
+			tokenType: tokenType,
+		})
+	}
+
+	// Marks the innermost function call as having at least one argument
+	beginOperand := func() {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i].lexType == yardLexFunction {
+				if stack[i].argc == 0 {
+					stack[i].argc = 1
+				}
+				return
+			} else if stack[i].lexType == yardLexBracketLeft {
+				return
+			}
+		}
+	}
+
+	// Pops operators off the stack into the output until a bracket or function call (or a weaker operator) is found
+	popOperators := func(precedence int) {
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.lexType != yardLexOperator || yardPrecedence[top.value] < precedence {
+				return
+			}
+
+			output("OPER", top.value)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	for _, token := range tokens {
+		switch token.lexType {
+		case yardLexOperand:
+			if !expectOperand {
+				return nil, &yardError{calc, token.column, "expected operator, found operand '" + token.value + "'"}
+			}
+
+			beginOperand()
+			output("OPRND", token.value)
+			expectOperand = false
+
+		case yardLexFunction, yardLexBracketLeft:
+			if !expectOperand {
+				return nil, &yardError{calc, token.column, "expected operator, found '" + token.value + "('"}
+			}
+
+			beginOperand()
+			stack = append(stack, &yardStackEntry{yardLexToken: token})
 
-	for _, line := range lines {
-		split := strings.Split(line, shuntSplit)
-		if len(split) == 2 {
-			retval = append(retval, &YardToken{
-				value:     split[1],
-				tokenType: split[0],
-			})
-		} else if len(split) > 2 {
-			log.Println("WARN: Encountered unexpected output from shunting yard parser: " + line)
+		case yardLexOperator:
+			if expectOperand {
+				// Prefix position, operator has to be unary
+				switch token.value {
+				case "+":
+					// Unary plus is a no-op
+				case "-", "~":
+					beginOperand()
+					token.value = yardUnaryPrefix + token.value
+					stack = append(stack, &yardStackEntry{yardLexToken: token})
+				default:
+					return nil, &yardError{calc, token.column, "expected operand, found operator '" + token.value + "'"}
+				}
+
+				continue
+			}
+
+			if token.value == "~" {
+				return nil, &yardError{calc, token.column, "operator '~' is unary, but was used as binary operator"}
+			}
+
+			popOperators(yardPrecedence[token.value])
+			stack = append(stack, &yardStackEntry{yardLexToken: token})
+			expectOperand = true
+
+		case yardLexComma:
+			if expectOperand {
+				return nil, &yardError{calc, token.column, "expected operand, found ','"}
+			}
+
+			popOperators(0)
+			if len(stack) == 0 || stack[len(stack)-1].lexType != yardLexFunction {
+				return nil, &yardError{calc, token.column, "unexpected ',' outside of function call"}
+			}
+
+			stack[len(stack)-1].argc++
+			expectOperand = true
+
+		case yardLexBracketRight:
+			popOperators(0)
+			if len(stack) == 0 {
+				return nil, &yardError{calc, token.column, "unmatched ')'"}
+			}
+
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if top.lexType == yardLexFunction {
+				if expectOperand && top.argc > 0 {
+					return nil, &yardError{calc, token.column, "expected operand, found ')'"}
+				}
+
+				output("FUNCT", top.value)
+				output("FUNARG", strconv.Itoa(top.argc))
+				output("SYS", "INVOKE")
+			} else if expectOperand {
+				return nil, &yardError{calc, token.column, "expected operand, found ')'"}
+			}
+
+			expectOperand = false
 		}
 	}
 
-	return retval
+	if expectOperand {
+		return nil, &yardError{calc, len(calc) + 1, "unexpected end of expression"}
+	}
+
+	popOperators(0)
+	if len(stack) > 0 {
+		return nil, &yardError{calc, stack[len(stack)-1].column, "missing ')'"}
+	}
+
+	return retval, nil
 }
@@ -1,5 +1,7 @@
 package compiler
 
+import "log"
+
 const AssigneableRegisters = 4
 
 // Parameter types for meta-assembly
@@ -33,6 +35,16 @@ type asmCmd struct {
 
 	// For verbose printing
 	originalAsmCmdString string
+
+	// File and line in the MSCR source this instruction was generated from (0 if unknown), for debug info
+	file string
+	line int
+
+	// Registers occupied by variables of the register allocator while this command executes (bit n for register n, see asm_regalloc.go)
+	allocatedRegisters int
+
+	// Set for commands written by the user in _asm blocks, the register allocator does not know which registers they use
+	inlineAsm bool
 }
 
 type asmParam struct {
@@ -44,8 +56,10 @@ type asmParam struct {
 }
 
 type asmTransformState struct {
-	currentFunction           string
-	currentScopeVariableCount int
+	currentFunction string
+
+	// Return type of the current function (nil for void)
+	currentReturnType *asmType
 
 	functionTable []asmFunc
 
@@ -59,12 +73,20 @@ type asmTransformState struct {
 	specificInitializationAsm []*asmCmd
 	binData                   []int16
 
+	// Types of global arrays (by name), their addresses are stored in stringMap, since they behave the same (i.e. as pointers to their data)
+	globalArrayTypes map[string]*asmType
+
+	// Runtime routines used by the program (see asm_runtime.go)
+	runtimeRequired map[string]bool
+
 	scopeRegisterAssignment  map[string]int
 	scopeRegisterDirty       map[int]bool
 	scopeVariableDirectMarks map[string]bool
 
-	printIndent int
-	verbose     bool
+	verbose bool
+
+	// Receives warnings and debug output
+	log *log.Logger
 }
 
 type asmVar struct {
@@ -80,6 +102,10 @@ type asmType struct {
 	builtin bool
 
 	members []asmTypeMember
+
+	// Element type and length for arrays (elem is nil for all other types)
+	elem   *asmType
+	length int
 }
 
 type asmTypeMember struct {
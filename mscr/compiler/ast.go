@@ -3,24 +3,29 @@ package compiler
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 	"reflect"
-	"regexp"
 	"strings"
 	"unicode"
 
 	"github.com/PiMaker/MCPC-Software/constants"
+	"github.com/alecthomas/participle/lexer"
 	"github.com/logrusorgru/aurora"
 )
 
-func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
+func (ast *AST) GenerateASM(bootloader, verbose, dumpIR bool, optimizations Optimizations, logger *log.Logger) string {
+
+	if err := optimizations.Validate(); err != nil {
+		panic("ERROR: " + err.Error())
+	}
 
 	if bootloader {
-		log.Println("! Using bootloader mode !")
+		logger.Println("! Using bootloader mode !")
 	}
 
 	// DEBUG
 	if verbose {
-		log.Println("DEBUG OUTPUT (AST):")
+		logger.Println("DEBUG OUTPUT (AST):")
 		fmt.Println(aurora.Cyan("*AST"))
 		printBody := false
 		walkInterface(ast, func(val reflect.Value, name string, depth int) {
@@ -66,7 +71,7 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 		fmt.Println()
 	}
 
-	log.Println("Validating source...")
+	logger.Println("Validating source...")
 
 	asm := make([]*asmCmd, 0)
 
@@ -119,10 +124,6 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 					panic(fmt.Sprintf("ERROR: Use of undefined type '%s' in function signature (return type of function '%s')", node.Type, node.Name))
 				}
 
-				if ret.size != 1 {
-					panic(fmt.Sprintf("ERROR: Return types with size != 1 are prohibited (type '%s' in function '%s')", node.Type, node.Name))
-				}
-
 				returnType = ret
 			}
 
@@ -139,10 +140,6 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 					panic(fmt.Sprintf("ERROR: Use of undefined type '%s' in function parameter '%s' (function '%s')", p.Type, p.Name, node.Name))
 				}
 
-				if asmType.size != 1 {
-					panic(fmt.Sprintf("ERROR: Parameter types with size != 1 are prohibited (type '%s' in parameter '%s', function '%s')", p.Type, p.Name, node.Name))
-				}
-
 				f.params = append(f.params, asmTypeMember{
 					name:    p.Name,
 					asmType: asmType,
@@ -218,56 +215,38 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 		specificInitializationAsm: make([]*asmCmd, 0),
 		binData:                   make([]int16, 0),
 
+		globalArrayTypes: make(map[string]*asmType, 0),
+		runtimeRequired:  make(map[string]bool, 0),
+
 		verbose: verbose,
+		log:     logger,
 	}
 
-	// Generate Meta-ASM
-	log.Println("Generating Meta-ASM...")
-
-	walkInterface(ast, func(val reflect.Value, name string, depth int) {
-
-		if !(val.Kind() == reflect.Struct || (val.Kind() == reflect.Ptr && val.Elem().Kind() == reflect.Struct)) {
-			// Early out if value instead of node
-			return
-		}
-
-		nodeInterface := val.Interface()
-		newAsm := asmForNodePre(nodeInterface, transformState)
-
-		if len(newAsm) == 0 {
-			return
-		}
-
-		for i := range newAsm {
-			newAsm[i].comment = fmt.Sprintf("%s [%s (in func: %s)]", newAsm[i].comment, name, transformState.currentFunction)
-		}
-
-		asm = append(asm, newAsm...)
-
-	}, func(val reflect.Value, name string, depth int) {
-
-		if !(val.Kind() == reflect.Struct || (val.Kind() == reflect.Ptr && val.Elem().Kind() == reflect.Struct)) {
-			// Early out if value instead of node
-			return
-		}
-
-		nodeInterface := val.Interface()
-		newAsm := asmForNodePost(nodeInterface, transformState)
-
-		if len(newAsm) == 0 {
-			return
+	// Globals are declared first, functions may use them regardless of their position in the source
+	for _, top := range ast.TopExpressions {
+		if top.Global != nil {
+			declareGlobal(top.Global, transformState)
+		} else if top.View != nil {
+			declareGlobal(top.View, transformState)
 		}
+	}
 
-		for i := range newAsm {
-			newAsm[i].comment = fmt.Sprintf("%s [%s (in func: %s)]", newAsm[i].comment, name, transformState.currentFunction)
-		}
+	// Build intermediate representation
+	logger.Println("Building IR...")
+	program := buildIR(ast, transformState)
 
-		// Formatting
-		newAsm[len(newAsm)-1].comment += "\n"
+	if optimizations.enabled("inline") {
+		logger.Println("Inlining functions...")
+		inlineCalls(program, optimizations.InlineThreshold, transformState)
+	}
 
-		asm = append(asm, newAsm...)
+	if dumpIR {
+		fmt.Println(program.String())
+	}
 
-	}, 0)
+	// Generate Meta-ASM
+	logger.Println("Generating Meta-ASM...")
+	asm = append(asm, lowerIR(program, transformState)...)
 
 	// Prepend bootloader init call to userland init if necessary
 	if bootloader {
@@ -305,8 +284,14 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 		a.originalAsmCmdString = a.String()
 	}
 
+	// Keep local variables in registers across their entire lifetime where possible
+	if optimizations.enabled("regalloc") {
+		logger.Println("Allocating registers...")
+		allocateRegisters(asm, transformState)
+	}
+
 	// Generate ASM
-	log.Println("Resolving Meta-ASM...")
+	logger.Println("Resolving Meta-ASM...")
 
 	// Resolve meta-asm
 	initAsm := make([]*asmCmd, 0)
@@ -320,15 +305,11 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 	}
 
 	// Optimize generated asm
-	if optimizeDisable {
-		log.Println("Optimization disabled.")
-	} else {
-		asm = optimizeAsmAll(asm)
-	}
+	asm = optimizeAsmAll(asm, optimizations, logger, verbose)
 
 	// DEBUG
 	if verbose {
-		log.Println("DEBUG OUTPUT (ASM):")
+		logger.Println("DEBUG OUTPUT (ASM):")
 		var prevOrigAsmCmd string
 		for _, a := range asm {
 			if prevOrigAsmCmd != a.originalAsmCmdString && a.originalAsmCmdString != "" {
@@ -341,38 +322,27 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 		}
 	}
 
-	// Print asm to string and check for warnings in compiled code
-	log.Println("Generating output ASM...")
+	// Print asm to string
+	logger.Println("Generating output ASM...")
 	outputAsm := ""
 
-	regexpLabelSetOrMetaCmd := regexp.MustCompile(`^(?:\..+\s+)?__.*$`)
+	annotatedFile := ""
+	annotatedLine := 0
+	for _, a := range asm {
+		// Annotate source lines for debug info (see assembler, ";@mscr" annotations)
+		file := ast.sourceFilePath(a.file)
+		if file != "" && a.line != 0 && (a.line != annotatedLine || file != annotatedFile) && a.asmString() != "" {
+			outputAsm += sourceAnnotation(file, a.line, a.scope) + "\n"
+			annotatedFile = file
+			annotatedLine = a.line
+		}
 
-	prevIns := &asmCmd{
-		ins: "__INTENTIONALLY_INVALID",
-	}
-	for i, a := range asm {
 		outputAsm += a.asmString() + "\n"
+	}
 
-		// Check for no return
-		if a.ins == "FAULT" && a.params[0].value == FAULT_NO_RETURN {
-			lastActualCmd := prevIns
-			prevInsIndex := i - 1
-
-			for regexpLabelSetOrMetaCmd.MatchString(lastActualCmd.ins) {
-				prevInsIndex--
-				if i < 0 {
-					panic("ERROR: No valid output asm before FAULT_NO_RETURN, this program would not be executable")
-				}
-
-				lastActualCmd = asm[prevInsIndex]
-			}
-
-			if lastActualCmd.ins != "RET" {
-				fmt.Printf("WARNING: Non-void function without trailing (default) return (%s @ %s)\n", strings.TrimRight(prevIns.asmString(), "\n"), prevIns.scope)
-			}
-		}
-
-		prevIns = a
+	// Reset annotation, so that code appended to the output is not attributed to the MSCR source
+	if annotatedLine != 0 {
+		outputAsm += sourceAnnotation("", 0, "") + "\n"
 	}
 
 	bootloaderInitialization := ""
@@ -383,7 +353,7 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 	// Create data section
 	dataAsm := ".mscr_data __LABEL_SET\n"
 	for _, d := range transformState.binData {
-		dataAsm += fmt.Sprintf("0x%x\n", uint(d))
+		dataAsm += fmt.Sprintf("0x%x\n", uint16(d))
 	}
 
 	// Combine everything together
@@ -392,6 +362,7 @@ func (ast *AST) GenerateASM(bootloader, verbose, optimizeDisable bool) string {
 		initializationAsm +
 		bootloaderInitialization +
 		outputAsm +
+		runtimeAsm(transformState) +
 		".mscr_code_end HALT" // Trailer (0x0, but includes label for Assembler)
 }
 
@@ -419,6 +390,10 @@ func resolveMetaAsm(asm []*asmCmd, initAsm []*asmCmd, transformState *asmTransfo
 
 		for ir := range resolved {
 			resolved[ir].originalAsmCmdString = asm[i].originalAsmCmdString
+			if resolved[ir].line == 0 {
+				resolved[ir].file = asm[i].file
+				resolved[ir].line = asm[i].line
+			}
 		}
 
 		if len(resolved) == 0 {
@@ -505,6 +480,60 @@ func walkInterface(x interface{}, pre func(reflect.Value, string, int), post fun
 	}
 }
 
+// Returns the source file and line of an AST node, or line 0 if the node has no (valid) position
+func sourcePosition(node interface{}) (string, int) {
+	val := reflect.ValueOf(node)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return "", 0
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return "", 0
+	}
+
+	field := val.FieldByName("Pos")
+	if !field.IsValid() {
+		return "", 0
+	}
+
+	pos, ok := field.Interface().(lexer.Position)
+	if !ok || pos.Filename == "Meta" {
+		return "", 0
+	}
+
+	return pos.Filename, pos.Line
+}
+
+// Absolute path of a source file for debug annotations (the output is usually assembled from a different directory),
+// falls back to the main source file if the file is unknown
+func (ast *AST) sourceFilePath(file string) string {
+	if file == "" {
+		return ast.SourceFile
+	}
+
+	if abs, err := filepath.Abs(file); err == nil {
+		return abs
+	}
+
+	return file
+}
+
+// Formats a source annotation comment for the assembler, line 0 resets the annotation
+func sourceAnnotation(file string, line int, function string) string {
+	if line == 0 {
+		return ";@mscr"
+	}
+
+	if function == "" {
+		return fmt.Sprintf(";@mscr %s:%d", file, line)
+	}
+
+	return fmt.Sprintf(";@mscr %s:%d %s", file, line, function)
+}
+
 func tryAddr(val reflect.Value) reflect.Value {
 	if val.CanAddr() {
 		return val.Addr()
@@ -3,16 +3,13 @@ package compiler
 import (
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"os"
-	"os/exec"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 
-	gppbin "github.com/PiMaker/MCPC-Software/mscr/gppbin"
-
 	"github.com/alecthomas/participle"
 	"github.com/alecthomas/participle/lexer"
 )
@@ -29,42 +26,14 @@ const LexerRegex = `(?s)(\s+)|` +
 	`(?P<RawToken>\S)`
 
 var regexpAutotestHeader = regexp.MustCompile(`(?m)^;autotest\s+(.*?)$`)
+var regexpForLoopHeader = regexp.MustCompile(`\bfor\s*\(`)
+var regexpAsmBlockStart = regexp.MustCompile(`^_asm\s*\{`)
+var regexpArrayAssignmentTarget = regexp.MustCompile(`(?:^|[;{}(])\s*((?:[a-zA-Z_$][a-zA-Z0-9_$]*\.)*[a-zA-Z_$][a-zA-Z0-9_$]*)#\(`)
+var regexpArrayAssignmentOperator = regexp.MustCompile(`^\s*([-+*/%]?)=`)
 
-func Preprocess(inputFile, outputFile string) {
-	cmd := os.TempDir() + string(os.PathSeparator) + "gpp"
-
-	if _, err := exec.LookPath("gpp"); err == nil {
-		log.Println("gpp found in path")
-		cmd = "gpp"
-	} else {
-		log.Println("gpp not in path, using bundled version")
-		err = gppbin.RestoreAsset(os.TempDir()+string(os.PathSeparator), "gpp")
-		if err != nil {
-			panic("ERROR: Could not extract bundled gpp: " + err.Error())
-		}
-	}
-
-	// Mostly for debugging at this point
-	if _, err := os.Stat("./gpp"); err == nil {
-		cmd = "./gpp"
-	}
-	if _, err := os.Stat(".\\gpp.exe"); err == nil {
-		cmd = ".\\gpp.exe"
-	}
-
-	log.Printf("Executing GPP: %s -o %s -C %s\n", cmd, outputFile, inputFile)
-	stdout, err := exec.Command(cmd, "-o", outputFile, "-C", inputFile).CombinedOutput()
-
-	fmt.Print(string(stdout))
-
-	if err != nil {
-		panic(err.Error())
-	}
-}
-
-func GenerateAST(inputFile string) *AST {
+func GenerateAST(source *PreprocessedSource, logger *log.Logger) *AST {
 
-	log.Println("Parsing into AST...")
+	logger.Println("Parsing into AST...")
 
 	ast := &AST{}
 	lexer := lexer.Must(lexer.Regexp(LexerRegex))
@@ -73,21 +42,18 @@ func GenerateAST(inputFile string) *AST {
 		participle.Lexer(lexer),
 		participle.Unquote("String"),
 		participle.UseLookahead(5))
-	fileContentsRaw, err := ioutil.ReadFile(inputFile)
-
-	if err != nil {
-		panic(err.Error())
-	}
+	fileContentsRaw := []byte(source.Text)
 
 	astCommentHeader := make([]string, 0)
 
-	// Check for autotest header
-	autotestMatch := regexpAutotestHeader.FindAllStringSubmatch(string(fileContentsRaw), 1)
-	if autotestMatch != nil && len(autotestMatch) > 0 && len(autotestMatch[0]) > 1 {
-		astCommentHeader = []string{";autotest " + autotestMatch[0][1]}
-		log.Println("Autotest header found: " + astCommentHeader[0])
+	// Check for autotest headers (there may be multiple lines), they are blanked out to keep line numbers intact
+	for _, autotestMatch := range regexpAutotestHeader.FindAllStringSubmatch(string(fileContentsRaw), -1) {
+		astCommentHeader = append(astCommentHeader, ";autotest "+strings.TrimRight(autotestMatch[1], "\r"))
+		logger.Println("Autotest header found: " + astCommentHeader[len(astCommentHeader)-1])
+	}
 
-		fileContentsRaw = fileContentsRaw[strings.Index(string(fileContentsRaw), "\n"):]
+	if len(astCommentHeader) > 0 {
+		fileContentsRaw = regexpAutotestHeader.ReplaceAll(fileContentsRaw, nil)
 	}
 
 	// Strip comments
@@ -96,18 +62,24 @@ func GenerateAST(inputFile string) *AST {
 	// Handle 'character' type as numbers directly
 	fileContents = handleCharacters(fileContents)
 
+	// Array accesses use square brackets as well, convert them before they are mistaken for calc expressions
+	fileContents = rewriteArrayAccesses(fileContents)
+
 	// Automatically enclose possible calc expressions in square brackets
 	// "Bracketless M"
 	fileContents = autoCalcBracket(fileContents)
 
 	//fmt.Println(fileContents)
 
-	err = parser.ParseString(fileContents, ast)
+	err := parser.ParseString(fileContents, ast)
 
 	if err != nil {
-		panic(err.Error())
+		// Report errors at their location in the original source file
+		panic(source.mapError(err))
 	}
 
+	remapPositions(reflect.ValueOf(ast), source)
+
 	if ast == nil || ast.TopExpressions == nil || len(ast.TopExpressions) == 0 {
 		panic("Empty AST parsed. Check your syntax!")
 	}
@@ -120,12 +92,24 @@ func GenerateAST(inputFile string) *AST {
 // This is actually a big clusterfuck, but it *seems* to be working well enough for now
 // TODO: Yeet this function into oblivion
 func autoCalcBracket(input string) string {
+	// Loop headers are handled separately, since their parts are not terminated by ";"
+	input, forLoopHeaders := autoCalcForLoops(input)
+
 	// Note: Function call parameters are converted to a single big calc, including the comma between multiple parameters (if there are any)
-	regex := `(?s)return\s+([^;]*?);|(?:\+\=|\-\=|\*\=|\/\=|\%\=|\=)\s*([^;]+);|if\s+([^{]*){|while\s+([^{]*){|(?:[a-zA-Z_$][a-zA-Z0-9_$]*)\s*\((.*?)\)\s*;|func\s+(?:[a-zA-Z_$][a-zA-Z0-9_$]*)\s+(?:[a-zA-Z_$][a-zA-Z0-9_$]*)|global.*?;`
+	regex := `(?s)return\s+([^;]*?);|(?:\+\=|\-\=|\*\=|\/\=|\%\=|\=)\s*([^;]+);|if\s+([^{]*){|while\s+([^{]*){|(?:[a-zA-Z_$][a-zA-Z0-9_$]*)\s*\((.*?)\)\s*;|func\s+(?:inline\s+)?(?:[a-zA-Z_$][a-zA-Z0-9_$]*)\s+(?:[a-zA-Z_$][a-zA-Z0-9_$]*)|global.*?;`
 	replacer := regexp.MustCompile(regex)
 	regexReplaced := replacer.ReplaceAllStringFunc(input, func(s string) string {
-		// Ignore patterns starting with '"', "global" or "func" (this is our makeshift replacement for lookbehinds)
-		if strings.IndexRune(s, '"') == -1 && strings.Index(s, "global") != 0 && strings.Index(s, "func") != 0 && strings.Index(s, "_reg_assign") != 0 {
+		// Global initializers are constant expressions, evaluated at compile time (see evalConstCalc)
+		if strings.Index(s, "global") == 0 {
+			if eq := strings.Index(s, "="); eq != -1 && strings.IndexRune(s, '"') == -1 {
+				return s[:eq+1] + " [" + strings.TrimSpace(s[eq+1:len(s)-1]) + "];"
+			}
+
+			return s
+		}
+
+		// Ignore patterns starting with '"' or "func" (this is our makeshift replacement for lookbehinds)
+		if strings.IndexRune(s, '"') == -1 && strings.Index(s, "func") != 0 && strings.Index(s, "_reg_assign") != 0 {
 			s = strings.Replace(s, "[", "", -1)
 			s = strings.Replace(s, "]", "", -1)
 			groupText, i := firstNonEmpty(replacer.FindStringSubmatch(s)[1:])
@@ -169,10 +153,253 @@ func autoCalcBracket(input string) string {
 		return s
 	})
 
+	// Put back loop headers
+	for i, header := range forLoopHeaders {
+		regexReplaced = strings.Replace(regexReplaced, forLoopPlaceholder(i), header, 1)
+	}
+
 	// Fix standalone function calls
 	return regexReplaced
 }
 
+// Rewrites "for (init; cond; step)" loop headers to "for (init; [cond]; step;)", with calc brackets applied to
+// init and step as if they were regular statements. The rewritten headers are replaced by placeholders in the returned
+// string, so that autoCalcBracket does not touch them again (put them back in using forLoopPlaceholder).
+func autoCalcForLoops(input string) (string, []string) {
+	headers := make([]string, 0)
+
+	for offset := 0; ; {
+		loc := regexpForLoopHeader.FindStringIndex(input[offset:])
+		if loc == nil {
+			break
+		}
+
+		start := offset + loc[0]
+		innerStart := offset + loc[1]
+
+		// Find closing bracket and top-level semicolons
+		depth := 0
+		end := -1
+		semicolons := make([]int, 0)
+		for i := innerStart; i < len(input) && end == -1; i++ {
+			switch input[i] {
+			case '(':
+				depth++
+			case ')':
+				if depth == 0 {
+					end = i
+				}
+				depth--
+			case ';':
+				if depth == 0 {
+					semicolons = append(semicolons, i)
+				}
+			}
+		}
+
+		if end == -1 || len(semicolons) != 2 {
+			// Invalid header, leave it to the parser to complain
+			offset = innerStart
+			continue
+		}
+
+		statement := func(s string) string {
+			if strings.TrimSpace(s) == "" {
+				return s + ";"
+			}
+
+			return autoCalcBracket(s + ";")
+		}
+
+		condition := input[semicolons[0]+1 : semicolons[1]]
+		if strings.TrimSpace(condition) != "" {
+			condition = "[" + strings.Replace(strings.Replace(condition, "[", "", -1), "]", "", -1) + "]"
+		}
+
+		header := input[start:innerStart] +
+			statement(input[innerStart:semicolons[0]]) +
+			condition + ";" +
+			statement(input[semicolons[1]+1:end]) + ")"
+
+		placeholder := forLoopPlaceholder(len(headers))
+		headers = append(headers, header)
+
+		input = input[:start] + placeholder + input[end+1:]
+		offset = start + len(placeholder)
+	}
+
+	return input, headers
+}
+
+func forLoopPlaceholder(index int) string {
+	return fmt.Sprintf("for __mscr_for_header_%d__", index)
+}
+
+// Rewrites array accesses "name[index]" to "name#(index)", since square brackets are reserved for calc expressions.
+// Assignments to array elements ("name[index].member = value;") are turned into calls to $$ with the element address
+// (marked by a leading '@', e.g. "$$(@name#(index).member, value);"). Array accesses in calcs are resolved in expandArrayAccesses.
+func rewriteArrayAccesses(input string) string {
+	var output strings.Builder
+	arrayBrackets := make([]bool, 0) // Whether the currently open square brackets are array accesses (true) or calcs (false)
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		switch {
+		case c == '"':
+			// Copy strings verbatim
+			end := i + 1
+			for end < len(input) && input[end] != '"' {
+				if input[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(input) {
+				end = len(input) - 1
+			}
+
+			output.WriteString(input[i : end+1])
+			i = end
+
+		case c == '_' && regexpAsmBlockStart.MatchString(input[i:]):
+			// Copy asm blocks verbatim
+			end := strings.IndexRune(input[i:], '}')
+			if end == -1 {
+				end = len(input) - i - 1
+			}
+
+			output.WriteString(input[i : i+end+1])
+			i += end
+
+		case c == '[':
+			wordStart := i
+			for wordStart > 0 && isIdentChar(input[wordStart-1]) {
+				wordStart--
+			}
+
+			word := input[wordStart:i]
+			isArray := word != "" && !(word[0] >= '0' && word[0] <= '9') && word != "return" && word != "if" && word != "while"
+			arrayBrackets = append(arrayBrackets, isArray)
+
+			if isArray {
+				output.WriteString("#(")
+			} else {
+				output.WriteByte(c)
+			}
+
+		case c == ']' && len(arrayBrackets) > 0:
+			if arrayBrackets[len(arrayBrackets)-1] {
+				output.WriteByte(')')
+			} else {
+				output.WriteByte(c)
+			}
+
+			arrayBrackets = arrayBrackets[:len(arrayBrackets)-1]
+
+		default:
+			output.WriteByte(c)
+		}
+	}
+
+	return rewriteArrayAssignments(output.String())
+}
+
+// Turns assignments to array elements into $$ calls, see rewriteArrayAccesses
+func rewriteArrayAssignments(input string) string {
+	var output strings.Builder
+	last := 0
+
+	for _, loc := range regexpArrayAssignmentTarget.FindAllStringSubmatchIndex(input, -1) {
+		targetStart := loc[2]
+		if targetStart < last {
+			continue
+		}
+
+		// Target continues with indices and members, e.g. "name#(i).member#(j)"
+		targetEnd := loc[3]
+		for targetEnd < len(input) {
+			if strings.HasPrefix(input[targetEnd:], "#(") {
+				closing := matchingBracket(input, targetEnd+1)
+				if closing == -1 {
+					break
+				}
+				targetEnd = closing + 1
+			} else if input[targetEnd] == '.' && targetEnd+1 < len(input) && isIdentChar(input[targetEnd+1]) {
+				targetEnd++
+				for targetEnd < len(input) && isIdentChar(input[targetEnd]) {
+					targetEnd++
+				}
+			} else {
+				break
+			}
+		}
+
+		if !strings.Contains(input[targetStart:targetEnd], "#(") {
+			continue
+		}
+
+		operator := regexpArrayAssignmentOperator.FindStringSubmatchIndex(input[targetEnd:])
+		if operator == nil || strings.HasPrefix(input[targetEnd+operator[1]:], "=") {
+			// Not an assignment (e.g. comparison in a loop header)
+			continue
+		}
+
+		// Value ends at the end of the statement (or loop header)
+		valueStart := targetEnd + operator[1]
+		valueEnd := valueStart
+		depth := 0
+		for ; valueEnd < len(input); valueEnd++ {
+			if input[valueEnd] == '(' {
+				depth++
+			} else if input[valueEnd] == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			} else if input[valueEnd] == ';' && depth == 0 {
+				break
+			}
+		}
+
+		target := input[targetStart:targetEnd]
+		value := strings.TrimSpace(input[valueStart:valueEnd])
+		if op := input[targetEnd+operator[2] : targetEnd+operator[3]]; op != "" {
+			value = fmt.Sprintf("%s %s (%s)", target, op, value)
+		}
+
+		output.WriteString(input[last:targetStart])
+		output.WriteString(fmt.Sprintf("$$(@%s, %s)", target, value))
+		last = valueEnd
+	}
+
+	output.WriteString(input[last:])
+	return output.String()
+}
+
+// Returns the index of the bracket closing the one at index "open", or -1 if it is never closed
+func matchingBracket(input string, open int) int {
+	depth := 0
+	for i := open; i < len(input); i++ {
+		switch input[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func isIdentChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '$'
+}
+
 func firstNonEmpty(arr []string) (string, int) {
 	for i, s := range arr {
 		if len(s) > 0 {
@@ -192,7 +419,8 @@ func stripComments(input string) string {
 			return s
 		}
 
-		return " "
+		// Keep line breaks of multi-line comments, so line numbers stay accurate
+		return " " + strings.Repeat("\n", strings.Count(s, "\n"))
 	})
 }
 
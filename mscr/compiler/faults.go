@@ -1,3 +1,4 @@
 package compiler
 
 const FAULT_NO_RETURN = "0x0"
+const FAULT_DIVISION_BY_ZERO = "0x1"
@@ -0,0 +1,1003 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+type irBuilder struct {
+	state    *asmTransformState
+	function *irFunction
+
+	// Block instructions are appended to, nil after a terminator
+	block *irBlock
+
+	// Enclosing loops, innermost last (for break/continue)
+	loops []irLoop
+
+	// Source position of the statement currently being built
+	file string
+	line int
+}
+
+type irLoop struct {
+	continueBlock *irBlock
+	endBlock      *irBlock
+}
+
+// Builds the IR of all functions of the AST. Globals have to be declared beforehand (see declareGlobal), since operands are
+// told apart from local variables by name.
+func buildIR(ast *AST, state *asmTransformState) *irProgram {
+	program := &irProgram{
+		functions: make([]*irFunction, 0),
+	}
+
+	for _, top := range ast.TopExpressions {
+		if top.Function != nil {
+			program.functions = append(program.functions, buildFunctionIR(top.Function, state))
+		}
+	}
+
+	return program
+}
+
+func buildFunctionIR(node *Function, state *asmTransformState) *irFunction {
+	state.currentFunction = node.Name
+	state.currentReturnType = nil
+	if f := findFunc(node.Name, len(node.Parameters), state); f != nil {
+		state.currentReturnType = f.returnType
+	}
+
+	b := &irBuilder{
+		state: state,
+		function: &irFunction{
+			node:       node,
+			label:      getFuncLabel(*node),
+			returnType: state.currentReturnType,
+			blocks:     make([]*irBlock, 0),
+		},
+		loops: make([]irLoop, 0),
+	}
+	b.setPosition(node)
+
+	// Structs are returned by copying them to a slot allocated by the caller, its address is passed as hidden last parameter.
+	// Parameters are declared in the order they are read from the stack (see lowerFunction).
+	if isStructType(state.currentReturnType) {
+		addVariable(returnSlotVariable, "word", state)
+	}
+
+	for i := len(node.Parameters) - 1; i >= 0; i-- {
+		addVariable(node.Parameters[i].Name, node.Parameters[i].Type, state)
+	}
+
+	b.startBlock(b.newBlock(""))
+	b.buildBody(node.Body)
+
+	// Void functions return implicitly at their end, all others fault (see lowerFunction)
+	if state.currentReturnType == nil {
+		b.terminate(&irInstr{op: irOpReturn})
+	} else {
+		b.terminate(&irInstr{op: irOpUnreachable})
+	}
+
+	f := b.function
+	f.buildCFG()
+	f.removeUnreachableBlocks()
+
+	for _, block := range f.blocks {
+		if block.terminator().op == irOpUnreachable {
+			state.log.Printf("WARNING: Non-void function '%s' can reach its end without returning a value. Source: %s\n", node.Name, node.Pos.String())
+		}
+	}
+
+	return f
+}
+
+func (b *irBuilder) setPosition(node interface{}) {
+	if file, line := sourcePosition(node); line > 0 {
+		b.file = file
+		b.line = line
+	}
+}
+
+func (b *irBuilder) newBlock(label string) *irBlock {
+	return &irBlock{
+		label:  label,
+		instrs: make([]*irInstr, 0),
+	}
+}
+
+// Appends a block to the function and continues building there. If the current block has not been terminated yet, it falls through to the new one.
+func (b *irBuilder) startBlock(block *irBlock) {
+	if b.block != nil {
+		b.jump(block)
+	}
+
+	block.id = len(b.function.blocks)
+	b.function.blocks = append(b.function.blocks, block)
+	b.block = block
+}
+
+func (b *irBuilder) emit(instr *irInstr) {
+	// Code following a terminator (e.g. after a return) goes into a block of its own, which is removed later on if unreachable
+	if b.block == nil {
+		b.startBlock(b.newBlock(""))
+	}
+
+	instr.file = b.file
+	instr.line = b.line
+	b.block.instrs = append(b.block.instrs, instr)
+}
+
+func (b *irBuilder) terminate(instr *irInstr) {
+	if b.block == nil {
+		return
+	}
+
+	b.emit(instr)
+	b.block = nil
+}
+
+func (b *irBuilder) jump(target *irBlock) {
+	b.terminate(&irInstr{
+		op:      irOpJump,
+		targets: []*irBlock{target},
+	})
+}
+
+// Branches to then if cond is not zero, to otherwise if it is. Constant conditions are resolved right away.
+func (b *irBuilder) branch(cond *irValue, then, otherwise *irBlock) {
+	if cond.kind == irValueConst {
+		if cond.constant != 0 {
+			b.jump(then)
+		} else {
+			b.jump(otherwise)
+		}
+
+		return
+	}
+
+	b.terminate(&irInstr{
+		op:      irOpBranch,
+		args:    []*irValue{cond},
+		targets: []*irBlock{then, otherwise},
+	})
+}
+
+func (b *irBuilder) newTemp(t *asmType) *irValue {
+	temp := &irValue{
+		kind: irValueTemp,
+		temp: b.function.temps,
+		typ:  t,
+	}
+
+	b.function.temps++
+	return temp
+}
+
+// Copies a value into a new temporary, unless it is one already
+func (b *irBuilder) materialize(v *irValue) *irValue {
+	if v.kind == irValueTemp {
+		return v
+	}
+
+	dst := b.newTemp(v.typ)
+	b.emit(&irInstr{
+		op:   irOpCopy,
+		dst:  dst,
+		args: []*irValue{v},
+	})
+
+	return dst
+}
+
+func (b *irBuilder) buildBody(body []*Expression) {
+	for _, expr := range body {
+		b.buildExpression(expr)
+	}
+}
+
+func (b *irBuilder) buildExpression(expr *Expression) {
+	b.setPosition(expr)
+
+	switch {
+	case expr.Assignment != nil:
+		b.buildAssignment(expr.Assignment)
+	case expr.FunctionCall != nil:
+		b.buildFunctionCall(expr.FunctionCall)
+	case expr.Variable != nil:
+		b.buildVariable(expr.Variable)
+	case expr.Return != nil:
+		b.buildReturn(expr.Return)
+	case expr.Break || expr.Continue:
+		if len(b.loops) == 0 {
+			panic("ERROR: 'break' or 'continue' outside of a loop. Source: " + expr.Pos.String())
+		}
+
+		loop := b.loops[len(b.loops)-1]
+		if expr.Continue {
+			b.jump(loop.continueBlock)
+		} else {
+			b.jump(loop.endBlock)
+		}
+	case expr.WhileLoop != nil:
+		b.buildWhileLoop(expr.WhileLoop)
+	case expr.ForLoop != nil:
+		b.buildForLoop(expr.ForLoop)
+	case expr.IfCondition != nil:
+		b.buildConditional(expr.IfCondition)
+	case expr.Asm != nil:
+		b.emit(&irInstr{
+			op:   irOpAsm,
+			name: *expr.Asm,
+		})
+	}
+}
+
+// Builds the init or step statement of a for loop (a variable declaration, assignment or function call)
+func (b *irBuilder) buildLoopStatement(stmt *LoopStatement) {
+	b.setPosition(stmt)
+
+	switch {
+	case stmt.Assignment != nil:
+		b.buildAssignment(stmt.Assignment)
+	case stmt.FunctionCall != nil:
+		b.buildFunctionCall(stmt.FunctionCall)
+	case stmt.Variable != nil:
+		b.buildVariable(stmt.Variable)
+	}
+}
+
+func (b *irBuilder) buildConditional(cond *Conditional) {
+	then := b.newBlock("")
+	end := b.newBlock(getConditionalLabelEnd(*cond))
+	otherwise := end
+	if len(cond.BodyElse) > 0 {
+		otherwise = b.newBlock(getConditionalLabelElse(*cond))
+	}
+
+	b.branch(b.buildCalc(cond.Condition), then, otherwise)
+
+	b.startBlock(then)
+	b.buildBody(cond.BodyIf)
+	b.jump(end)
+
+	if otherwise != end {
+		b.startBlock(otherwise)
+		b.buildBody(cond.BodyElse)
+		b.jump(end)
+	}
+
+	b.startBlock(end)
+}
+
+func (b *irBuilder) buildWhileLoop(loop *WhileLoop) {
+	header := b.newBlock(getWhileLoopLabelStart(*loop))
+	body := b.newBlock("")
+	end := b.newBlock(getWhileLoopLabelEnd(*loop))
+
+	b.startBlock(header)
+	b.branch(b.buildCalc(loop.Condition), body, end)
+
+	b.startBlock(body)
+	b.loops = append(b.loops, irLoop{
+		continueBlock: header,
+		endBlock:      end,
+	})
+	b.buildBody(loop.Body)
+	b.loops = b.loops[:len(b.loops)-1]
+
+	b.setPosition(loop)
+	b.jump(header)
+
+	b.startBlock(end)
+}
+
+func (b *irBuilder) buildForLoop(loop *ForLoop) {
+	// Init statement runs once, before the loop header
+	if loop.Init != nil {
+		b.buildLoopStatement(loop.Init)
+	}
+
+	header := b.newBlock(getForLoopLabelStart(*loop))
+	body := b.newBlock("")
+	step := b.newBlock(getForLoopLabelContinue(*loop))
+	end := b.newBlock(getForLoopLabelEnd(*loop))
+
+	// No condition means an infinite loop (left via break or return)
+	b.setPosition(loop)
+	b.startBlock(header)
+	if loop.Condition != nil {
+		b.branch(b.buildCalc(*loop.Condition), body, end)
+	}
+
+	b.startBlock(body)
+	b.loops = append(b.loops, irLoop{
+		continueBlock: step,
+		endBlock:      end,
+	})
+	b.buildBody(loop.Body)
+	b.loops = b.loops[:len(b.loops)-1]
+
+	// Step statement is executed after the body and is the target of "continue"
+	b.setPosition(loop)
+	b.startBlock(step)
+	if loop.Step != nil {
+		b.buildLoopStatement(loop.Step)
+	}
+	b.jump(header)
+
+	b.startBlock(end)
+}
+
+func (b *irBuilder) buildVariable(variable *Variable) {
+	state := b.state
+
+	if variable.Length != nil {
+		elemType, ok := state.typeMap[variable.Type]
+		if !ok {
+			panic(fmt.Sprintf("ERROR: Invalid type '%s' given to array '%s' (scope: %s)", variable.Type, variable.Name, state.currentFunction))
+		}
+
+		if variable.Value != nil {
+			panic(fmt.Sprintf("ERROR: Array '%s' cannot be initialized. Source: %s", variable.Name, variable.Pos.String()))
+		}
+
+		// Arrays are stored in the VarHeap like any other variable (elements are not initialized)
+		addVariable(variable.Name, getArrayType(elemType, *variable.Length, state).name, state)
+		return
+	}
+
+	addVariable(variable.Name, variable.Type, state)
+
+	// (Take) Note: Variables without initial assignment are *not* assigned a value!
+	if variable.Value == nil {
+		return
+	}
+
+	if t := state.typeMap[variable.Type]; isStructType(t) {
+		b.buildStructAssignment(variable.Name, t, variable.Value)
+		return
+	}
+
+	b.emit(&irInstr{
+		op: irOpCopy,
+		dst: &irValue{
+			kind: irValueVar,
+			name: variable.Name,
+		},
+		args: []*irValue{b.buildRuntimeValue(variable.Value)},
+	})
+}
+
+func (b *irBuilder) buildAssignment(assignment *Assignment) {
+	if assignment.Value == nil {
+		panic("ERROR: Cannot assign nothing as value")
+	}
+
+	targetType := getAccessorType(assignment.Name, b.state.currentFunction, b.state)
+	if isArrayType(targetType) {
+		panic(fmt.Sprintf("ERROR: Cannot assign to array '%s' of type '%s', assign to its elements instead. Source: %s", assignment.Name, targetType.name, assignment.Pos.String()))
+	}
+
+	if isStructType(targetType) {
+		if assignment.Operator != "=" {
+			panic(fmt.Sprintf("ERROR: Operator '%s' cannot be applied to struct '%s' of type '%s'. Source: %s", assignment.Operator, assignment.Name, targetType.name, assignment.Pos.String()))
+		}
+
+		b.buildStructAssignment(assignment.Name, targetType, assignment.Value)
+		return
+	}
+
+	var value *irValue
+	if assignment.Operator == "=" {
+		value = b.buildRuntimeValue(assignment.Value)
+	} else {
+		value = b.buildCalc(fmt.Sprintf("[%s %s (%s)]", assignment.Name, assignment.Operator[0:1], runtimeValueToCalc(assignment.Value)))
+	}
+
+	b.emit(&irInstr{
+		op: irOpCopy,
+		dst: &irValue{
+			kind: irValueVar,
+			name: assignment.Name,
+		},
+		args: []*irValue{value},
+	})
+}
+
+// Assigns a struct value to the struct variable (or member) dest.
+// Struct values are either other variables of the same type (copied word by word) or calls to functions returning that type.
+func (b *irBuilder) buildStructAssignment(dest string, destType *asmType, value *RuntimeValue) {
+	if variable := runtimeValueVariable(value); variable != nil {
+		srcType := getAccessorType(*variable, b.state.currentFunction, b.state)
+		if srcType != destType {
+			panic(fmt.Sprintf("ERROR: Cannot assign '%s' of type '%s' to '%s' of type '%s'. Source: %s", *variable, typeName(srcType), dest, destType.name, value.Pos.String()))
+		}
+
+		b.emit(&irInstr{
+			op:   irOpStructCopy,
+			name: dest,
+			args: []*irValue{
+				&irValue{
+					kind: irValueVar,
+					name: *variable,
+					typ:  srcType,
+				},
+			},
+		})
+		return
+	}
+
+	if call := runtimeValueFunctionCall(value); call != nil {
+		checkStructReturnType(call, destType, b.state)
+
+		// Callee copies its return value directly into dest
+		b.buildCall(call.FunctionName, call.Parameters, &irValue{
+			kind: irValueAddr,
+			name: dest,
+			typ:  destType,
+		})
+		return
+	}
+
+	panic(fmt.Sprintf("ERROR: Cannot assign a calc expression or literal to '%s' of type '%s'. Source: %s", dest, destType.name, value.Pos.String()))
+}
+
+func (b *irBuilder) buildReturn(value *RuntimeValue) {
+	returnType := b.state.currentReturnType
+	if !isStructType(returnType) {
+		b.terminate(&irInstr{
+			op:   irOpReturn,
+			args: []*irValue{b.buildRuntimeValue(value)},
+		})
+		return
+	}
+
+	// Returned structs are copied to the return slot given by the caller
+	if variable := runtimeValueVariable(value); variable != nil {
+		srcType := getAccessorType(*variable, b.state.currentFunction, b.state)
+		if srcType != returnType {
+			panic(fmt.Sprintf("ERROR: Cannot return '%s' of type '%s' from function '%s' with return type '%s'. Source: %s", *variable, typeName(srcType), b.state.currentFunction, returnType.name, value.Pos.String()))
+		}
+
+		b.terminate(&irInstr{
+			op: irOpReturn,
+			args: []*irValue{
+				&irValue{
+					kind: irValueVar,
+					name: *variable,
+					typ:  srcType,
+				},
+			},
+		})
+		return
+	}
+
+	if call := runtimeValueFunctionCall(value); call != nil {
+		checkStructReturnType(call, returnType, b.state)
+
+		// Pass our own return slot on to the called function
+		b.buildCall(call.FunctionName, call.Parameters, &irValue{
+			kind: irValueVar,
+			name: returnSlotVariable,
+		})
+		b.terminate(&irInstr{op: irOpReturn})
+		return
+	}
+
+	panic(fmt.Sprintf("ERROR: Cannot return a calc expression or literal from function '%s' with return type '%s'. Source: %s", b.state.currentFunction, returnType.name, value.Pos.String()))
+}
+
+// Builds a function call whose return value is ignored. Otherwise function calls are part of calc expressions (see buildCalc).
+func (b *irBuilder) buildFunctionCall(call *FunctionCall) {
+	// Special handling for meta-functions
+	switch call.FunctionName {
+	case "_reg_assign":
+		// _reg_assign forcibly assigns a variable to a register
+		// Useful for _asm blocks
+		if len(call.Parameters) != 2 {
+			panic("ERROR: A call to _reg_assign must have two parameters (register, variable). Source: " + call.Pos.String())
+		}
+
+		regParam := call.Parameters[0]
+		varParam := call.Parameters[1]
+
+		if regParam.Number == nil {
+			panic("ERROR: A call to _reg_assign must have a register number as its first parameter. Source: " + call.Pos.String())
+		}
+
+		if varParam.Variable == nil {
+			panic("ERROR: A call to _reg_assign must have a variable as its second parameter. Source: " + call.Pos.String())
+		}
+
+		b.emit(&irInstr{
+			op:   irOpRegAssign,
+			name: *varParam.Variable,
+			reg:  *regParam.Number,
+		})
+		return
+
+	case "$$":
+		// $$ creates references in calc blocks,
+		// here it sets values to an address
+		// e.g. $$(0xA, 0xB) sets memory location 0xA to value 0xB
+		if len(call.Parameters) != 2 {
+			panic("ERROR: A call to $$ must have two parameters (address, value). Source: " + call.Pos.String())
+		}
+
+		value := b.buildRuntimeValue(call.Parameters[1])
+		address := b.buildRuntimeValue(call.Parameters[0])
+		b.emit(&irInstr{
+			op:   irOpStore,
+			args: []*irValue{address, value},
+		})
+		return
+
+	case "$":
+		// $ dereferences, only valid in calc blocks
+		panic("ERROR: Cannot use special function '$' in non-value context (e.g. calling $ as a void function/standalone. Use calc context [] instead.)")
+	}
+
+	var returnSlot *irValue
+	if f := findFunc(call.FunctionName, len(call.Parameters), b.state); f != nil && isStructType(f.returnType) {
+		// Returned struct is ignored, but the callee still needs somewhere to put it
+		returnSlot = &irValue{
+			kind: irValueAddr,
+			name: addTempVariable(f.returnType, b.state),
+			typ:  f.returnType,
+		}
+	}
+
+	b.buildCall(call.FunctionName, call.Parameters, returnSlot)
+}
+
+// Calls a function, ignoring its return value. For functions returning a struct, returnSlot has to be the address of
+// a struct variable of the return type, which will be filled in by the callee.
+func (b *irBuilder) buildCall(funcName string, parameters []*RuntimeValue, returnSlot *irValue) {
+	// Structs returned by nested function calls are stored in temporary variables and passed on from there
+	parameters = append([]*RuntimeValue{}, parameters...)
+	for i := range parameters {
+		if call := runtimeValueFunctionCall(parameters[i]); call != nil {
+			if f := findFunc(call.FunctionName, len(call.Parameters), b.state); f != nil && isStructType(f.returnType) {
+				temp := addTempVariable(f.returnType, b.state)
+				b.buildCall(call.FunctionName, call.Parameters, &irValue{
+					kind: irValueAddr,
+					name: temp,
+					typ:  f.returnType,
+				})
+
+				parameters[i] = &RuntimeValue{
+					Pos:      parameters[i].Pos,
+					Variable: &temp,
+				}
+			}
+		}
+	}
+
+	// Arguments are passed on the stack, in order. Structs are passed by address, the callee copies them into its own scope.
+	args := make([]*irValue, len(parameters))
+	for i, param := range parameters {
+		if variable := runtimeValueVariable(param); variable != nil {
+			args[i] = b.materialize(b.operandValue(*variable))
+		} else {
+			args[i] = b.materialize(b.buildRuntimeValue(param))
+		}
+	}
+
+	f := findFunc(funcName, len(parameters), b.state)
+	if f != nil {
+		checkCallArguments(f, valueTypes(args), b.state.currentFunction)
+
+		if isStructType(f.returnType) && returnSlot == nil {
+			panic(fmt.Sprintf("ERROR: No return slot given for function '%s' returning struct '%s'. This is a compiler bug, sorry.", funcName, f.returnType.name))
+		}
+	} else {
+		b.state.log.Printf("WARNING: Cannot find function to call: Function '%s' with %d parameters (Assuming extern function)\n", funcName, len(parameters))
+	}
+
+	b.emit(&irInstr{
+		op:         irOpCall,
+		name:       funcName,
+		args:       args,
+		returnSlot: returnSlot,
+	})
+}
+
+func (b *irBuilder) buildRuntimeValue(value *RuntimeValue) *irValue {
+	return b.buildCalc(runtimeValueToCalc(value))
+}
+
+// Builds a calc expression (e.g. "[x + f(y) * 2]"), returning a constant, variable or temporary holding its value.
+// Operators are turned into instructions by evaluating the expression in reverse polish notation (see asm_shunting_yard.go).
+func (b *irBuilder) buildCalc(calc string) *irValue {
+	state := b.state
+	scope := state.currentFunction
+
+	// Remove square brackets, they are just indicators that this is a calc value string
+	calc = strings.Replace(calc, "[", "", -1)
+	calc = strings.Replace(calc, "]", "", -1)
+	calc = strings.Trim(calc, " \t")
+
+	// Turn array accesses into pointer arithmetic
+	calc = expandArrayAccesses(calc, scope, state)
+
+	if calcTypeRegexAsmRegexp.MatchString(calc) {
+		// Assume developer knows what they are doing, the asm block has to fill F
+		// Note: underscore (_) is not used in calc (e.g. "asm", not "_asm"), to not confuse the parser
+		dst := b.newTemp(nil)
+		b.emit(&irInstr{
+			op:   irOpAsm,
+			dst:  dst,
+			name: "_" + calc,
+		})
+		return dst
+	}
+
+	if calcTypeRegexLiteralRegexp.MatchString(calc) {
+		return b.operandValue(calc)
+	}
+
+	if !calcTypeRegexMathRegexp.MatchString(calc) {
+		panic("ERROR: Unsupported calc string: " + calc)
+	}
+
+	shunted, err := parseIntoYardTokens(calc)
+	if err != nil {
+		panic("ERROR: " + err.Error())
+	}
+
+	// Evaluate constant (sub-)expressions at compile time
+	shunted = foldYardTokens(shunted)
+	materialize, references := calcOperandUses(shunted)
+
+	invalid := func() {
+		state.log.Println("ERROR: In calc resolving, RPN attached hereafter:")
+		spew.Dump(shunted)
+		panic("ERROR: Calc expression would produce invalid stack. This is either a compiler bug or an invalid calc-string (e.g. invalid operators or function calls). (calc: " + calc + ")")
+	}
+
+	// Values of the operands evaluated so far. Structs are represented by their address and may only be used as function arguments.
+	stack := make([]*irValue, 0)
+	pop := func(n int) []*irValue {
+		if n > len(stack) {
+			invalid()
+		}
+
+		popped := append([]*irValue{}, stack[len(stack)-n:]...)
+		stack = stack[:len(stack)-n]
+		return popped
+	}
+	requireWords := func(context string, values []*irValue) {
+		for _, v := range values {
+			if isStructType(v.typ) {
+				panic(fmt.Sprintf("ERROR: Cannot use struct of type '%s' in %s. Structs can only be passed to functions expecting them. (calc: %s, scope: %s)", v.typ.name, context, calc, scope))
+			}
+		}
+	}
+
+	var funcFunct string
+	var funcFunarg int
+	for i, token := range shunted {
+		switch token.tokenType {
+		case "FUNCT":
+			funcFunct = token.value
+		case "FUNARG":
+			funcFunarg, _ = strconv.Atoi(token.value)
+
+		case "SYS":
+			// Check for $$ invocation mistakes
+			if funcFunct == "$$" && (i < 3 || shunted[i-3].tokenType != "OPRND" || calcTypeRegexLiteralRegexp.MatchString(shunted[i-3].value)) {
+				panic("ERROR: Tried calling special function $$ on anything else than a variable name (Note: $$ does not support nesting or addressing literals)")
+			}
+
+			args := pop(funcFunarg)
+			switch funcFunct {
+			case "$":
+				if len(args) != 1 {
+					panic("ERROR: Special function $ requires exactly 1 argument, " + strconv.Itoa(len(args)) + " given")
+				}
+
+				requireWords("special function "+funcFunct, args)
+				stack = append(stack, b.unary(irOpLoad, "", args[0]))
+
+			case "$$":
+				if len(args) != 1 {
+					panic("ERROR: Special function $$ requires exactly 1 argument, " + strconv.Itoa(len(args)) + " given")
+				}
+
+				stack = append(stack, b.addressOf(args[0].name))
+
+			case "sdiv", "smod":
+				// Signed division/modulo (operators / and % are unsigned)
+				if len(args) != 2 {
+					panic("ERROR: Special function " + funcFunct + " requires exactly 2 arguments, " + strconv.Itoa(len(args)) + " given")
+				}
+
+				requireWords("special function "+funcFunct, args)
+				stack = append(stack, b.divide(true, funcFunct == "smod", args[0], args[1]))
+
+			default:
+				f := findFunc(funcFunct, len(args), state)
+				if f != nil {
+					checkCallArguments(f, valueTypes(args), scope)
+
+					if isStructType(f.returnType) {
+						panic(fmt.Sprintf("ERROR: Function '%s' returns struct '%s', which can only be assigned to a variable directly (calc: %s, scope: %s)", funcFunct, f.returnType.name, calc, scope))
+					}
+
+					if f.returnType == nil {
+						panic(fmt.Sprintf("ERROR: Tried calling a void function in a calc context: Function '%s' with %d parameters\n", funcFunct, len(args)))
+					}
+				} else {
+					state.log.Printf("WARNING: Cannot find function to call (calc): Function '%s' with %d parameters (Assuming extern function)\n", funcFunct, len(args))
+				}
+
+				dst := b.newTemp(nil)
+				b.emit(&irInstr{
+					op:   irOpCall,
+					dst:  dst,
+					name: funcFunct,
+					args: args,
+				})
+				stack = append(stack, dst)
+			}
+
+		case "OPRND":
+			if references[i] {
+				// Operand of $$, only its name is used
+				stack = append(stack, &irValue{
+					kind: irValueVar,
+					name: token.value,
+				})
+				break
+			}
+
+			value := b.operandValue(token.value)
+			if materialize[i] {
+				value = b.materialize(value)
+			}
+			stack = append(stack, value)
+
+		case "OPER":
+			switch token.value {
+			case "+", "*", "-", "&", "|", "^", "==", "<", ">", "<=", ">=", "!=", ">>", "<<":
+				args := pop(2)
+				requireWords("operator "+token.value, args)
+				stack = append(stack, b.binary(symbolToALUFuncName(token.value), args[0], args[1]))
+
+			case "/", "%":
+				// No hardware divider, unsigned division is performed by a runtime routine
+				args := pop(2)
+				requireWords("operator "+token.value, args)
+				stack = append(stack, b.divide(false, token.value == "%", args[0], args[1]))
+
+			case ".-", ".~", "~":
+				args := pop(1)
+				requireWords("operator "+token.value, args)
+
+				alu := "COM"
+				if token.value == ".-" {
+					alu = "NEG"
+				}
+				stack = append(stack, b.unary(irOpUnary, alu, args[0]))
+
+			default:
+				panic("ERROR: Unsupported tokenType returned from shunting yard parser. This calc feature is probably not implemented yet. (" + token.tokenType + " = " + token.value + ")")
+			}
+		}
+	}
+
+	if len(stack) != 1 {
+		invalid()
+	}
+
+	requireWords("a word context", stack)
+	return stack[0]
+}
+
+// Determines which operands of a calc expression (in RPN) have to be copied into temporaries right away: arguments of
+// function calls (since they are passed on the stack) and variables read before a function call (which might modify them).
+// Operands of $$ are references instead, only their name is used.
+func calcOperandUses(shunted []*YardToken) (materialize []bool, references []bool) {
+	materialize = make([]bool, len(shunted))
+	references = make([]bool, len(shunted))
+
+	// Token indices of the operands on the stack, -1 for computed values
+	stack := make([]int, 0)
+	pop := func(n int) []int {
+		if n > len(stack) {
+			n = len(stack)
+		}
+
+		popped := stack[len(stack)-n:]
+		stack = stack[:len(stack)-n]
+		return popped
+	}
+
+	var funcFunct string
+	var funcFunarg int
+	for i, token := range shunted {
+		switch token.tokenType {
+		case "FUNCT":
+			funcFunct = token.value
+		case "FUNARG":
+			funcFunarg, _ = strconv.Atoi(token.value)
+
+		case "SYS":
+			args := pop(funcFunarg)
+			switch funcFunct {
+			case "$$":
+				for _, a := range args {
+					if a >= 0 {
+						references[a] = true
+					}
+				}
+			case "$", "sdiv", "smod":
+			default:
+				for _, a := range args {
+					if a >= 0 {
+						materialize[a] = true
+					}
+				}
+
+				for _, a := range stack {
+					if a >= 0 && !calcTypeRegexLiteralRegexp.MatchString(shunted[a].value) {
+						materialize[a] = true
+					}
+				}
+			}
+			stack = append(stack, -1)
+
+		case "OPRND":
+			stack = append(stack, i)
+
+		case "OPER":
+			if token.value == ".-" || token.value == ".~" || token.value == "~" {
+				pop(1)
+			} else {
+				pop(2)
+			}
+			stack = append(stack, -1)
+		}
+	}
+
+	return materialize, references
+}
+
+// Returns the value of a calc operand: Literals are constants, strings and global arrays yield the address of their data,
+// struct and array variables are represented by their address (arrays decay to the address of their first element).
+// Anything else is a variable (or global).
+func (b *irBuilder) operandValue(name string) *irValue {
+	if calcTypeRegexLiteralRegexp.MatchString(name) {
+		value, _ := parseCalcLiteral(name)
+		return &irValue{
+			kind:     irValueConst,
+			constant: value,
+		}
+	}
+
+	if addr, ok := b.state.stringMap["global_"+name]; ok {
+		return &irValue{
+			kind:     irValueConst,
+			constant: uint16(addr),
+			name:     name,
+		}
+	}
+
+	if _, ok := b.state.globalMemoryMap["global_"+name]; !ok {
+		if t := getAccessorType(name, b.state.currentFunction, b.state); isStructType(t) || isArrayType(t) {
+			if isArrayType(t) {
+				t = nil
+			}
+
+			dst := b.newTemp(t)
+			b.emit(&irInstr{
+				op:  irOpCopy,
+				dst: dst,
+				args: []*irValue{
+					&irValue{
+						kind: irValueAddr,
+						name: name,
+					},
+				},
+			})
+			return dst
+		}
+	}
+
+	return &irValue{
+		kind: irValueVar,
+		name: name,
+	}
+}
+
+// Returns a temporary holding the address of a variable (special function $$)
+func (b *irBuilder) addressOf(name string) *irValue {
+	if _, ok := b.state.stringMap["global_"+name]; ok {
+		panic("ERROR: A 'string' global is already a pointer. Please first check out the string into a variable before creating a pointer-pointer.")
+	}
+
+	// Globals are always directly-addressed
+	if addr, ok := b.state.globalMemoryMap["global_"+name]; ok {
+		return b.materialize(&irValue{
+			kind:     irValueConst,
+			constant: uint16(addr),
+			name:     name,
+		})
+	}
+
+	// Mark value as directly-addressed since we never know when someone is going to dereference this pointer
+	b.emit(&irInstr{
+		op:   irOpSetDirect,
+		name: name,
+	})
+
+	dst := b.newTemp(nil)
+	b.emit(&irInstr{
+		op:  irOpCopy,
+		dst: dst,
+		args: []*irValue{
+			&irValue{
+				kind: irValueAddr,
+				name: name,
+			},
+		},
+	})
+
+	return dst
+}
+
+func (b *irBuilder) binary(alu string, x, y *irValue) *irValue {
+	dst := b.newTemp(nil)
+	b.emit(&irInstr{
+		op:   irOpBinary,
+		dst:  dst,
+		args: []*irValue{x, y},
+		alu:  alu,
+	})
+
+	return dst
+}
+
+func (b *irBuilder) unary(op irOp, alu string, x *irValue) *irValue {
+	dst := b.newTemp(nil)
+	b.emit(&irInstr{
+		op:   op,
+		dst:  dst,
+		args: []*irValue{x},
+		alu:  alu,
+	})
+
+	return dst
+}
+
+func (b *irBuilder) divide(signed, modulo bool, x, y *irValue) *irValue {
+	routine := "divu"
+	if signed {
+		routine = "divs"
+	}
+
+	dst := b.newTemp(nil)
+	b.emit(&irInstr{
+		op:     irOpDivide,
+		dst:    dst,
+		args:   []*irValue{x, y},
+		alu:    requireRuntime(routine, b.state),
+		modulo: modulo,
+	})
+
+	return dst
+}
+
+func valueTypes(values []*irValue) []*asmType {
+	retval := make([]*asmType, len(values))
+	for i, v := range values {
+		retval[i] = v.typ
+	}
+
+	return retval
+}
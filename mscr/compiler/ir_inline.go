@@ -0,0 +1,523 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Inlining of function calls on the IR
+//
+// The blocks of the callee are copied into the caller in place of the call, with all variables of the callee renamed to new
+// variables of the caller ("mscr_inline_<n>_<name>"). Parameters are bound to the arguments: Constants and local variables of
+// the caller (unless their address is taken) are used directly wherever the parameter is read, as long as the callee never
+// assigns to it, all other arguments are copied into the parameter. Returns store the return value and continue behind the
+// inlined code, where the result of the call is read from it (or from F, if the callee consists of a single block).
+//
+// Functions declared 'func inline' are always inlined, all others only if they consist of at most Optimizations.InlineThreshold
+// IR instructions. Recursive calls are never inlined, neither are functions using _asm blocks or _reg_assign, functions taking
+// or returning structs and functions that might end without returning a value.
+
+type irInliner struct {
+	state     *asmTransformState
+	threshold int
+
+	// Functions by label
+	functions map[string]*irFunction
+
+	// Functions whose calls have been inlined already, and those currently being processed (i.e. called recursively)
+	done       map[*irFunction]bool
+	processing map[*irFunction]bool
+
+	// Number of calls inlined so far, for unique variable names
+	sites int
+}
+
+// A single call being inlined
+type irInlineSite struct {
+	caller *irFunction
+	callee *irFunction
+	call   *irInstr
+
+	// Prefix of the callee's variables in the caller
+	prefix string
+
+	// Replacements for values of the callee: temporaries by new temporaries of the caller, parameters by their arguments
+	temps  map[int]*irValue
+	params map[string]*irValue
+
+	// Copies of the callee's blocks
+	blocks map[*irBlock]*irBlock
+}
+
+func inlineCalls(program *irProgram, threshold int, state *asmTransformState) {
+	in := &irInliner{
+		state:      state,
+		threshold:  threshold,
+		functions:  make(map[string]*irFunction),
+		done:       make(map[*irFunction]bool),
+		processing: make(map[*irFunction]bool),
+	}
+
+	for _, f := range program.functions {
+		in.functions[f.label] = f
+	}
+
+	for _, f := range program.functions {
+		in.process(f)
+	}
+
+	state.currentFunction = ""
+}
+
+// Inlines calls in f, after processing all of its callees first. Inlined code thus never contains calls that could be inlined.
+func (in *irInliner) process(f *irFunction) {
+	if in.done[f] || in.processing[f] {
+		return
+	}
+
+	in.processing[f] = true
+	for _, b := range f.blocks {
+		for _, instr := range b.instrs {
+			if callee := in.callee(instr); callee != nil {
+				in.process(callee)
+			}
+		}
+	}
+
+	// Variables of inlined functions are added to the scope of the caller
+	in.state.currentFunction = f.node.Name
+
+	inlined := false
+	for i := 0; i < len(f.blocks); i++ {
+		b := f.blocks[i]
+		for j := 0; j < len(b.instrs); j++ {
+			callee := in.callee(b.instrs[j])
+			if callee == nil || !in.shouldInline(callee) {
+				continue
+			}
+
+			inlined = true
+			if len(callee.blocks) == 1 {
+				// Continue behind the inlined instructions
+				j = in.inlineInstrs(f, b, j, callee) - 1
+			} else {
+				// Continue with the block following the inlined ones, which holds the rest of this block
+				i = in.inlineBlocks(f, i, j, callee) - 1
+				break
+			}
+		}
+	}
+
+	if inlined {
+		for i, b := range f.blocks {
+			b.id = i
+		}
+		f.buildCFG()
+	}
+
+	delete(in.processing, f)
+	in.done[f] = true
+}
+
+// Returns the function called by instr, nil if it is not a call or the function is not defined (i.e. extern)
+func (in *irInliner) callee(instr *irInstr) *irFunction {
+	if instr.op != irOpCall {
+		return nil
+	}
+
+	return in.functions[getFuncLabelSpecific(instr.name, len(instr.args))]
+}
+
+func (in *irInliner) shouldInline(callee *irFunction) bool {
+	// Not done yet means the call is recursive
+	if !in.done[callee] || !callee.inlinable(in.state) {
+		return false
+	}
+
+	return callee.node.Inline || callee.size() <= in.threshold
+}
+
+func (f *irFunction) inlinable(state *asmTransformState) bool {
+	if isStructType(f.returnType) {
+		return false
+	}
+
+	for _, p := range f.node.Parameters {
+		if isStructType(state.typeMap[p.Type]) {
+			return false
+		}
+	}
+
+	for _, b := range f.blocks {
+		for _, instr := range b.instrs {
+			switch instr.op {
+			case irOpAsm, irOpRegAssign, irOpUnreachable:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Number of instructions of a function (for the inlining threshold)
+func (f *irFunction) size() int {
+	size := 0
+	for _, b := range f.blocks {
+		size += len(b.instrs)
+	}
+
+	return size
+}
+
+// Returns the variables of a function that are assigned to and those whose address is taken (only the variable, not the member accessed)
+func (f *irFunction) variableAccesses() (assigned map[string]bool, addressed map[string]bool) {
+	assigned = make(map[string]bool)
+	addressed = make(map[string]bool)
+	for _, b := range f.blocks {
+		for _, instr := range b.instrs {
+			if instr.dst != nil && instr.dst.kind == irValueVar {
+				assigned[variableRoot(instr.dst.name)] = true
+			}
+
+			switch instr.op {
+			case irOpStructCopy:
+				assigned[variableRoot(instr.name)] = true
+			case irOpSetDirect:
+				addressed[variableRoot(instr.name)] = true
+			}
+
+			for _, a := range instr.args {
+				if a.kind == irValueAddr {
+					addressed[variableRoot(a.name)] = true
+				}
+			}
+
+			if instr.returnSlot != nil {
+				addressed[variableRoot(instr.returnSlot.name)] = true
+			}
+		}
+	}
+
+	return assigned, addressed
+}
+
+// Returns the variable of a struct member access chain, e.g. "pos" for "pos.x"
+func variableRoot(name string) string {
+	return strings.SplitN(name, ".", 2)[0]
+}
+
+func (in *irInliner) newSite(caller *irFunction, call *irInstr, callee *irFunction) *irInlineSite {
+	s := &irInlineSite{
+		caller: caller,
+		callee: callee,
+		call:   call,
+		prefix: fmt.Sprintf("mscr_inline_%d_", in.sites),
+		temps:  make(map[int]*irValue),
+		params: make(map[string]*irValue),
+		blocks: make(map[*irBlock]*irBlock),
+	}
+	in.sites++
+
+	for _, v := range in.state.variableMap[callee.node.Name] {
+		addVariable(s.prefix+v.name, v.asmType.name, in.state)
+	}
+
+	return s
+}
+
+// Binds the parameters of the callee to the arguments of the call, given the instructions preceding it. Returns the preceding
+// instructions without those computing arguments that are used directly, followed by copies of all other arguments.
+func (in *irInliner) bindParameters(s *irInlineSite, instrs []*irInstr) []*irInstr {
+	calleeAssigned, calleeAddressed := s.callee.variableAccesses()
+	_, callerAddressed := s.caller.variableAccesses()
+
+	direct := make(map[*irInstr]bool)
+	copies := make([]*irInstr, 0)
+	for i, arg := range s.call.args {
+		name := s.callee.node.Parameters[i].Name
+
+		// Arguments are temporaries, look up the instruction computing them
+		var def *irInstr
+		for _, instr := range instrs {
+			if instr.dst == arg {
+				def = instr
+			}
+		}
+
+		// Only the callee runs until the parameter is read, which cannot modify local variables of the caller without their address
+		if def != nil && def.op == irOpCopy && !calleeAssigned[name] && !calleeAddressed[name] {
+			src := def.args[0]
+			if src.kind == irValueConst || (src.kind == irValueVar && in.isLocal(s.caller, src.name) && !callerAddressed[variableRoot(src.name)]) {
+				s.params[name] = src
+				direct[def] = true
+				continue
+			}
+		}
+
+		copies = append(copies, &irInstr{
+			op:   irOpCopy,
+			dst:  &irValue{kind: irValueVar, name: s.prefix + name, typ: arg.typ},
+			args: []*irValue{arg},
+			file: s.call.file,
+			line: s.call.line,
+		})
+	}
+
+	retval := make([]*irInstr, 0, len(instrs)+len(copies))
+	for _, instr := range instrs {
+		if !direct[instr] {
+			retval = append(retval, instr)
+		}
+	}
+
+	// Temporaries are used in reverse order
+	for i := len(copies) - 1; i >= 0; i-- {
+		retval = append(retval, copies[i])
+	}
+
+	return retval
+}
+
+// Checks whether the root of a variable access is a local variable of f (and not a global)
+func (in *irInliner) isLocal(f *irFunction, name string) bool {
+	root := variableRoot(name)
+	for _, v := range in.state.variableMap[f.node.Name] {
+		if v.name == root {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Inlines a callee consisting of a single block in place of the call at b.instrs[index], returns the index of the first instruction following the inlined ones
+func (in *irInliner) inlineInstrs(f *irFunction, b *irBlock, index int, callee *irFunction) int {
+	call := b.instrs[index]
+	s := in.newSite(f, call, callee)
+	after := b.instrs[index+1:]
+
+	instrs := in.bindParameters(s, b.instrs[:index])
+
+	body := callee.blocks[0].instrs
+	ret := body[len(body)-1]
+
+	// A returned temporary directly becomes the result of the call
+	if len(ret.args) > 0 && ret.args[0].kind == irValueTemp && call.dst != nil {
+		s.temps[ret.args[0].temp] = call.dst
+	}
+
+	for _, instr := range body[:len(body)-1] {
+		instrs = append(instrs, s.instr(in, instr))
+	}
+
+	if len(ret.args) > 0 {
+		value := s.value(in, ret.args[0])
+		if call.dst != nil && value != call.dst {
+			instrs = append(instrs, s.returnCopy(call.dst, value, ret))
+		} else if call.dst == nil && value.kind == irValueTemp {
+			// Ignored return value still has to be taken off the stack
+			instrs = append(instrs, s.returnCopy(in.returnVariable(callee), value, ret))
+		}
+	}
+
+	next := len(instrs)
+	b.instrs = append(instrs, after...)
+	return next
+}
+
+// Inlines a callee consisting of multiple blocks in place of the call at f.blocks[blockIndex].instrs[index]: The block is
+// split behind the call, the copied blocks of the callee are inserted in between. Returns the index of the second half of the block.
+func (in *irInliner) inlineBlocks(f *irFunction, blockIndex, index int, callee *irFunction) int {
+	b := f.blocks[blockIndex]
+	call := b.instrs[index]
+	s := in.newSite(f, call, callee)
+	after := b.instrs[index+1:]
+
+	// Temporaries cannot be used across blocks, so those computed before the call and used after it are stored in variables
+	usedAfter := make(map[*irValue]bool)
+	for _, instr := range after {
+		for _, a := range instr.args {
+			usedAfter[a] = true
+		}
+	}
+
+	before := make([]*irInstr, 0, index)
+	reloads := make([]*irInstr, 0)
+	for _, instr := range b.instrs[:index] {
+		before = append(before, instr)
+		if instr.dst == nil || instr.dst.kind != irValueTemp || !usedAfter[instr.dst] {
+			continue
+		}
+
+		temp := instr.dst
+		spilled := &irValue{
+			kind: irValueVar,
+			name: addTempVariable(in.state.typeMap["word"], in.state),
+		}
+
+		instr.dst = s.newTemp(temp.typ)
+		before = append(before, s.copy(spilled, instr.dst))
+		reloads = append(reloads, s.copy(temp, spilled))
+	}
+
+	instrs := in.bindParameters(s, before)
+
+	var result *irValue
+	if callee.returnType != nil {
+		result = in.returnVariable(callee)
+	}
+
+	cont := &irBlock{
+		instrs: reloads,
+	}
+	if call.dst != nil {
+		cont.instrs = append(cont.instrs, s.copy(call.dst, result))
+	}
+	cont.instrs = append(cont.instrs, after...)
+
+	for _, block := range callee.blocks {
+		s.blocks[block] = &irBlock{
+			instrs: make([]*irInstr, 0, len(block.instrs)),
+		}
+	}
+
+	for _, block := range callee.blocks {
+		clone := s.blocks[block]
+		for _, instr := range block.instrs {
+			if instr.op != irOpReturn {
+				clone.instrs = append(clone.instrs, s.instr(in, instr))
+				continue
+			}
+
+			if len(instr.args) > 0 {
+				clone.instrs = append(clone.instrs, s.returnCopy(result, s.value(in, instr.args[0]), instr))
+			}
+			clone.instrs = append(clone.instrs, s.jump(cont, instr))
+		}
+	}
+
+	b.instrs = append(instrs, s.jump(s.blocks[callee.blocks[0]], call))
+
+	blocks := make([]*irBlock, 0, len(f.blocks)+len(callee.blocks)+1)
+	blocks = append(blocks, f.blocks[:blockIndex+1]...)
+	for _, block := range callee.blocks {
+		blocks = append(blocks, s.blocks[block])
+	}
+	blocks = append(blocks, cont)
+	blocks = append(blocks, f.blocks[blockIndex+1:]...)
+	f.blocks = blocks
+
+	return blockIndex + len(callee.blocks) + 1
+}
+
+// Adds a variable for the return value of an inlined call to the caller
+func (in *irInliner) returnVariable(callee *irFunction) *irValue {
+	return &irValue{
+		kind: irValueVar,
+		name: addTempVariable(callee.returnType, in.state),
+		typ:  callee.returnType,
+	}
+}
+
+// Copies an instruction of the callee into the caller
+func (s *irInlineSite) instr(in *irInliner, instr *irInstr) *irInstr {
+	clone := *instr
+
+	clone.args = make([]*irValue, len(instr.args))
+	for i, a := range instr.args {
+		clone.args[i] = s.value(in, a)
+	}
+
+	if instr.dst != nil {
+		clone.dst = s.value(in, instr.dst)
+	}
+
+	if instr.returnSlot != nil {
+		clone.returnSlot = s.value(in, instr.returnSlot)
+	}
+
+	switch instr.op {
+	case irOpStructCopy, irOpSetDirect:
+		clone.name = s.variable(in, instr.name)
+	}
+
+	clone.targets = make([]*irBlock, len(instr.targets))
+	for i, t := range instr.targets {
+		clone.targets[i] = s.blocks[t]
+	}
+
+	return &clone
+}
+
+// Returns the value in the caller corresponding to a value of the callee
+func (s *irInlineSite) value(in *irInliner, v *irValue) *irValue {
+	switch v.kind {
+	case irValueTemp:
+		if temp, ok := s.temps[v.temp]; ok {
+			return temp
+		}
+
+		temp := s.newTemp(v.typ)
+		s.temps[v.temp] = temp
+		return temp
+
+	case irValueVar, irValueAddr:
+		if arg, ok := s.params[v.name]; ok && v.kind == irValueVar {
+			return arg
+		}
+
+		clone := *v
+		clone.name = s.variable(in, v.name)
+		return &clone
+	}
+
+	return v
+}
+
+// Renames a variable of the callee, globals keep their name
+func (s *irInlineSite) variable(in *irInliner, name string) string {
+	if !in.isLocal(s.callee, name) {
+		return name
+	}
+
+	return s.prefix + name
+}
+
+func (s *irInlineSite) newTemp(t *asmType) *irValue {
+	temp := &irValue{
+		kind: irValueTemp,
+		temp: s.caller.temps,
+		typ:  t,
+	}
+
+	s.caller.temps++
+	return temp
+}
+
+func (s *irInlineSite) copy(dst, src *irValue) *irInstr {
+	return &irInstr{
+		op:   irOpCopy,
+		dst:  dst,
+		args: []*irValue{src},
+		file: s.call.file,
+		line: s.call.line,
+	}
+}
+
+// Stores the return value of the callee, the instruction is attributed to the return statement
+func (s *irInlineSite) returnCopy(dst, value *irValue, ret *irInstr) *irInstr {
+	instr := s.copy(dst, value)
+	instr.file = ret.file
+	instr.line = ret.line
+	return instr
+}
+
+func (s *irInlineSite) jump(target *irBlock, source *irInstr) *irInstr {
+	return &irInstr{
+		op:      irOpJump,
+		targets: []*irBlock{target},
+		file:    source.file,
+		line:    source.line,
+	}
+}
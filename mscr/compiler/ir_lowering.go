@@ -0,0 +1,660 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lowering of the IR to meta-asm
+//
+// Temporaries are kept on the calc stack: A temporary is computed into F, where it stays if the next instruction uses it,
+// otherwise it is pushed. Copies of variables and constants are not even computed until then, since most instructions can
+// use them as parameters directly. Variables are left to the resolver (see asm_resolver.go), which checks them out on demand.
+//
+// Every block jumped to explicitly starts with a label, followed by __CLEARSCOPE, so the scope is flushed before every jump.
+// Blocks only reached by falling through from the previous one simply continue with its scope.
+
+type irLowering struct {
+	state    *asmTransformState
+	function *irFunction
+	output   []*asmCmd
+
+	// Blocks that are jumped to explicitly and thus need a label
+	labeled map[*irBlock]bool
+
+	// Temporaries pushed onto the stack, top last
+	stack []int
+
+	// Most recently defined temporary, if it has not been pushed yet
+	held *irHeld
+
+	// Description of the commands currently being generated (for comments)
+	context string
+	file    string
+	line    int
+}
+
+type irHeld struct {
+	temp int
+
+	// Variable or constant the temporary is a copy of, nil if its value has been computed into F
+	value *irValue
+}
+
+func lowerIR(program *irProgram, state *asmTransformState) []*asmCmd {
+	retval := make([]*asmCmd, 0)
+	for _, f := range program.functions {
+		retval = append(retval, lowerFunction(f, state)...)
+	}
+
+	state.currentFunction = ""
+	return retval
+}
+
+func lowerFunction(f *irFunction, state *asmTransformState) []*asmCmd {
+	state.currentFunction = f.node.Name
+	state.currentReturnType = f.returnType
+
+	l := &irLowering{
+		state:    state,
+		function: f,
+		output:   make([]*asmCmd, 0),
+		labeled:  make(map[*irBlock]bool),
+		stack:    make([]int, 0),
+		context:  "function " + f.node.Name,
+	}
+	l.file, l.line = sourcePosition(f.node)
+
+	// Jumps to the following block fall through, branches only need a single jump if one of their targets follows
+	for i, b := range f.blocks {
+		var next *irBlock
+		if i+1 < len(f.blocks) {
+			next = f.blocks[i+1]
+		}
+
+		term := b.terminator()
+		switch {
+		case term.op == irOpBranch && term.targets[0] == next:
+			l.labeled[term.targets[1]] = true
+		case term.op == irOpBranch && term.targets[1] == next:
+			l.labeled[term.targets[0]] = true
+		default:
+			for _, t := range term.targets {
+				if t != next {
+					l.labeled[t] = true
+				}
+			}
+		}
+	}
+
+	l.label(f.label)
+	l.emit(&asmCmd{
+		ins: "__CLEARSCOPE",
+	})
+	l.emit(funcPushState(state)...)
+
+	// Temporarily store return address in E
+	l.emit(&asmCmd{
+		ins: "POP",
+		params: []*asmParam{
+			rawAsmParam("E"),
+		},
+	})
+
+	if isStructType(f.returnType) {
+		l.emit(varFromStack(returnSlotVariable, state)...)
+	}
+
+	// Read parameters from stack (in reverse order)
+	for i := len(f.node.Parameters) - 1; i >= 0; i-- {
+		param := f.node.Parameters[i]
+		if paramType := state.typeMap[param.Type]; isStructType(paramType) {
+			// Struct parameters are passed by address, copy them into our scope
+			l.emit(structFromStack(param.Name, paramType)...)
+		} else {
+			// varFromStack scopes automatically (via asmParamTypeVarWrite)
+			l.emit(varFromStack(param.Name, state)...)
+		}
+	}
+
+	// Push return address back
+	l.emit(&asmCmd{
+		ins: "PUSH",
+		params: []*asmParam{
+			rawAsmParam("E"),
+		},
+	})
+
+	for i, b := range f.blocks {
+		var next *irBlock
+		if i+1 < len(f.blocks) {
+			next = f.blocks[i+1]
+		}
+
+		l.lowerBlock(b, next)
+	}
+
+	// Reached by falling off the end of non-void functions (see irOpUnreachable)
+	l.context = "function " + f.node.Name
+	l.emit(&asmCmd{
+		ins: "FAULT",
+		params: []*asmParam{
+			rawAsmParam(FAULT_NO_RETURN),
+		},
+		comment: " Ending function: " + f.node.Name,
+	})
+
+	for _, cmd := range l.output {
+		cmd.scope = f.node.Name
+	}
+
+	return l.output
+}
+
+// Label of a block in the generated asm
+func (l *irLowering) blockLabel(b *irBlock) string {
+	if b.label != "" {
+		return b.label
+	}
+
+	// Must not look like a function label (see regexpFunctionLabel)
+	return fmt.Sprintf("mscr_block_%s_%d", strings.TrimPrefix(l.function.label, "mscr_function_"), b.id)
+}
+
+func (l *irLowering) label(label string) {
+	l.emit(&asmCmd{
+		ins:         fmt.Sprintf(".%s __LABEL_SET", label),
+		printIndent: -1,
+	})
+}
+
+func (l *irLowering) emit(cmds ...*asmCmd) {
+	for _, cmd := range cmds {
+		cmd.comment = fmt.Sprintf("%s [%s (in func: %s)]", cmd.comment, l.context, l.function.node.Name)
+		cmd.printIndent++
+		if cmd.line == 0 {
+			cmd.file = l.file
+			cmd.line = l.line
+		}
+
+		l.output = append(l.output, cmd)
+	}
+}
+
+func (l *irLowering) lowerBlock(b *irBlock, next *irBlock) {
+	if l.labeled[b] {
+		l.context = b.String()
+		l.label(l.blockLabel(b))
+		l.emit(&asmCmd{
+			ins: "__CLEARSCOPE",
+		})
+	}
+
+	for _, instr := range b.instrs {
+		l.lowerInstr(instr, next)
+	}
+
+	if len(l.stack) > 0 || l.held != nil {
+		panic(fmt.Sprintf("ERROR: Temporaries left on the stack at the end of IR block %s of function '%s'. This is a compiler bug, sorry.", b, l.function.node.Name))
+	}
+}
+
+func (l *irLowering) lowerInstr(instr *irInstr, next *irBlock) {
+	l.context = instr.String()
+	if instr.line > 0 {
+		l.file = instr.file
+		l.line = instr.line
+	}
+
+	// Temporaries only stay in F until the next instruction, calls expect all of their arguments on the stack
+	if l.held != nil && (instr.op == irOpCall || !instr.uses(l.held.temp)) {
+		l.spill()
+	}
+
+	switch instr.op {
+	case irOpCopy:
+		src := instr.args[0]
+		if instr.dst.kind == irValueTemp {
+			if src.kind == irValueAddr {
+				l.emit(&asmCmd{
+					ins: "MOV",
+					params: []*asmParam{
+						&asmParam{
+							asmParamType: asmParamTypeVarAddr,
+							value:        src.name,
+						},
+						rawAsmParam("F"),
+					},
+				})
+				l.held = &irHeld{temp: instr.dst.temp}
+			} else {
+				l.held = &irHeld{temp: instr.dst.temp, value: src}
+			}
+			break
+		}
+
+		dest := &asmParam{
+			asmParamType: asmParamTypeVarWrite,
+			value:        instr.dst.name,
+		}
+
+		if src.kind == irValueConst && constantRegister(src.constant) == "" {
+			l.emit(&asmCmd{
+				ins: "SETREG",
+				params: []*asmParam{
+					dest,
+					rawAsmParam(fmt.Sprintf("0x%x", src.constant)),
+				},
+			})
+			break
+		}
+
+		l.emit(&asmCmd{
+			ins: "MOV",
+			params: []*asmParam{
+				l.operands(instr.args, []string{"F"}, false)[0],
+				dest,
+			},
+		})
+
+	case irOpBinary:
+		params := l.operands(instr.args, []string{"F", "E"}, false)
+		l.emit(&asmCmd{
+			ins: instr.alu,
+			params: []*asmParam{
+				params[0],
+				rawAsmParam("F"), // Output
+				params[1],
+			},
+		})
+		l.held = &irHeld{temp: instr.dst.temp}
+
+	case irOpUnary:
+		l.emit(&asmCmd{
+			ins: instr.alu,
+			params: []*asmParam{
+				l.operands(instr.args, []string{"F"}, false)[0],
+				rawAsmParam("F"),
+			},
+		})
+		l.held = &irHeld{temp: instr.dst.temp}
+
+	case irOpDivide:
+		// Runtime routine divides F by E, quotient is returned in F, remainder in E
+		l.operands(instr.args, []string{"F", "E"}, true)
+		l.emit(&asmCmd{
+			ins: "CALL",
+			params: []*asmParam{
+				rawAsmParam(instr.alu),
+			},
+		})
+
+		if instr.modulo {
+			l.emit(&asmCmd{
+				ins: "MOV",
+				params: []*asmParam{
+					rawAsmParam("E"),
+					rawAsmParam("F"),
+				},
+			})
+		}
+		l.held = &irHeld{temp: instr.dst.temp}
+
+	case irOpLoad:
+		l.emit(&asmCmd{
+			ins: "LOAD",
+			params: []*asmParam{
+				rawAsmParam("F"),
+				l.operands(instr.args, []string{"F"}, false)[0],
+			},
+		})
+		l.held = &irHeld{temp: instr.dst.temp}
+
+	case irOpStore:
+		params := l.operands(instr.args, []string{"F", "E"}, false)
+		l.emit(&asmCmd{
+			ins: "STOR",
+			params: []*asmParam{
+				params[1],
+				params[0],
+			},
+		})
+
+	case irOpCall:
+		l.popArguments(instr.args)
+
+		if slot := instr.returnSlot; slot != nil {
+			// Hidden last parameter: address to copy the returned struct to
+			param := &asmParam{
+				asmParamType: asmParamTypeVarRead,
+				value:        slot.name,
+			}
+			if slot.kind == irValueAddr {
+				param.asmParamType = asmParamTypeVarAddr
+			}
+
+			l.emit(&asmCmd{
+				ins:    "PUSH",
+				params: []*asmParam{param},
+			})
+		}
+
+		l.emit(
+			&asmCmd{
+				ins: "__FLUSHSCOPE",
+			},
+			&asmCmd{
+				ins: "__CLEARSCOPE",
+			},
+			&asmCmd{
+				ins: "CALL",
+				params: []*asmParam{
+					rawAsmParam("." + getFuncLabelSpecific(instr.name, len(instr.args))),
+				},
+			},
+			&asmCmd{
+				ins: "__CLEARSCOPE",
+			})
+
+		if instr.dst != nil {
+			l.emit(&asmCmd{
+				ins: "MOV",
+				params: []*asmParam{
+					rawAsmParam("A"),
+					rawAsmParam("F"),
+				},
+			})
+			l.held = &irHeld{temp: instr.dst.temp}
+		}
+
+	case irOpStructCopy:
+		l.emit(structCopy(instr.name, instr.args[0].name, instr.args[0].typ)...)
+
+	case irOpSetDirect:
+		l.emit(&asmCmd{
+			ins:                 "__SET_DIRECT",
+			scopeAnnotationName: instr.name,
+		})
+
+	case irOpRegAssign:
+		l.emit(&asmCmd{
+			ins:                     "__FORCESCOPE",
+			scopeAnnotationName:     instr.name,
+			scopeAnnotationRegister: instr.reg,
+			comment:                 " _reg_assign",
+		})
+
+	case irOpAsm:
+		rawAsm := toRawAsm(instr.name)
+		for _, a := range rawAsm {
+			a.inlineAsm = true
+		}
+		l.emit(rawAsm...)
+
+		// asm{} calc expressions put their result in F
+		if instr.dst != nil {
+			l.held = &irHeld{temp: instr.dst.temp}
+		}
+
+	case irOpJump:
+		target := instr.targets[0]
+		if target != next {
+			l.emit(
+				&asmCmd{
+					ins: "__FLUSHSCOPE",
+				},
+				&asmCmd{
+					ins: fmt.Sprintf("JMP .%s", l.blockLabel(target)),
+				})
+		} else if l.labeled[target] {
+			// Falling through into a label, which starts with a clean scope
+			l.emit(&asmCmd{
+				ins: "__FLUSHSCOPE",
+			})
+		}
+
+	case irOpBranch:
+		// Note: The condition could check out variables again after flushing. However, these checkouts are read-only and thus never dirty.
+		cond := l.operands(instr.args, []string{"F"}, false)[0]
+		l.emit(&asmCmd{
+			ins: "__FLUSHSCOPE",
+		})
+
+		then, otherwise := instr.targets[0], instr.targets[1]
+		switch {
+		case then == next:
+			l.emit(&asmCmd{
+				ins: "JMPEZ",
+				params: []*asmParam{
+					rawAsmParam("." + l.blockLabel(otherwise)),
+					cond,
+				},
+			})
+		case otherwise == next:
+			l.emit(&asmCmd{
+				ins: "JMPNZ",
+				params: []*asmParam{
+					rawAsmParam("." + l.blockLabel(then)),
+					cond,
+				},
+			})
+		default:
+			l.emit(
+				&asmCmd{
+					ins: "JMPEZ",
+					params: []*asmParam{
+						rawAsmParam("." + l.blockLabel(otherwise)),
+						cond,
+					},
+				},
+				&asmCmd{
+					ins: fmt.Sprintf("JMP .%s", l.blockLabel(then)),
+				})
+		}
+
+	case irOpReturn:
+		if len(instr.args) > 0 {
+			if value := instr.args[0]; isStructType(value.typ) {
+				l.emit(structToPointer(value.name, value.typ, returnSlotVariable)...)
+			} else {
+				l.operands(instr.args, []string{"A"}, true)
+			}
+		}
+
+		l.emit(funcPopState(l.state)...)
+		l.emit(
+			&asmCmd{
+				ins: "__FLUSHGLOBALS",
+			},
+			&asmCmd{
+				ins: "RET",
+			})
+
+	case irOpUnreachable:
+		// Always the last block of a function, which ends in FAULT_NO_RETURN anyway
+	}
+}
+
+func (instr *irInstr) uses(temp int) bool {
+	for _, a := range instr.args {
+		if a.kind == irValueTemp && a.temp == temp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Pushes the temporary held in F (or the value it is a copy of) onto the stack
+func (l *irLowering) spill() {
+	held := l.held
+	l.held = nil
+
+	param := rawAsmParam("F")
+	if v := held.value; v != nil {
+		if v.kind == irValueVar {
+			param = &asmParam{
+				asmParamType: asmParamTypeVarRead,
+				value:        v.name,
+			}
+		} else if reg := constantRegister(v.constant); reg != "" {
+			param = rawAsmParam(reg)
+		} else {
+			l.emit(constantCmd("F", v.constant))
+		}
+	}
+
+	l.emit(&asmCmd{
+		ins:    "PUSH",
+		params: []*asmParam{param},
+	})
+	l.stack = append(l.stack, held.temp)
+}
+
+// Removes the arguments of a call from the stack, the callee pops them itself
+func (l *irLowering) popArguments(args []*irValue) {
+	if len(args) > len(l.stack) {
+		panic(fmt.Sprintf("ERROR: Arguments missing on the stack in function '%s' (%s). This is a compiler bug, sorry.", l.function.node.Name, l.context))
+	}
+
+	base := len(l.stack) - len(args)
+	for i, a := range args {
+		if a.kind != irValueTemp || l.stack[base+i] != a.temp {
+			panic(fmt.Sprintf("ERROR: Arguments out of order on the stack in function '%s' (%s). This is a compiler bug, sorry.", l.function.node.Name, l.context))
+		}
+	}
+
+	l.stack = l.stack[:base]
+}
+
+// Returns parameters for the operands of an instruction, emitting commands to load them where necessary: Temporaries are
+// moved into the given registers (from F or the stack), constants are loaded into them if there is no constant register for
+// their value. Variables are passed on to the resolver, unless force is set, which loads every operand into its register.
+func (l *irLowering) operands(values []*irValue, registers []string, force bool) []*asmParam {
+	values = append([]*irValue{}, values...)
+	params := make([]*asmParam, len(values))
+
+	// Temporaries first, the most recently defined one might still be in F, the others are popped in reverse order
+	temps := make([]int, 0, len(values))
+	for i, v := range values {
+		if v.kind == irValueTemp {
+			temps = append(temps, i)
+		}
+	}
+	sort.Slice(temps, func(a, b int) bool {
+		return values[temps[a]].temp > values[temps[b]].temp
+	})
+
+	for _, i := range temps {
+		reg := registers[i]
+		if l.held != nil && l.held.temp == values[i].temp {
+			held := l.held
+			l.held = nil
+
+			if held.value != nil {
+				// Loaded below, just like any other variable or constant
+				values[i] = held.value
+				continue
+			}
+
+			if reg != "F" {
+				l.emit(&asmCmd{
+					ins: "MOV",
+					params: []*asmParam{
+						rawAsmParam("F"),
+						rawAsmParam(reg),
+					},
+				})
+			}
+			params[i] = rawAsmParam(reg)
+			continue
+		}
+
+		if len(l.stack) == 0 || l.stack[len(l.stack)-1] != values[i].temp {
+			panic(fmt.Sprintf("ERROR: Temporary %s used out of order in function '%s' (%s). This is a compiler bug, sorry.", values[i], l.function.node.Name, l.context))
+		}
+
+		l.stack = l.stack[:len(l.stack)-1]
+		l.emit(&asmCmd{
+			ins: "POP",
+			params: []*asmParam{
+				rawAsmParam(reg),
+			},
+		})
+		params[i] = rawAsmParam(reg)
+	}
+
+	for i, v := range values {
+		if params[i] != nil {
+			continue
+		}
+
+		reg := registers[i]
+		switch v.kind {
+		case irValueVar:
+			params[i] = &asmParam{
+				asmParamType: asmParamTypeVarRead,
+				value:        v.name,
+			}
+
+			if force {
+				l.emit(&asmCmd{
+					ins: "MOV",
+					params: []*asmParam{
+						params[i],
+						rawAsmParam(reg),
+					},
+				})
+				params[i] = rawAsmParam(reg)
+			}
+
+		case irValueConst:
+			if constReg := constantRegister(v.constant); constReg != "" && !force {
+				params[i] = rawAsmParam(constReg)
+			} else {
+				l.emit(constantCmd(reg, v.constant))
+				params[i] = rawAsmParam(reg)
+			}
+
+		default:
+			panic(fmt.Sprintf("ERROR: Invalid operand %s in function '%s' (%s). This is a compiler bug, sorry.", v, l.function.node.Name, l.context))
+		}
+	}
+
+	return params
+}
+
+// Returns the constant register holding the given value, or an empty string if there is none
+func constantRegister(value uint16) string {
+	switch value {
+	case 0:
+		return "0"
+	case 1:
+		return "1"
+	case 0xFFFF:
+		return "-1"
+	}
+
+	return ""
+}
+
+func constantCmd(reg string, value uint16) *asmCmd {
+	if constReg := constantRegister(value); constReg != "" {
+		return &asmCmd{
+			ins: "MOV",
+			params: []*asmParam{
+				rawAsmParam(constReg),
+				rawAsmParam(reg),
+			},
+		}
+	}
+
+	return &asmCmd{
+		ins: "SETREG",
+		params: []*asmParam{
+			rawAsmParam(reg),
+			rawAsmParam(fmt.Sprintf("0x%x", value)),
+		},
+	}
+}
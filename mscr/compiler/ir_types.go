@@ -0,0 +1,308 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MSCR functions are translated into a typed three-address intermediate representation (IR) before generating meta-asm:
+// Every function consists of basic blocks forming a control-flow graph, every block is a list of instructions ending in
+// exactly one terminator (jump, branch, return or unreachable). See ir_builder.go (AST -> IR) and ir_lowering.go (IR -> meta-asm).
+//
+// Values are either constants, variables (words, struct members or globals) or temporaries. Temporaries are defined exactly
+// once and used exactly once, by a later instruction of the same block, in reverse order of their definition. This allows
+// lowering them onto the calc stack, just like evaluating an expression in reverse polish notation.
+
+type irOp int
+
+const (
+	irOpCopy       irOp = iota // dst = a
+	irOpBinary                 // dst = a <alu> b
+	irOpUnary                  // dst = <alu> a
+	irOpDivide                 // dst = a / b (or a % b), calls a runtime routine
+	irOpLoad                   // dst = $(a)
+	irOpStore                  // $(a) = b
+	irOpCall                   // [dst =] call name(args...), args are temporaries
+	irOpStructCopy             // name = a, copies a struct variable word by word
+	irOpSetDirect              // marks variable name as directly assigned (its address has been taken)
+	irOpRegAssign              // forces variable name into register reg (_reg_assign)
+	irOpAsm                    // inline assembly, dst is set for asm{} calc expressions (result in F)
+
+	// Terminators
+	irOpJump        // jump targets[0]
+	irOpBranch      // if a != 0 jump targets[0], otherwise targets[1]
+	irOpReturn      // return [a]
+	irOpUnreachable // end of a non-void function without return statement
+)
+
+type irValueKind int
+
+const (
+	irValueTemp  irValueKind = iota // Temporary (temp is its number)
+	irValueConst                    // Constant, name is set for addresses of globals (e.g. strings)
+	irValueVar                      // Variable, struct member access chain or global word (name)
+	irValueAddr                     // Address of a local variable (name), only valid as source of irOpCopy and as return slot
+)
+
+type irValue struct {
+	kind     irValueKind
+	temp     int
+	constant uint16
+	name     string
+
+	// Type of the value, nil for words. Structs are passed around by address, values of struct type are addresses.
+	typ *asmType
+}
+
+type irInstr struct {
+	op   irOp
+	dst  *irValue
+	args []*irValue
+
+	// ALU instruction for irOpBinary/irOpUnary (e.g. ADD, NEG), runtime routine label for irOpDivide
+	alu    string
+	modulo bool
+
+	// Function for irOpCall, variable for irOpStructCopy/irOpSetDirect/irOpRegAssign, source code for irOpAsm
+	name string
+
+	// Register for irOpRegAssign
+	reg int
+
+	// For calls to functions returning structs: address the callee copies the returned struct to
+	returnSlot *irValue
+
+	// Successors for irOpJump and irOpBranch
+	targets []*irBlock
+
+	// MSCR source position the instruction was generated from (for debug info)
+	file string
+	line int
+}
+
+type irBlock struct {
+	id int
+
+	// Label in the generated asm, only emitted if the block is jumped to
+	label string
+
+	instrs []*irInstr
+
+	preds []*irBlock
+	succs []*irBlock
+}
+
+type irFunction struct {
+	node       *Function
+	label      string
+	returnType *asmType
+
+	// Basic blocks in output order, the first one is the entry block
+	blocks []*irBlock
+
+	temps int
+}
+
+type irProgram struct {
+	functions []*irFunction
+}
+
+func (op irOp) isTerminator() bool {
+	return op >= irOpJump
+}
+
+func (v *irValue) String() string {
+	switch v.kind {
+	case irValueTemp:
+		return fmt.Sprintf("%%%d", v.temp)
+	case irValueConst:
+		if v.name != "" {
+			return "&" + v.name
+		}
+		return fmt.Sprintf("%d", v.constant)
+	case irValueAddr:
+		return "&" + v.name
+	}
+
+	return v.name
+}
+
+func (b *irBlock) String() string {
+	return fmt.Sprintf("bb%d", b.id)
+}
+
+func (b *irBlock) terminator() *irInstr {
+	if len(b.instrs) == 0 || !b.instrs[len(b.instrs)-1].op.isTerminator() {
+		return nil
+	}
+
+	return b.instrs[len(b.instrs)-1]
+}
+
+func (instr *irInstr) String() string {
+	args := make([]string, len(instr.args))
+	for i, a := range instr.args {
+		args[i] = a.String()
+	}
+
+	var retval string
+	switch instr.op {
+	case irOpCopy:
+		retval = args[0]
+	case irOpBinary, irOpUnary:
+		retval = strings.ToLower(instr.alu) + " " + strings.Join(args, ", ")
+	case irOpDivide:
+		retval = strings.TrimPrefix(instr.alu, ".mscr_runtime_")
+		if instr.modulo {
+			retval = strings.Replace(retval, "div", "mod", 1)
+		}
+		retval += " " + strings.Join(args, ", ")
+	case irOpLoad:
+		retval = "load " + args[0]
+	case irOpStore:
+		retval = "store " + strings.Join(args, ", ")
+	case irOpCall:
+		retval = fmt.Sprintf("call %s(%s)", instr.name, strings.Join(args, ", "))
+		if instr.returnSlot != nil {
+			retval += " -> " + instr.returnSlot.String()
+		}
+	case irOpStructCopy:
+		retval = fmt.Sprintf("%s = struct %s", instr.name, args[0])
+	case irOpSetDirect:
+		retval = "setdirect " + instr.name
+	case irOpRegAssign:
+		retval = fmt.Sprintf("reg_assign %s, %s", toReg(instr.reg), instr.name)
+	case irOpAsm:
+		retval = "asm { " + strings.Join(strings.Fields(instr.name), " ") + " }"
+	case irOpJump:
+		retval = "jump " + instr.targets[0].String()
+	case irOpBranch:
+		retval = fmt.Sprintf("branch %s, %s, %s", args[0], instr.targets[0], instr.targets[1])
+	case irOpReturn:
+		retval = strings.TrimSpace("return " + strings.Join(args, ", "))
+	case irOpUnreachable:
+		retval = "unreachable"
+	}
+
+	if instr.dst != nil {
+		retval = fmt.Sprintf("%s:%s = %s", instr.dst, typeName(instr.dst.typ), retval)
+	}
+
+	return retval
+}
+
+func (f *irFunction) String() string {
+	params := make([]string, len(f.node.Parameters))
+	for i, p := range f.node.Parameters {
+		params[i] = p.Type + " " + p.Name
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("func %s %s(%s) ; %s\n", f.node.Type, f.node.Name, strings.Join(params, ", "), f.label))
+
+	for _, b := range f.blocks {
+		sb.WriteString(b.String() + ":")
+		if b.label != "" {
+			sb.WriteString(" ; " + b.label)
+		}
+		sb.WriteString("\n")
+
+		preds := make([]string, len(b.preds))
+		for i, p := range b.preds {
+			preds[i] = p.String()
+		}
+		sb.WriteString("    ; preds: " + strings.Join(preds, ", ") + "\n")
+
+		for _, instr := range b.instrs {
+			sb.WriteString("    " + instr.String() + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func (p *irProgram) String() string {
+	functions := make([]string, len(p.functions))
+	for i, f := range p.functions {
+		functions[i] = f.String()
+	}
+
+	return strings.Join(functions, "\n")
+}
+
+// Recomputes predecessors and successors of all blocks from their terminators
+func (f *irFunction) buildCFG() {
+	for _, b := range f.blocks {
+		b.preds = make([]*irBlock, 0)
+		b.succs = make([]*irBlock, 0)
+	}
+
+	for _, b := range f.blocks {
+		term := b.terminator()
+		if term == nil {
+			panic(fmt.Sprintf("ERROR: IR block %s of function '%s' has no terminator. This is a compiler bug, sorry.", b, f.node.Name))
+		}
+
+		for _, t := range term.targets {
+			if !containsBlock(b.succs, t) {
+				b.succs = append(b.succs, t)
+				t.preds = append(t.preds, b)
+			}
+		}
+	}
+}
+
+// Removes blocks that cannot be reached from the entry block and renumbers the remaining ones.
+// Blocks containing inline assembly are kept, since they might be jumped to from other inline assembly.
+func (f *irFunction) removeUnreachableBlocks() {
+	reachable := make(map[*irBlock]bool)
+
+	var visit func(b *irBlock)
+	visit = func(b *irBlock) {
+		if reachable[b] {
+			return
+		}
+
+		reachable[b] = true
+		for _, s := range b.succs {
+			visit(s)
+		}
+	}
+
+	for i, b := range f.blocks {
+		if i == 0 || b.containsAsm() {
+			visit(b)
+		}
+	}
+
+	blocks := make([]*irBlock, 0, len(f.blocks))
+	for _, b := range f.blocks {
+		if reachable[b] {
+			b.id = len(blocks)
+			blocks = append(blocks, b)
+		}
+	}
+
+	f.blocks = blocks
+	f.buildCFG()
+}
+
+func (b *irBlock) containsAsm() bool {
+	for _, instr := range b.instrs {
+		if instr.op == irOpAsm {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsBlock(slice []*irBlock, value *irBlock) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,6 +8,9 @@ type AST struct {
 	TopExpressions []*TopExpression `{ @@ }`
 
 	CommentHeaders []string
+
+	// Path of the original source file, used for debug info annotations in the output asm
+	SourceFile string
 }
 
 type TopExpression struct {
@@ -25,10 +28,12 @@ type Expression struct {
 	Assignment   *Assignment   `(( @@`
 	FunctionCall *FunctionCall `| @@`
 	Variable     *Variable     `| @@`
-	Return       *RuntimeValue `| "return" @@) ";")`
+	Return       *RuntimeValue `| "return" @@`
+	Break        bool          `| @"break"`
+	Continue     bool          `| @"continue") ";")`
 
-	WhileLoop *WhileLoop `| @@`
-	//	ForLoop     *ForLoop     `| @@`
+	WhileLoop   *WhileLoop   `| @@`
+	ForLoop     *ForLoop     `| @@`
 	IfCondition *Conditional `| @@`
 	Asm         *string      `| @ASM`
 }
@@ -64,15 +69,23 @@ type FunctionParameter struct {
 	Name string `@Ident`
 }
 
-// type ForLoop struct {
-// 	Pos lexer.Position
+// Note: The loop header is rewritten before parsing, so that every part is terminated by ";" (see autoCalcForLoops)
+type ForLoop struct {
+	Pos lexer.Position
+
+	Init      *LoopStatement `"for" "(" ( @@ | ";" )`
+	Condition *string        `( @Eval ";" | ";" )`
+	Step      *LoopStatement `( @@ | ";" ) ")"`
+	Body      []*Expression  `"{" { @@ } "}"`
+}
+
+type LoopStatement struct {
+	Pos lexer.Position
 
-// 	IsVar        bool          `"for" @"var"`
-// 	IteratorName string        `@Ident`
-// 	From         int           `"from" @Int`
-// 	To           int           `"to" @Int`
-// 	Body         []*Expression `"{" { @@ } "}"`
-// }
+	Assignment   *Assignment   `( @@`
+	FunctionCall *FunctionCall `| @@`
+	Variable     *Variable     `| @@) ";"`
+}
 
 type WhileLoop struct {
 	Pos lexer.Position
@@ -89,12 +102,14 @@ type Conditional struct {
 	BodyElse  []*Expression `["else" "{" { @@ } "}"]`
 }
 
+// Note: Array declarations ("word buf[32]") are rewritten to "word buf#(32)" before parsing (see rewriteArrayAccesses)
 type Variable struct {
 	Pos lexer.Position
 
-	Type  string        `@Ident`
-	Name  string        `@Ident`
-	Value *RuntimeValue `["=" @@]`
+	Type   string        `@Ident`
+	Name   string        `@Ident`
+	Length *int          `["#" "(" @Int ")"]`
+	Value  *RuntimeValue `["=" @@]`
 }
 
 type Assignment struct {
@@ -131,9 +146,10 @@ type RuntimeValue struct {
 type Global struct {
 	Pos lexer.Position
 
-	Type  string `"global" @Ident`
-	Name  string `@Ident`
-	Value *Value `["=" @@]`
+	Type   string `"global" @Ident`
+	Name   string `@Ident`
+	Length *int   `["#" "(" @Int ")"]`
+	Value  *Value `["=" @@]`
 }
 
 type View struct {
@@ -148,4 +164,5 @@ type Value struct {
 
 	Text   *string `  @String`
 	Number *int    `| @Int`
+	Eval   *string `| @Eval`
 }
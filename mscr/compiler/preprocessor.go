@@ -0,0 +1,419 @@
+package compiler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/participle"
+	"github.com/alecthomas/participle/lexer"
+)
+
+// Maximum nesting depth of #include directives, guards against (unguarded) recursive includes
+const maxIncludeDepth = 64
+
+var regexpDirective = regexp.MustCompile(`^\s*#\s*([a-z]+)\s*(.*?)\s*$`)
+var regexpDefine = regexp.MustCompile(`^([a-zA-Z_$][a-zA-Z0-9_$]*)(?:\(([^)]*)\))?(?:\s+(.*))?$`)
+var regexpMacroName = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// PreprocessedSource is the output of Preprocess, i.e. a single source text with all
+// includes and macros resolved, together with the original location of every line.
+type PreprocessedSource struct {
+	Text string
+
+	// Origin of every output line (index is output line - 1)
+	lines []sourceOrigin
+}
+
+type sourceOrigin struct {
+	file string
+	line int
+}
+
+// Origin returns the file and line an output line of the preprocessed text originated from
+func (s *PreprocessedSource) Origin(line int) (string, int) {
+	if line < 1 || line > len(s.lines) {
+		return "", line
+	}
+
+	return s.lines[line-1].file, s.lines[line-1].line
+}
+
+// Remaps a position in the preprocessed text to the original file and line
+func (s *PreprocessedSource) mapPosition(pos lexer.Position) lexer.Position {
+	if file, line := s.Origin(pos.Line); file != "" {
+		pos.Filename = file
+		pos.Line = line
+	}
+
+	return pos
+}
+
+// Formats a parser error with its position remapped to the original source file
+func (s *PreprocessedSource) mapError(err error) string {
+	perr, ok := err.(participle.Error)
+	if !ok {
+		return err.Error()
+	}
+
+	message := strings.TrimPrefix(perr.Error(), lexer.FormatError(perr.Position(), ""))
+	return lexer.FormatError(s.mapPosition(perr.Position()), message)
+}
+
+type macro struct {
+	function bool
+	params   []string
+	body     string
+}
+
+type conditionalBlock struct {
+	origin sourceOrigin
+	active bool
+	parent bool // Whether the enclosing block is active
+	inElse bool
+}
+
+type preprocessor struct {
+	includePaths []string
+	defines      map[string]*macro
+
+	output []string
+	lines  []sourceOrigin
+}
+
+// Preprocess resolves #include, #define/#undef and #ifdef/#ifndef/#else/#endif directives in an MSCR source file.
+// Includes are searched for relative to the including file first, then in the given include paths (in order).
+func Preprocess(inputFile string, includePaths []string) *PreprocessedSource {
+	p := &preprocessor{
+		includePaths: includePaths,
+		defines:      make(map[string]*macro),
+		output:       make([]string, 0),
+		lines:        make([]sourceOrigin, 0),
+	}
+
+	p.processFile(inputFile, 0)
+
+	return &PreprocessedSource{
+		Text:  strings.Join(p.output, "\n"),
+		lines: p.lines,
+	}
+}
+
+func (p *preprocessor) fail(origin sourceOrigin, format string, args ...interface{}) {
+	panic(fmt.Sprintf("ERROR: %s:%d: %s", origin.file, origin.line, fmt.Sprintf(format, args...)))
+}
+
+func (p *preprocessor) processFile(file string, depth int) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		panic("ERROR: Could not read source file: " + err.Error())
+	}
+
+	conditionals := make([]*conditionalBlock, 0)
+	active := func() bool {
+		return len(conditionals) == 0 || conditionals[len(conditionals)-1].active
+	}
+
+	for i, line := range strings.Split(string(contents), "\n") {
+		origin := sourceOrigin{file, i + 1}
+
+		directive := regexpDirective.FindStringSubmatch(line)
+		if directive == nil {
+			if active() {
+				p.output = append(p.output, p.expand(line, origin, map[string]bool{}))
+				p.lines = append(p.lines, origin)
+			}
+
+			continue
+		}
+
+		name, arg := directive[1], directive[2]
+
+		switch name {
+		case "ifdef", "ifndef":
+			if !regexpMacroName.MatchString(arg) {
+				p.fail(origin, "#%s requires a macro name", name)
+			}
+
+			_, defined := p.defines[arg]
+			conditionals = append(conditionals, &conditionalBlock{
+				origin: origin,
+				active: active() && defined == (name == "ifdef"),
+				parent: active(),
+			})
+
+		case "else":
+			if len(conditionals) == 0 || conditionals[len(conditionals)-1].inElse {
+				p.fail(origin, "#else without #ifdef/#ifndef")
+			}
+
+			block := conditionals[len(conditionals)-1]
+			block.active = block.parent && !block.active
+			block.inElse = true
+
+		case "endif":
+			if len(conditionals) == 0 {
+				p.fail(origin, "#endif without #ifdef/#ifndef")
+			}
+
+			conditionals = conditionals[:len(conditionals)-1]
+
+		default:
+			if !active() {
+				continue
+			}
+
+			p.directive(name, arg, origin, depth)
+		}
+	}
+
+	if len(conditionals) > 0 {
+		p.fail(conditionals[len(conditionals)-1].origin, "unterminated conditional, missing #endif")
+	}
+}
+
+// Handles all directives that are not part of a conditional (only called in active blocks)
+func (p *preprocessor) directive(name, arg string, origin sourceOrigin, depth int) {
+	switch name {
+	case "include":
+		if len(arg) < 2 || !((arg[0] == '"' && arg[len(arg)-1] == '"') || (arg[0] == '<' && arg[len(arg)-1] == '>')) {
+			p.fail(origin, "#include expects \"file\" or <file>")
+		}
+
+		if depth >= maxIncludeDepth {
+			p.fail(origin, "#include nested too deeply (> %d), recursive include?", maxIncludeDepth)
+		}
+
+		p.processFile(p.findInclude(arg[1:len(arg)-1], origin), depth+1)
+
+	case "define":
+		define := regexpDefine.FindStringSubmatch(arg)
+		if define == nil {
+			p.fail(origin, "invalid #define: %s", arg)
+		}
+
+		m := &macro{
+			function: strings.HasPrefix(arg[len(define[1]):], "("),
+			params:   make([]string, 0),
+			body:     define[3],
+		}
+
+		if m.function && strings.TrimSpace(define[2]) != "" {
+			for _, param := range strings.Split(define[2], ",") {
+				param = strings.TrimSpace(param)
+				if !regexpMacroName.MatchString(param) {
+					p.fail(origin, "invalid macro parameter name '%s'", param)
+				}
+
+				m.params = append(m.params, param)
+			}
+		}
+
+		p.defines[define[1]] = m
+
+	case "undef":
+		if !regexpMacroName.MatchString(arg) {
+			p.fail(origin, "#undef requires a macro name")
+		}
+
+		delete(p.defines, arg)
+
+	default:
+		p.fail(origin, "unknown preprocessor directive #%s", name)
+	}
+}
+
+func (p *preprocessor) findInclude(name string, origin sourceOrigin) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+
+	candidates := append([]string{filepath.Dir(origin.file)}, p.includePaths...)
+	for _, dir := range candidates {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	p.fail(origin, "included file '%s' not found (searched: %s)", name, strings.Join(candidates, ", "))
+	return ""
+}
+
+func isMacroIdentChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '$'
+}
+
+// Expands all macros in a line of source code. String and character literals as well as line comments are left untouched.
+// Macros listed in "disabled" are not expanded (to prevent infinite recursion on self-referencing macros).
+func (p *preprocessor) expand(text string, origin sourceOrigin, disabled map[string]bool) string {
+	var retval strings.Builder
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		switch {
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(text) && text[end] != c {
+				if text[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			end++
+			if end > len(text) {
+				end = len(text)
+			}
+
+			retval.WriteString(text[i:end])
+			i = end
+
+		case c == '/' && i+1 < len(text) && text[i+1] == '/':
+			retval.WriteString(text[i:])
+			i = len(text)
+
+		case isMacroIdentChar(c):
+			start := i
+			for i < len(text) && isMacroIdentChar(text[i]) {
+				i++
+			}
+
+			ident := text[start:i]
+			m, ok := p.defines[ident]
+			if !ok || disabled[ident] || (c >= '0' && c <= '9') {
+				retval.WriteString(ident)
+				continue
+			}
+
+			body := m.body
+			if m.function {
+				args, end, isCall := p.macroArguments(text, i, origin)
+				if !isCall {
+					// Function-like macro name without arguments is not expanded
+					retval.WriteString(ident)
+					continue
+				}
+
+				if len(args) != len(m.params) && !(len(m.params) == 0 && len(args) == 1 && strings.TrimSpace(args[0]) == "") {
+					p.fail(origin, "macro '%s' expects %d argument(s), %d given", ident, len(m.params), len(args))
+				}
+
+				expandedArgs := make(map[string]string, len(m.params))
+				for ai, param := range m.params {
+					expandedArgs[param] = strings.TrimSpace(p.expand(args[ai], origin, disabled))
+				}
+
+				body = substituteParams(body, expandedArgs)
+				i = end
+			}
+
+			disabled[ident] = true
+			retval.WriteString(p.expand(body, origin, disabled))
+			delete(disabled, ident)
+
+		default:
+			retval.WriteByte(c)
+			i++
+		}
+	}
+
+	return retval.String()
+}
+
+// Parses the argument list of a function-like macro call starting at "start" (which may point to whitespace before the opening bracket).
+// Returns the raw arguments, the index after the closing bracket and whether there is an argument list at all.
+func (p *preprocessor) macroArguments(text string, start int, origin sourceOrigin) ([]string, int, bool) {
+	i := start
+	for i < len(text) && (text[i] == ' ' || text[i] == '\t') {
+		i++
+	}
+
+	if i >= len(text) || text[i] != '(' {
+		return nil, start, false
+	}
+
+	args := make([]string, 0)
+	depth := 0
+	argStart := i + 1
+
+	for i++; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return append(args, text[argStart:i]), i + 1, true
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, text[argStart:i])
+				argStart = i + 1
+			}
+		}
+	}
+
+	p.fail(origin, "unterminated macro argument list (macro calls may not span multiple lines)")
+	return nil, start, false
+}
+
+// Replaces all occurences of parameter names in a macro body with the given arguments
+func substituteParams(body string, args map[string]string) string {
+	var retval strings.Builder
+
+	for i := 0; i < len(body); {
+		if !isMacroIdentChar(body[i]) {
+			retval.WriteByte(body[i])
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(body) && isMacroIdentChar(body[i]) {
+			i++
+		}
+
+		if arg, ok := args[body[start:i]]; ok {
+			retval.WriteString(arg)
+		} else {
+			retval.WriteString(body[start:i])
+		}
+	}
+
+	return retval.String()
+}
+
+// Rewrites all node positions of a parsed AST to point at the original (pre-preprocessing) source files and lines
+func remapPositions(val reflect.Value, source *PreprocessedSource) {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !val.IsNil() {
+			remapPositions(val.Elem(), source)
+		}
+
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			remapPositions(val.Index(i), source)
+		}
+
+	case reflect.Struct:
+		if pos, ok := val.Interface().(lexer.Position); ok {
+			if val.CanSet() && pos.Line > 0 {
+				val.Set(reflect.ValueOf(source.mapPosition(pos)))
+			}
+			return
+		}
+
+		for i := 0; i < val.NumField(); i++ {
+			if val.Type().Field(i).PkgPath == "" {
+				remapPositions(val.Field(i), source)
+			}
+		}
+	}
+}
@@ -1,7 +0,0 @@
-#!/bin/bash
-
-EOF='EOF'
-CHARSEP='·'
-TOKFLDSEP='·'
-TOKSEP='¦'
-UNARY='.'
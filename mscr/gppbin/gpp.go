@@ -1,235 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// gpp
-// DO NOT EDIT!
-
-package gppbin
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _gpp = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x7c\x53\x45\xf6\xf0\xdc\x36\x29\x01\x8a\x37\x68\xab\xd5\x05\x89\x7a\x59\xcb\x5a\x94\x2a\x68\x2b\xed\x9a\xd8\x44\x26\x10\xdc\xae\x14\xc5\x05\x04\x0d\x54\x54\x10\x84\x04\x8a\x14\x08\x26\x91\x8e\xd7\xab\x55\x11\x51\x40\xf0\x1d\x57\xd7\x65\xd5\xad\x08\x0a\x69\x4b\x5b\x70\x45\xa1\x88\xa2\x28\x20\x2a\x4c\x08\xef\x05\x5a\x0a\xed\x3c\xbf\x33\x73\x93\xde\x16\xba\xfb\xff\xf6\x7c\xb1\xfc\xf4\xde\x39\xe7\xcc\xcc\x39\x67\xce\x9c\x39\xf3\x72\x27\xf3\x5d\x9e\x3b\x92\x24\x09\xc5\xff\x92\xd1\x9f\x11\xa4\x56\x5e\x2d\xd2\x76\x1d\xbe\xd3\x92\x9c\xa0\xb1\xa3\x1c\xd4\x1d\xd9\x91\x82\xae\x41\x29\x08\x21\x93\x81\xae\xe3\x73\x52\x52\xfb\xa7\x25\x51\x8f\xc8\xb7\x4a\x87\x77\x7c\xf6\x42\xed\x9f\x92\xe1\x69\x42\x9d\xff\x95\x5c\xdc\xfe\x89\xac\x6d\xf9\xcc\xf0\x62\xd3\xe1\x1d\x9e\xe6\xf2\xf6\x4f\x63\x3e\x5e\x5f\x44\x87\x77\x78\x06\xae\x6b\xff\x34\xe6\x03\xdd\xd0\xc3\x22\x4d\x5b\xda\x3f\xf7\x9a\xf5\x62\xba\xb7\xcf\x97\xa4\xe7\x6b\xd2\xf3\x35\xb5\xb4\x7f\xee\x95\xda\x3f\xe3\xfa\x34\xe9\xff\x6d\xd4\xf5\xd7\xf1\xe9\x44\xed\x9f\x71\x1d\x16\xfe\xe6\x9b\x00\xef\x6b\x7e\x10\xe9\x8e\xcf\x31\x49\xed\x9f\xf1\x7c\x7f\xfd\xcd\x37\x21\x05\xfd\xdf\xff\x74\xf1\xd0\x5d\x7a\x7d\x9d\xe9\x25\x23\xa9\xfd\x33\xde\xee\x37\x4c\x7e\xe8\x81\x9b\x07\xde\x30\x79\x42\xff\xc9\x0f\x3d\xea\x2f\xe9\x5f\x92\x73\x73\xff\x9b\x07\x5e\x3f\x63\xea\xf5\x37\x72\x9e\xac\x3a\xed\x90\x3b\x47\x72\xfa\x64\x5d\x97\x71\x9e\xd3\x74\x18\xe0\xaf\x78\x60\xca\x61\xa4\xbc\x3e\x69\xdb\x67\xc1\xcf\x0f\x9c\x4e\xff\xcb\x81\xf0\xdb\x79\x80\xfb\xa3\xa1\xdd\x90\x64\x43\x01\xc9\x9a\x04\xb0\x6b\xf5\x72\xfa\xf5\x7e\xba\x57\xf1\xc8\xfb\xad\xb9\x27\xd4\x5e\x9d\xc9\x79\x8d\x84\xd0\xc5\x17\x80\xaf\x43\x17\x86\xef\xea\x04\x3e\xa8\x13\x78\xff\x4e\xe0\x6e\xa9\xcd\xa4\x8d\x7f\xdd\x3b\xa1\x7f\xbb\x13\x78\xaf\x4e\xf8\x57\x3b\xa1\xcf\xef\x04\xfe\x5b\x27\xf0\x2d\x9d\xc0\x2f\xeb\x04\x3e\xaf\x13\xf8\xcb\x9d\xc0\xbb\x75\xc2\x7f\xa8\x13\xfa\xe4\x4e\xe8\x0f\x75\x42\x3f\xbb\x13\xf8\xc4\x4e\xf4\x3f\xbd\x13\xfa\x15\x9d\xc0\xa7\x74\x02\xff\x47\x27\xf0\x3b\x3b\x81\x3f\xd4\x09\x7c\x4c\x27\xf0\x6b\x3b\x81\x7f\xdc\x09\xbc\x4f\x27\xf0\x8b\x3b\x81\x7f\xd3\x09\xdc\xd7\x89\xde\x1e\xe8\x84\xfe\x24\x42\xe8\xea\x0b\xc0\x9f\x47\x08\xf5\x44\x19\x68\x6f\xb2\xf0\x18\x71\xbf\x78\xb7\x0e\x3f\xd6\x01\x5e\xab\xc3\x91\xa9\x3d\x1c\x4d\x7e\xe8\x01\x2f\xb8\x95\x9b\xd1\x0c\xdf\x74\xef\xb4\xd9\x68\x62\xc9\x43\x3e\x54\xfc\xe8\x94\xfb\x7d\xde\x49\x68\xc6\xb4\xe9\x0f\x3d\xea\x2b\x46\xc5\x53\xa7\x4d\x7c\x14\x28\x1e\xf5\x4e\x99\x26\x9e\xd3\x66\xa3\x71\xe3\x66\xf8\xee\xf7\x3e\x32\xce\x3b\xe9\x91\x71\xc5\xf7\x3f\x34\x19\x4d\x9f\x78\xff\xe4\xc9\x53\xbd\x68\x86\x6f\xc2\x43\x8f\xa2\x69\xf1\x4c\x13\xfc\x3c\x4f\xb1\xef\xa1\x29\x13\xe1\xc5\x37\x75\x32\x2a\x9e\x38\xb5\x18\x15\x3f\x38\xd1\xe7\x45\xde\x78\xa6\xe9\x93\x39\xfd\x84\xa9\x7e\x1f\x2a\x9e\xe6\xf7\x79\xd1\x94\x89\x53\xa0\xa2\x62\xef\xe4\xa9\x33\x26\xa2\x29\x09\x42\xef\xfd\x3e\xf1\x98\x31\x51\x30\x34\x61\xe2\xf4\xe9\x68\x9a\x20\x2b\x9e\x35\xfd\x21\xdf\x44\x34\x79\xaa\xf7\xfe\xc9\xf1\x2a\xbd\x93\xa6\xa3\x62\x5d\x98\x71\xe3\xbc\x25\xf7\x8f\x2b\x7e\xe8\xd1\xfb\x27\x3f\xf4\xb8\xc0\x4e\x99\x86\xc6\x8d\x03\x55\x80\x44\xd3\x7d\xe3\xa6\xdc\xff\x10\x70\x32\x8d\x57\x3e\x7d\xe2\x44\x34\xc4\xe3\xbe\xbd\x60\xdc\x8d\xd7\x67\x0f\x4c\xbc\xb6\xbd\xdd\x78\xfd\x20\x34\xce\x5d\x34\x7c\xdc\x84\x89\xd3\x27\x3e\xf8\xd0\x0c\xdf\xc4\xe9\x45\xc3\x0b\x26\x4f\x7d\x74\x62\xd1\xfd\x0f\x4c\x9e\x88\xc6\x8d\x7b\x70\xca\xd4\x47\xf5\xa2\xc7\x09\xd2\x0b\x12\x82\x0f\x8f\xff\x6b\x7b\x4f\x36\x40\xdb\xe3\x4d\x9d\x62\xe2\xff\x24\x94\xcc\x7d\x7c\x7c\x3c\x5a\xf4\xdc\xe2\x14\x18\x21\x32\x25\x01\x4b\x7b\xe8\xa1\x1e\x30\x52\xdc\xa4\xa7\xfd\x57\x3c\xd4\x15\xf2\xe7\xeb\x03\x51\x7c\x9c\x8a\xdb\x4b\xf9\x35\xe2\x79\xac\x03\xdc\xae\xc3\x33\xa5\xf6\xf6\x15\x4f\xdb\x3b\xc0\x1f\xd8\x28\x9e\xb8\x03\x7c\xa2\x0e\x2f\xec\x00\x9f\xa4\xc3\x47\x75\x80\xcf\xad\x16\xcf\xf1\x9d\x94\x33\xa9\x03\xdc\xab\xc3\x4b\x3a\xc0\x4f\xc5\xe3\x9b\x0e\xf0\x62\x9d\x7e\x65\x27\xfc\xaf\xea\x84\x7e\x75\x27\xfc\xaf\xe9\x84\xff\x48\x27\xe5\x6c\xea\x84\xff\x9d\x9d\xf0\xbf\xb7\x93\x72\x50\x52\x7b\xf8\xc3\x3a\xdc\xd2\x01\xee\xd3\xe1\xd6\x0e\xf0\x29\x3a\x3c\xa3\x03\x7c\xb2\x0e\xb7\x75\x80\x2f\xd6\xe5\xca\x4c\xba\x30\xff\x39\x9d\x94\x6f\xef\xa4\x9c\xf1\x1d\xe0\x53\xe3\xed\xdb\x01\xee\xd4\xf5\x30\xad\x03\x7c\x46\xbc\xdd\x3b\x81\x07\x3a\x29\xa7\xac\x13\xfe\x97\x74\xa2\xb7\x95\x9d\xc0\x23\x9d\xc0\x37\x75\x02\xdf\xda\x01\x9e\x5c\x29\x9e\x3b\x3b\xc0\xfd\x3a\xfd\xde\x0e\xf0\x59\x3a\x9c\x76\xc2\x7f\x53\x27\xe5\xa3\xe4\x0b\x97\x63\x4b\xbe\x70\x39\x99\xc9\x17\xd6\xdb\x80\xe4\x0b\xeb\x39\xa7\x13\xb8\xbd\x93\x72\x70\x27\xf5\x8e\x4a\xbe\x30\xff\xe3\x3b\xe1\x3f\x90\x7c\x61\x3d\x97\x75\x02\x2f\xef\xa4\xfc\x25\xc9\x17\xd6\xff\xca\x4e\xea\x5d\xd5\x09\xff\x6b\x3a\x29\x3f\xd2\x49\x39\x3b\x99\x78\xa6\xc4\xe3\x75\xfd\x6f\xaf\x01\xde\xd3\x00\xa7\x06\xf8\xa5\x06\xf8\x31\x03\xfc\x1a\x03\xbc\xc9\x00\xef\x6b\x2c\x5f\xe7\xc7\xac\xcf\x17\x12\xe5\x18\xe0\xd7\x1a\xe0\xf1\xf8\x02\xe0\x99\x06\x70\x86\x3e\xb3\xe9\x62\x98\xe3\x20\x3e\x1d\x6d\x83\x27\x19\xe0\x99\x06\x78\xb2\x01\x3e\xc0\x00\x37\xce\x8b\x73\x0c\x70\xb3\x01\x6e\x37\xc0\xbb\x18\xe0\xd8\x00\xb7\x18\xe0\x85\x06\x78\x57\x03\x7c\x94\x01\xde\xcd\x00\x1f\x6f\x80\x77\x37\xc0\x27\x19\xe0\xa9\x06\xf8\x34\x03\xbc\x87\x01\x5e\x62\x80\x5f\x64\x80\x07\x0c\x70\xd9\x00\x2f\x33\xc0\xad\x06\x78\xb9\x01\x6e\x8c\x27\x97\x18\xe0\x97\x18\xe0\x2b\x0d\xf0\x34\x03\x7c\x95\x01\x9e\x6e\x80\xaf\x36\xc0\x2f\x33\xc0\xd7\x18\xe0\x19\x06\x78\xc4\x00\xbf\xdc\x00\xdf\x64\x80\x5f\x61\x80\x6f\x35\xc0\xff\x60\x80\xef\x34\xc0\x8d\x93\xd1\xbd\x06\x78\x6f\x03\x9c\x1a\xe0\x57\x1a\xe0\xc7\x0c\xf0\x3e\x06\x78\x93\x01\xde\x2e\x3e\x97\xda\xe0\x57\x19\xc0\x16\x03\xdc\x18\x9f\x5b\x0d\x70\x05\xfd\xfe\xf7\xfb\xdf\xef\x7f\xbf\xff\xfd\xfe\xf7\xfb\xdf\xef\x7f\xbf\xff\xfd\xfe\xf7\xfb\xdf\xef\x7f\xbf\xff\xfd\xfe\xf7\xff\xeb\xaf\x41\xbe\xb2\x19\x07\x0f\x59\xb0\x6a\xfe\x7e\x0f\x42\x38\x1c\xf1\x25\xb1\xad\x38\xb8\xd1\x22\x16\x1a\xd9\xa0\x7d\x7b\x10\x62\x7d\x7f\xdd\x83\x90\xdc\xc7\x8e\x58\x5f\x48\x4f\x02\x54\x74\x2f\x63\x8c\xf5\xfd\x01\xd2\x30\xc9\x8b\x6e\xe5\xe9\xfa\x3d\xfa\x6e\x7f\x34\xc2\xd3\x5f\x40\x3a\x19\xd2\xab\x79\xba\x0a\xd2\x26\x48\xaf\xe4\xe9\xb5\x90\x36\x43\xba\x9c\xa7\x3f\x84\x74\x0a\xa4\x03\x3c\xfd\x77\x48\xc3\xf4\x31\x3a\x8d\xa7\x5f\x87\xb4\x05\xd2\xe3\x79\xfa\x15\x48\x77\x85\x74\x21\x4f\x3f\x0f\xe9\x6e\x90\xb6\xf3\xf4\x53\x90\xee\x0e\xe9\x01\x3c\xfd\x04\xa4\x53\x21\x6d\xe3\xe9\xc7\x21\xdd\x03\xd2\x56\x9e\x9e\x0e\xe9\x8b\x20\x8d\x78\xfa\x61\x48\xcb\x90\x3e\xd6\x0a\xe9\x07\x20\x6d\xe5\xf2\xf3\xf4\xdf\x20\xdd\x93\xcb\xcf\xd3\x77\x41\xfa\x62\x2e\x3f\x4f\x0f\x85\xf4\x25\x5c\x7e\x9e\xbe\x1d\xd2\x69\x5c\x7e\x9e\xbe\x15\xd2\xe9\x5c\x7e\x9e\xbe\x11\xd2\x97\x72\xf9\x79\xfa\x4f\x90\xbe\x8c\xcb\xcf\xd3\x57\x43\x3a\x83\xcb\xcf\xd3\x57\x40\xfa\x72\x2e\x3f\x4f\x5f\x0c\xe9\x2b\xb8\xfc\x3c\xdd\x0d\xd2\x7f\xe0\xf2\xf3\x74\x12\xa4\x7b\x71\xf9\x79\xba\x79\x37\x42\x93\x7a\x73\xf9\x79\xfa\x04\xa4\xaf\xe4\xf2\xf3\xf4\x41\x48\xf7\xe1\xf2\xb7\x30\x06\xb6\x42\x67\xcd\x45\x08\xab\xf9\x5b\x7f\x06\x83\x61\x3e\x33\xbd\x7b\x18\x00\xcc\xd7\xfe\x82\x10\x0e\xe6\x7f\xfd\x33\x82\x64\x21\xc6\xea\xe3\x56\xdf\x15\x40\x92\x8e\xd5\x42\x1b\xd6\x7a\x48\xaf\x23\xb4\x1e\x8c\x85\xde\x94\x8c\x10\xfd\x60\x28\xcf\x77\x74\x1f\x3c\xec\x18\xab\x25\x56\xda\xdc\xc2\x58\x76\x84\x5b\xa0\xdc\x87\xdb\x67\xf6\x61\x37\xd9\x76\x1f\x26\xfb\x70\xf0\xd7\x63\x85\x45\x1e\xcd\x7c\xb3\x86\x10\xd6\x7a\x6c\x78\x1a\x1e\xf9\x93\xa0\x9d\xd2\x2f\xfe\x09\xa1\x93\xe5\x58\xcb\x3f\xbe\x17\xa0\xe6\x83\xf0\xc8\x6d\x82\xaa\xcd\x47\x7e\x14\xa6\xdd\x95\xed\x95\xfb\x04\xc0\x78\xab\xf5\x27\xd6\xf2\x2b\x39\xfd\xa0\xb5\xf0\xe8\xd7\x8a\x2b\x5b\x93\x31\x39\x86\x2b\xe9\x6d\x58\xaa\xc5\xdb\x5a\x7d\x69\x58\x35\xd7\xe8\x05\x58\xd8\xde\x62\xb9\x8f\xb3\x2d\x3f\xf0\x17\xc8\xff\x7c\x2f\x42\xc8\x7f\xd3\x48\x1c\xcc\x7f\xfd\x47\x28\x95\xfc\xe6\xeb\x81\xd5\xfc\xda\x9f\x10\x62\xe9\xe5\x3f\x22\x44\xbd\x8c\xb1\x5a\xf3\xa2\xbd\x08\x49\x63\xab\x8b\xaf\x97\xfb\x84\x78\xff\xaa\x2e\x6e\x7b\x87\xfe\x05\xe5\x45\x1f\x64\x8c\xe9\xe5\x3b\x46\xba\x49\x8b\xa3\x68\xe4\x08\xbd\x93\x6e\xfc\x19\x21\x8f\x3a\xde\xe6\x21\x07\xe8\xb1\x73\x8c\x79\xc8\x51\x4c\xaa\xe9\x6f\xe7\x18\xe3\x7a\xc5\x5a\x69\xb2\x44\xef\x80\x96\x22\x35\x64\x67\x90\x4a\x25\x7f\xc2\xde\x9d\x8e\x40\x5e\xaf\x1b\x7c\x0f\xe0\x60\x4c\x8a\x5d\x0c\xf5\x70\x00\xd6\x46\x30\xdf\x70\x4c\xb6\x07\xa9\x14\x6c\x62\xfe\x83\x98\xd0\x5a\x84\x3c\xe4\x08\x26\x47\x69\x25\x2f\xe4\x28\xd6\x06\xed\x5e\x8e\x10\x7d\x5d\x4f\x92\x6a\xda\x78\x16\x0c\x61\xa3\x05\x93\x9d\xa3\xc7\x3a\xc6\x38\xc6\x56\x17\xc7\x65\xc0\xe4\x07\x0f\xd9\x0f\xd9\x93\xce\x31\x56\xeb\xec\x8d\x6e\xc0\x9a\xb3\xb7\x14\x7b\x47\xe8\x6d\x24\x26\xa7\x46\x60\x72\x16\xc4\x91\x2b\xba\x68\x85\x8d\x81\x66\x49\x7e\xf2\x45\x84\x50\x9e\x4d\x0e\x69\xa0\x86\x8a\x91\x48\x1b\xda\x18\x38\x23\xc9\x4f\xfe\x03\x21\x14\x68\xb6\xc9\xa1\x37\x11\x42\xa1\x88\xef\xbe\x50\xbd\xef\x6f\x98\xec\xa1\x27\x81\x05\xcd\x99\xcc\xe4\x0a\x6b\x9c\xb8\x3c\x4e\xbc\x90\x33\x72\x94\x7e\x2d\x88\xcc\x6d\x44\x33\xcd\x81\x66\x9b\x3f\x03\x07\xa9\x24\x57\x58\x6b\xed\x92\x5e\xd3\xcc\xc3\x81\x66\x9b\x8f\x0a\xa1\x8e\x60\xb2\x67\xf4\xd8\x68\xcf\x73\x8c\x95\xe3\x60\xb5\x24\x57\x24\xeb\x7c\x2e\x1c\xc5\x40\xf2\x76\x20\x17\x63\x2c\xb6\x33\x21\x7f\xb0\x46\x8a\xde\xda\xd6\x7e\xe7\xd5\x24\x2f\xbc\x17\x32\x1c\x88\xe3\x55\x73\xd7\xbd\x08\xa9\xe5\x2b\x11\x42\xe1\x7a\x7f\x57\xac\xda\x27\x05\x72\xba\xf9\x52\xaa\xb9\xbe\x30\x69\xdd\xc0\x9d\x99\xf7\x2c\x94\x89\x46\x66\x47\x30\xd9\xc2\x35\xa8\xe5\x4f\xdd\x0d\x15\x1c\xb2\x60\x92\xee\xdb\x8d\x10\x39\x81\xb5\x41\x37\xbc\x82\x10\xbd\xf4\x1c\x63\x6e\x42\x31\xd9\x93\x1d\xc1\x6a\x8f\x7b\x80\x4e\x1d\x94\xc3\xc9\x37\x5a\xb0\x96\x3e\x68\x37\x42\xa3\xc7\x46\xff\x7c\x96\xb1\x76\x06\x88\x74\x33\x1b\xbb\x07\x21\x37\x69\xc2\x6a\xfe\xae\x9f\x78\xef\xd8\xf5\x32\x42\x2a\xce\x80\x6e\x9c\x1d\xa1\x29\x67\x19\xdb\xc0\xbb\xf1\x67\xed\x4b\x28\xc7\xaa\xf9\xaa\x44\xde\x15\x22\xef\xbf\x8c\x79\xa3\x5f\x34\x33\xd6\x96\x81\x9b\x36\x39\xbb\x21\xc2\xd7\xe8\x9b\x19\xc3\xe1\x88\x1c\xea\x29\x41\xcf\x9a\x7a\x49\x83\x3c\x75\xb4\xd5\x4d\x36\x36\xc8\x53\xbd\xb6\x06\x79\xea\x23\x03\x1a\xe4\xa9\x33\xec\x0d\xf2\xd4\x39\x85\x72\x4f\xab\xdc\x73\x94\xb5\x41\x9e\x3a\x62\x3c\xd0\x4d\x93\x7b\x8e\x07\x9a\x37\x40\xaf\x72\xcf\x49\x40\xfa\x5e\x39\x7f\x9f\x06\x39\x3e\x5a\xc9\xdf\x4b\x0a\x1b\xe4\xa9\xeb\x56\x73\xd5\x2f\x15\xe8\x55\xe2\x51\x38\x5e\xee\x39\x6a\x9a\xdc\x73\xa5\x28\x60\xb5\xa0\x5f\xc3\x49\xeb\x02\x4b\xf4\x01\x13\x87\x77\xf9\xa6\x6c\xc8\x04\x76\x0b\x9b\x79\x2f\x73\x93\x90\x02\x25\xc4\x7a\xcb\x7d\x90\xc0\xdc\xcc\x31\x2e\x1b\x56\x47\xdb\x30\xa9\x81\x3c\xb9\x0d\xf2\xd4\x64\xb9\xa7\x0b\xe4\x1a\x66\x95\x7b\x0e\xb7\x62\xb5\xc0\x26\x88\x06\xd3\x17\x21\x83\x3a\xc7\x82\x89\x0b\xd1\x32\x48\x04\xe7\xd8\x10\x26\x2e\x8b\xff\x1f\x1e\xb2\x17\x7a\x59\x35\xd6\xf2\xe7\x2c\x41\x88\xde\xdb\xca\x58\x5b\x7f\x0b\x33\xdf\x5d\x23\x31\x69\xd1\x1d\xc4\x8a\x72\x9d\xc7\x9b\x45\x7f\xe3\x95\xa8\x83\x31\x09\x03\x82\xe6\x37\x89\x7a\xe8\x40\x78\x21\x7b\x68\x16\x07\x2c\xd7\x73\xf9\xf9\xc8\x3f\x7a\x6c\x75\x75\x9b\x3d\x1f\xb2\xd0\x7b\x5a\x81\x28\x7d\x3c\x34\x27\x31\xdf\xcb\x1f\xe5\x4b\x90\xb0\x26\xa3\x07\x2b\x2e\x1f\x81\xd5\xde\x39\x40\xa0\x06\x39\x01\xd9\x83\x89\xf9\xda\x9f\x10\xa2\x77\x31\x51\xe3\xda\x46\x90\x2e\x1f\xdc\x35\xf2\x25\x8d\x06\xb1\x1a\x97\x22\x44\x9f\x68\x61\xac\xb8\x5c\x48\xd1\x63\xe2\x2e\x84\xd6\xc2\xa0\xae\x3b\xb5\xfc\xf2\x97\x10\xa2\xe3\xce\x30\xb6\xb6\x2f\x6f\xba\x1e\x05\xbb\xda\x90\x7b\x20\xff\xed\x80\xcc\x14\xc8\x3f\x1a\x90\x07\x01\xd9\x17\x90\x2f\x09\x64\xd7\x5d\x82\xf1\x38\xc1\x99\xa5\x08\x45\x4d\x67\xa0\x7b\xd9\x75\xfb\xef\xf1\xdb\x0f\x08\xad\xfd\x8b\xa1\xfe\xc9\xcb\x10\xa2\x7b\x9a\x18\x5b\x7b\xa7\x28\xa5\xfa\x87\x36\xe4\x0a\x40\x56\x01\x32\x57\x20\xdf\x36\x20\xeb\x01\xf9\x16\x20\x6f\x11\x48\x62\x40\x9e\x02\x64\x19\x20\x07\x0b\xe4\x64\x03\xd2\x0a\x6e\xf7\x11\x43\xb1\x7f\x31\x20\x6f\x02\xe4\x9d\x06\xe4\x8d\x06\xe4\xdd\x80\xcc\x06\xe4\xad\x02\x99\x6e\x40\xce\x06\x64\x1a\x20\xff\x28\x90\x4d\xdf\xb7\x21\x97\x01\xb2\xb1\x91\xb1\xb5\x77\x08\xe4\x2e\x03\xf2\x1d\x40\xfe\x00\xc8\x61\x02\xb9\xde\x80\xac\x07\xe4\xe7\x80\xcc\x10\xc8\xd7\x0c\xc8\x5d\x2f\x22\x44\x57\x02\xf2\x1a\x81\x0c\x19\x90\x14\x72\x06\x01\x79\xbd\x40\x3e\x68\x40\x36\x00\xb2\x18\x90\x57\x0b\xe4\x50\x03\x32\xe5\x55\x84\xa8\x1b\x90\x0e\x81\xec\x6f\x40\xf6\x04\x64\x16\x20\xed\x02\x69\x35\x20\xf3\x00\x29\x1b\x72\x36\xec\x6c\x43\x3e\x08\xc8\x13\xa7\xdb\x72\x7e\x6b\x40\x3e\x0f\xc8\x1d\x80\x94\x05\xf2\x53\x03\x32\x15\xe4\x5c\x03\xc8\xfe\x02\xb9\xcc\x80\x5c\x09\x39\x97\x02\xd2\x25\x90\xf3\x0d\xc8\x8f\x00\x39\xef\x74\x9b\xe2\x1f\x30\x20\x77\x03\xf2\x7e\x40\x0e\x12\x48\x97\x01\xd9\x75\x05\x42\xd4\x79\xba\xad\x3d\x33\x0d\x48\x05\x90\xd7\x02\x72\xb8\x40\x76\x37\x20\x6f\x00\x64\x37\x40\xde\x28\x90\x47\xbe\x6b\x43\x3e\x00\xc8\xc3\xa7\xda\x44\xd9\xf6\x5d\xfb\x7e\x33\x7b\x05\x42\xd1\x2f\x4f\xb5\xf7\xff\xd9\x11\x7b\x88\x95\x5c\xab\xdd\xb3\x35\xd0\xdc\x75\x66\x1f\xed\x9e\x0d\x81\xe6\xcb\x67\x5e\xa6\x0d\x59\x91\x77\xb9\xbc\x38\x62\x0f\xb0\x71\xf2\xa2\xaa\xae\x5b\x83\xc7\x24\xb9\x22\x52\x5d\xae\x0d\x69\xcc\x93\x04\xc2\xc6\x11\x00\x35\x14\xa8\xc7\x3f\x1e\xef\xfe\x91\x64\x87\xf0\x71\xde\x1e\x75\x3b\x11\x1a\xdc\xe3\xad\xef\xf8\xf0\xf9\xf7\x9d\x08\xf9\x4a\xb0\xd6\xcb\xed\xd0\x4a\x15\x09\x7b\x19\xae\xfc\xc5\x84\xa5\x6a\xba\xfa\x24\xc4\x47\xfb\x78\x38\x93\x8c\x49\x1d\x9d\xda\x00\x7e\x2c\xf9\xf6\x5a\x93\x0d\x61\xaf\x39\xb0\x13\xe4\xe8\x65\xe7\xf4\xc9\xbd\xef\x87\x64\x5d\x01\xdf\x3d\xac\x2b\xe8\x25\x1e\x7c\xcf\x36\x7c\xd8\xff\x17\x5c\x57\x60\x43\xba\xcb\xd3\x83\x1e\xf0\x17\x5a\x51\x57\x09\x93\x3a\x92\x7e\x19\x70\xe3\xad\xc7\xda\xc0\xbb\x70\xe5\x7e\x13\x2d\x38\x09\xee\xce\x9c\xba\x53\x04\x8e\x7f\xc2\xde\x1e\xd6\x9d\x08\x45\x47\xf0\xd8\x00\xc2\xe7\x9e\x3b\x11\xa2\xe7\xb8\x1f\x2e\xb0\x19\x8a\xc5\x5a\xfe\x8e\xe7\x11\xa2\x8f\x35\x31\x56\xee\xb8\x07\x7b\x99\xe3\x6e\xc7\x48\x47\x91\x47\x53\xe6\x8f\x74\x57\xfe\x6a\x02\x35\x64\x78\x92\xfb\xae\xfe\x0e\x21\x77\xb0\x54\xb1\x22\x0f\x99\xa0\x58\x7c\x97\xe0\xe0\xc6\x0c\x51\x88\xe3\x3e\xc7\x38\xce\x9f\xa3\xce\xa9\xf4\x82\xf8\xd8\xa3\xe6\xaf\xfc\x0e\xa1\xe1\xea\xac\x4c\x0f\x69\xa4\x1f\x9f\x60\xcc\xa5\x4e\x56\x32\xdc\xea\x18\xc5\xe2\x0a\x1f\x9e\x57\xe5\xca\xfe\x15\x7b\x69\xec\x06\x3d\xee\x38\xe8\x21\xa7\xc1\x5f\x0f\x3d\xc1\x58\x38\xe2\xef\x2e\x57\x98\xae\x0b\xd2\xec\x3c\xcb\xcc\xeb\x1c\xc1\x8d\x12\x44\x41\xae\xdc\x43\x73\x55\xb9\x22\x39\x14\xf1\x7d\xed\xb8\x75\xc8\x00\xff\xe6\x38\x26\xf6\x63\x22\xbe\x71\x2a\x96\x3a\x7b\x2f\x5d\x75\xed\xd9\x73\x22\x04\x83\x3f\xbd\xf6\x04\xc4\x69\x25\x12\x6d\x3d\xc1\x98\x5c\x71\x09\x26\x95\xa1\x7a\xff\x65\xb1\xe1\xc5\xe5\xe4\x3b\x27\xa9\xb2\x97\xe5\x62\xd2\x80\x83\x95\x52\xa8\xde\x97\xe3\x94\x2b\x0a\x24\xac\xcd\x90\x02\xcd\x63\xfc\x7b\x36\x8c\x41\x08\x39\x49\x95\x23\xd0\xf4\xa8\xff\x5b\xb9\x62\x44\x12\xd6\x66\x24\xf1\x98\x09\x32\xd8\xcb\x66\x33\x4c\x1a\x42\xf5\xfe\x4d\xb5\x12\x1a\x5d\xcd\xeb\xfb\xe4\xb8\x5e\xdf\x33\xbc\xbe\xc1\x6e\x12\xa1\x05\x2d\x20\xa6\x1c\x7a\x59\x42\x88\x9c\xb1\x87\x98\x1c\x7a\x5c\x42\x88\xdb\xe7\xcc\x14\xfb\xe9\xba\xf1\xbe\xdb\x3d\x24\x82\xb5\xfc\x97\xdf\x42\x28\x31\xfe\xca\x15\x23\xa4\xc0\x99\x31\xbe\x5b\x02\x67\x6c\x72\xe8\xcf\x12\x42\x65\x16\xb2\x0d\x54\x80\x83\x11\x29\xb4\xd9\xf7\xe8\xe9\xca\xf1\xfe\xef\xb5\xbf\x42\xb0\x38\x73\x1b\xd6\xf2\xa7\x83\xcb\x7f\xf1\x34\x58\x81\x13\xa1\xec\xcd\x81\xe6\xab\xe4\x45\x95\x58\xf3\x75\x97\xe4\x8a\x8b\x82\xd1\x6b\x02\x67\x8a\xe4\x85\xbf\xf2\xb2\x37\x63\x6f\xea\xb3\x58\x3a\xc3\x7e\x8e\xd7\x07\xd4\xa1\x28\x47\x0e\x4b\x82\x6a\x6a\x51\x12\xd4\x04\xaf\xa1\xcd\xfe\xe7\x79\xf8\x1e\x19\x9d\x18\xbf\x6b\x51\x57\x5e\x4f\xa0\xf9\x2a\xff\x35\x72\xc5\xb0\xe4\x00\x0a\xe0\x60\x75\x52\xf4\x5e\x61\x87\xb5\xa8\x7b\xa0\xf9\x2a\xdf\x6f\x6d\xf2\xdc\x29\x61\xd2\xa0\x87\xb0\x76\x54\x8b\xba\xc5\xaa\x39\x9d\x25\xb6\x8e\x3f\xa5\xd8\x47\xfc\x69\x8e\xbd\x27\xf7\x81\x72\x7d\x63\x81\x97\x5a\x64\x02\x1e\xa2\x52\xbc\xdc\xd4\x98\xca\x9f\xc9\xb1\x20\x7f\xa6\x44\x4b\x60\x0a\x84\xba\xf0\x79\x7c\x1b\x7f\x63\xc8\xb6\xe8\x97\x22\xa4\x71\x42\x5a\x22\xdb\xa2\x9f\xb5\x42\xcf\x8d\x44\xaf\x87\x98\x41\xcb\xff\xe6\x59\x84\xe8\x03\xa7\xf8\xbb\x17\x86\xf3\xbb\x4f\xc5\xe9\xc1\x23\xb8\x49\x93\x5c\x21\x87\x4f\xfb\xac\x72\xc5\x19\xfa\xf8\x59\xd1\x90\x25\x12\x42\x81\x33\x57\xcb\xa1\x3c\x09\x21\x68\x84\x2e\x9a\xa3\x31\x4f\x9a\x35\xd4\x43\x6a\xb1\x96\x7f\xed\x5b\x3c\xde\x85\xb6\x1b\xc6\xdb\xae\x1b\xb4\xdd\x45\x1e\x52\x15\xb8\x15\xc9\xa1\xab\x25\xd0\xc5\x50\xc9\xa3\xdd\x2e\xe1\x60\xad\xc4\x4b\x3a\x85\x44\x49\x5f\xf0\x92\x66\x42\x4f\x7d\x13\xc2\xed\xba\x93\x6d\xf3\x35\xb9\x02\x4b\xd9\x91\xc0\x99\xab\xe4\x45\x11\xb7\x56\x84\x24\xb9\x22\x29\x48\xaf\xce\x1b\x29\x2f\xac\xe2\xfa\x8d\x60\xaf\x69\x21\x96\x9a\x60\x2a\x6a\x47\x40\x18\xaa\x04\x45\xa4\x24\xc5\xc2\xc5\xe5\xb5\x29\x7a\x7b\x9d\xb9\x4a\x0e\x4f\x67\x8c\x05\x52\x02\xd1\x87\xdb\xe6\x13\xb5\x29\xdd\x63\xbf\x80\x3e\x53\xba\xc5\x76\xf1\xa7\x25\xb6\x9d\x3f\xa5\xd8\x7f\xf8\xd3\xcc\xdb\x2b\x70\xe6\x2a\xdf\x94\xda\x14\x13\x57\x21\x87\xa7\xf2\xf6\xaa\x4d\x49\x8e\xbd\xc1\x9f\x29\xb1\xa5\xfc\xd9\x25\xf6\x82\x4e\xdf\xb7\xec\xa2\x28\x6b\xd5\xdb\x3d\x45\xf2\x90\x2a\xbe\xe4\xe1\x21\xb5\xb5\x29\xc8\xad\xd9\x25\x1e\xee\x61\x2d\xff\x32\x10\xba\xbe\x81\xb7\xc7\x60\x78\xaf\x15\xef\x59\xcf\x20\x44\xd7\x8a\xf7\x75\x2f\xc3\xfc\xbe\xa1\xe3\xfc\x42\xae\xe8\x92\x67\x9b\x75\x35\x5e\x7b\x65\x8a\x38\xf8\x12\xf7\x13\xf2\x1b\x55\x33\xba\xe3\x60\x9d\xc4\x29\x66\x1e\x0d\x45\xe4\x45\x91\x0e\x83\x81\xdc\x07\x45\xd7\x1d\x6f\x57\x24\x56\x87\x58\xf8\x94\x61\x3b\x9f\x4c\x38\xc8\x37\x89\x19\x26\xa9\xa3\x17\x9d\xe3\x71\x38\xc2\xea\x1c\x2b\x0e\x33\x39\xb4\x1c\x82\xe0\xa6\xb3\x1c\x6a\xc1\xea\x9c\x0c\x0e\x85\xf9\x22\xdd\x27\xa0\x56\xac\xce\xb1\x41\x54\xed\xa7\x5b\x05\x24\x03\xab\x73\x32\x01\xe2\xa5\xeb\xcf\xc6\xe3\xfa\x39\x03\x00\xf2\x57\xfa\xbe\x80\xe4\x60\x75\x4e\x0e\x40\x6e\xa3\xaf\x08\x88\x7d\x0d\x48\xe7\x0a\xff\xea\xbf\x1e\xab\x73\xec\x80\xbb\x86\xce\x13\xb8\x4c\xac\x16\x60\x18\x15\x7a\xca\x15\xa8\xcc\x35\x80\x93\xc6\x52\x40\xef\xd9\x11\x1e\xeb\x27\xfc\x4b\x76\xa4\x7d\x7c\x1d\x3c\x5a\x60\x0f\xb0\x01\xb3\xfc\x58\x4b\xff\xec\x0d\x84\xec\x72\x05\xc3\x5e\xd3\x5a\x2c\x6d\x05\x83\x42\x6b\x92\xf9\xa2\x42\x22\xc7\x1a\x53\x87\xb4\xbd\x43\xda\xdc\x21\xdd\xa5\x7d\x3a\x3b\x22\xfc\x34\x67\xb1\xba\x10\xfc\x17\xcc\x41\x76\x1c\xd7\xfd\x97\xe3\x1e\x31\x38\xf1\x05\x8a\x8c\xc0\xad\x08\x93\x3c\xc5\x49\x9c\x8a\xc5\x49\x3c\x4a\xaa\x1c\xb2\x48\xd0\x48\x26\xc5\x4d\x4e\x62\xb2\xc3\x4d\xbe\xf2\xa8\x7c\xbe\x35\x3c\x7c\x58\x0e\xad\x87\x17\x72\x34\x76\x69\x42\xde\xe1\xea\x7c\xdb\x70\x68\x93\x37\x10\x42\x6e\xb5\x8f\x98\x8d\xd3\xf0\x21\x3e\x08\xfd\xec\x56\xe7\x5b\x3c\xe4\x34\xf5\xf3\xb4\x1c\xae\x10\x73\x0c\x3a\x31\xc6\x18\xe0\xe8\x98\x18\x78\x74\xa7\x62\x71\x93\x1b\x3c\xe4\xc0\x70\x32\xcb\xe2\x28\x1b\x62\x05\x50\xaa\xa3\x6c\x48\x4f\xba\x2e\x06\xd3\x8f\xa6\x64\x7f\xb1\x43\x5e\x9f\xa7\xd0\xf7\x5a\x19\x73\x90\x21\x69\x0e\x79\x7d\xa9\x22\xd1\x65\x22\x99\xc1\x93\x49\x54\x13\xc9\x5e\x1d\x86\xae\x36\xfd\x88\xf9\xde\x1d\x87\x60\xbe\x5d\xe7\x21\x93\x6c\x20\xab\x87\xac\x01\x11\xa3\x33\x84\xfb\xb2\xc3\xdc\xe5\x8d\x63\xfc\xbd\x08\xde\x5f\x12\xef\x01\x78\x57\x8f\xb5\xb3\x67\xa1\x4d\x37\x39\x39\x72\x84\x61\x32\xe7\x71\x93\x56\x57\xf6\xe1\x58\x6a\xb9\x9b\xfc\x84\xc3\xf5\xbe\xc1\x98\x6c\xc7\xea\x75\xfa\x52\xcb\x83\x20\x93\x3a\xc2\x16\x8e\xf8\xf7\x0c\x0f\x1f\xf6\xdd\xe8\x26\x23\x6d\x80\xb8\xf3\xa0\x3e\xdd\x73\x1d\x14\x93\xaf\xfc\x83\x8c\x8d\xf6\x90\x03\xba\x28\xd1\xfe\x07\xf5\x76\x74\x93\xc5\x7c\x0e\xbb\xb9\xc3\x7a\xd4\xc8\xc4\xcc\xd2\xec\xfe\x1a\x5a\x72\x14\x56\x47\xa4\x86\xeb\x7d\x7f\xc4\xde\x02\x0b\x56\x07\x6b\xd3\xa4\xdc\x13\xbe\xf1\x64\x86\xc5\x5e\x96\xd5\x45\x9f\x3e\xb6\x95\xa1\xf6\xb8\x75\x3b\x42\xe1\xcd\xbe\x14\x7b\x80\x75\xf3\x4d\xb3\x07\x5a\x7a\xf8\xbe\xb7\xcb\xeb\x0f\x63\x75\x86\x95\x1c\xa5\xea\x41\xc6\x54\xb3\xb6\x05\xa1\x70\xc4\x17\xc1\xea\xa0\xa1\x5b\x44\x90\x45\x8e\x8e\x1e\x1b\x9d\x12\xe7\x4f\x3a\x4d\x66\xa4\x62\xef\x69\x1a\xe3\x92\x24\x63\xd5\xfc\xe9\x57\x82\x1f\x98\xde\x86\x99\x6f\x14\x30\xa4\x4d\x93\xa2\x8f\x31\xe3\xfc\x58\x9d\x61\xdd\xd0\x03\xda\xe8\x6a\xa8\x28\x5d\xd9\xc2\x97\x59\x32\xb0\x6a\x9e\xad\xe7\x8f\x3e\x6a\x18\x8f\xb0\x3a\x68\xf3\x97\x08\x89\x2c\x47\xa3\x8c\xc5\x76\x60\x2d\xdf\x45\x10\xa2\x3f\x1f\x61\xac\x3c\xbe\x10\xd7\x7b\xf0\x57\x08\xa9\x05\x69\xb9\x4d\xf3\x52\x75\xa1\x05\x9f\x4d\xa4\x20\x0d\x7b\x9b\xe8\x4d\xc0\xa7\x77\x84\x15\xab\x37\x61\x52\x87\x49\x0d\x95\xe2\x8d\xb1\x86\xc2\x8b\xf9\x0c\x08\x4a\x32\x31\x99\x64\x49\x94\x80\x46\x8e\xc0\xde\x3d\x50\xc5\x82\x7c\x6d\x33\x42\xc5\x2d\x8c\xc9\xa1\x65\x9c\xb1\xf4\x4d\x5c\x33\x8f\x67\x22\xdf\x6c\xb2\x87\xc2\xe0\x80\x55\xf3\x47\x5b\x10\x1a\x3c\xca\x5a\x7a\x4f\xf6\xe1\x58\xd7\xe2\x72\xac\x9a\x5f\x03\x32\xb5\x24\x93\xda\xa2\x8c\x05\x9b\x7a\xf8\x62\xc1\x7c\xe7\x66\x84\xba\x61\xb5\x47\x78\x0b\x42\x7e\x53\xd0\x91\x21\x61\xef\x5f\xad\xc1\x26\x86\xd5\x6c\x62\xbe\x76\x33\x42\xb2\xb3\x46\x7b\x5c\x22\xb3\xad\x65\xa6\x4b\xb1\x6a\x1e\x23\x8a\x9d\x5b\x81\x55\x24\x57\x98\x32\x74\x0e\xcb\xb3\x23\xb9\x7f\xb3\xce\x3b\x8e\xd5\x24\x03\x14\xab\x66\xe7\x16\x84\x3e\x4d\x12\x13\x83\x43\x0b\x81\x81\x69\x85\x0d\x6f\xcb\x2f\x45\x7a\xa1\x50\x44\x0e\xff\x99\x31\x56\x67\x5e\xb7\x49\x1c\x16\x8c\xde\xc0\xe2\xe3\xb7\x58\xba\x80\xe0\xee\x90\x45\xbd\x4d\x4d\xc9\xad\x9b\x7b\x13\x6d\x6c\x65\x4c\x2c\xaf\xcd\xfc\x4b\x9e\xcd\x37\x4c\x9d\x8b\xd4\xe4\xdc\xba\xb9\x97\x6b\x25\x8c\xfe\xd0\x86\xbc\x3a\xcf\xe6\xbb\x52\x47\xca\xcb\xf8\x9a\xb1\x5c\x11\x69\x6f\x7f\xc9\x41\x2a\x91\x64\x75\x2e\xca\x6d\x9a\x1f\x89\x7d\xa7\xba\x90\x56\x22\x11\x91\xa7\xf4\xc5\x58\xed\x05\xec\x9d\xc4\xf8\xaa\xc7\x63\xad\x8c\x85\x22\xbe\xeb\xb0\x9a\x5e\xfc\x25\x42\x39\xb7\x0f\xf0\x65\x93\x58\x4c\xe6\x7c\x07\xab\x25\xb2\x87\xde\x09\xa3\x65\x45\x13\x4d\x69\x00\x77\xe4\x3b\xc8\xed\x76\xe7\x68\xc3\x7a\xa8\x36\x16\xe8\xfe\x28\x8a\xda\x2b\x57\x34\x69\x63\x93\xe8\x77\x22\xa4\xde\x46\xf6\x90\x9f\x68\xb7\x56\xc6\x62\xef\xc4\xe9\x47\x90\xb3\x31\xb3\xdc\x07\x91\x6a\xb2\x87\x4e\x65\x8c\xe5\x56\xfb\x1b\xc8\xce\xd1\xf1\xf5\x66\x72\x8c\x1c\x5f\x0b\xbe\xb8\xb2\xc9\x1c\xfc\xb9\x0f\x5e\x82\xb7\xef\xc3\xe1\xb4\x3a\xf9\x45\x3e\x58\x96\x83\xff\x70\x93\x53\xe0\x90\xe5\x8a\x2e\x4e\xf5\xe6\x50\x44\x0e\x3d\xc3\xbd\x64\x8b\x47\xeb\x3b\xfc\x55\x10\xd3\x89\xd0\xaa\xf1\x72\xf8\x18\x42\x28\xb8\xb7\x4f\x5e\xaa\xbc\xf0\xca\x24\x1e\x9a\xb8\xbd\xa6\x90\x47\xda\xcb\x17\xb9\x05\x3f\x4e\xd2\xe8\xd0\xee\x93\xe8\x84\x16\xb0\xb6\xfc\xb1\xff\xd1\x27\x88\xf2\xfa\xad\xc1\xbd\x7d\x48\x65\x65\xb3\x19\x6f\xdf\x8f\xd5\x67\xf9\x32\x99\xb7\x1e\x87\x07\x6e\x93\x43\xc5\x49\x08\x05\xe6\x22\x24\x97\xbd\xa6\xeb\xd5\x41\x76\xe3\x60\x8d\x24\x57\xb8\x50\x28\xe2\x5f\x30\x9a\x8f\x27\x63\x5d\xc4\x8f\x84\x27\x8a\xcb\x17\xaf\xef\x24\x8c\xdc\x6a\x8f\xc3\x5f\xf0\xaa\xa0\x16\xf5\xd3\x55\xa2\x8a\xe0\xde\x3e\x9c\x05\x07\xd9\x4d\x2a\xb3\x23\x50\x7d\x78\xe0\x77\xf2\x8b\x11\x52\x15\x3c\x21\xf1\x6a\x71\x55\x38\xe2\xeb\xd7\x56\xa1\xef\xd9\x55\xe3\xe5\x90\x95\x31\x16\x2f\x7f\xd1\x39\xc6\x6e\x75\x21\x39\xe4\x65\x8c\x8d\xce\x8e\xe8\x0e\xb1\x3a\x8e\xf7\x9d\x03\xc7\xbf\x3b\xaf\x9b\x88\x42\x20\x66\x6a\xc7\x5f\x91\x8e\xef\x2a\xf0\x7c\xaf\x0a\xe4\x8c\xe3\x07\x0a\xfe\xfb\x1b\xf9\x5f\x23\xf8\x8f\x0e\x36\xf8\x9b\x38\xbd\x49\x94\x17\xa4\x03\xf2\xba\xca\x8b\x79\x91\xfd\x19\x63\xe5\x71\xfc\xaf\x67\x19\x23\x55\xc1\x7d\x7b\x82\x07\x1d\x81\xe6\xcb\xe5\x27\x97\xb7\x32\x96\x67\x93\x43\x2f\xc2\xb3\xab\x1c\x82\x91\x2a\xaf\x9b\x1c\x5e\x11\xdf\x39\x12\xfd\x2b\x9e\xff\xcd\xb3\xa2\xfc\xbd\x7b\x82\xd4\xc1\xa7\xe2\x50\xc5\x17\x06\xba\x06\x08\x40\x84\x5d\x3b\xc8\x6e\x3a\xe3\x6c\xa2\x9b\xc5\xf2\x6c\xbe\x03\x5c\xaf\xe4\xdd\x56\xc6\xa2\x5b\x5a\xcf\xe7\xdf\xd9\x46\xbe\x21\xcf\xe6\xef\x15\xfb\xb4\x7d\xfd\xca\x59\x60\xd7\x77\x4d\x5e\x57\x5f\x1f\x51\xd4\x42\x3e\xb4\xee\x8e\xfe\xd6\x62\x5c\x0f\x4e\xd4\xdf\xd0\xcc\x33\x1c\xcd\xeb\xea\x8b\x89\x0c\xfd\xa1\xee\xbf\xb6\xc6\xf5\xdc\x10\xfb\x96\xcf\xa3\x9f\xe5\x66\x11\x1e\xb8\xcd\xf7\x8e\xa0\x7b\xae\x85\xb1\x68\x3f\x9d\x2e\x70\x1b\xf2\x77\x17\xc1\xcb\x79\xfb\x0f\xea\x9f\xa9\xd6\x0c\x1d\xd3\x6f\x0e\x0c\xee\xe6\x2b\xd2\x63\x8d\x4f\xcf\xf1\xce\x0c\x5e\xf0\xd1\x4d\x08\xa9\x01\xbe\x34\x1f\xf1\xa7\xf1\x62\x12\xae\x5a\xf7\x2f\xe0\x4f\x18\x75\x35\x27\xc4\x37\xe5\xd9\xfc\x3b\x82\x53\x90\x14\xfb\x1a\xe2\xea\x39\x12\xf2\xbf\x1d\xdb\x18\xa7\x37\xd6\x7f\xba\xcb\x78\xff\x2c\x35\x05\xf2\x9b\x9b\xf9\xc4\x37\x54\xef\x9b\x47\xbe\x0d\xee\xef\x63\x0f\xb4\xa6\xce\x1a\x81\xb5\xfc\xad\xaf\xf0\x18\xef\x34\xf6\xa6\x7e\xa2\x4f\x04\xb9\x1d\xca\xeb\x8f\x61\x35\xff\xab\x3a\xde\x83\x49\x25\x67\xad\xb2\xd5\x8c\xb7\xef\x4d\xf4\xc5\xd3\x38\x6c\x3a\xee\xbf\x28\xa1\x21\xd3\x71\x5f\x6e\x79\xa8\xbe\x24\x47\xcc\x4e\x0d\x3b\x1d\x75\x67\x13\xf3\x8b\xf6\x32\xda\x51\x9e\xcd\xf7\x65\x5e\x57\xdf\xa6\x50\x7d\xc9\xa6\xec\x48\xec\xb7\x3c\x9b\xef\xb3\xbc\xae\xbe\x35\x79\xdd\x7c\x1f\xb7\x37\x26\x52\x19\x3c\x2e\x85\xea\x65\x5c\x19\x8e\xf8\x97\xc5\xd6\x89\xf6\xcf\xb3\xf9\x9e\xce\xeb\xea\x2b\xcb\xeb\xe6\xff\x2c\xf6\x44\x71\xb9\xc1\xc0\x63\x5b\x78\xfb\xf5\xe8\x5a\xc7\xc5\xa9\x6c\x35\x7b\xd4\x05\xa2\x8f\x9f\x0e\xee\xed\xc3\xf7\x17\x44\x07\x3f\xe0\x0e\xe7\x1d\x93\x5f\x8c\xc4\x96\x96\x27\x3a\x64\xb9\x28\x9f\xeb\xa1\x47\x6d\xad\x90\xde\x7b\xda\xa3\x2e\x80\x6e\x16\x5b\xaf\xcb\x93\xe8\xa0\x63\xc5\x3c\xe8\x82\xfe\xb3\x6b\x5a\x5d\xdc\xff\xf0\xcd\x08\x88\x91\xe9\xf4\x7d\x10\x68\x54\x61\x0d\xdb\x81\xcf\xba\x24\xb1\x84\x51\x65\xc1\xb9\xdb\xfc\xc7\xe4\x8a\x4b\xb8\x13\xf2\x68\xe6\xfb\x5f\x46\x28\x54\x2f\xe6\x16\xe5\xa7\xab\xc6\xcb\xe1\x03\x88\x43\xca\xee\x95\x10\x0a\xee\xeb\x13\x68\x4e\x95\x17\x7e\xc8\x27\x7f\xf5\x6e\x6f\x5a\xb9\x47\xaa\x62\xfb\x12\xfb\x43\xd8\x22\xe1\x05\x16\x94\xc2\xb7\x4c\xad\x9f\x4a\xfa\x3e\x0c\x69\xc4\xdb\x0f\x04\x2b\x25\xdc\xb5\x39\x78\xe6\x0f\xfe\xa3\x98\x14\x5a\xb1\x5a\x98\xc1\x09\x0c\xf3\x9f\x0b\xd0\x65\xc8\x15\x23\xa4\x44\xf9\xd5\xd0\x28\xe1\x09\xe0\xe6\xaa\x21\x3e\xb0\x06\xbe\x32\xf1\x5a\x03\x5f\x25\x61\x62\x05\xda\xd8\xf7\x86\x78\xc9\x1a\xfb\x19\xea\x5f\x60\x41\x26\xb1\xbc\xc1\xe7\xfd\x00\xff\x8a\xc3\xb1\x05\x21\x96\xcc\x11\xaf\x17\x97\x3b\xe5\x8a\x61\x92\x23\x70\xa6\xbf\xaf\x2f\x26\xdf\x05\xf7\xf5\xc1\x95\x47\x93\xc8\x36\x4c\x4e\x04\x0f\xe4\x08\xdd\xe6\x38\xc9\x17\x51\x5f\xdc\x3f\xca\x15\x23\x92\x1c\xa7\xab\xc6\xfb\xb6\x3a\x72\xea\xa7\xff\x07\x93\xef\xc8\x36\x37\x69\x08\x56\x49\x90\x33\xf8\x73\x9f\xe0\x81\x1c\xf7\xf6\x5f\x3c\x5d\x7b\xe5\x38\x72\xea\x67\xec\x91\x2b\x46\x24\xf3\x05\x8b\x1b\x45\x30\x5d\xfd\x34\xcc\xff\x7f\xe3\xef\xb3\xe0\x7d\xe8\x6f\xed\xf7\x93\x1c\xe4\x24\x04\x15\x98\x7c\x37\x02\x93\xed\xf4\x3f\x7b\x18\xcb\xae\x77\xe4\x6e\xf4\xe7\xbb\xc2\xbf\xce\xbb\x49\xae\x48\xce\x71\x21\xff\x00\x87\x56\xa4\x30\xdd\xda\xab\xa4\xd8\xa5\xf1\x7e\x55\xe1\x31\x23\x1c\x8c\x48\xb7\x7a\x92\x99\xbf\x27\xce\xdd\xea\x3f\xc4\xed\x65\x34\xd9\x0a\x13\xb3\xec\xfa\xd1\x63\xc9\x56\xc7\x98\xb8\xbd\x38\xee\x76\xaa\x83\x6e\xd9\x88\x90\x98\x04\xad\x63\x8c\x31\x57\xf8\xf4\xbc\x9b\x1d\xe4\x94\x9b\x9c\xc3\x6a\xff\x6e\x1b\x11\xca\xde\x15\xbb\xb8\x38\x1e\x37\xe0\x60\xcd\x00\x67\x6e\x83\x4f\xc1\xea\x48\xe4\x24\x47\x3c\xe4\x00\x75\x31\xee\x73\x7e\x8e\x6f\xa8\x3a\xee\xab\x16\xf4\xbc\x40\x03\x14\xab\xe6\xca\x6a\x7d\x9a\xf0\x39\xe0\xa0\x56\x8f\x3a\xcd\xee\x56\x2f\x85\x89\xc1\xf5\x0e\x98\x67\xb5\x40\x85\xfa\xfa\x48\xb5\xe4\x56\xd3\x76\x03\xee\x1a\x27\x9f\x2e\x38\xc8\x4f\x74\x47\xab\xa8\x6f\xb4\x93\xd0\xb1\x89\x11\x18\xe6\x73\xbc\xd0\xd1\x63\x9d\x84\xc6\xf9\x68\x37\xff\x0e\x32\x49\x7e\xe6\x25\xde\x51\xd4\xde\xb7\x54\x23\xa4\xfd\x85\xa9\x05\xd6\xdc\x4d\xf3\x7b\xeb\x4b\xa7\xf1\xd9\xf2\x44\x7a\x6c\x37\x63\xa4\xa0\x10\x62\x17\xd4\x6f\x13\x56\x2f\x09\x36\x4d\x98\x5f\x9c\x1d\xc1\x5e\x16\xeb\x96\x58\x1f\xbc\x04\x6b\x03\x93\xe4\x0a\xdf\x9f\x99\xbd\x6c\x60\x12\x56\x7b\xff\x5c\x85\x78\x0b\x68\x03\x25\x75\x84\x35\xf7\xc4\xfc\x5d\x58\x4d\xee\xf7\x05\x19\x61\x0d\xe4\x20\xd1\x9b\x49\x41\x61\xb0\x59\x9a\xb7\x68\x74\x75\x5d\x41\x21\x6f\x9d\xea\x8e\xfb\xfa\xde\xe3\xa4\xc0\x9a\x1d\xc1\xd2\x89\x7e\xc3\xd2\x30\xb9\x24\x70\x2b\xe4\xe5\xec\x18\xd7\xab\x61\xfe\xea\x26\xad\xd0\x7a\x60\x38\x64\xc7\x08\xf2\x1f\x1c\x3c\x94\x09\x53\xd8\x54\x8f\x36\x59\x49\x9b\x80\x55\x53\x5f\xbe\xaf\x44\x9c\x4a\x46\x76\x44\xb4\x0b\xd9\x43\xff\xca\x97\x97\x8f\x78\x48\x23\x19\xa3\xa4\x39\x48\x1d\x7d\xf6\x0c\x8f\xeb\x46\xb8\x42\xcc\x37\xd5\x99\xd3\xe2\xeb\xe7\xca\x39\xe0\xeb\x75\xda\xa9\xa4\x9a\x7c\x3d\xb0\x6a\xbe\xb2\x0a\x21\x67\x4e\xc9\x00\x9f\x25\x58\x2d\xfa\x55\xb0\x3a\x89\xaf\xa7\x65\x1f\x2e\x0e\xe6\xbf\xfe\x39\x42\xd2\xbc\xff\xc0\x84\xe3\x29\x84\xe8\xea\x7d\x8c\xc5\x3e\x8f\xef\xe3\x79\x94\x8c\x09\xf8\xa6\x54\xce\x0a\xd9\xe9\xff\x03\x0e\x6e\xcc\x34\x4c\x49\xb1\x96\xbf\x17\x66\x29\x83\xf7\x31\x46\xf7\xff\x18\x1f\xd7\x1d\x77\x7b\xbc\xa7\x1d\x23\x1d\x64\x07\xcc\x2a\xcf\x8d\x1c\xe1\x50\xf3\x14\xba\xb8\x81\xc7\xa3\x05\x58\x35\xab\x95\x50\xf8\x92\xf8\x4c\x73\x80\x5b\x7b\xd4\x24\xf7\x41\xaa\xf3\x3d\xd3\x2a\xbb\x3f\x23\x1c\xf1\x99\x5d\xe1\xc3\x7e\x19\xab\x83\x3d\x64\x3f\xcd\x13\x22\x66\xf0\x9d\xcd\xf0\x2e\xff\x77\x1d\xec\x55\x5e\x3f\xc3\x0a\x93\x1c\x79\xfd\x88\x9e\x77\xa8\xce\x8f\xd2\x1c\x6a\xaa\x42\x17\xb7\x8a\xa9\x8f\x87\xec\x77\x10\x93\x42\x8b\x9b\x18\x8b\xc2\x04\x06\xe6\x2b\xc7\x23\x08\xa9\xf6\xa2\x70\xc4\xdf\x2d\x3a\x9c\xe9\xf3\xbd\x44\xff\xba\xc7\x4d\x1a\x31\x69\x85\x56\xfa\x56\xdf\x0c\x18\x4e\xbe\xe4\xd3\xb0\x1c\xac\x86\x94\x00\x37\xa2\xc9\xca\x34\x4c\x52\x15\x0f\x71\x2a\x16\x68\x27\x6b\xbb\x56\xcb\xcc\x8e\xc0\xbc\x28\x02\x94\x39\x74\x76\x13\x17\xa1\x2f\x00\x3d\x4a\x66\x42\xa9\x72\x38\x35\x89\x8f\x7d\x39\x06\xb5\x3a\xd4\xb9\x88\x1e\x68\x80\x50\xa5\x4b\x38\xe2\x93\x61\xb0\x1e\x75\x82\x6b\x6f\x83\x43\xed\xe2\x20\x29\x58\x35\x0f\x89\x20\xe4\x21\xa7\xb1\x5a\x62\xa1\x6a\x23\x2f\xbd\x8b\xa3\x2e\x85\x77\x4f\xb5\x0b\xd6\xc6\x28\x0a\x26\xbb\x89\x53\x51\xb0\x6a\xbe\x82\xb3\x51\x62\xe5\xf6\xa3\x7a\x94\x12\x52\x15\xde\xec\xbb\x11\xab\x3d\xe8\x06\x84\x1c\xea\x2d\xc4\xa9\xf4\x72\x7b\x5d\xa8\x5f\x2d\x4e\x96\x30\xa9\xa3\x4b\xce\x82\xf6\x3c\x8a\x55\x2d\x52\x7a\x85\x23\x44\x92\xcb\xea\x91\x58\x55\xa9\xe3\x67\x94\xc2\xf5\x72\xe8\x0b\x84\x50\x9d\x4b\x5f\xb3\x71\xaa\xa5\x50\x97\x47\xb1\xe0\x25\xae\xec\xc3\x4e\x92\xb7\x20\x66\x11\xf6\x40\xea\x9c\x84\xd1\x74\x90\x28\xb7\xce\x7f\x0c\xab\xe9\x8f\x6d\x40\x08\x78\x53\xef\x2a\x0a\xd7\xfb\xd3\xd5\x8f\x95\x32\x28\xa4\x1e\x62\xa1\xcf\x5b\x18\x53\x9d\x8a\x42\xea\xe8\x03\xc7\xb9\xd4\x13\xa1\x68\x3a\xf2\x38\xdf\x51\xb8\x73\x03\x1f\x81\xdd\xea\xfc\x1c\xfa\xc4\x0f\x7c\xf3\xde\x37\x12\x08\x1c\xc1\x1a\x89\x0e\x38\xce\x18\x1f\x9f\xcd\x7f\xdc\xc0\x65\xb6\xd3\xb1\x3f\xf0\xd5\x17\xc9\x11\xdc\xc1\xcb\x75\x6a\x25\x92\x93\x94\x2a\x4a\xb4\x98\x4f\x67\xe3\x12\x39\xd4\x94\xc6\xad\x95\xb4\x4f\xf4\x5e\x3d\x8e\x83\x42\xc1\x0e\xff\x8e\xbd\x2e\x84\xc9\x6e\x07\xc9\x0b\xb9\xd5\xf9\x19\xb4\x3b\x9f\x7a\xf9\x6f\xc6\xaa\x39\xb2\x1e\x9a\x7b\x37\x56\x4b\x6c\xd4\x74\x1a\x9a\xac\x26\x1c\xf1\x2d\x51\xb9\xde\x53\x95\xa0\x0b\x49\x44\x0a\x47\x4a\x5e\xe7\x6e\x3f\xda\x0a\xb2\xb9\x50\x30\x22\x11\x17\x0a\x36\x79\xe5\x67\x6a\x5a\xf9\xb0\xb2\x60\x36\x42\xb4\x61\x37\x63\xf4\xd5\xef\x3b\xae\x5f\x0a\x7f\x01\x3d\xaa\x68\x38\xd9\x38\x12\x8b\x4d\x29\x0c\x96\x58\xc6\xad\x0d\x74\xae\x8d\x51\xb2\x3c\x24\x15\x1a\xdd\xa3\x7e\x26\x4c\x14\x8c\x12\xa8\xca\xe3\x9e\xa4\x9d\x85\xe6\x70\x0b\x65\x9f\x23\x44\x4a\x95\x2c\xac\x96\x8c\xa2\x37\x9e\x10\xeb\xd8\x5f\x27\x21\xe4\x54\x27\x2b\x59\x2e\x72\xbd\x43\xbd\x25\x7c\x5a\x0e\x2b\x12\x42\x8e\x3a\xfe\xf9\x9e\x2b\xfb\x34\x8f\xdb\x1d\xa4\xc6\x49\x8e\xd2\xfc\xe3\xd0\xae\x35\xbc\x5d\x5f\xfe\x9c\xb7\x6b\x96\x3a\xb4\x28\xbc\xd9\x6f\xcd\xae\xcf\xe6\xfb\x39\x07\xcf\xf2\x26\xcd\x02\x7f\x75\x94\x47\xbf\x33\xd4\x52\x25\xcb\x05\x05\x2f\x16\x27\x52\x66\x41\xce\xb9\xba\x2e\xc7\xd3\x55\xc7\xc5\x62\xdb\xc5\x7c\x29\xcf\x7c\xef\xe7\x71\x35\x4f\xa2\xcb\x4f\x82\x9a\x6b\x01\x7b\x57\x12\x82\x56\xca\xa2\xb9\xbc\x54\x39\xf4\x11\x00\x9c\x4a\x96\x53\x9b\x24\x39\xc9\x64\x25\x2b\xfa\xb7\xb6\x75\x6c\x4e\x29\x1f\x8d\x1b\x88\x19\xca\x54\xd7\x00\x8a\xe6\xee\xd4\x4f\x81\x4c\x64\x8c\x01\x9d\x23\x58\x2b\xd1\x5f\x8e\x70\xf3\x5b\xce\x33\x7e\x7b\x24\x9e\xf1\xcb\xcf\x78\xc6\x32\xfe\x89\xdb\xce\xb8\x71\x7d\xcb\xf8\x2a\x10\xd6\x3c\xca\x40\xac\x15\x29\x03\x1e\x46\x98\x1c\x81\xff\xed\x79\x18\x39\xee\xf1\xa8\x1e\x25\xc7\xa3\x3a\x95\x01\x74\x99\x38\xca\x64\x83\xfa\x5e\x93\x04\xc3\x0e\x92\xa2\x3a\x95\x81\xe1\x88\x5c\xf6\xb9\x24\x74\x88\x55\xf3\x03\x9f\xa1\x84\x46\x16\x1f\x13\x6d\x33\x1b\x72\x4c\x06\xb4\x53\xb1\xb8\xb2\x4f\x93\xcc\x98\x45\x1f\x1f\x6a\xc8\x51\xfa\xcf\xa3\x8c\xe5\xd6\xf8\x1b\xb0\x9a\x9e\xfa\x99\xde\x18\xbc\x93\x25\x1a\x63\x6c\x73\xa2\x31\x92\xb8\x80\xfe\x3b\xf5\xc6\xf0\x3f\x86\x55\xf3\x91\x75\xd0\x63\xf3\x94\xb8\xba\xaf\x6f\x48\xa8\x3b\x14\x57\xe2\x17\x87\x85\xba\xff\xa8\x73\xaf\x4d\x92\xb8\xb2\x4b\x19\x63\xc5\xe5\x9c\xe4\x9d\xc3\x71\x75\x2d\x5f\xd7\xa6\xe7\x6d\xdf\x8a\x0e\xfb\x4c\x42\xc7\xf3\x79\x51\xbe\x55\x3c\xd3\xf4\x44\xa6\x49\xeb\xda\x74\xbc\xec\x5b\x83\x8e\xa1\x0a\x95\x6b\x27\x55\x59\x0b\xf1\x31\x91\xc0\xe9\xe4\xb4\x8d\x53\x5b\xe5\xf0\x2b\x62\x42\x81\x0d\x4e\x95\x7b\x69\xd5\x6c\x5d\x17\xd7\x68\x21\x2d\xe2\xd6\xee\x2b\x89\x17\xa7\x16\x29\x16\x22\xc5\x56\x27\x8a\xe7\xb3\x01\x29\xb6\x82\x77\x85\x68\x2b\x9f\x5e\x9b\xbf\x5a\xdb\x66\x8a\x17\x8b\x45\x95\xaf\xb0\x96\x7f\x4b\x10\x21\x7a\xe3\x2e\x2e\xbf\x53\x19\xa0\x77\x15\x50\x7f\xf4\x26\xd1\xc5\xeb\xfd\x08\x51\xeb\x2e\xc6\xe8\xba\x1d\x7c\x5e\xb0\x16\xbc\x4e\xf4\xcf\x22\xc6\x7c\x05\xb0\x27\x7e\x80\xfc\xf1\xf5\x6d\x88\xe5\xc8\xd7\x10\x1f\x38\x35\x3f\xe3\xeb\x55\x39\x30\xda\x64\xf0\x1e\xef\x21\x1e\xc5\x46\xc7\xef\x60\x6c\xb8\xb7\x96\x8f\x38\xc3\xa5\x8d\x8e\xdc\xdd\xa5\x0f\x3b\x48\x1d\x77\xc1\xbc\xff\xc7\x1c\xe5\x79\x99\xfe\x6e\x8e\xe0\x61\xc9\x11\x6c\x61\x3e\x0f\x78\x34\x1b\xa1\xce\x7e\xc7\x1c\xb9\x75\xf3\x2f\xc5\x6a\x11\x38\x09\x1f\x14\x78\x80\x6e\xfb\x86\xab\x24\xc7\x11\x3c\x22\xb9\x83\x87\x24\x67\x6e\xc3\xfc\x2b\x1c\x72\x85\x49\xc9\xeb\xe7\xff\x04\xa0\xe0\x65\x75\xcc\xbc\xfd\x30\x70\x89\xdd\x83\x78\x44\xa0\x3a\x95\x0c\x18\xe1\x9c\x64\x00\xbc\xdb\x9c\xd2\x71\x62\x01\x32\xee\xff\x8c\xcb\xd9\x86\xf8\xf9\x1e\xb1\x82\xb4\x45\x84\x0b\x10\x0f\x81\xac\x99\x1d\xa3\xa0\xdc\x13\xf2\x33\xd3\xc0\x1f\x78\x6b\xe5\x0a\x53\xe2\x1c\x5f\x8b\x7e\x8e\xef\x14\x0f\xc1\x6a\x1c\x72\x85\xd3\xc4\xe2\x48\xab\x24\x90\x16\x09\xe6\x4d\xa5\x8a\xc5\xa3\xf9\x14\x13\x39\x48\x76\x0f\x27\x67\x86\x93\x63\x58\xeb\x31\xcc\x07\x23\xe9\x01\x1a\x16\xf1\xa9\x43\x2d\x52\x4c\xd0\x8b\x53\xc9\x6e\x00\xdf\xc7\x84\x27\x9a\x29\xc1\x28\x87\xd5\x52\x1e\x13\xe5\xe9\xc3\xf7\xb5\x3d\xb8\xa5\x19\xa3\xa2\xf2\x44\xc9\x27\x67\x20\x04\x55\x41\x31\xd7\xb6\x8a\xee\xbb\x01\xfa\x50\xfb\x1a\xce\x89\x8a\x5f\x87\x46\x00\xb0\x95\x1c\x04\xf0\x6f\x02\xac\xaa\x4e\x25\x35\x1c\xf1\x75\x87\x86\xca\x8e\x84\xeb\xe5\x17\x23\x0e\xe2\x12\xb3\xe6\xe8\x64\xc6\x18\xc4\xf7\xde\x6a\x52\x9d\x5b\x33\xaf\xc8\x21\x57\xa4\x99\x78\x24\x2b\xce\x18\x46\x03\xcd\x36\x39\x0c\x45\xc5\x76\x27\xd6\x3b\x0e\x4b\xd8\x5b\x43\x6a\x72\xab\xe7\x2a\x0e\xb9\xa2\xc8\xc4\x70\x90\xc6\x33\x50\x9e\x61\x1b\x64\xf8\x49\xf7\x9b\xb9\x3f\xc9\xa1\x24\x30\xd5\x75\x7c\x60\x44\x09\xf9\x56\xfb\xdb\xe4\x1b\xa2\xef\x65\x3f\x7b\xbe\x7c\xbd\x74\xd1\xd7\xb7\x32\xd6\x41\x46\x49\x47\xbd\xd1\xca\x7b\x75\x6a\x77\xa7\x62\x6d\x13\xee\x59\x51\x5f\x87\x3c\x5b\xf4\x9a\xa6\x1a\xf2\x88\xa5\xc8\xb6\x9c\x23\x8d\xe7\xd5\x12\xfc\x3e\x38\xbd\x8d\xdf\x97\xf8\x8a\x8b\x7f\x46\x02\x79\xca\xd7\x86\x7c\xfc\x9c\xa8\xe2\xf5\xf3\x85\xf9\x8b\x5e\xfb\xf1\x96\xf3\x84\x19\xa8\xa3\xbe\x15\x01\x4c\xea\x35\xed\x84\xa9\x6a\x89\xc7\xc7\x1d\x4a\x6c\xd1\x2b\x5b\x71\x7e\x89\xfb\x74\x54\x48\x2f\xf1\xa6\x76\x25\x4e\x6b\x11\x71\x4a\x42\x04\xf2\x58\x9b\x08\x9f\x88\x2d\xe9\xf0\x2d\x92\x41\x01\x8f\x18\x08\x16\xeb\x04\xcb\x8c\x04\xc3\x0d\x04\x7e\x9d\x00\x25\x19\x08\x6e\x30\x10\x14\xe9\x04\xee\x64\x03\x41\xd8\xa0\xc6\x9b\x74\x82\xa5\x26\x03\xc1\xb1\x69\x6d\x04\x17\xeb\x04\x7f\x37\x1b\x08\xea\x0d\x04\xc7\x9b\x05\xc1\xa6\x2e\x06\x82\x8f\x0c\x04\x5b\x05\x41\x28\xa9\xcb\x79\x7a\x7d\x4b\xdf\x94\x1f\x72\xee\x3c\xbd\x3e\xa5\xa3\xb2\xce\x71\xbd\x5a\x93\x9d\x4a\x6a\x9b\x5e\x33\xce\xe9\xf1\x5f\xfb\xf2\x46\xea\xcc\x5e\x23\x62\x0c\x53\xee\xce\x79\x57\x62\xef\x56\xe8\x41\x69\x2c\x7e\x1e\xf8\x0d\x49\x3f\x0f\xfc\x0a\x77\x54\x45\x8a\x25\xf7\xe0\xdc\x2b\xb1\xf7\x0b\x87\x5c\x91\x9a\xaa\x3d\xd6\x68\x0f\xb4\x4a\xf2\x93\xff\xe0\x07\x05\x6c\x72\xe8\x4d\x09\xa1\x7e\x3b\xfb\xed\xc8\xa5\x72\xe8\x5f\x49\x10\x62\xb9\xf8\x16\xb5\xe0\xe4\x19\x88\x9b\xda\x73\xf1\xb5\xae\x11\x6b\xb2\x10\x38\xf7\x87\x79\xbd\xb1\xb7\x4a\xf7\x7a\x58\x70\x51\x9d\x24\xbc\xde\x3a\x98\x5c\x78\x9d\x8a\x25\x97\x02\x13\x9b\xda\x33\xf1\x48\x92\xce\xc4\x03\x49\x08\xf5\xfb\xa1\x5f\x4d\xee\x41\x39\xb4\x24\x59\x30\x91\x58\x98\x8b\x76\x3d\x9f\x89\xa1\x3a\x13\xcb\x4d\xff\x45\x15\x33\x93\x75\x55\x4c\x49\xe6\x54\xc0\x44\x6f\x41\x95\x96\x16\x27\x5a\x10\x27\x2a\x49\x06\x56\xcf\x7a\xa4\x03\xf4\xdc\xd7\x8c\x61\x6f\xa9\x62\x71\x93\x5a\x28\xdd\x23\x1d\xe8\xb7\x13\x2f\x71\xd4\x9a\x52\x10\xdd\xf3\x35\x63\xd9\xf5\xfc\x9c\x50\x35\xa1\xd7\xc1\xa8\xbe\x04\x93\x3d\xb5\xa6\x64\x44\x5f\xf8\x0a\x66\xc7\x8d\x61\x7d\x1b\x1f\x5a\x94\x6e\xd8\x22\x76\x21\x3f\xd9\xc2\xc4\xd2\x4b\x34\xb7\x99\xb1\x20\x95\xb0\x77\x2b\xd9\x9a\xbb\x5d\xd6\x3e\x4e\xe1\x47\x28\x3c\x69\x0c\x07\x0f\x4a\xba\x76\x66\xfe\x12\x38\x63\xf3\xc1\xfc\xc9\x14\x1d\xd4\xca\x58\xb0\x4a\xc2\xde\x2f\xb0\x77\x5b\xee\x16\xf9\x99\x7e\x5d\x78\x8e\x81\xa9\x38\x58\x29\x69\xf3\x1a\xed\x01\x26\xcd\xfc\xc5\x1e\x68\xb5\xf9\x7e\x22\x45\x8a\x25\xfa\xa7\xf3\x3d\xdd\x65\x67\x84\xc9\x55\x89\xae\x6c\xcd\x75\x2a\xa9\x1d\xbd\xd6\x8a\x33\xe7\x69\x7a\x6f\x93\xd0\xf4\x27\xff\xad\xb9\x1f\x4e\x16\xcd\xfd\x00\xd7\x21\xcc\x06\xc8\x26\xb7\x36\x30\x35\x77\xcb\xbc\x2b\xe4\x8a\x9b\x75\x0e\xe5\x27\x2f\x4a\x46\x08\xb8\x94\x43\xa6\x64\xd1\xe2\x40\x05\x5a\xf7\x9e\xc0\xde\x1d\x8e\xdc\x23\x6e\xf9\xce\x6f\xa1\x09\x36\x7f\xc9\x18\x0f\x7f\xc2\x91\xf9\xb2\x23\xf7\x88\xbc\xbc\x32\xbb\x1e\xf4\x5a\x75\xd5\x17\x0e\x32\x52\xe7\xf7\x68\x13\x63\x78\x09\xf6\x9e\x75\x6b\x03\xd3\x3c\xd2\x01\x4c\xaa\xe8\xb3\x5f\x72\x86\x3b\x48\x56\xd3\xc4\x58\x30\x22\x61\xef\x26\xec\xad\x04\x15\xfa\x52\xfe\x8b\x0a\x9d\x8a\x25\x3a\xb9\x85\x31\xed\x76\x86\x97\x90\xaa\xdc\x6a\x59\xdb\x62\x16\x8d\xa4\x8f\x55\xed\x1b\xa9\x48\x31\x45\xd3\xcf\xf7\x9e\x6f\x36\x0a\x95\xef\x38\x67\x50\xf9\xa2\xf6\x8c\x65\x35\x9d\xa7\xf2\x29\x22\x5b\x38\xdb\xf4\x5f\x54\xde\x45\x57\x39\x4b\xfa\xef\x2a\xbf\x39\x29\xae\xf2\xac\xa4\xff\xae\xf2\xbb\xff\x93\x50\xb9\xfc\xec\x12\x18\xf9\x16\x45\xb2\xeb\x41\xf7\x2b\xaa\xae\xaa\x8a\x3e\x09\x41\xa4\xf7\x2c\xf6\xd6\xbb\xb5\x81\xd0\x25\x68\xdf\xff\x70\x3e\x3b\x08\x34\xba\x51\x58\xf7\x12\x52\x09\x7a\x4b\x17\x7a\x8b\x8f\xf1\xd3\xe3\x7a\x6b\x06\xbd\x79\x14\x53\xd4\x7b\x4e\xb4\x0c\xd0\xd7\xc8\xcf\x8c\x31\x71\x7a\x3d\x86\xd0\xc9\x7f\x05\xf2\x3d\x10\x74\x46\xef\x3d\x77\x9e\xbe\xf2\x4f\x0b\x7d\xdd\xf4\xdf\xf4\x35\x4a\xd7\x57\xe1\xff\x30\xd1\x86\x84\xbe\xa2\xff\x43\x5f\x7f\xfc\xa2\x4d\x5f\x65\x93\x5a\x60\x0e\x7a\x44\x7e\xb5\xcd\x4c\xa3\x45\xe7\x5b\xc4\xf3\xa7\x84\x45\xe0\xb3\x8c\x45\xa3\x2d\x71\x9b\x24\x9b\xc0\x24\x17\x9b\x2e\x60\x92\xbf\x72\x93\xdc\xcd\x67\x0f\xde\x4d\xd1\x4d\xe7\xda\x59\xa5\xcb\xf4\x3f\xac\xf2\xd1\xf3\xc7\x9e\x8b\x74\x1e\xae\x37\x5a\xe5\xf2\xf6\x8d\xb8\xf8\x54\x7b\xd6\xf6\x27\xff\x6f\xd6\x56\xb6\x30\x96\x18\x22\x9f\x7f\xa8\x6d\x88\xfc\x47\x83\xa8\xd0\x9c\x14\x6f\x1f\x39\x74\x06\x46\xa7\xb6\x26\x7c\xfc\xa4\x20\xe9\x75\xea\x3c\x76\xc7\xe9\x28\xe9\x94\x08\x41\xae\x6b\x17\x82\x1c\x3e\xd9\x4e\x1f\x43\x93\xff\x87\x3e\xb6\x9d\x6f\x3e\xad\x82\xbd\xf0\x75\xfa\x58\x65\xca\xde\xec\x20\xb5\x50\xda\x15\xa2\x34\x9f\x89\x69\x77\xf3\x68\x7e\x71\x15\x88\x6c\x97\x17\xd5\x42\xf1\x76\xcb\x09\x39\x1c\x46\x08\x85\x36\xfb\xcc\x4e\xe2\x53\x4c\x7c\xa8\xcb\xde\x4c\xea\xa0\x8b\x80\x35\x2f\x4a\x42\xc8\x25\x57\x98\x4c\x0e\x7d\x3e\xb0\xb8\xca\x11\x68\xb2\x39\xe4\x45\x11\xb0\x6f\xa7\xa5\xca\x5f\x10\xda\xec\x33\x91\x52\xc5\xd2\xaf\xc5\xd1\x6f\x37\xf6\x9e\x75\xe4\x1e\x21\x07\x1d\xc2\xc8\xb0\xb7\x9e\xae\xaf\x33\xd8\x19\x3a\x6b\xe8\x97\xcb\xa0\x5f\x1e\x69\x66\x8c\x6c\x8b\xa9\x64\x5b\xd4\xc5\xce\xd3\xde\x55\xba\xee\x3f\x3e\x6b\x68\xec\x15\xed\x1b\xfb\xdd\x86\xf6\x8d\x3d\x30\xe9\x7f\x37\xf6\x2f\xe7\x18\xe3\x0a\x7d\xb9\xb1\x5d\x03\xec\x96\xfe\x47\x03\x04\xce\x19\xac\xe4\xf3\x07\xdb\xac\x64\xa1\x58\x70\x09\x1d\x3e\x3f\xe4\x1d\xa3\xaf\x0a\xb5\x9e\x38\x4f\x3e\x87\x8e\xfa\xe5\x84\xb0\x0e\xf9\x9f\xed\xcc\xe3\xab\x13\x8c\x71\xb7\x71\x79\x23\x63\x74\x63\x2d\x63\xdc\xb7\xa7\x35\xf1\x17\x53\x74\x7c\xe3\x79\x25\x1e\xd5\xf9\xf8\xd0\xa8\xb1\x57\xdb\x6b\x6c\xd6\xf9\x8c\xac\xd1\xb3\xad\x35\x0e\xaf\xcb\xda\x67\xb3\xc7\xb9\xb1\x36\xea\xf5\x17\x37\x1a\xb4\xf1\x58\x71\x9b\x36\x66\xeb\x8b\x2d\x91\xf3\xb5\x71\xa7\x5e\xd3\xb1\xe3\xe7\x31\x71\x93\x8e\xda\x71\x9c\xaf\xb2\x5a\xc3\x9b\x7d\x33\x41\x2b\x2f\x37\x9e\x69\xe3\x62\xed\x71\xc6\x42\x9b\xe5\xd0\xd3\x2d\xba\x36\x82\x2d\x3a\x37\x8e\x26\xc1\x1f\xf6\x6e\x8e\xd6\x9f\xd1\x81\x10\x13\xc4\x81\x43\xe3\x7a\x5b\xdb\x2c\xca\xe8\x1e\xcf\x9a\xd4\xd2\x46\x55\xd7\xcc\x17\x14\xf6\x4f\x47\x88\xbe\xf6\x85\x41\xc0\xf9\x13\xdb\x04\x5c\x72\x94\x4f\x7f\x46\x7b\xbc\x0d\xc3\xa5\xfd\x2e\xb9\xe2\xcf\x8e\x00\x9b\x27\x87\x0a\xc1\x80\x02\xec\x2a\x39\x94\x26\xde\xfa\xcb\xa1\x77\x11\x42\x6b\xbb\xf0\x73\x36\x83\xf2\x27\xc2\x64\xb9\x91\xae\xda\x08\xc3\xd1\x8e\x70\xc4\x5f\xec\x08\x94\xa6\xb1\x7e\x72\x78\xcf\x31\xc6\x08\xed\xb7\x33\x48\x2d\xd1\xd1\xc7\xcf\xeb\xe7\x97\xea\x2a\x5d\x73\xec\x3c\xbd\x9d\x3d\x2a\x50\xcb\x8f\xc5\xf5\x26\x87\x6e\x15\xeb\x3c\xa0\xbb\xe8\x53\x8d\x8c\x39\x02\x2c\xd3\x5f\x18\xaf\x6b\xc6\x31\xc6\xb4\x91\x4c\x9b\x21\x79\x48\x94\xb7\xff\x51\xc6\xa2\xe3\x8e\x89\xf5\xb7\x54\x6d\x86\x24\x4a\x7e\x4d\x2f\xb9\xe0\x98\x28\xad\x71\x67\x5b\x43\x64\x1f\x63\xcc\x2d\x7d\x83\x35\x9f\x62\x85\x88\xb2\xd1\x21\x57\xf4\x52\x1c\xb5\x26\x05\xd1\x4f\x37\x32\x06\x94\xd9\x11\x4f\xee\x04\xc5\xea\x28\xeb\xa5\xc8\x8b\x22\xd1\xd6\xa3\x1d\x2b\xb0\xeb\x15\xec\x3b\xca\x58\x76\x24\x58\xaa\xa4\x22\x79\x91\xc1\xe6\xbe\x3c\x2f\xc7\x45\x7a\x8e\xd5\x47\x75\x96\xb6\xb6\x51\xbf\x7a\x94\xb1\xe2\xf2\x68\xec\x48\xbb\x25\xe0\x91\xd8\xcb\x46\x60\x2d\x6b\x3e\xae\xfc\xcd\xa4\x1f\x8c\xcb\x5c\x85\x50\xb0\x7f\xf6\x2a\x84\x24\xac\xe6\x65\xd2\x9d\x55\x7c\x71\xaa\xe7\x2a\x84\xb0\x5a\x9a\x69\xa1\x75\x02\x80\x00\x20\x51\xac\x96\x58\x71\x98\xf9\x0a\x54\x7b\xaf\x70\x64\x5e\xdf\xec\x5d\x7c\x7f\x35\x6f\x0f\x0e\x56\x4b\x74\xa9\xa0\xfd\xe6\x1d\x4e\x9b\x3b\xaa\x17\x90\xcf\xdf\x4b\x83\x02\xfe\x99\x80\xe3\x3a\xbb\xbe\xd9\x56\x62\xa3\x4d\x9b\x39\xea\xb5\x77\x44\x7d\x36\x7a\x0f\xd0\x7a\xcd\x2b\x39\x20\x9d\xc0\x43\x33\xd9\xb1\xb4\x03\x57\xee\x35\x61\x69\x6b\x83\x3c\x15\xe9\x9f\xa2\x61\xfe\x29\x5a\x83\x3c\xb5\xd0\x26\xf7\x1c\x69\x3b\xef\xfc\x5e\xbb\xf3\x9d\x99\x72\x45\x17\x0c\xfe\x39\x2f\x7f\x56\x1a\xe6\x8e\x18\x49\xc8\x86\xe5\x37\xaa\xe4\x27\x6c\x30\x66\x80\x31\x91\xe3\x58\xbb\x43\xc2\xeb\xe3\xe8\xc6\xef\xf9\xb9\x5f\xab\x96\x25\x61\x52\x0d\xbe\x3e\xd0\x9c\x3f\xeb\x08\x96\xdf\xf8\x76\x06\xc5\x6a\xa9\x62\x21\x47\xe8\xc7\xfb\xa0\x25\x4a\xba\x90\x3a\xfa\x32\xdf\x2c\x2a\x55\x2c\xd9\xf5\xe4\x08\x3d\xbb\x85\xb1\xc0\xe0\x4c\xdf\x0c\x5e\x36\xf6\xd6\x78\x34\x25\x45\xac\x82\xe5\xfb\x0a\x43\x11\x39\xfc\x91\x24\xd6\x8c\x7e\xae\x04\xa9\x7b\xfc\xfd\x6d\x9e\xc4\x5a\x2f\x37\x26\x5f\xe8\xdf\x76\x84\xdf\x46\x28\x58\x25\x91\x82\x0c\x92\x4e\xde\x46\x88\x2a\xd5\xfc\xbb\x0b\x4b\x87\xf5\xa2\x60\x8d\xe4\x26\x27\xb1\xb7\xc6\x2d\x6d\x8c\xfd\xbd\xce\xa9\x58\xf5\x75\x7f\xa7\x36\x51\x1a\xee\x3d\xe4\x24\xfb\x3d\xc9\x93\x15\x8b\x87\x1c\x74\xf6\x3b\xc2\xe5\xba\x55\xcb\xba\x14\x93\xed\x34\x09\x26\x37\xda\x08\x29\x1c\xf1\x1d\xb4\x07\x58\x96\xaf\x9b\x3d\x00\xdd\x23\x4d\xac\xcb\x5a\x9d\x9a\x5d\xc2\x5e\x73\xf7\xb7\x11\x72\x7b\x37\x39\x89\x53\xe9\x85\xb5\x34\xbb\x47\x1b\xb8\x85\xb7\x64\xe5\x3e\x13\x4e\x4e\xff\xf1\x2d\x84\xdc\xe4\x3b\xac\x3e\x6e\x75\xe4\x1e\x92\xb5\xcf\x10\x42\x6e\x6d\xa6\x85\x36\x54\x32\xe6\x26\x43\xac\xd8\x6b\xde\xf4\x16\x42\x0e\x72\xd4\xd5\xef\x80\x47\x53\xec\x0e\x6d\xbe\x34\xdc\xcb\xdc\x95\xbf\x9a\x3c\xc9\x7d\x97\xbd\x05\x23\x37\x73\x43\x8d\x43\xb5\xa2\x01\x4d\x98\x14\x29\x56\xfa\x16\xe8\x46\x2d\x52\xac\xfc\x63\x98\x3a\x4c\x92\x3c\xa4\x99\x4e\x85\x32\x39\x6f\xaa\x53\xe9\x35\x54\x75\x0e\x68\x02\xbe\xac\xb7\xd7\x9a\x72\x50\x60\x70\x3f\x39\x6c\x66\xdc\x92\xee\x7e\x0b\x21\x8f\xb7\x54\xb1\x06\x6b\x24\x6e\x4a\x60\x47\xc9\xe6\x1b\xde\x42\xc8\x49\x4a\x7a\x61\xd5\x6e\x1d\x5a\x67\x6a\x02\x39\xa2\x45\xad\xc6\xfd\x78\x0f\x39\x4d\x73\x74\xd6\x63\xef\x63\x75\x8c\x62\xc9\x3e\x1c\x4d\x11\xab\xb0\x47\xa7\x20\x44\x9f\xaa\xe9\xb8\x7f\xff\xcd\x48\xb1\xe8\x68\x25\xa5\x4a\x2a\xd6\x26\x2b\xa9\x6a\xa9\x92\x9a\xbb\x67\xee\x1f\xb1\x6a\xae\x7e\x33\xbe\x5b\x98\x85\xc9\x51\x6e\x83\x4e\xb2\x9f\xa6\xfd\xc2\xf7\x0a\x52\x73\xf7\x94\x26\xce\xe9\x58\xe3\x47\xa9\x9d\x08\x69\x43\x24\xe2\x54\x52\xf9\xfa\xb5\x7e\x50\xe7\xef\x79\x36\xdf\xdb\xd9\x91\xd8\x8e\x76\xfb\xc1\x77\x93\x26\xb1\x19\xdc\x8e\x0b\xd2\xc8\xf9\xe8\x57\x17\xac\x93\xb0\x97\xd1\x91\x11\x51\x9d\x9b\xd4\xba\x49\x8d\xce\x5a\x66\x82\xb5\x6c\x23\x6b\xb3\xf7\x25\x58\xbb\xdc\x51\xeb\x12\xac\x79\xc8\xb1\xd1\x6d\x1b\xef\xf6\x04\x7f\xe7\x76\xf0\x73\x63\xa7\xde\x80\x66\x1f\x29\x41\x3e\x47\x99\x0b\xdd\xea\x18\xe0\x4b\x75\x93\x1d\xb1\x45\xf1\x75\xc0\x3d\x73\x8f\xc5\xf3\x6c\xda\xa1\x33\xa3\x8d\x4c\x72\x94\xb9\xa4\xd8\xb7\x46\x7f\xd5\x76\xde\x08\xab\xe6\xb7\xde\x40\x08\x93\x85\xab\xf4\x7d\x9e\xd7\x79\x4a\x7c\x0c\x6b\x9e\x2d\x70\x6b\x10\x42\xf4\xad\x1a\xc6\xe2\xc7\x39\x77\xdf\x07\xf0\x23\x89\xd3\x9c\xbe\x2b\x3f\x35\x09\x4c\x6d\x07\x8c\x1c\x7e\x4b\x6c\x11\xbd\xbd\x12\xa1\x62\x79\xaa\xf9\xbe\x95\x08\xd5\x05\x57\xea\x77\x5c\x62\x2d\x7f\xc9\x7d\x1c\xd1\x63\xa4\x20\x48\x1f\x2b\x9e\xbd\x27\xae\x84\x92\x0a\xec\x58\x35\x77\x13\xa0\xbe\x55\x2b\x10\x92\x7b\x26\x17\xcb\x53\xfb\xd7\xad\xe0\xa0\x41\x5f\x73\xd0\x30\x6b\xb1\x3c\x35\x7f\xfb\x0a\xc8\x11\x2c\xe3\x1f\xe9\x8e\xb0\xc9\x3d\x47\x0f\x90\x7b\x7a\xb1\xdc\xf3\x91\x51\x72\xcf\x19\x93\xe4\x9e\x73\x4a\xe8\x6d\x1b\xb8\x99\xdd\xcf\xd9\xac\xa1\x03\x20\x49\xa2\xae\xec\xcd\xae\xec\x08\x3f\xda\x33\xa8\xfb\x7d\xe2\xfc\xf8\xa0\x6d\x9c\xf2\xc6\xb1\x08\xd1\xae\x22\xd7\xc9\xb1\x22\x57\xf3\xfa\xf3\x72\xed\x18\x2b\x72\xb1\xad\xe2\xb3\x87\xfb\x11\xa2\xdb\xd7\xf3\xf7\x4a\x3d\xd7\xc6\xf3\x73\xad\xd0\x73\x6d\x16\xb9\x5e\x81\xba\x96\x8b\x5c\xcf\xe8\xb9\x9e\x59\xcf\x98\xe3\x53\xfe\xed\x12\x89\x3a\xd6\x8c\xe1\x2f\x90\x77\xaa\x9e\x57\x13\x79\x97\x42\xde\xfb\x45\xde\x67\xf5\xbc\x77\x25\xf2\xea\x19\xf9\x09\xaf\x28\xff\x02\xb7\x6a\x34\xd6\x06\x5d\x37\x16\xa1\xb1\xd1\x61\x5b\xf9\x3a\x6e\xbc\x09\xaf\x18\xdb\xb1\x09\x9f\x12\xd6\x70\xcd\xab\xa2\x09\x77\x2d\x8f\x37\x21\xff\x00\x41\x9e\xda\xe3\xfb\xe5\xa2\xed\x7e\x16\xcf\xde\xd1\xe5\x89\xb6\x3b\x22\x40\x7d\xdf\x5e\x9e\x68\xbb\xf7\x04\x68\xd0\x47\xcb\xdb\xda\xee\x93\xe5\xff\xb3\xed\xe2\xe7\x8d\xe3\x7c\xce\x1c\xd3\x81\xcf\xd0\x28\xc6\xd8\xa7\x66\x81\x1d\x7b\x1e\xd6\x24\x21\x14\xc7\xba\xce\xc3\xee\x47\x08\x7d\x9a\x22\xb0\xb6\xf3\x4b\x96\xda\xb0\x96\xf3\xb0\xb7\x02\xd6\x22\xb0\xc7\x46\x77\xd4\xde\x1b\xa2\x03\x4c\xd4\xb5\xd7\xfb\xd5\x8e\xda\xfb\x83\xc0\xa4\xeb\xfa\xed\x7d\x9d\x78\xf6\x7d\x41\xd7\x22\xe8\xec\x25\x5d\x67\x2b\x12\x6a\xcc\x7f\x73\x39\x2f\xf7\x1f\xba\x16\x3b\x55\x9b\xd0\x6a\xf4\xf3\x56\xfd\x1c\x05\x56\xcd\x67\x96\x0a\x5e\xfe\xb5\xb4\x23\x2f\xff\x14\x98\xf4\x4f\xc4\xb3\xf7\x06\xf1\xec\xbb\x70\x69\x1b\x2f\xaa\x80\x0d\x7a\x61\x69\x82\x97\x25\x4b\x79\xb9\xaf\x2d\xfd\xbf\xf1\x92\xdd\xee\x7b\x75\xd5\xdc\x55\xe7\xa7\xf2\x95\x8e\xfc\x6c\x78\x45\xf0\x53\x27\x9e\xbd\xbf\x16\xcf\xbe\x2f\xbd\xd2\xc6\xcf\x52\x01\x1b\xf4\xc6\x2b\x09\x7e\x56\xbd\xc2\xcb\xfd\xd7\x2b\xff\x37\x7e\x5e\x6b\x61\xec\x53\x11\x42\xe7\xdf\xf2\xb7\xf3\x7c\x9b\x8e\x51\xfe\xd6\xb1\x69\x17\x89\xa6\xfd\x42\x67\xff\x89\xa5\x1d\x7c\xdb\x67\xf7\x0a\x29\x66\xeb\x5a\x5d\xa0\x6b\x95\x2c\x4d\xf4\x8f\xeb\x75\x05\xef\x4f\x30\xdf\x3f\xa6\xcb\xd3\xa0\x73\xaf\x73\x89\x55\xf3\xe6\x65\x1c\x93\x1f\x7d\x45\x74\x13\x4c\x82\xab\x78\x8f\xb9\x80\xa7\x1b\xb9\x96\xfb\x82\x99\xf7\x0a\x5f\x70\xc7\xda\xf3\xbc\xcf\xb8\x7b\x85\x07\x19\xf6\x25\xa7\x2c\x19\x83\x10\xed\x23\x72\xf5\xd0\x73\x59\xcf\xcf\xd5\x5f\xcf\x95\x2e\x72\x5d\x3c\x0a\x21\x1a\xfd\x94\xbf\x7f\x3e\x4a\xe4\xfa\xe1\xd3\xf3\x72\x35\x8c\x12\xb9\xf6\xfc\x87\x53\xae\x86\x5c\x1f\x89\x5c\x6f\xea\xb9\xde\xfc\x94\x31\x4e\x0f\x0e\x2a\xee\xf2\x20\x6f\x18\x88\xdf\x16\x19\x3f\x86\xf7\xc7\x45\xc6\x77\xf4\x8c\x8f\x7c\x1a\x77\x73\xae\xec\x48\xf4\x32\x7e\xc3\x47\xbe\x06\x84\x8e\xcf\xf9\x7d\x1d\xf9\x85\x4b\x11\x42\xbe\x3b\xb1\x6a\x7e\x69\x99\x7e\xaf\x4c\xb0\xc4\x8a\x7c\x79\xed\x3f\x0f\xcb\x6d\x9c\xd9\x3d\x66\xe3\x67\x61\x73\x7f\x9a\x7e\x59\x60\x70\x37\xff\x09\x57\xf8\x57\x5f\x6f\x00\x39\x82\x87\x00\x3c\x23\xca\x43\x08\xb9\x4f\xdb\xb9\x32\xd5\xec\x58\x86\x90\xfe\x7d\x69\xb5\x24\xae\xad\x99\x66\xa5\xd5\x9f\x30\x86\x73\x63\xb3\xbe\x82\x82\xde\x8d\x7d\x97\x38\x87\xd6\x43\x5a\xc6\xef\xa3\xb9\x71\x29\x42\xaa\xa9\x2c\x1c\x91\xc3\x0b\xf9\x87\xcd\x89\x33\x59\xc0\x50\xf0\x90\x25\x7c\x7a\xfe\xb5\xb1\xdb\xf4\x7c\xa1\xcd\x3e\x27\x56\xd3\x0f\x2f\x45\x28\x67\xe8\x00\x9f\x5d\x5e\x7f\x86\x4e\xfb\x92\x31\xb2\x33\xf7\xa7\x79\x57\x60\xef\x26\x6d\x94\xc4\x77\x10\x5c\xe1\xc3\xfe\x7a\xb2\x33\xb4\xd9\xff\x63\xee\x4f\xf3\xf7\xb7\xfb\x3c\xd9\x89\x10\xd9\x19\xfb\x0a\xe2\x8b\xdc\x9f\x7c\xbf\x62\xef\x2e\xc8\xd2\x4b\x1b\x95\x04\x19\x7c\x5f\xc7\xde\x2d\xc7\xde\xd3\xd9\xf5\x58\xf3\xdd\xc2\xa2\x95\xad\x8c\x95\xeb\x91\x7e\xfb\xf3\x53\x16\x7e\x3a\xc5\x0d\x02\x14\x16\x85\xeb\xe5\xf0\xb7\xe2\x83\x94\xbf\x40\x17\x70\x2a\xfc\x8a\x6b\xac\xbe\xa2\x9f\x21\x93\x43\x9f\xf0\x0e\x31\x59\x31\xc5\xd2\xf4\x73\x62\xe2\x7b\x2e\xac\x9a\x4d\xd0\x19\xbc\xf6\x51\xa7\x9d\xc1\x34\xbb\xff\x67\x7e\x35\xc5\x11\xba\xbf\x9e\x6f\xaf\xd6\x63\xd5\xfc\x0b\x58\xfb\xfa\x11\x3d\x55\x8f\x62\xe2\x87\xc9\xbc\xf6\x51\x58\x9d\x63\x71\xaa\xce\x60\x1a\x1d\xbe\x33\x7e\x4f\xc6\x7e\xec\xa5\x24\x4b\xa1\x7f\xa8\xe7\x01\xd3\xfb\xe0\x03\xbc\x8e\x51\x98\x54\x01\xe1\xaa\x24\x39\xfc\xa8\x84\xd0\x2a\x49\x0e\xe5\xc2\xd3\x24\x87\x77\xf3\x9f\x27\x90\xc3\x1f\x40\x40\x9e\xa7\x00\xc9\x87\x10\xeb\xf4\xfb\x8e\xb1\x98\x55\xd7\x3b\x5d\x77\x31\x42\xe1\x48\xc9\xb5\xfc\x48\xc7\x04\x7c\x93\x49\xdf\x5c\x3e\x25\x19\x63\xca\x44\x7c\x45\xcf\x5e\x07\xf4\xb3\xb7\x62\xd5\x6c\xe7\x6e\x6a\x7f\x9d\x49\x11\x0a\x29\xc9\xa4\x53\xbe\xe2\x82\x5d\x0c\x71\xcd\x2b\x10\x1d\x8e\x81\xf6\xbf\x5b\x42\x48\x5c\x9f\xa2\x6d\x61\x2c\xd8\x24\xc9\xa1\x1e\x12\x42\xc4\xa4\x04\xa9\x84\xd5\xf4\x3d\x2f\x23\x44\x6a\xb1\x7a\xeb\x5a\x11\xc9\xd6\x49\xf4\xe3\x16\xc6\xd4\x3c\x25\x7a\x27\x63\x8c\xb4\x62\x35\x37\xbb\xbe\xdf\x11\x2c\x1d\xa5\x4b\x75\xc4\x20\x1e\xa9\x3b\x15\x53\x9d\xa3\x88\x9f\x95\x38\x92\x38\x3f\xb6\xd3\x04\x73\x8a\x8d\x58\x35\x3f\xf9\x32\x68\xa9\x70\xd4\x69\xe7\x0b\x69\x92\xbf\xb4\xce\x5e\x24\x62\xf4\x03\x54\xf9\x98\xab\x71\xe2\xcb\x42\x8d\xa0\xc3\xe8\x77\x7c\x22\x26\x61\xef\x0e\xac\xf9\xac\xfc\x8b\xe9\xec\x7a\xfa\x8a\xf8\xb2\x61\xf0\xcb\xed\x14\x1e\x7d\x45\xec\x00\x9b\x38\x6b\xda\x34\xc6\xb9\x16\xc7\x34\x7a\xc8\x1d\x68\x8b\xf9\x57\xa5\x07\xe8\x0d\x1f\x8b\xfd\xa3\x03\xa4\x96\x6e\x17\x27\x3a\x7e\x5d\x82\x50\x74\x02\x63\xac\xce\xa4\xf0\x4b\xc4\x0e\xb7\xea\x72\x91\x23\xd9\xf5\x09\x91\xde\x12\x05\x57\x2c\x31\xb0\x9b\xd9\xca\x35\x41\x3f\xfd\x82\x31\xfe\xa1\xba\xbc\xf0\x25\x71\x71\xc9\xb3\x4b\x10\x92\x2b\x36\x0d\x1e\x3a\xa0\xd4\xaa\x9a\x14\xad\x44\xa2\x03\x77\x30\x16\xcd\xe0\xe8\xbb\x72\xe4\x8a\x08\x56\x7b\x55\x81\xcb\x5a\xf6\x11\xb0\x84\xc9\x1e\x52\x4b\xed\xe7\x18\x8b\x7d\x4d\x5f\xfe\xc8\x38\x7e\x89\x38\x3a\x7f\x09\x42\x2a\xb6\x86\x37\xfb\xff\x84\x55\x54\x8b\xba\x61\xd5\xdc\x0f\x60\x85\x85\x41\xbb\x55\x0a\xd7\xfb\x2f\x17\xf3\x0e\xfa\x9e\x90\x2b\x55\x20\xc3\xf5\xbe\xc3\x60\x18\x30\xff\x4f\xa5\xf2\x47\x1c\x77\xec\x25\x6e\x2d\xfc\x2b\xb0\xe8\xd2\x0f\xdb\x7f\x0f\xd8\x76\xfe\x44\x7c\x62\x49\x9b\x3f\x64\xcc\x11\xc8\x53\x6e\x70\x93\x1a\x39\xf4\x59\x12\x42\xea\xa0\xbb\x17\x23\x14\x3e\x2d\x87\x9e\x81\x54\x8f\x32\x48\x6d\x96\x9f\x19\x9f\x84\xd0\x70\xef\xe1\xec\x5d\x1e\x2d\xff\xe3\x97\x60\x42\xe1\x4a\xf2\x68\x83\xac\x45\xe0\x3b\xf9\x89\x14\x4b\x2c\x43\xb7\x7b\x77\xb0\x46\x72\xe6\xf6\x1f\xbe\x18\x21\xf9\x99\x3e\x49\x08\x0d\x53\xb3\x8e\x62\x72\x94\xaa\x1f\x8a\xc3\x87\x16\x08\x24\xb5\x81\x16\xfa\x30\x30\xad\xcf\x22\xab\x69\xde\x87\xfc\x56\x28\x3a\x41\x3c\x6b\x4d\xc9\x37\xd0\x51\x1f\x42\xf3\xee\x17\x57\x51\xfd\x81\x27\xf8\x61\xa7\xf1\x3c\x67\x0d\x0e\x47\x7c\xe5\x90\x5e\xfd\x2f\xc6\x3c\x6a\xff\xe3\x8b\x11\xda\x30\x9e\xdf\xff\xf3\x21\x63\x1b\x0a\xb9\xd3\xa9\xc6\x64\x52\x66\x9d\x7d\x00\xd7\x79\x9d\x3d\x47\xd8\xe9\x78\x1b\x26\xe6\xca\xc5\x08\xb9\x55\x17\xae\x2b\xc8\xe0\xdd\x83\x14\x60\x0c\x93\x16\x24\x72\x16\x58\xe9\x27\xc0\x4c\x5d\x81\x5d\x38\xec\xfc\x55\x8b\x39\xdc\x82\x49\x32\xae\x2b\x28\x14\x45\x16\x8c\x02\xf3\xa2\xa5\xe0\xb6\x49\x35\x56\xcd\x4f\x70\xaa\xde\x73\xf8\x83\x5f\x5e\xa3\x9a\xef\x7c\x91\x7f\x96\x38\x14\x8c\xf5\x89\x7f\x31\xf6\x69\xb2\x18\xea\x6f\xba\x0b\xb4\x59\x64\x6a\xc5\xe4\x5b\x43\x68\xf8\x35\x84\x95\x3a\xc9\x25\x77\x41\x39\x46\xec\xbf\x60\x7e\x09\x8c\xe3\x60\xfe\xfb\x8b\x84\x87\xb0\xfa\xae\x02\x53\xe8\x85\x55\xf3\xc1\x17\xf9\x9d\x66\xbb\xff\x1a\xbf\x82\x43\x2d\xb4\xd1\x1e\x15\x8c\xd1\xb2\x16\x98\xa6\xf3\x7c\xbe\x44\xbe\x41\x90\x6f\x80\x5b\x1d\x69\x73\xa8\xfe\x0c\xac\xf5\x78\xe7\xaf\x08\xd1\x9f\xfe\x9d\x20\x75\x27\x48\xbb\x89\x4b\xda\x9e\xfb\x37\xff\x84\xeb\x89\x17\x11\xa2\x83\x56\x33\x46\x0b\xd7\x40\xd3\xf4\x7f\xec\xc5\xf3\xae\x66\x30\x7c\x5f\xa0\x0d\xda\x5d\x28\x46\xf3\x7f\x1d\x67\x2c\x9a\xa7\x9f\xe3\x07\xc0\xb1\x7f\x32\xa6\xa6\x7f\xfb\x02\xff\xa2\xf2\x6e\xac\xe5\x0f\xb9\x0f\x21\xba\xf6\xdf\xed\xe7\xff\x07\x68\x4e\xa2\xdd\xe5\xd0\x7d\x2d\x8c\x45\xbb\xb4\xb6\xdd\x8f\xa5\x0d\x0a\xfc\x55\x90\x39\xfe\xd5\x46\x76\x0b\x90\xed\x6d\x11\x6e\xa2\xe9\xc3\x04\x22\xfc\x65\x0b\x63\xb1\x45\x9d\xac\x4f\x65\x40\x1f\xf8\x93\x84\x90\x53\x9b\x97\x84\x49\x8b\x83\x9c\x24\x45\x4a\xaa\xdb\xcb\xdc\xde\x1f\x1d\xc1\x8d\x12\x5d\xbe\x1a\x8a\xfa\x01\x93\x23\xd0\x67\xb5\x12\xc9\x4d\x6a\xe8\xa4\xd5\x8c\x71\x03\xc4\x6a\xff\x09\x8b\x10\x72\xd4\x3a\x7b\x4b\x88\x3e\x06\xe0\x8c\xb8\x35\x9a\x87\x2e\x42\x88\x8e\x5d\xcd\x2d\x8b\xdf\x0a\xc2\x2d\xd5\x4d\x6a\xc1\x00\x55\xd7\x00\x52\x30\x00\xab\xae\x1c\x4c\x0a\x72\xb0\xea\xb2\x61\x52\x60\xa3\xd7\xaf\xe6\x8b\xa8\xa9\xee\xba\x3b\xf8\x42\x92\x9b\xa4\xb4\x99\xaa\x9b\xdc\x61\x51\x5d\x19\x4e\x32\x27\x8d\x14\x64\x60\xd5\x65\x77\x12\xaf\x15\x22\x45\xd5\x55\xe4\x21\x8f\x58\x48\x41\x91\xea\x1a\x43\x86\x8d\x22\x05\x63\x3c\xe4\x3a\xdd\x74\xe9\xec\xb3\x8c\x65\x33\xfa\xc0\x46\xf1\xfd\xe7\x0b\xbc\x95\x31\x56\xfb\xd0\x71\x1f\xf0\x16\x5e\xf9\x02\x42\x74\x04\xbc\x93\xfe\x8b\x5e\x10\x8d\x8b\xdb\xbe\x21\x68\xff\xfd\x32\x1f\x86\x9e\xfc\x27\xef\x05\xb5\x08\xc5\xea\x3b\x9c\x77\x05\xdd\x92\x53\xeb\xbb\x88\x6f\x13\xb1\x96\xef\xf8\x0b\xf7\x82\x19\x1d\x23\x04\x7a\xfc\x2b\xb1\x00\x1e\x8e\xc8\x65\x87\x45\x27\xb8\x0f\xa2\xcc\xa8\xf0\x72\xdd\x5e\x80\x41\x09\x7b\xcf\x66\xd7\xf7\xdb\x8d\x93\x73\xa8\xa3\x95\x57\xaa\xf2\x2c\x25\x33\xc1\xa2\xae\xfc\x80\xb1\xec\xd3\x58\xf3\x28\x26\x70\xc1\x55\x74\xca\xf7\x7c\xfc\xec\xbf\x41\x06\x36\xf7\x7c\x10\x5f\xc9\x1e\x84\x87\x43\xc5\x75\x98\xd4\x64\x47\xe8\xb5\x9c\xfd\x3d\x98\xc4\xe8\x98\x7f\xe8\xee\x2a\x71\x06\x11\x93\x9d\x72\x78\x5b\xbb\xeb\x47\x0c\xf2\xaf\xd7\xc3\xfd\x47\xee\x44\x88\xde\x90\x90\xa0\x24\x03\x7b\xb7\x62\x2d\xed\x2e\x7d\x59\xce\xf5\x3c\x42\xc1\xc7\x7b\xb5\xfa\xe6\x63\x2d\x7f\xcd\x68\x84\xe8\x25\x1f\x31\x16\xc5\x89\xef\x58\xf9\x48\x1d\x2f\xec\x4a\x28\xec\xf6\x4f\xf9\xd2\xd9\x1f\x9e\xd7\x95\xec\xd1\x14\xbb\x9b\xd4\xe8\xeb\x74\x27\x9f\x43\xc8\x11\xac\x91\x1c\x75\x4e\x85\xff\x3e\x04\x4d\xfa\x80\x31\x27\xe9\x1f\x7b\x0e\x2c\xc3\xa9\x58\x6a\x91\xb8\xbc\xa5\x95\x31\xc6\x2f\xc4\x10\xf1\x5c\x1d\x9d\x1d\x65\x2c\xfa\x55\x2b\x63\x94\xbd\xdf\xe1\x78\xb2\xbd\xad\x3f\x90\x2d\x23\xc8\x76\x1c\x3c\x54\x22\x16\xd1\x4a\x95\x54\xc2\x17\xfa\x7d\xa0\x1a\x43\xcb\x4d\xca\x8e\xd0\x59\x1b\xc5\x02\xd2\xe0\x52\xc5\x22\x87\xbe\x31\x23\x44\x4f\x6e\x64\x6c\xb0\x53\xb1\xc8\xe1\x1a\x33\x42\xe1\x5d\x72\xd9\x53\x62\xfa\x53\xf0\x5c\x87\x66\x7c\x9c\xdf\x35\xb7\xd1\x21\x57\x64\x29\x9a\xab\x31\x4f\x9a\x39\xc2\x43\x7e\xb1\x07\x5a\x6c\xbe\xa1\xf6\x40\xcb\xd5\xbe\xfb\xed\xa1\xc3\xbe\x31\xc1\xc3\x5d\x31\xd9\x69\x0f\xb4\x48\xf2\xc2\xa3\xfc\x43\x9b\x49\x12\xd6\x0a\xa5\x5a\x84\x78\x2e\xf9\x49\x49\x42\x08\xb2\xc9\xa1\x46\xde\x3b\x7e\xc2\x64\x7b\xec\x5a\xdd\x4f\x78\xc8\x2f\xe2\xde\xbd\xeb\x44\x1d\x27\x78\x05\x87\xa0\x02\xff\x0a\x18\xcd\xbc\xa5\x4a\xaa\xea\xe3\xa7\x70\xb1\x96\x7e\xf5\x70\xce\xec\xe2\x72\x84\xfa\xb5\x02\x97\xbf\xf2\x0d\x7a\x87\xbc\xa8\xd6\x95\xcd\x62\x3d\x12\xe3\xb9\x3b\x58\x27\xd9\x43\x87\xe5\x50\x35\x4f\xb0\x6e\x72\xe8\x39\xde\x88\x73\x24\x28\x5b\x0e\x9f\x4d\x46\xc8\x49\x4e\x0f\x25\xa5\xe7\xac\xf4\x99\x75\x8c\xc9\x15\x99\x98\x54\x0b\x2e\x52\x80\x0b\xff\x3a\x23\x67\xb3\x0e\xb5\x4b\xed\x8e\xed\xd3\xbf\xc3\x89\x48\x72\x45\x26\xaf\xaa\x20\x19\x21\x6d\xa4\x38\x13\x79\xaa\x95\x31\x5e\xc8\xee\xe8\xc1\x56\x3e\x7f\xa9\x92\xe4\x8a\x3f\x09\x95\x2c\x84\xe0\x27\x76\x88\xfb\x5b\x2d\x7f\xf6\xbd\x08\xd1\x31\xab\x75\xff\xab\xe5\xff\x1b\xac\x6c\xcc\xfb\x5c\xfb\xd1\xb9\xfa\x39\x79\xec\x1d\xa3\xa4\x3a\x41\x11\x4e\x92\xa7\x60\x2d\xfd\x9f\xe2\x6a\xce\xa7\x9f\x45\xc8\x01\x0d\x96\xe1\x14\x83\xf1\xf6\x2f\x18\x53\x53\x95\x70\xc4\x37\x66\x78\xf8\xb0\x1c\xee\x63\x42\x28\x78\x46\x92\x9f\xbd\xd4\xc4\xbf\xd7\xb7\x40\x00\x6c\x05\x61\x55\xf3\x83\xcf\x42\x19\xfc\x0a\x3c\x7b\xd9\xa4\x01\xe0\x93\x4f\xbe\x0b\xfd\xcc\xa9\x4c\x32\x84\xc8\xdf\x25\xf3\x4e\x56\x62\x3c\x7f\x89\xb5\xf4\xa9\xc3\xb8\x06\xa1\x43\xa7\x2a\xd0\xbd\xe8\x3d\xf1\xba\x21\x82\x79\x02\xbc\xb8\x36\xa4\xd5\x09\x11\x7f\xb0\x29\x49\x5e\xf8\x48\x32\xbf\x1e\x02\x6b\xe6\xc9\x43\x11\xf2\xc8\x4e\x1a\x3c\x93\x24\x87\x2a\x24\xe0\xd0\xe4\xef\x0a\xf5\x66\x3a\xe5\xf5\x9c\x45\x1b\x3f\x05\xea\x24\xa9\x8a\xbc\x3e\x93\x0e\x7f\x4f\xec\x1d\x58\x21\x36\xb1\xbf\xc7\x98\x53\x4d\x55\x1c\x84\x62\xf2\x3d\x56\xd3\x57\x3c\x23\x2e\xb7\x54\xd7\x8a\xbb\xe9\xaa\x68\xfe\xe7\x8c\x45\xb3\xf8\xa9\xdf\xf4\x6d\x43\x0d\x7c\xd2\x8f\x36\xeb\x3c\xca\xe1\x4d\xdc\x20\xd2\x1f\x69\x87\x7f\xa1\x0d\x7f\x3d\xdf\xc0\x4f\x2f\x4c\xe0\xe9\xcc\x36\x64\x4f\x81\xcc\x69\x97\xf9\xee\x36\xbc\x43\xe0\x6d\x6d\x99\x73\xdb\x90\x8a\x40\x76\x69\x97\xf9\xf2\x04\x3e\xf4\xb0\xa4\x5f\xa4\x91\x95\xc4\x43\xbe\xb0\xc2\x37\x61\xcc\x15\x9a\x2e\xe8\x1a\x10\x94\x36\x82\xc7\xb8\xa3\x95\x9f\x67\xd6\x86\xb4\x72\x25\x5f\x9c\x14\x57\xf2\x25\x6e\xa1\xe4\xec\x5d\xc1\x33\x49\xbe\x74\xae\x54\x79\x7d\x06\xa8\x9a\x6b\x38\x53\x5e\x9f\xc9\x95\x4c\x8f\xbe\xab\x6b\xd7\x4d\x6a\xe9\xbe\x77\x19\x73\x90\xa8\x83\xec\xf4\x90\xe3\x58\x4d\xbf\x5d\xeb\xa8\xdc\xbd\xeb\x18\x8b\x7e\xdd\x02\xca\x35\x5b\x3c\xc2\xdd\xd8\xa2\x43\x5a\x19\xe3\x16\xb7\x4c\x12\x3c\xeb\xeb\x2a\x49\x1a\x3f\x65\xce\x19\xde\xcf\xbd\x78\xfa\xd1\xa7\x01\xf4\x94\x28\xaf\x4c\x3c\x9e\x80\x47\x74\x36\x84\x44\xe0\x21\xaa\xa3\x49\xe7\xf4\xf2\xfc\x7a\x79\x53\x44\x79\x1f\x3e\x9d\x28\x0f\xab\x2b\x96\xc4\xe7\xa6\x05\x12\x42\xb4\x96\x0f\x94\xe6\x67\xdb\x48\xe8\x35\xef\x70\x50\x29\x80\xc8\x12\x5e\x49\x1a\x67\x3d\xbf\xdf\xdd\x08\xd1\x5f\xdf\xe7\x36\xf2\x24\x36\xb6\xc4\xb3\x75\x89\x96\xb0\xea\x2d\x11\x3e\x8b\xc0\x4a\xbb\xca\xe1\x13\x62\xc2\x7b\x1d\xaf\x03\x2c\x52\xe5\xa5\xd2\xbf\xaf\x12\x4a\xcc\xc0\x24\x99\x2e\xd7\x13\x10\x2e\x58\xe8\xb3\x7a\x2a\x93\x47\xb1\x0b\xf4\xd4\x00\x4c\x0a\x32\xe8\x0c\x3d\x95\xc3\x03\x8b\x89\x7a\xca\x0e\xe1\x06\xbd\x47\x4f\x61\x08\x1e\xa8\x7b\x15\xdf\xda\xca\x84\xb6\x2b\x94\x2b\x50\x59\xc1\x80\xa8\xfb\x9c\x58\x27\x19\x82\x10\xbd\x9c\x8b\x92\xff\x37\x10\xab\x87\x78\xff\x60\x24\x42\x54\x12\xef\x5f\xc0\xfb\xa9\xf7\xc4\xbe\x35\xbc\x47\xdf\x63\x8c\x2e\x7a\x9b\x03\xc6\x02\x60\xa7\x40\x3a\x86\x22\x44\xbf\x14\xef\xbf\x01\x3c\x22\xde\xaf\x82\x82\x3f\xe6\xef\xe9\xa3\x87\x18\xf5\x35\xae\x36\xee\x73\x72\x41\x57\x2f\x46\xa0\xb9\xb4\x24\xc4\xc3\xe3\x70\x12\x8f\x06\x95\x21\x60\x46\x47\xe9\x0b\x6f\x8b\xad\xd9\xc2\x24\x71\xa7\x04\x54\x30\x4e\x14\xda\xbd\x5d\xa1\xdd\x6a\x13\x8d\xf0\x4e\xfc\x5e\x99\xe7\x91\x30\x85\xa7\x44\x84\xf9\xd4\x1d\xa2\xcc\xdb\x78\x99\xbe\xbf\x61\x6d\xd0\x27\x58\x80\xf2\xde\xe2\xa0\x21\x58\x1b\xb4\x41\xa7\xba\x5c\x50\x5d\x86\xb5\x41\x1f\xe8\xa0\x4b\xdf\x12\xe7\x62\x2e\x15\xa6\x75\xcf\x53\x09\x7f\x58\x17\x88\x2f\x60\x46\x7f\xe3\x77\x78\x9a\x6f\xed\x88\xe4\xbb\xc9\x9b\xc4\x41\x00\x17\x88\xb1\xe4\xef\x5c\x8c\x91\x77\x18\xc5\x28\xa8\x49\xf4\xfa\x4c\xd1\xeb\x6f\x4b\xe0\xe9\x1f\xdb\x90\xdd\x05\xd2\xd1\x2e\x73\x4a\x8d\xbe\xf3\x1d\x57\xc0\x16\x98\xbe\xa4\x2a\xc1\xa8\x24\x87\x2b\x85\x12\x36\xb8\x84\x2c\x43\xdf\x14\xb4\x4f\x0a\x30\xd2\x45\xbc\x89\x83\x7d\x7f\xc1\xda\xa0\xe3\x3a\xa5\x8d\x83\xfc\x03\xb0\x6a\x9e\x48\x3a\x58\xf1\x37\x55\x60\x64\x41\x08\x66\xa3\x87\xce\x70\xd9\x9e\x2b\x42\x88\x7e\xf6\xae\xb0\x07\x78\x5f\xcd\xdd\x85\xb9\x5f\xc7\xbc\x4b\x44\xde\xd5\xfc\x4e\xec\x33\x9c\xc6\xdc\x91\xc6\x27\x68\x56\x01\xcd\xd3\xa2\xfc\x1c\x17\x8c\x77\xa2\xfc\xbf\xc2\x7b\xe1\xbb\x7a\xcf\xe7\x17\x59\xc0\xd8\x75\x40\xf4\xb9\x4f\xca\xf8\x37\x8d\x4b\x74\x2b\xf8\x44\xbf\x24\x68\xeb\xdf\x19\x8b\xde\x26\x2a\x5c\x54\x86\xd0\xa7\x17\x73\xf2\xd5\xdc\x37\x68\xf3\x70\xc3\x5b\xd1\xab\xce\x30\x16\x3c\xd3\x45\x0e\x93\x16\xfe\x55\x4d\x78\x01\xbf\xf7\xc2\x3c\xb1\xac\x03\x7f\xbf\xbe\x99\xe8\xc5\x05\x98\x7e\xfb\x66\x5b\x37\x2e\xa4\x9b\xde\x6c\xeb\xc6\xa3\xe8\xda\x37\xdb\xba\xf1\x78\xfa\xfe\x9b\x6d\xdd\x78\x12\x7d\xf5\xcd\x44\x37\x0e\xf2\x6f\x72\xb4\x37\xb9\xd8\x7c\x9d\xfa\xc5\x26\xdd\xdb\xd2\x59\x6f\xea\xf1\x6d\x2d\xfd\xf1\x13\xc6\xa2\x73\x9a\xb8\x12\x0a\x47\x20\x44\x87\xad\xba\xe0\x7a\xc1\xc8\x11\x78\xc1\xa1\x92\x2e\x08\x19\x82\xbd\x90\x32\xa9\x8b\xf8\x6e\xc2\xfc\xe2\x42\x10\xd9\xa7\xf4\x82\x08\x53\x5f\xad\xc2\x74\xdc\x06\x61\x1a\x75\xe2\x53\xae\x5e\xf4\xc8\x5a\xf0\xf1\x75\x60\x77\x6f\xf0\x23\x61\x63\x14\x98\xaa\xde\xb6\x10\x8c\xaf\x05\x6b\xe9\xbf\x16\xf0\x15\xc0\x81\x50\x75\xbf\x9f\x70\x72\x86\xb8\x42\x61\x66\x95\x28\xe8\x03\x24\xd0\xfc\xba\x0e\x3e\xab\xd5\x97\x75\x20\xc4\xcf\xf4\x68\x93\x15\xc5\x49\x98\x47\x9b\xa0\xd8\x70\xf0\x90\xc5\x43\x0e\x62\x6d\x99\xb2\x93\x6f\xe8\xef\x1f\x81\xb5\xc9\x4a\xe1\x48\xac\x39\x15\x4f\xa1\x47\xf3\x28\x85\x1e\x78\xa5\xb3\x37\x89\x4f\xb6\x1c\xa4\x2e\xd8\xc4\xe4\xd0\xe5\x49\x08\xc1\x90\x16\x5a\x05\x01\x42\x93\x24\x87\x86\x48\xfc\x84\xd8\xa2\x88\x9a\xa6\x84\xeb\x7d\x16\x68\xc8\x93\x97\x42\xc0\xdd\x9a\x26\x2f\x7c\xa0\x07\xef\x41\xbb\xef\x45\xc8\x45\x4e\x0c\xf5\x9a\x9e\xe7\x97\x67\xe9\xdf\xd9\x6a\xe9\x97\x15\xf0\x08\x97\xec\xa1\xff\xac\x14\xdd\xbe\x4e\xf4\x39\x53\x02\xb1\x58\x47\x44\x04\xe2\xf8\xed\x71\xc4\xdc\x4a\xc6\x88\x89\x77\xd4\x7d\x02\xf7\x63\x02\x77\xbf\x9e\x69\x97\x40\x6c\x4a\x20\xb0\x8e\xb8\xea\x22\x8e\xc8\x4f\x54\x73\x83\x8e\x40\x17\x73\xc4\xb2\x44\x8e\x4b\x75\xc4\xc3\x97\x70\x44\x59\x02\xd1\x12\x11\x8a\xf7\x5e\x62\x54\x7c\xd7\x36\xc5\x47\x9f\x10\xdf\x71\x84\xea\xe5\x30\xb4\x8e\xea\x53\xb2\xc0\x54\xb3\xeb\xe9\x82\xbd\x22\xef\x4b\xc8\x70\xbf\x21\x56\xcd\x0f\x85\xf9\x2a\xfa\x28\xb9\xc2\x1a\x68\xb6\xcd\xba\x06\x7f\xda\x76\x77\x1b\xc2\xf2\x1b\xdb\xe4\xe0\x17\x28\x1e\xe1\x02\xc9\xcc\x83\x42\xd1\x0d\xdd\x39\x77\x9b\x47\xb5\x57\x74\x7c\x5f\xaa\xa7\x28\x77\xfc\x7f\x29\xf7\x6e\x7e\xdd\x76\xc7\x72\x5f\x13\xe5\xd6\x8c\x02\x2b\x3c\x86\xbd\xa6\x27\xda\xca\xd5\x4a\x18\x5d\x53\x21\xfa\xee\xf0\x16\xc6\xd6\xf0\xef\xba\x2a\x85\x48\x4e\xae\x06\xa1\x13\xae\x0c\x7f\x0b\x5f\x68\x14\x10\xfe\x1b\x05\x5e\xe8\xf7\xf5\x72\xf8\x56\x71\x7e\x50\x81\x4e\x9d\x5d\x4f\x27\xee\x11\xba\xb9\x86\xe9\x27\xd7\x5d\xd9\x2c\xfa\xaa\x31\x37\xff\x45\x83\xbe\x2d\x8c\x39\xd6\xf3\x1f\x33\xb8\x42\x38\x8e\xb4\x10\xbf\x12\xff\xb3\x27\x90\xe1\x4a\xfc\x9e\x62\xf9\x0f\xf1\xde\x6f\x3e\x1e\xe4\xf7\x50\xd9\xa0\xfd\x7a\xaf\x68\x5b\x7e\xac\x3c\xc0\x58\x39\x38\x0e\xba\x30\xc2\xaf\xc4\x57\x3f\xe6\x1d\x78\x02\xbe\x69\xa0\x1e\x71\x5f\x97\x86\x10\x5e\xb0\x11\xfa\xb9\xf1\x9b\xe5\x0d\xa2\x47\x9b\x5f\x0a\xf2\xca\x6f\x13\x95\x8b\xfb\xf5\xe3\xab\x41\xc1\x37\xf8\xf4\x74\x42\x90\x77\x95\xd0\xd8\x4b\x10\xd2\x0a\x19\xd6\x7a\x3f\xf5\x04\xbf\xa5\x46\xcd\x7a\x21\x7c\xd8\x1f\x08\xe6\x05\x25\x39\xb4\xaa\x07\x68\x2e\xc8\x75\xf6\x30\x63\x2c\x51\xc3\x20\x51\xc3\x4f\x0b\x2e\x50\xc3\x55\xbc\x86\xf4\xa4\x20\x42\xda\xed\x12\x31\x9f\x79\x02\xba\xe7\x8d\x72\xe8\x7d\x6e\xce\xbd\xaf\x82\x8a\x96\xa8\x69\x2f\x90\xb4\x60\xb8\x5e\x0e\xf7\x84\x82\x83\x2c\x49\x0e\xbd\xd0\x83\xaf\x08\x79\xc8\x11\xfa\xe3\xbf\xf9\xd4\xd4\x36\xb8\x54\x51\xe4\xd0\x7b\x17\x23\x44\xef\xad\xe0\x53\x53\x45\x0e\x2f\xbb\x58\x7c\xd7\x61\xeb\xb7\x95\xd4\x62\xd5\xfc\xce\x13\xfc\x0b\x6f\x2c\x7d\x47\x27\xac\xe7\x95\x87\x79\x9d\x4c\x1c\x81\x27\xa6\x17\xa2\xef\xb7\x26\xb8\xf7\xa6\x3f\xf8\x04\xdf\x99\x59\xb2\x00\x21\xa7\x9a\x56\xee\x02\x2e\xd4\x56\x98\x34\xf4\x98\xb0\x00\x21\x17\x0c\x1e\x37\xa5\xf2\xf8\x63\x42\x21\x42\xb4\xcf\x1b\x8c\x45\x1f\x6e\x35\xca\xff\x84\x90\x3f\x70\x21\xf9\x5f\x17\xf2\x8b\x4a\x6e\x5a\x80\x90\x9a\x56\x0e\x55\x64\xb6\xc6\x05\xad\xef\xc9\x8f\x34\x2a\xd8\xeb\x54\x6c\xe4\x5b\xac\xa6\xff\xba\x00\xf1\x0f\xbf\x93\xc0\x0a\xea\x68\xef\xc3\xe0\xf9\xeb\xe8\xc4\xd7\xe3\xf7\xed\xc5\x6b\x5e\xb7\x80\xd7\xec\xbd\x50\xcd\xef\xbc\xc6\x6b\x2e\x5b\xa0\x6b\x7e\xfe\x02\x5d\xf3\xa9\x42\xf3\xef\x04\xda\x6b\xfe\xa5\x16\x9d\xa1\xf0\x9e\x9e\xe2\xe0\xaf\x0d\xab\xe9\xf7\x2e\xe0\xee\x41\x31\xb0\xf3\xcc\x21\x1e\x97\xdf\x26\x30\x59\x98\xd4\x00\x71\xa6\x81\x62\xf2\x21\xfd\x57\x02\x6a\xdd\x64\x23\xbd\x68\x25\x67\x84\x05\x74\x7f\x2c\x57\x44\x30\x39\x4a\x4c\x2f\xd0\x8f\x97\x8a\xb5\xbd\xf7\x97\x32\x16\xbd\xac\x85\x31\x17\x69\xc4\xaa\xf9\xb7\x00\x97\x4a\x9b\x7f\x01\xa9\xb6\x89\xc2\x3e\x0c\x70\x7d\xfe\x32\x1f\x1a\x6d\x60\x39\xff\x6e\xf9\xdb\x73\x71\x8d\xce\xea\x9e\x30\x9d\xd7\x3e\x32\x9a\x4e\x81\x15\x21\x7a\xe5\xc7\x71\xd3\x19\x68\x6d\x6f\x3a\x93\x03\x09\xd3\xe9\xf7\x19\xef\xeb\x65\x0b\xce\x31\x46\xea\x68\xb7\x9d\x8c\x45\xfd\xe7\x0c\x8d\x6e\x17\x4c\xd2\x79\x17\x60\x32\x4b\x30\xd9\x23\xa0\xab\xde\x14\xd0\x55\xff\x89\xcc\x55\x9f\x35\xbf\xbd\xea\x7b\x25\x38\x2f\xec\x96\xe0\xfc\xc0\x87\x46\xce\x5b\x65\x84\xe8\x84\x8f\xe2\x9c\x1f\x95\xdb\x73\xfe\xe1\xfc\x04\xe7\x53\xd7\xf1\xca\xcb\xe7\x0b\xa3\x5f\x14\x37\xfa\x4f\xce\x1a\x8c\x7e\xfa\x7c\xae\xbf\x37\xe6\x81\xfe\x4c\xe5\x2e\xe8\xfd\x5f\x76\xe1\x82\x3d\x38\x9f\x0b\x26\x1b\x05\x93\x43\xb3\xcf\x32\xc6\xaf\xc9\x9c\x76\x96\x31\x3a\x6c\x05\x63\xd1\xe2\xb3\x06\x75\x64\x8b\x5c\xdf\xcf\xbd\x80\x3a\xc0\x9f\x61\x6f\x7a\xeb\x3c\x5d\x1d\xa7\xe6\xe9\xea\x78\x42\xa8\xa3\xf7\xbc\xf6\xea\xe8\x71\x36\x6e\x89\x2b\x2e\x6a\xb3\xc4\xaf\xe7\x9d\x67\x89\x26\x7e\x99\x5e\xfa\xbf\xe7\x75\x6a\x89\xfb\xa2\x46\x4b\x0c\x2d\xe7\x8c\xf8\xe7\x71\x4b\x7c\x31\x12\x7d\xac\xc5\x20\xc2\xa3\xf3\xb8\x08\x97\x5e\x48\x84\xbf\xbd\xca\x73\x0e\x9e\xc7\xd5\x36\x79\x2e\x42\xaa\xa9\x1c\x06\xdf\xfb\x9b\xf9\x6a\x64\x16\xf0\xeb\x33\xb5\xef\xc6\x20\x97\x81\x97\xbb\xa3\x62\x97\x81\xd4\xd1\x5d\xaf\x8a\x6f\xa0\x3e\x5a\xc2\x58\xf4\xca\x66\x03\x13\xd1\xb9\x9c\x89\xe7\x4a\x2f\xc0\xc4\x8e\xe5\x5c\x2f\x92\xdf\x52\xe7\x54\x32\x19\xdf\xeb\x4b\x5f\x35\x97\xb3\xf4\x7d\x29\xc4\xfa\xe5\xe1\xcd\x72\xe8\xa0\x19\x21\x3a\x7b\x25\x63\xd1\x8d\x22\xcc\x7d\x91\x93\x2c\x53\xc6\x27\xf3\x60\xff\xe2\x1c\x1e\x09\xab\x85\x19\xd9\x11\x7a\xfc\x65\x4e\x32\x5d\x54\x7b\xc5\x85\xaa\xbd\x0f\xb4\x46\x76\xab\x97\xe2\x60\xad\x49\x7b\x82\xb5\xb6\xb6\x36\xd6\x5f\xb5\xb5\x6f\x20\x10\x08\xf8\xa2\xa4\x0a\x93\x3d\x95\x07\xad\xef\x06\x02\x08\xc9\xce\x2a\xac\xdd\x9d\x84\x65\xe7\x09\x52\x89\x2a\x71\x70\x77\x72\x76\x3d\xee\xb7\x9b\x36\xef\x65\x2c\x3a\xe8\x8c\x41\x50\x24\x6a\x5c\x35\xe7\x02\x35\x1e\x5a\xc6\x45\xdb\x52\xaa\x1b\xcc\xc6\x52\xdd\x60\x32\x79\xd4\xd6\xfb\xd0\x1c\x6e\x30\x59\x2f\x90\xac\x20\x84\xfe\x3f\x36\xc5\xfb\xcf\xdc\x14\x61\x24\xd0\x71\xa8\x2b\xca\x58\x20\x67\x80\xbf\x5b\xf6\xe1\x40\x89\x84\xec\xf2\xa2\x1a\xec\x4d\x9f\x55\xca\x17\x8d\x49\xd6\x0b\x74\xe0\x4b\x8c\x45\x57\x34\xf1\xca\xc6\x97\x72\x3d\x3e\x37\x07\x7a\x44\xaf\x72\x57\x78\x97\x1c\x3e\xa2\xf7\x4b\xff\xa5\xc5\xc1\xfc\x6e\x25\x08\xa1\x79\xa9\x58\xcb\x1f\x04\x73\xde\x8c\xe5\xfa\xc5\x2f\x07\x68\xfe\x3f\x45\x5c\x30\x2f\x95\x47\xcc\x36\x9a\xf7\x01\x63\x79\x57\xcb\xa1\xd7\x52\x74\xc0\x75\x00\xc8\x93\x43\x6f\x77\xe5\xae\xde\xa6\x3a\x15\x25\xb7\x4a\xd6\x3c\xa9\x08\xf5\xdb\x4a\xe2\x07\x0a\x4d\x4b\xb8\x31\x29\x98\xd4\xa8\x4e\xc5\xd6\xaf\x2a\x5c\x3f\xcf\x96\xbd\x4b\xcb\xcb\xa0\xd1\x7f\x08\x54\x99\xb3\x37\xe2\x0b\x5e\xc1\x6a\xa9\x5f\x55\xee\x0f\xf3\xf7\x61\x6f\x3d\x26\x47\x6b\x9d\xe9\x08\xd1\xed\xbf\x30\x16\xfd\xaa\x91\x31\x57\x98\xd9\xe5\x15\x35\x58\x35\x2f\x99\xc3\xf5\x6c\x7f\xfc\x02\x7a\x0e\x2d\xe5\xa2\x4f\x9c\xc3\xb5\xba\xf8\x71\x10\x3d\xeb\x05\x4c\xb6\xba\xc2\x87\xfd\x5d\xed\xa1\xc3\x72\x38\xc5\x2c\x42\x83\xbf\x75\x17\xa1\x81\xb7\xde\xa9\x2a\x2f\xb8\xc2\xbf\xca\xe1\xe2\x46\xc6\xd4\xb4\x60\xb0\x39\x49\x0e\xfd\xad\x91\x5f\x40\xb2\xa8\x4a\xae\xa8\x27\x69\xc1\xe8\xd0\x46\x5e\x72\x77\x5e\xb2\x39\xef\x71\x84\xd4\x81\xe5\xe0\xa4\xb3\x1b\xe3\x7d\x7b\x91\x24\x7a\x2d\x89\x37\xd7\xe2\x03\x7c\x87\x47\xae\x40\x98\xc4\x42\x11\xdf\x25\xf2\xfa\x26\x7e\x1a\x7a\xee\x7b\x8c\xf1\x0b\xc6\xfd\x87\x31\x39\x4a\xe7\xbd\xc8\x58\x34\x7a\x9a\x0b\x29\xaf\xa8\xc6\xaa\xf9\xe3\xc7\xb9\x8c\x63\x66\x5f\x40\xc6\x95\xaf\xf0\x03\xc1\x0b\x1e\xe7\xe1\xe2\x87\xb3\x11\x52\xb3\x9e\xc2\x64\x53\xf8\xb0\xdf\x12\xda\x25\x87\x91\x49\x08\x18\xee\x86\x50\x90\x4a\xc4\x7c\xcf\xe3\x08\x45\x9f\x3a\xcd\xb7\x57\xbc\x8b\x45\x00\xc8\x6f\xe7\x88\x3b\xa2\x3b\x1e\x6f\xeb\x4e\x86\xee\x3d\x8b\x5f\x47\xc5\x27\x75\x35\xb4\x79\x11\xc8\x72\x70\x3d\x94\xce\x27\x6d\x95\x74\xc8\x62\xfd\x46\x8b\x61\xdd\x78\x07\x60\xb3\x39\xd3\xef\x94\x5c\x80\xe9\xd8\xcb\xff\xbd\xcb\x5d\xa8\xbf\xd5\xd9\x51\x1d\x54\x95\xe8\x73\x4f\xfe\xc4\x58\xf4\x3f\xad\x5c\x90\x57\x5e\xe4\x82\x64\x71\x41\xc4\x2d\x5a\xde\xf4\x29\xb3\x79\xeb\xbc\x5a\x82\x90\x9a\x57\x1e\x66\x72\xf8\x05\xf1\xb9\x91\x12\x7d\x24\x11\xdf\x44\x1f\x69\x62\xec\x73\xfe\xf6\x41\x0b\x63\xeb\xf8\x5b\x19\xdf\x8f\x4f\xcf\x12\x05\x8c\x85\x02\x7a\x95\x87\x77\xc9\xa1\x83\xe7\x18\x8b\x7e\x72\xc6\x30\xd2\x58\x04\x4d\x6e\x49\xc2\x65\x2e\x39\xc3\x47\x7d\x18\xe3\x92\xdf\x37\x8e\x71\x9f\x5b\x10\xa2\x81\xf7\xe3\x63\xdc\x07\x96\xc4\x18\xe7\x20\x60\xca\x5b\x4a\x20\xd6\x3f\x82\x93\xad\x98\x7c\xe7\x26\xdf\xd0\xd0\xc7\xfc\xbc\xf8\x55\xa4\x8e\x3e\xb4\x55\x9c\x10\xb0\x39\xd5\xc9\x8a\x82\xd5\xf4\x95\x25\x08\x39\xfa\xd5\xe0\xe4\x24\x37\xd9\xa8\x1f\xc3\xe5\xbd\xf5\x9f\x2b\x84\x17\x95\x43\x29\x29\x62\x74\xc9\xc2\x6a\xfa\x63\x25\xd0\x0a\xdd\xb0\x9a\x3e\x1f\x38\x7d\x7e\x25\x9f\x9f\x0e\x94\xb0\x36\x5b\xa2\xef\xef\x12\xda\x13\x73\x24\xec\x35\x0f\x2b\xd1\xb7\x72\xc4\x3e\x4e\x56\x09\x42\xb4\x6e\x3b\x63\x1e\x75\x90\xad\x44\xdf\xba\xc1\x5e\xb3\xc2\xc9\xb2\xec\x6e\xb2\xd1\x5d\xf9\x9b\x69\xb8\x74\xca\xad\xce\xb5\xd0\xfc\x17\xf8\xb7\x0c\x19\x58\x35\x37\xcf\xe2\xa7\x85\x1d\xc4\x95\xa9\xdf\x36\x12\xfe\x5e\x7c\x74\xcc\x2f\xcb\x77\x68\x4e\x45\x72\x92\x03\xc4\xfc\xfd\x2c\x84\xe8\x49\x18\x76\x8f\x36\xc0\xf4\x82\xff\x36\xda\x3a\xf1\x79\x46\x96\x5a\xa4\x64\x26\xbc\x1e\xfb\x8d\xb1\xe8\xb9\x36\x1d\xaf\xf8\xbb\x51\xc7\x55\x5d\x10\xa2\xbd\xde\x8b\xeb\xf8\xa3\x2e\x42\x05\x99\x58\x4d\x7f\x74\x96\xe8\x90\x06\x9b\x7e\x63\x1f\xc4\xc1\x3e\xae\xd0\xbf\xcc\x02\xc1\xf8\x08\x6b\x73\x70\x1a\xbe\xf4\x54\x07\x03\xeb\xd9\x0f\x13\x0d\xb1\xf7\xab\x44\x43\x88\x7c\x97\xcd\x82\x86\xa8\x6d\xd7\x10\xa7\xa1\x21\xfe\xf8\x6a\xbc\x21\x1e\x31\xb5\x35\x04\x9d\x19\x6f\x88\xd3\x33\xcf\x6b\x88\x6b\x7e\xe0\xf3\x95\x2f\x67\x22\xe4\x51\x07\xfd\x7b\x26\x5f\xa2\xf2\x68\x79\x42\xc3\x07\x4c\xc3\xa5\xc6\xe1\x64\xaa\x85\xee\x7a\x4e\x5c\xe0\x0c\xfe\x6f\xa6\xae\xe1\x21\x09\x0d\x6f\xe2\x1a\x1e\xc2\x35\x1c\x7d\xb9\x95\xb1\xe2\x60\x7e\xd6\x74\x98\x4b\x3d\x73\x42\x1c\x25\xbb\xce\x89\x10\xbd\xf9\x25\xc6\x54\x33\x9e\x89\x50\x74\xd7\x59\x4e\xd4\x45\x10\x1d\xd2\xbf\xe7\x29\x40\x88\xf6\x7c\x89\x73\x74\x35\x50\x7d\xd3\x2c\xcc\x04\x3c\x5a\xf4\xf1\x33\xba\x1e\xb0\x9a\x9e\x36\xf3\xbc\xd0\xc5\xfd\x33\x0f\x06\xc4\xa8\x75\x48\xf7\x12\xda\xa0\xd9\x37\x22\x44\xfb\x3f\xaf\xaf\xf4\xd4\xd0\xe7\xcb\xc1\x69\x1c\xf6\xa7\x47\x87\x99\x61\x4a\xac\xef\xf1\xad\x6f\xa2\x93\xde\x16\x67\x2e\xec\xcf\x31\x16\x6c\x62\xfe\x18\x24\x2e\x79\x0e\xfc\xff\x71\x98\x91\x06\xb9\x74\xfb\x9b\x38\xe3\x77\x3e\xc6\x19\x7f\x5d\xdc\x9e\x3f\xe5\x76\x84\xe8\xa8\xc5\x8c\x45\x9f\x13\xdf\x10\x2a\x30\x61\x5e\xf8\x0e\x1f\xb4\xc2\xc3\xce\x70\x98\x4d\x2b\x94\xf8\xa5\x4f\x45\x8a\x0d\xfc\xa2\x53\x51\xa2\xee\x33\xbc\x34\x24\x4a\x1b\xc7\x7f\xb0\x21\x7f\x3e\x94\xd6\x0d\x4a\xbb\xf3\x04\x47\xff\x3c\x8d\xa3\xe7\x9c\xe4\xe8\x95\x0e\x84\x68\x0c\x5c\xf6\xc3\x27\x19\x9b\x97\xed\x26\x87\x5d\xd9\xbf\x0e\xd5\x06\xbe\xe1\x21\x47\xdd\xc1\x8d\x92\x3b\x58\x63\xa2\xcd\xef\xf0\x4a\x07\x3a\x73\xf7\xce\xff\xc5\xe3\xed\x7b\xd0\xc7\x5b\x78\xbb\x4f\x5f\xcc\xe9\x6f\x82\x59\xbd\x34\x4c\x4b\x9b\xc0\x7f\xdb\x63\x9f\x09\x57\x1e\x48\x76\x4b\xdf\xd2\xad\xa0\x21\xaf\x47\x19\xe8\x26\x77\x58\x71\x9d\x69\x13\xef\x37\xe4\xce\x5e\xe1\xcd\xf2\x33\x11\x71\x3f\x8e\x7d\xf0\x67\xca\x56\x09\x21\x39\xf4\x9e\x49\xdc\x96\x74\xb5\xbe\xfe\xe0\x54\x2c\xea\x5c\x93\x3b\x58\x2b\xe1\x60\xb5\x09\x07\x6b\x4c\x83\x4b\x4c\x72\x28\x64\x42\x88\x9e\x7e\x07\x34\x3c\x46\xb1\x0c\x4e\x96\xc3\xd3\x4d\x30\x38\x4e\x41\xce\x7e\xd4\xe3\xdd\x8b\xbd\x66\xec\x83\xee\x59\xaa\x88\x4f\x21\x80\x27\xfe\x35\xc4\x55\x3e\xe8\x25\x75\xfc\x63\x08\x2d\xed\x18\x26\x69\x0a\xed\x0f\x2c\xaa\x69\x8a\xdb\x7b\x4a\x7c\x76\x81\xd5\x74\xc9\x87\x10\x4e\xbe\xd1\x43\xf6\xd1\x23\xcf\x32\xe6\x56\x87\x58\x87\xaa\xa6\x63\xfc\x57\x49\x1c\xda\x1d\x52\xae\x53\x19\x28\x3f\x3b\x9a\x41\xff\xeb\xfb\xe3\x0c\x84\xa2\xc7\xce\x1a\x16\x1a\xf8\x4f\x21\x7e\x76\xa8\x73\x85\xbe\xfb\x76\x7b\x85\xbe\x3c\x83\x2b\xf4\x89\x19\xba\x42\x7b\x24\x77\xa6\xd0\xf9\xcf\xfe\x17\x85\x4e\x15\xb7\x31\xb5\x29\xf4\xe6\x64\x7e\x8e\x28\x76\x15\xdf\x77\x4c\xe8\xb3\xa6\xbd\x3e\xad\xc9\x08\xd1\xb7\xdf\x36\xe8\xb3\x95\xaf\x24\xa6\x27\xcd\x00\xb5\xce\x45\x1e\x6d\xe0\x5d\xce\x7e\x4d\xee\xca\xfd\x26\x4f\xf2\xa0\x1f\xa7\x23\xe4\xf1\xee\x75\xab\x77\x58\xe3\x3f\xe7\x32\x54\x4b\xa3\x5c\x99\x7b\x9f\x89\x2b\xb3\x31\xae\x4c\xf3\xc7\x40\x4f\xf6\xe1\xe4\x01\xf4\xb5\x67\x40\x99\x77\x80\x32\xa9\xae\x4c\x97\xae\xcc\xfb\x18\x63\xd1\x0f\xf4\x93\x64\x0a\x71\x2a\x99\xfc\x32\xa0\xb2\xb3\x1d\x21\x87\xcf\xb4\xf5\x8a\xc7\xde\x64\x2c\xef\xcf\x72\xf8\x8e\xd3\x8c\x45\x7f\x3b\x67\x68\x02\xfe\xeb\x93\xb9\x31\xbe\x8a\x9f\x76\x43\x7c\xed\x6d\xc1\x3f\xc4\xa2\xdc\x76\x1e\x6b\xa7\xa3\x04\x62\x92\x8e\x58\x25\x10\x3f\x5d\x1f\x47\xfc\x45\x47\xcc\x15\x88\x8c\x44\x8e\x9b\x75\xc4\x5d\x02\xb1\xa1\x7f\x1c\xd1\x5b\x47\xbc\xce\x77\x45\xd2\x5f\x4f\x14\x65\xfa\x47\x62\xe1\xd1\x27\x70\xb9\x03\xe2\x38\xfa\xbe\xc8\x24\xb6\x52\xd2\xa7\x27\x32\x7d\xad\x23\xfa\x0a\xc4\x7d\x09\x44\x85\x8e\x68\x16\x4b\x95\xc3\x12\x88\xe5\x3a\xe2\x6b\x81\xb8\x35\x8e\x70\xac\x4f\x43\x08\xd1\x79\xef\x27\xb4\xc4\xb7\x4b\x0e\x32\x16\x7d\xfc\x90\xf1\x3c\x10\x1f\x71\x78\x38\xa2\xa6\x37\x4d\x6b\x17\x45\x65\xff\xc8\x7d\x1f\x0c\x2a\x97\x3e\xcd\xc7\x30\xec\xa5\xb4\xfb\xd3\xc2\x23\x6a\x3e\x73\x12\x3d\xc2\xe1\xfb\x6b\x4d\x99\x36\xac\x95\x66\x4a\x98\x54\xd3\xb5\xaa\x58\x49\x78\x40\x65\x2c\xfa\x2f\xe1\x87\xed\xb9\x1f\x0b\x23\x0d\x57\x41\xd7\xd1\x17\xa8\xb3\x23\xd1\x8f\x84\x93\xff\xea\xcf\x08\xd1\xbf\x82\xc7\x5c\x76\xa8\x43\x86\x7d\xe7\xda\x65\xd8\x2e\x36\xce\xee\xee\x8f\x10\xdd\x5b\xce\xdf\x8f\xe7\x21\x44\xbf\x11\xef\xab\x01\xbe\x49\xbc\x3b\x00\xfe\x59\xb9\x98\x14\x46\xfb\x1d\xe1\xc0\x50\x3e\x42\xf4\x1d\x41\xb0\x1d\x88\x97\x8a\xf7\xf7\x81\x83\x67\xc5\x7b\x4f\x80\x07\xc5\xfb\x65\xf0\x3e\x4b\xbc\x67\x42\x81\x8f\x88\xf7\x1f\xe1\x7d\x9c\x78\xcf\x81\xbc\x23\xc4\xfb\x73\x40\x3f\x44\xbc\x1f\xc9\x42\x88\x0e\x16\xef\x77\x01\xfc\x06\xf1\x5e\x36\x18\x21\x7a\x4d\x39\x63\xf4\xab\xa7\xda\x2a\xb9\x58\x20\xbf\x83\x4c\x29\xe5\x06\xeb\xe6\xbf\x55\x3a\x9a\x1a\x20\xfc\xd7\x4c\xdd\x46\x08\xff\xbd\xd3\x5b\x28\x84\x23\xfc\xa7\x50\xb3\x04\x92\xfb\x8b\xf5\xfc\xc7\x52\xaf\xe0\x97\xdc\xe7\x67\x4d\x05\xc8\x45\x60\x1c\x3d\x45\x85\xaf\xff\x49\x9c\xa8\x52\xcd\xd6\xa9\xed\x4e\xd0\x13\xfd\x17\xe3\x7a\x37\x3d\x0a\xfa\x50\x13\x7b\x1e\xf4\x55\x9e\xf1\xa1\x3f\x21\x44\x27\xab\x62\x1f\x52\x14\x42\xc7\xaa\xbc\x96\xda\x47\x11\x6a\xf7\xed\x0b\xf9\x5e\x9c\x26\xbf\x05\xf2\x8c\x5a\xde\x76\x9c\x34\x78\xc0\x20\x05\xff\xd5\xd7\xc7\x8c\x10\xfe\xbb\xb0\xe3\x0e\x24\xe8\x1d\xeb\xf9\x2f\xc7\x0e\x37\x42\xf8\x0d\xf6\x83\x0f\x88\xf3\x16\xed\xf6\x5b\x96\xa4\x74\xd8\x6f\x29\x4b\x01\x23\xf2\x68\x13\x14\x3b\xbf\x8b\x0e\x26\xef\x92\xd8\x13\xc9\x13\xa7\xdf\xde\xe7\x66\xc7\xef\xa8\x1b\x4e\xf6\x62\x0d\x7a\x86\x56\xaa\x64\x3e\x2c\xb9\x49\x14\x6b\x4e\xc5\x59\xf8\xb0\x04\xcf\xc2\x42\x7a\xec\xfd\xb6\xbb\xea\x96\x9b\x11\x52\xc7\x28\x03\xc2\xbb\x4a\xfe\xe4\x51\xfb\xff\x63\x0a\x42\xee\xe0\x5c\x3b\xf2\xf5\xc2\xde\x52\xd1\xc3\xfa\xb5\xba\x93\xe7\x22\xfa\xc5\xbb\xbc\xcb\x92\x1f\xf4\x48\x2b\x2f\x1c\x91\xcb\xee\x33\xf3\x31\xe1\xd1\x29\xe2\xd3\x50\xd5\xa3\x0c\x84\x51\xcc\x23\x1d\x23\x1e\xc5\xa4\x16\x82\xc7\x2f\xdb\x8a\x10\x0a\x36\xb7\xca\xe1\xcb\x24\x84\x82\xa5\x4a\x8e\x24\x87\xfb\x42\xbd\xa2\xaf\xc0\x60\xc0\x83\x3a\x5a\xb7\x49\x1c\xd0\x23\x75\x74\x4c\x19\x4c\xd8\x46\xc2\x3c\x0e\x93\xda\x50\xbd\xef\x3e\xac\x8d\x31\x33\xb9\xa2\x3b\xff\xcd\x1c\x79\x71\x24\x70\xc6\x26\x2f\xaa\xec\xba\x09\xe7\xfe\xe4\xfb\x43\x28\x22\x87\x09\x0c\xf0\xb7\x37\x62\x12\x13\xf8\x66\xf1\x43\x60\xa1\x88\x1c\xfa\xca\x24\x4e\xd9\x27\x7e\x6e\x73\xe6\xf1\x3c\x9b\xef\x30\x78\xa2\x86\x85\x50\x67\x2d\xbc\x96\xac\x12\x0b\x76\x03\x4c\x08\x6d\xc8\xe6\xbf\xc7\xb1\x5c\x44\x48\x77\x2e\xe4\x63\x96\x89\xba\xde\x65\x2c\x3b\x12\xcb\x2c\xaf\x73\x2a\xfc\x2c\x29\xc8\x15\x1a\x65\xe2\xf3\x2f\x4b\x20\x07\xc9\xe1\x5b\x4d\xfc\x56\xd7\x3f\xbc\x2b\xd6\xda\x9f\xe6\x8d\x67\xb8\x1c\x34\xdf\xc4\xd7\xda\xa1\x8d\xdb\x5d\xb2\x26\xb2\x87\x06\x9b\x10\x52\x3d\x4a\x0e\x0c\x95\x0d\x7c\x64\x3c\x1d\x9f\x29\x82\x9d\x0c\xf3\xe6\x7d\x7c\xbb\xea\xfb\xb7\x38\x28\x86\x55\xf3\xd9\x47\xe2\x47\x93\x72\xbf\x63\x6c\x28\x31\x35\x40\x28\xe2\xcc\xf5\x29\x39\xf3\xeb\x21\xfe\x79\x04\x5a\xa8\xef\x37\x8f\xc4\x0f\xc7\xee\x5b\x08\x01\x41\xa9\x92\x03\x91\x38\x31\xaf\x7b\x04\x21\xb7\xea\x54\xb0\xbb\xce\x95\x29\xce\x16\xba\x30\x00\x6c\x2e\x32\x77\x80\x9b\xb8\x6c\x0e\xd5\xa9\x64\xb8\xc9\xd8\x1c\x3e\xe1\x80\x96\x77\xd4\xb9\xc6\x88\x8f\x55\xb2\xec\xb8\xf2\x37\x93\x47\x3a\x15\x9c\xdb\x8b\x61\x75\xae\x45\x0e\xff\x20\x21\x44\x97\x3f\xc9\xdd\xd7\x40\x7e\xa6\x2f\x5c\x2f\x97\x6d\xe6\x3b\xde\x53\x6c\x40\x13\xb8\x0d\xc9\xe1\x35\x1c\x30\xd7\x4a\xa7\x3f\x09\x5d\x6f\xae\x8d\x5f\x08\x47\x27\x88\x54\x06\xbf\xe7\x8e\xde\xcd\x53\x3e\xc5\xe2\x01\x1e\x6e\xd7\x26\xa7\x24\x39\xb4\x59\x2c\x79\xb2\x62\x95\xff\x59\x2b\xd5\xb8\xc2\x6c\xde\x9f\xb0\xe6\x6c\xb0\xb8\xa1\x8b\x3b\x15\x4b\xe2\x3c\x96\x7a\x9b\x3b\x58\x67\xa1\x69\x4f\x32\x26\xd5\x78\x72\x4b\x15\x8b\x3f\x86\xbd\x2d\xf4\x97\x27\xf5\x1f\xdb\x74\x59\xdc\xc4\x25\x2c\x99\x87\xff\x22\xf6\xe7\x3f\xfe\xb2\x27\xcc\x58\xad\x0b\x75\x73\xab\xb3\x2c\x58\x9b\x2b\x39\xb5\x69\x12\x7d\x25\xcc\x98\xdb\xdb\xea\x08\xb6\x4a\xf3\x5c\x72\x45\x51\x3e\xc3\xda\x10\x16\xbf\x4e\x85\xff\x1e\xec\xf5\x8e\x7e\xad\x0e\x2d\x2d\x25\xd8\x2c\xcd\xeb\x23\x57\x14\x99\x19\xd6\x4a\xe2\x24\xf2\x93\x83\x93\xf4\x7b\x91\x6e\x4c\xe2\xeb\x47\x6e\x75\x88\x0d\x4b\x47\xf9\x67\x1f\x79\x61\x1e\x30\xe4\x84\x23\xf3\xe6\x3a\xb5\x69\xcc\xad\xdd\x21\x89\x9d\x4e\x71\xbe\xda\xa6\x9f\x5f\x73\x85\x4f\xcf\xb3\xba\xd5\x7b\x6c\x10\xb9\xa8\xd3\x33\x68\x32\xb0\x05\xc1\x27\x39\xaa\x5e\x86\x83\x75\x17\x9a\xeb\x0f\x55\x7d\x0d\x4d\xed\x26\xfc\xf7\xc0\x84\xbf\x59\x2c\xb0\xed\x49\xa6\xbb\x43\x8c\x79\x72\x7d\x8a\xc5\xbf\x82\x33\x45\x80\x29\x1b\xed\xf2\x24\x63\xd0\xd6\x50\x71\xec\xcf\xe2\x1c\x7a\x75\x88\xff\xe6\x59\x12\xd7\x07\x26\x35\xb5\xa8\x1b\xe8\x12\xd4\x74\x61\x7d\xce\xb2\xd0\x71\x21\xde\x8d\xb2\x0f\xd3\x70\x88\x97\x58\x28\x9a\x68\xbe\xcd\x41\x5c\xd6\x60\x44\x72\x10\x57\x1a\xc4\xaf\x6e\xe2\xb2\x3b\xfe\x1f\x7b\x6f\x02\x1d\x55\x91\xfd\x8f\x7f\x5e\x6f\xe9\x4e\x42\x27\x40\x12\x92\x6e\x02\x2f\x21\x40\x58\x02\x01\x64\x0b\x09\x24\x24\x40\x07\x83\x04\x08\x10\x20\x2c\x9d\xa4\x43\x5a\x43\x12\xd3\x1d\x08\x9b\x20\x9b\x44\x88\x82\x3a\x8a\x0e\xa3\x38\x6e\x88\x88\x28\x8e\x32\x8e\x33\xa2\x20\xa0\xe3\x82\xa3\xe3\x3e\x33\x38\x6e\x61\xd0\x11\xd7\xc1\x01\xad\xff\xb9\x55\xf5\x96\x2c\x38\xf3\xfd\xfe\xff\xe7\xf7\x3b\xe7\x7f\xec\x73\x92\xf7\xea\x6e\x75\xeb\xd6\xad\x5b\xb7\xea\x6d\xdb\xa6\xa4\xe7\x9d\x98\x54\x2a\x72\xc6\x49\x25\xad\x29\xd7\xf3\x2d\xda\x81\x41\xfe\xd5\xd0\x3e\x41\xfd\xab\xa1\xad\x4f\x9e\x62\xac\xf5\x4f\x22\x74\x7f\x5d\x4d\xf9\xe1\x46\x61\xc5\xa2\xe6\xbe\x1f\x56\x53\xee\x70\x8d\x47\x7f\xde\x26\xfb\xac\x6f\xe3\xf3\x4a\xeb\xc6\x8d\x8c\x8d\xad\x49\x1b\xb3\xee\x73\x3e\xa0\xc3\xf7\xd1\x30\x3d\x53\x72\x51\xbb\x2e\x32\x76\x52\x66\xcc\x0d\xd9\x17\x29\x05\x9c\x3d\xc6\xb7\x4b\xbb\x9f\xff\xa5\x8d\x7c\x1a\x19\xf6\xa7\xe6\xe3\xfc\xf9\x19\x8a\x99\x67\xe2\x2f\xea\xf7\x1f\x3f\x93\x49\x0a\x25\xdc\xce\xd8\x99\x3f\x5e\xe0\xf7\xdf\x9d\x55\x62\x9e\xe8\x7e\x3c\x5f\xa1\x98\xd4\x26\x20\xf9\xc6\xfe\x25\x66\x53\xd5\x05\xfe\x96\xce\xb7\x29\xc6\x9d\x99\x6c\xbc\x37\xbf\xb9\x2c\xcd\xa6\x8d\xf7\x33\x05\x17\xb5\x0b\x8f\x8f\xf3\x0f\x3e\x9d\xe7\x97\x0a\x5b\x8b\x49\x97\x8d\x2f\x90\x3e\x5f\x1d\xf0\x35\x9f\xf4\xfd\xeb\x94\xaf\xa5\x9e\x9d\x19\x73\x81\xeb\x65\x3b\xb3\xb6\xfd\xe7\x78\x73\xe1\xdb\x98\xb3\xbe\x0a\x40\xd8\xed\xdb\xcc\xc2\xae\x33\x2f\x5d\x27\xe6\x1d\xfd\x79\xa4\x8d\x39\x4b\xcc\xf8\xf7\xdb\xe1\xdb\x3e\xff\x3b\x5e\xec\x31\x8d\xde\x20\xf6\xa0\x1f\xa1\x63\x4b\x36\x5a\xb7\x6c\x14\xdf\xc9\x8a\x79\x62\x5c\xee\x26\x16\x9e\x3a\xec\x85\xb3\xd1\xda\x7b\xa7\x27\xa1\xf9\x35\x5f\xc5\x6b\x3e\xe5\x54\xcb\x0c\xa5\xa8\xe2\xb9\xdc\xf5\xac\xac\x31\xf6\x38\xca\x08\xd5\x32\xc3\x52\xa4\x1c\x29\xaa\x78\x2e\xe6\x89\x28\x0a\xd4\x5b\x9d\x42\x44\xe3\x09\xc2\x16\x29\x47\x8e\xeb\x5f\x13\x90\xef\xa3\xa6\xb9\x92\xbf\x9e\xfd\x5f\xda\x67\x5f\x5a\xd3\x37\x30\xd6\xd2\xd4\xad\xb0\xf9\xf9\xbc\xe6\x63\xbe\x0a\xd6\xfa\xaf\x0d\x9a\x3a\x51\x93\x36\x7f\x1e\x73\xc3\xfb\xfc\x81\xa8\x98\x4d\x6f\x90\x6b\xf1\x00\x3a\xec\xbb\x49\xc3\x3e\x9f\x34\xec\xbd\xb3\x39\xb2\x9d\x5b\x23\xa9\xc2\x49\xc3\x3e\xf7\xb5\xc4\x8d\xc9\x6b\x59\x6d\x99\xd4\x7c\xd4\x57\xc1\x26\xc6\x3c\x11\xdd\x7d\xd3\x0b\x79\x31\xbf\x78\x76\xd2\xd8\x37\xf2\x62\xee\x3a\x52\xb8\xf1\x39\x65\xd2\xa6\x67\xc5\xcb\x11\x0b\x2b\x5e\x9f\xd4\x92\xaf\xf8\x94\xe7\x0a\x2b\xce\x4f\xda\xfc\x79\xe3\xe1\xf5\xdf\x4f\x08\x8f\xbb\x26\x69\xfd\xf7\xb9\xe1\xca\xf5\xdf\x97\x35\xc6\xe5\xf1\x0d\xb0\xb3\x4f\xc8\x7a\xd6\x7f\xdf\x37\xec\x15\x95\xb5\xad\xe9\xec\x2d\xe2\xfb\x75\x96\xbe\x84\xdb\x78\x5c\xc9\x6b\xb9\xdc\x72\xdc\x36\x26\xb4\xf1\x47\xeb\xba\x6c\x82\xe5\xb5\xac\xb6\xfa\x2a\x5e\xcb\x6b\xfe\x23\x2d\xbb\x94\xe7\xce\x2c\x66\x8c\x1d\xb7\xa8\x67\x1f\xf3\x35\x3f\xc7\xf7\xcc\x8e\x5b\xb8\xe0\xe3\xb6\x31\x68\xfb\x3d\xc8\x27\x2e\x03\x5a\xfd\x5b\xc4\xf7\x20\x5b\x72\x1e\xed\xa3\x7d\x28\x4a\x74\xef\xc6\xcf\xc6\xb4\xe6\xdf\xc5\x13\xa3\x87\xfa\xf0\xd1\xf6\xfb\x00\xd0\x9a\x29\x40\xfb\x04\x68\x55\x25\x20\xae\xc7\xb4\xe4\xdc\x29\x40\x2b\x08\x14\x79\x17\x63\xcf\x9c\xb2\x03\x27\xec\x57\x05\xc4\xad\x4c\xe2\x86\xf7\x60\x80\x60\xe3\x2a\x81\xde\x34\x46\xca\xaf\x65\x8c\x7f\xa6\xd9\xd7\x6c\x1f\x45\xe2\x67\x5c\xcb\xc7\xf7\xaa\x0a\xf1\x4c\xe3\xf0\x0a\x39\x53\x55\xc5\xd4\x65\x8c\x14\xc0\x91\x39\x15\xfc\x76\x3a\xfe\xe5\x93\xe6\xe2\x5c\xdf\xb6\xf8\xb3\xe5\xe2\xa9\xc6\x11\x82\xc2\xfe\xcb\x72\xfe\x79\xe5\x1d\xd0\xaa\xde\x21\x1e\x7f\x8c\xff\x77\xa5\x78\x9c\xf2\xfa\x72\x20\xa6\xab\x5a\x15\x53\x17\x7f\x63\x39\x27\x10\x4f\xaf\xc7\xff\xb6\x42\x3e\x5f\xc9\x09\xaa\x63\x7d\xcd\x3b\xda\x7d\xab\xd9\xb8\x39\x2b\xa6\x6b\xbd\x1a\xd3\xb5\x29\x33\xa6\x6b\xae\x2f\xa6\xab\xaf\x34\xa6\x6b\x71\x75\x4c\xd7\xd2\x26\x5f\xb3\xfd\x7a\x32\xc2\xe6\xf5\xdc\x2e\xd7\xa7\x6a\x8d\x2f\xab\xe4\xb3\xf4\xcb\xe5\xc0\x89\x7c\xf1\x14\x4a\x73\x7e\xba\xef\x44\x3e\xcf\x1e\x5a\x3f\x5e\xaf\x3f\x1b\x90\xaf\xb6\x4e\x59\xcf\x2d\xb1\xa3\x5c\x3e\x14\x60\x7e\x32\xa5\xb9\x38\xf6\x44\x6e\x34\x17\xd0\xe6\x21\x95\x13\xf6\xbf\x94\x0b\xdd\x5a\x9d\x9c\x7d\xb6\x53\x7f\x64\xa5\xf3\x67\x55\x4e\xd8\xcf\x4a\x8e\x13\xf6\x07\x2b\xb4\xb3\x27\x75\xd8\xaf\xf4\xb3\xa3\x4b\xa5\x31\x37\xff\x29\x66\x53\x2f\x1b\x50\xd4\xb2\xd6\x79\xa2\x20\xcd\x26\x72\xce\x8c\x07\x53\xf8\x8d\x10\xfc\xbe\xea\x96\xbe\xbf\x4e\xa1\x43\xc6\x9d\x29\x3c\x2f\x75\x0a\xe8\xc8\x7b\x45\x31\x0e\x22\x5d\xe5\x8f\x2f\x9c\x79\x40\xbf\x8f\x42\x3e\xb1\xf9\xa6\xaf\x25\x67\x73\x0a\x60\x7a\xcc\x65\x9f\x05\xf8\x6d\x8c\xb8\x11\xbe\x31\xa5\xfd\x43\x30\x4f\x9b\xb0\x4b\x3b\x60\x47\x59\x34\xc9\x2d\x39\x89\xed\xb1\x9b\x33\x15\x9a\x7c\xa6\x38\x0b\x5b\x16\x8b\x6f\xfe\xc5\xf1\xcd\x33\xbb\xcf\x4f\x7d\x35\xcb\x59\xd4\xb2\xda\xc9\x9b\x7c\x17\x00\xf1\x1c\x6f\xf3\x9b\xbe\xe6\x2f\x4c\x15\xfc\xc5\xa2\x3d\xa1\xd3\xfc\x66\x51\xf3\xa7\x26\xcc\x51\x8b\xd1\xa8\xa2\x36\x3c\xaf\xb5\xc1\xfc\xcb\xa4\xd0\xbf\xf8\xf3\x63\x39\x87\x97\x10\xee\xb5\xa2\xe6\x0b\xbe\x96\x91\xe3\x86\x91\xf9\x56\x3b\x5b\xff\xbe\x96\x7f\x94\xcd\x79\xc2\x4e\x78\x45\x76\x07\xff\x10\x47\x4c\x6f\x6c\x8b\xff\xfb\x12\xa0\x60\x5b\xdf\x0b\x7e\x80\x74\xbe\xc9\x02\x0c\xfb\x7c\xd8\x7b\x93\x36\x7f\xb4\x2e\xed\x4c\xa3\x45\x7e\xa7\xa9\xf9\x6f\xbe\x8d\xcf\x67\x6e\x3c\xaa\xb4\x7e\xd5\xcc\xd8\xd8\xe4\x97\xfc\x40\xcc\x0d\x73\x2c\x3c\x39\x7c\xc4\x0f\x14\x2a\x9f\x15\x6e\x5c\x93\xa6\xa2\xf1\x0d\xdf\xb6\x9c\xdf\xfb\x81\xd6\xbd\x1b\x18\x2b\x6c\x2e\x48\x53\xcf\x3e\xbb\xe3\xb7\x6e\x61\xcd\x28\xb5\xbd\xad\xaf\x54\x80\xdf\x46\x09\xec\x13\xbd\xdb\x63\x8f\x99\xb0\xf7\x74\xc0\xae\x27\x6c\xac\xc0\xee\xe8\x80\x2d\x35\x61\x37\xb4\xc3\x86\x6b\xb4\xde\x6f\xea\xc0\xf7\x0c\xff\xe6\xa6\x25\x7b\x50\xcc\xa6\x5c\x17\x90\x9d\x11\xb3\xf9\x79\x0e\x9a\xa8\x6c\x6c\xcd\xcf\x1e\x1d\x73\x5d\x2f\x17\x7f\x46\xaa\xf7\x48\xed\x43\x9a\xd7\xfa\x94\x93\xfc\xbb\x87\xbe\x6d\x39\xc3\x16\xf3\x6e\xf0\xb5\x8c\x7c\x72\x28\xc5\xab\x35\x94\x0b\xac\x90\xde\xf2\x5d\xcc\xa6\x4f\xf8\x42\x2b\x67\xf8\x62\xa0\xb5\xf1\x7b\xc6\xce\x9c\x14\xdf\xb4\xfa\x7e\x91\xc6\xb7\x6e\x28\x50\xd4\x7c\xa1\xb5\xff\x1a\xc6\x4e\xd8\x7f\x58\x24\xef\xdd\xdc\xf3\x83\xf9\xfb\x70\x39\x2f\xe8\xf4\xd3\x25\xfd\xbf\x56\x13\xfd\x0b\x92\xfe\x84\xfd\xa8\xc6\x59\xa5\xbf\x67\xd6\xb7\x2d\x67\xa7\xce\xd7\x63\x28\x40\x6a\xb5\xfe\x61\xb5\xf8\x6c\x21\xbf\xbc\x3e\x59\x01\x9e\x8a\xe4\x93\x5b\xeb\xf3\xab\x19\xab\x6a\xb6\xdf\xb4\x00\x38\x93\xf2\x83\xe9\xf9\xc3\x13\xf6\x72\xbd\x9a\x32\x79\x56\xd4\x7c\xe1\xcc\x45\xf9\x9e\xd4\xd6\x71\x1b\xf9\x55\x9f\xb4\x35\xa2\x7c\xc2\x9e\x65\xa2\x7a\xf3\xa2\xa6\x4f\xeb\xcd\x1b\x38\xdd\xc5\xd5\x7c\x9b\x31\x69\x11\xff\x56\x65\x38\xb9\xdd\xe7\x0f\xb6\xd9\xd9\x42\xb1\x97\xb3\x44\xdc\x0c\x9d\xd2\x0b\x68\xfd\x94\xb7\x37\x79\x91\xb6\x70\xb7\xcf\x59\x0c\x9c\x19\xff\x83\x68\x8c\x30\x38\x8b\xd9\x3c\x94\x31\x76\x36\x24\xdb\xdf\xfc\x96\x6f\x5b\xb2\x63\x31\xbf\x0c\x7d\x83\x17\x68\x9d\xb9\x5a\xbb\x4d\xd9\xfe\xc5\x22\xf9\x26\xa3\x3b\x57\x52\x8f\x24\xff\x6d\x11\x5f\x08\xb4\xae\x5b\xcd\x3f\x84\x9f\xce\xa3\x6c\xcc\xa6\x07\x9d\x34\x62\x4a\xd2\x62\x29\x1e\x51\xcd\xdb\x0a\xd2\x6c\x1b\xcf\x29\x9b\x6d\x69\x8d\xd1\x45\xcd\x17\x26\xd1\x38\xfa\xfa\x02\x63\xad\xa3\x36\x30\x26\x9e\x6c\xea\xc9\x55\x1d\xb8\x50\xa8\x6a\x52\x2f\xfc\xae\x6f\x9b\x7d\xf9\x22\xae\x4f\x66\x32\x0d\xa7\x26\x5f\xeb\x1f\x56\xc9\x97\xec\x6c\xb3\x2f\x58\x64\x7a\x76\x3c\x66\xd3\x58\x59\x77\x5c\x61\xf3\xdf\x28\x2c\xf2\xee\x5d\xc3\xbf\x6a\x37\xbb\x5c\x37\xb0\xa1\x50\xcc\xa6\x2b\x2f\xc8\x6f\x5c\x88\xfb\xce\xec\xee\x85\x3a\xd9\xd9\xb7\x7c\xdb\xec\xdd\x16\xf1\x73\xe3\x1e\xe1\xb3\xcf\x9e\xb0\xbf\x53\x66\x10\x3d\x66\x36\xe6\xa6\x74\xc6\x58\xeb\xf8\xeb\xb8\xc7\xbe\xc5\x5f\xda\x71\xbc\xf5\xd5\x16\xc6\xce\x6e\xf3\x6d\xb3\x1f\x5f\x28\xa6\xc2\x5a\xf1\x0a\x82\xf8\xe5\xf2\x55\x04\xd7\xcc\xe3\x33\xe5\x6d\xa2\x68\x5f\x37\x8f\x66\xc2\x52\x1f\x35\xc4\x19\xd3\xb5\xb8\xb4\x20\x2a\x2e\x2d\xa6\xab\xaf\xba\x20\xaa\x24\xcd\x16\xd3\x35\xb7\x49\x4e\xbf\x31\x5d\x13\x63\xba\x16\xc7\xc6\x74\xf5\xa9\x31\x5d\x73\x33\x69\xea\xa6\xa9\x81\x37\xfa\x3a\x1e\xf4\x46\xce\x5a\x28\x0c\x62\x1b\xf6\xa7\xa2\x66\x46\x02\x9c\x05\xcd\x25\x69\x89\xad\x57\x5e\x2f\x2e\x89\xaf\xf8\x91\xb1\x6d\xb6\xb4\xc2\x96\xc5\xbc\xbe\xc4\xcd\x47\x9a\x0b\xd2\x6c\x31\x9b\xe7\x70\x7e\x7b\xf7\x85\xc6\xb3\xe3\x5f\xc7\xd4\xa9\x5f\xc7\xd4\x55\xc7\x7e\x1d\x53\x57\x4f\x67\x4d\x99\xbe\x6d\xc5\xb9\x31\x5d\x97\xf8\x62\xba\x56\x97\xc6\x74\xad\xaf\x8e\xe9\xda\xa4\x29\x77\x26\x4e\x48\x78\xad\x4c\x34\x7a\xac\x6c\x74\x9e\x6c\x74\xe1\x3c\xa1\x99\x93\x37\x95\xb7\xb0\xb8\x94\x9a\x9f\x4b\x08\xd1\x52\x5f\xb5\x6f\x5b\x7c\x2e\x37\x47\x6e\xd3\x19\x07\xff\xf6\x44\xf2\xfa\x32\x71\x1f\xc6\x3c\x1e\x27\x3e\xef\x21\xf2\x9a\x3b\x84\xd8\x8c\x5f\x8b\xe3\xc8\x7d\xe2\x98\xf3\xd8\x3c\xfd\xb5\x0e\x73\xb9\xa0\x3e\x44\x1d\xcd\x4f\xaf\x8a\xe5\x0a\x10\x5b\xd4\x3c\xfd\x5d\x27\xa4\x8b\x6f\x9b\xfd\xcb\xf9\x44\x12\xa2\x8c\x66\xe4\x0f\xa5\x74\xbe\x3a\x93\x04\x2a\x9a\x7a\x6d\xf6\xc4\xc4\x2b\x1f\xda\xbe\xee\x41\x18\xda\xdf\xc4\x47\xe4\x86\x1e\x72\xad\xd9\x5a\xdc\x24\x9f\x7d\x33\xbf\x8b\x61\x69\x0f\x71\xf3\xf3\x2c\xb1\xf5\xb7\xde\x03\xb4\xf6\x17\x9c\xf1\x3d\xc4\x26\x5a\x22\x15\x9b\xdf\x91\x5c\xdb\x72\x9a\x16\x68\x2f\x63\x18\xd2\x03\x68\x4d\x12\x9c\x09\x09\x40\xeb\xe7\x2b\xc4\x5b\x6e\x12\x04\xe7\x5f\x57\xb4\xe5\x1c\xaf\x73\x9e\x23\xea\xbf\x35\x8b\x4d\x52\x3a\x3f\x24\x38\xef\x95\x9c\xf7\xae\xe0\x9e\xdc\x65\x01\x7f\x8f\x83\xaf\xf9\x1d\xf3\x3b\x20\xae\x25\x86\x5f\x9b\x98\x97\x0b\xe6\x7b\x24\xf3\xd2\x15\xa6\x77\x40\xf8\xb6\xe5\x9c\x98\x6f\xda\xf2\x2b\x20\x86\x40\x33\xdf\xc5\xe5\x1e\xcb\xbd\xef\x0c\xff\x98\xef\x36\xfb\xee\xf9\xfc\x5e\x3f\xf1\x3d\x01\xcd\x71\x7d\x2d\xf5\x3e\x61\xd3\x87\xaf\x93\xaf\x2e\xbc\x40\xab\xf3\x13\x63\xe4\xc6\xa0\x10\xd1\x42\xeb\xb4\x2e\x03\xe7\x01\xd7\x7e\x4f\x8b\x81\x98\x4d\x51\x36\x60\xfd\x2a\x0b\xc4\xa6\x7f\x9b\xf1\xda\x74\x81\xb1\x96\x3c\xa5\xb9\x28\x2d\xb1\xd9\xfe\x5d\x29\xd0\xfa\xe8\x72\x71\x75\x25\xd1\xd7\x12\xdf\x97\x6b\x6c\x7b\xf1\xcc\x8b\x42\x2d\xfb\x7c\xe1\xcb\x5d\xe6\x08\x5f\x8e\x17\xc7\x2e\xc9\x73\x2e\xe1\xcb\x71\x73\xda\xfa\x32\x95\xb9\x2f\x67\x5d\x64\x4c\xa8\x34\xb4\xbd\x4a\x1f\xff\x9b\xb1\xd6\x6d\x7c\x6d\x75\x42\xec\xda\xf4\x7a\x4c\x3c\xc5\x95\xe8\x6b\x3e\xd1\x9a\x12\x66\xec\x4c\x16\xd7\x27\xb9\x91\x07\x0e\xfb\x5f\xe7\xf0\xa1\xf0\xf7\x38\x31\x14\x6e\x14\x5a\x65\xdc\x36\x47\xbe\x9f\x46\x1c\x73\x1e\x98\xa3\x0f\x85\xa2\x39\xfa\x50\x50\xe6\xb4\x1b\x0a\x98\x23\x1c\x9f\xda\x41\xec\xa2\x2c\x9c\xdf\xa6\xb5\x47\x8e\x15\x63\x0c\x74\xee\xfc\x95\x8d\xdc\x23\xb6\xc4\x69\xce\x3f\xab\xb1\x13\xe7\x1f\x1c\x27\x9c\x7f\xce\x75\x9c\x7a\x56\x77\xa0\x75\xa0\xe0\x8c\x8f\x13\xbe\xe4\x6d\x6c\xeb\xc2\xab\x4b\x35\x2f\xfc\x82\xa8\x7b\x0a\xce\x42\x1a\x08\xe7\xc2\xfc\xfc\xaf\xdd\x05\xe7\x07\xe1\xb6\x9c\x79\x3a\xe7\x6f\x88\xf3\xef\x5b\x38\xf5\x21\x3a\x7f\x42\x70\x3e\x20\x39\x1f\x08\x73\xe7\x8f\x2d\xbd\xc4\xae\xf5\xb5\xc4\xf3\x6b\xc1\x7f\x90\xce\x97\x0b\xfe\x7b\x25\xff\xd2\x70\xdb\x57\x3d\x9d\x39\xaf\xcf\xeb\x62\x79\x7c\xe6\xef\xe7\x19\xa3\xbc\x29\xbb\x36\x66\x53\x0b\x80\x6b\x7a\x65\x87\xc4\x77\x64\xb3\x57\x35\xbe\x77\xc2\x7e\xf7\x02\xc8\xc7\xb3\x2f\x9c\x99\xf2\x3d\x63\xd9\x15\x8d\xcf\x93\xd7\xf8\x5a\x46\x9e\xef\x06\x84\x6d\xbe\x96\x06\x4b\xe1\xc6\x75\x4e\xf8\x9a\xc3\x69\x34\xf7\x9e\xe7\x93\x7b\x6c\x61\xcb\x62\xf1\x25\xed\x5d\xe7\x19\x6b\x3d\xb0\x5e\xe6\x2f\xdc\x9b\xf6\xac\x17\x5d\x90\xa8\x99\x24\x9c\xa6\xfa\xb6\xe5\x0c\x98\x2b\x1e\x07\x7b\x6d\x33\x63\x67\xde\xe5\x0f\x35\xd8\xe3\xe7\x9a\x26\x3f\xbe\xb4\x78\xf6\xbc\xf0\x5b\x5f\xcb\xc8\x0d\x1d\x35\x48\x8f\xd9\xd4\x5f\x6a\xc0\x6b\xf7\xf0\xc2\x94\xc4\xc2\x96\xc5\x89\x7c\x29\xe0\x3a\xcf\x58\x41\xcc\x1f\x50\x40\x79\x6f\x6b\x42\x1b\xbd\x68\x7d\x55\xc0\x77\x46\x87\xbd\xc0\xf5\x23\xcd\xd2\x7d\xdb\x72\x6e\x9c\x23\x34\x1b\x4d\x9a\xe5\xf2\x87\x2a\x56\x59\x28\xa1\xab\x5a\xc7\x18\x4f\x96\x5a\xf2\x14\x82\xd1\x28\x2e\x6a\xbe\xd0\x6c\xcf\x98\x0d\xb4\xb6\x36\x18\x03\xb9\x6c\x8e\x1c\xc8\x8a\x78\x26\xe3\x64\x1c\xd0\xfa\xe4\x72\x7e\x7e\x1f\x9d\x3f\x24\xce\x5f\xa5\xf3\xbb\x96\x9b\x2f\x85\xf1\xfe\xfa\x3a\xa6\xd7\xbf\xf3\xe6\x16\x36\xbf\xa5\x7d\x56\xa8\xf9\x87\xbc\x92\xa2\x96\xbe\x45\x57\x00\xb3\x7d\x2d\x19\x74\x9c\x55\x34\xe0\x63\xdf\xc6\xcf\x9c\x4b\x5b\xaf\xa8\x67\xcc\xf7\xec\x0f\xd6\x70\xaf\x61\xef\x89\xfe\x2e\x6a\xfe\x77\x51\xf3\x77\x05\xcd\x5f\xe4\xb1\xb8\xf7\xe5\xcb\x6c\x1a\xff\x61\xec\x1e\xb4\xff\xbe\x07\xd5\x77\x54\x1e\xf9\x9e\xd0\xc6\x63\xce\xa3\xf8\xf9\xf7\xf3\xef\xe7\xdf\xcf\xbf\x9f\x7f\x3f\xff\x7e\xfe\xfd\xfc\xfb\xf9\xf7\xf3\xef\xe7\xdf\xcf\xbf\x9f\x7f\x3f\xff\x7e\xfe\xfd\xff\xf9\xa7\xc0\x82\xbe\x95\xe8\x1b\xca\xea\x5b\x99\xa5\x06\x1a\x1a\xea\x1a\xb2\xd4\xbe\xa1\x48\x0d\xb2\xc2\xdf\x50\x1b\xac\x5d\x2a\x60\xd3\x1b\xc3\x6a\x5d\x95\xba\x2c\xb0\xac\xae\x61\xe5\x10\x4c\x29\x2e\x56\x87\x0f\x19\x3e\x32\x12\x6a\x46\x9d\x9a\xa5\xd6\x35\x86\xeb\x1b\xc3\x6a\xb8\x8e\xce\xaa\x82\x35\x81\x48\xa8\x45\x75\xb5\x4b\xd5\xba\xfa\x70\xb0\xae\x36\x94\xd5\x4e\x02\xca\x56\xa8\xfe\xda\x4a\xb5\x6c\xae\x5a\xe1\xaf\xad\xad\x0b\xab\xe5\x01\xb5\x36\xb0\xd4\x1f\x0e\x54\x22\x5c\x57\xa7\x2e\xf3\xd7\xae\x54\x97\xf9\x2b\x1a\xea\xd4\x7a\x7f\x83\x7f\x59\x20\x1c\x68\x08\xa1\xb1\xb6\x2a\x58\x1b\x0c\x55\x07\x2a\x25\xce\xdf\xb0\xb4\x71\x59\xa0\x36\xdc\x01\x83\x35\x6b\xd0\xaf\x1f\x16\x21\x25\x07\x39\x39\xc8\xb9\x06\xe3\x73\x90\x9d\x83\x6c\x0c\x42\x06\xfa\xa2\x26\x50\xbb\x34\x5c\x9d\x8e\x7c\x54\xd4\xd7\x63\xe0\x50\x54\x54\x54\x60\xe8\x50\x94\x45\x22\x15\xa1\x50\x08\x25\x81\x52\x84\x03\x4d\x28\xf5\x95\x4c\x2b\x42\x53\x75\x78\x59\x0d\x2a\x03\x55\xfe\xc6\x9a\x30\x8a\x1b\xea\x6a\xea\x96\xa2\x5e\x1c\xb6\x0d\x1d\x88\x8a\x50\x08\x15\xc1\x20\xae\xeb\x8f\xc6\xda\xab\x6a\xeb\x56\xd4\xaa\xa1\xb0\xbf\xb6\xd2\xdf\x50\xa9\x2e\xab\xab\x0c\x60\x48\x35\x86\x54\x60\x18\x86\x93\x90\x60\x6d\xa0\x12\xc1\xda\xe5\xfe\x9a\x60\xa5\xda\x87\xf0\x6a\x68\x65\x6d\xd8\xdf\x84\xab\x1b\xeb\xc2\x01\x84\xfc\xcb\x03\xa8\x6f\x0c\x55\xa3\x21\x10\x0a\xd7\x35\x04\x50\x5f\x57\x0f\x4d\x20\x1a\x43\x81\x06\x2c\x0b\x84\xfd\xa8\x6f\x08\x84\x02\x0d\xcb\x03\x35\x55\xc8\x44\x5d\x55\x15\x6a\xeb\x2a\xea\x96\x71\x83\xd4\xd6\x85\xc2\x0d\xc1\xda\xa5\x10\x92\x45\xff\xaa\xc1\x5a\x59\x5f\x45\xb5\xbf\x21\x14\x08\xa3\xde\xdf\x80\x60\x25\x1a\x6b\x1b\x02\x15\x75\x4b\x6b\x83\xab\x02\x9a\x46\x24\xc8\x5f\x5b\x29\xf5\x45\x63\x6d\x65\xa0\x0a\xc1\x2a\xf1\x9f\x17\x02\x35\xa1\x00\x02\xb5\x95\xc1\x2a\x04\x9a\x02\x15\x44\x19\x58\xee\xaf\x41\xb0\x2a\x70\x35\xd1\x04\xae\x46\x0d\xb1\x06\x6a\x88\x82\xea\x87\xf4\x29\x54\xfa\xc3\x01\x94\x68\xfd\x5c\x1b\x08\x85\xa9\x5e\x2e\x3d\x84\x3e\x24\x58\x5d\x11\x0c\x57\xd7\x35\x86\x09\x8a\x3e\xbc\x96\x36\xa0\x69\xc1\x50\x28\x58\xbb\x54\x25\x67\x53\x6b\xfd\xcb\x02\xbc\x6d\xc1\xda\x8a\x9a\xc6\xca\x4e\x45\x07\xae\x0e\x81\x1f\xd4\x86\xc0\xd5\x8d\xc1\x86\x40\x48\x0d\xaf\x30\x3c\x88\x23\x6b\x2f\x8d\xed\x44\x22\xd7\xb4\x9d\x5a\xd4\x30\xb5\xbc\xb1\xaa\x2a\xd0\xa0\x06\x9a\x2a\x02\x81\xca\x40\x25\x06\x65\x0c\x1c\x5a\xb6\x28\x7b\x7c\xce\x92\x6b\xb2\x86\x4c\xc8\xed\xd3\x2f\xa5\xef\x9a\x8e\x40\xa4\x0f\x58\xb0\x70\xf5\x5a\xd8\x23\x16\x23\x14\xae\x0c\xd6\x22\x23\xa3\x3a\x50\x53\x8f\x8c\x6a\x64\x64\x2c\x0f\x34\x84\x82\x75\x04\xd3\xda\x98\x91\x41\x7d\x5c\x19\xac\xad\xe0\xa7\x15\x8d\x0d\xe2\xb4\xa2\xb1\xa1\x32\x48\xe7\x35\xfe\x50\xd8\xa0\x5f\xe6\x6f\xb8\x2a\xd0\x80\x8c\x0c\xd9\x07\x35\x81\xe5\x81\x1a\xe4\x8b\xd1\x57\x57\x1f\xa8\x55\x83\xb5\x34\x86\xc9\xa0\xc6\x10\x94\xcc\x6a\x65\xb0\x21\x50\x11\xae\x6b\x08\x06\x42\x58\xa1\x71\x55\x34\x04\xa8\xb9\x72\xf0\x73\xc6\xa1\x8d\xa1\x86\xa1\x9a\x86\xd9\x7d\x30\x74\x3c\xac\x28\xc3\xda\xd5\x88\xec\x63\x81\x82\xc1\x18\xa0\xc5\x1f\xe1\x66\xd2\xc3\x0d\x23\x8a\xe1\x40\x23\x40\xfe\xf2\xeb\xea\x57\x36\x04\x97\x56\x87\xd5\xf4\xfc\x01\xea\xb0\xb1\x63\x47\x65\x0c\xcf\xcc\x1c\xa6\x16\x04\x6a\x83\x21\x35\xaf\xb1\xa1\xae\xb1\x29\xb2\x03\xdd\xf0\xcc\xcc\x11\x83\xe9\xff\x65\x6a\x49\x43\x90\xc6\x8e\x3a\x2d\x58\x53\x13\x68\x88\xd4\xe4\x96\x54\x07\x43\x6a\x30\xa4\x56\x35\x04\x02\x6a\xa8\xae\x2a\xbc\xc2\xdf\x10\x18\xa7\x86\x02\x01\x35\x5c\x4d\x90\xc6\x86\x8a\x80\x5a\x55\xd7\xa0\x56\xd4\xd5\xaf\x24\x5f\xab\xa8\xab\xad\x0c\xf2\x78\x36\x44\x55\x4b\xaa\x03\x0d\x01\xe2\xbf\x62\x7a\xe4\x0a\x7f\x43\x83\xbf\x36\xbc\x72\x9c\x4a\x66\x09\x2c\x0f\xd4\x72\xbe\x69\x93\x66\xe6\xfb\xf2\xae\x28\xc9\x9b\x58\x58\x54\x58\x32\x4f\xad\x6b\x50\x27\x17\x96\x5c\x31\x69\xd6\x2c\x75\xf2\xf4\x99\x6a\x9e\x5a\x9c\x37\xb3\xa4\x30\x7f\x76\x51\xde\x4c\xb5\x78\xf6\xcc\xe2\xe9\xb3\x26\x0d\x89\xc4\xec\x90\x7f\x69\x40\xcd\x52\x97\xd6\xd7\xab\x0b\x32\x56\xd7\xad\x99\xbe\x56\x8b\xaa\x0b\xd5\x05\x19\x85\x9a\x79\x87\xd6\xfb\xc3\xd5\x04\x29\x20\xf7\xcf\x59\xee\xaf\x51\x87\x0c\x19\x42\x80\x55\xf4\xaf\x89\xfe\x2d\x5b\x18\x09\xd5\xf4\x5b\x90\x51\x4b\xe0\x7c\x75\x8d\x9a\x51\x42\xff\x7c\xf4\xaf\x94\xfe\x15\xd3\xbf\xd9\x24\x42\x5d\x90\x31\x8d\x8b\x5a\xa8\x2e\x18\x54\x91\x5d\x3b\x5e\x0d\x85\x1b\x86\xd1\xbf\xe1\x0b\x23\xd1\x56\xde\xa0\x50\x1b\xbc\x5a\xb1\x50\x5d\x50\x63\x0a\xfc\x0b\xd5\x05\xc1\x5a\xae\x7a\xa4\x66\x78\xc1\x29\x63\x69\x16\x9d\xf7\x11\x31\x46\x6d\x52\x57\x9a\x64\xf3\x38\x9e\xee\x6f\x58\x3a\x78\xc8\x90\x21\x03\x68\xa6\xc9\x57\xb3\xd4\x65\xfe\xa6\xe0\xb2\xc6\x65\x6a\x45\x7d\x3d\x85\xa8\x7a\x7f\x38\x58\x1e\xac\x09\x86\x57\xaa\xe9\xd2\x2e\x21\x35\xa3\x76\xb0\x3a\xa8\x62\xb0\x3a\x28\x34\x58\x15\xbc\xbc\xde\x8c\x12\x35\x4b\x2d\x09\x94\x66\xd4\x04\xaf\x0a\x50\x0d\x65\xa2\xde\xd5\x4d\x6b\x57\xaf\x5c\xab\xd7\x5b\xc6\x2b\x5e\xed\x6f\x58\xba\x76\xf5\x90\x21\x43\xd6\xea\x7a\x67\xf8\xd4\x2c\x95\xa6\x03\x4d\x40\xb6\xae\xf8\x9a\x95\xe3\x75\xfe\xec\x3e\xfa\xdc\xb4\x66\xc8\x90\x21\xe3\x0d\xfe\x52\x35\x4b\x2d\x35\x09\x30\xf3\x0f\x1d\x7f\x09\xfe\xa1\x9a\x00\xea\xa2\x2c\x55\x4c\x3a\x7a\xd3\x6b\x02\x64\x09\x21\x8f\x46\x0e\xf5\x4f\xc6\x6c\x35\x4b\xa5\x49\x22\x43\xce\x35\x72\x76\x51\xd3\x43\xf5\x81\x8a\x60\x55\x30\x50\x49\xc1\x72\xac\x5a\x55\x57\x53\x53\xb7\x82\x3c\xdb\xdf\xb0\x34\x24\x5c\x7f\x99\xbf\xb6\xd1\x5f\x33\x80\xcb\x99\x76\x09\x39\xe4\xdb\x34\xff\x64\x70\x45\x43\xed\xe4\x8e\x6e\x27\x77\x40\xa4\x66\xff\xe9\x9d\x65\x0a\x3c\x0f\x08\x85\x2b\xeb\x1a\xc3\x1a\xdd\x2a\x35\x4b\xa5\x99\x43\x0d\x07\x1a\x96\x05\x6b\xfd\x61\x9a\xb9\x42\x6a\xfe\xcc\x8c\xa2\xc9\x6a\xfa\xb4\x59\x19\x05\xd3\x67\xa9\xa1\xf0\xca\x9a\x80\xd0\xb3\x49\xcd\x52\x03\xb5\x7e\x32\x46\x1f\x9a\x89\xd4\xf2\xc6\x60\x4d\x38\x23\x58\x2b\x7c\x48\xb7\xdf\x32\x83\xce\xdf\x18\xae\x5b\xe6\x0f\x07\x2b\x54\x11\x6f\x56\x04\xc3\x15\xd5\xa4\x71\x63\x7d\x5d\xad\x8c\x7a\x54\x1c\x52\x3d\x74\x48\x05\x0f\x6d\x21\xa3\x1f\x6b\xd5\x2c\x35\x14\xa8\xad\x54\x8b\x26\xf3\x89\xd4\x5f\x41\xd9\x89\x4a\x53\x31\x6f\x74\x48\x66\x27\x86\xfa\x21\xd9\xe0\x7a\xad\x91\x24\x67\x50\x85\xb0\xaf\x5a\x1b\x68\x0a\xab\xc3\xb9\xb1\x88\x59\x4e\xe1\x94\x41\x34\x84\xb9\x79\x34\x08\xd5\x19\x0a\x5c\xdd\x18\xa8\xad\x30\xe9\x33\x28\x64\x96\x33\x42\x97\x23\x12\x00\x21\x66\x30\xe7\x25\x59\x3c\xc5\x30\xd4\x8e\x34\xf4\xd1\xa7\x0a\x31\xa9\x72\x5f\xab\x08\x84\x42\xa2\x58\x1e\xa8\xa2\x38\x2d\x46\x73\xa4\xa0\xd7\x26\x20\x35\x4b\xad\xac\xab\xed\x1f\x56\x43\x01\x7f\x43\x45\xb5\x91\xfb\x98\xa6\x0d\xee\x35\xdc\x90\x64\x0b\x59\x93\x2e\x47\xcc\x5e\xed\xe5\x50\x1c\xae\x68\x6c\x68\xa0\xb6\x6b\xa2\x56\x5e\x4a\x90\xda\x6e\xea\xe3\x9d\xf4\x13\x62\x88\x46\xd6\x6f\x9e\x12\x55\xd1\xbb\x61\x2d\xff\x55\x39\x34\xd2\x6c\x1f\x31\x95\x92\x1e\xcb\xfc\x61\x69\xe5\x2c\xf5\xaa\x40\xa0\x5e\x0d\x37\xf8\x2b\xae\xa2\x3c\xb7\x4f\xdb\x99\x33\xb8\x3c\x10\xa2\xc1\x21\x7d\x40\xd6\x2b\x27\x75\x6a\x76\x30\x54\x5f\xe3\x5f\xa9\x6a\x90\x60\xad\x90\x4e\xe7\xd4\x6b\x81\xa6\x60\x58\xf7\xe3\xea\xc1\xaa\x48\x0d\x4c\x8c\x61\x9a\xcd\x96\x05\x42\x7c\xf2\xd0\x39\x88\x45\xef\x6a\x3e\x39\xf9\x69\x68\x8a\x81\x2a\x47\xb3\x36\x78\x1b\xa4\x1f\x74\x4e\x1f\xae\xd3\xfc\xf1\x52\x7c\x5a\xe2\x1b\x08\x55\xf8\xeb\x03\xba\x9f\x5e\xb2\xa6\xc6\x5a\x6d\x7c\x50\x64\x11\x56\x34\xf2\x53\x99\x7d\x02\x28\x94\x89\xb2\x1c\x04\x43\x25\xe9\xb2\xba\x4a\x21\x2b\xbf\x2d\x5c\x68\x59\x19\xa8\x09\x2e\x0b\x52\x23\x96\x35\x86\xc4\xea\xa2\xae\x36\x23\xb0\xac\x3e\xbc\xd2\xc8\x2b\x6a\xab\x6a\x82\x15\x61\x31\xb3\xb7\x91\x61\x70\x4b\x95\x2b\x78\x4f\x84\x38\x9f\x5c\xa6\x08\x94\xbf\xc6\x18\x90\xed\xed\x2b\x73\xec\xf6\x32\xda\xf3\xfd\x57\x6c\xdc\x0e\x14\x38\x03\xb5\x95\x81\x4a\x75\x45\x35\x0d\xc9\x10\xad\x9d\x78\xc4\x69\xd7\x00\xae\x67\x7d\x5d\x28\x24\x26\x88\xb6\x8d\xd3\xac\x4e\xae\x55\xdf\x50\x57\x5e\x13\x58\x26\xf2\x31\x11\xe5\x87\xf6\x91\x79\xbc\x91\x09\xfb\x6b\xd5\x60\x65\xa0\x36\xcc\xed\x4d\x59\x8c\x5f\x0d\xf1\xe1\x22\xe3\x5c\x85\xbf\xa6\x06\x28\x08\x2e\x0f\x72\xdf\x2d\x5f\xa9\xae\x0a\x34\xd0\xd0\x54\x03\x4d\xb4\x44\xe1\x89\xab\xbe\xe0\x91\xce\x10\xac\x55\x33\x0a\xd4\xca\x40\x45\x8d\xbf\x41\x6b\x22\xff\xcd\x24\xbb\xf0\x1c\xbb\x4d\x38\x22\x2b\x55\xd5\x35\x0a\x9f\xa0\x1f\x8d\x6a\x39\x31\xa5\xd3\x2c\x2f\x95\x09\x86\x54\x7f\x4d\x43\xc0\x5f\xb9\x52\xc3\xfe\x34\xfd\x0a\x7f\x48\x6d\x08\x68\x33\x5c\x3b\x9d\x39\x9f\x96\x0b\xeb\x8b\x81\x4e\xdd\xf4\x52\x0b\x2d\x41\xa1\xa6\x2f\x93\x0b\x96\x54\x32\x60\xb8\xc1\x1f\xac\xe1\x9e\xe6\x0f\xfb\x65\x46\xdc\x39\xbf\x0c\xd7\xff\x55\x3d\x14\xdf\x0c\xca\xce\xe9\xa4\x13\x98\x04\x76\xd2\xbe\x70\x5d\x27\x79\xf8\x4f\xd1\x69\xeb\xd4\x4b\xeb\xa7\xaf\x5e\x65\xde\x4f\x79\x85\xe1\x61\x63\xcd\xeb\x30\x8e\xa7\xfc\xc2\xc0\x8f\x36\xe1\x3b\x97\x6f\x5a\x0a\xb7\x59\x5f\x18\x70\x43\x5a\x5d\xed\xd0\xba\xaa\x2a\x63\xf3\xe0\xa7\xec\xaf\xaf\xa8\x87\xea\x2b\xea\x76\xf1\x4e\x1f\xaf\x8d\xe5\x74\xd0\xd7\xa2\x6d\xd6\xd9\x80\xbe\x62\xd5\xaa\x25\x22\x23\x8b\xd2\xc7\xdf\xa5\x86\x5d\x7a\x5e\xc6\xfc\xc1\xfe\x8c\x55\x83\x33\x33\xc6\x0e\x5e\xac\xd6\xd5\xd6\xac\x1c\x60\x1e\xb7\x7c\x05\xc5\x6b\xaf\x14\x19\x40\x6d\x20\x50\x19\x32\x49\xe0\x39\x81\xbf\x61\x29\xa7\x09\x01\x93\x9a\xc2\x0d\x7e\x43\x1b\xea\x4b\xbe\xc8\x57\x83\x4b\x6b\xeb\x1a\xf4\x61\x03\x09\xfd\x9f\xaa\xd5\x99\x7c\xbe\xc0\xef\x20\x5f\x40\xff\xbf\x91\xdf\x49\x03\x34\xe8\xff\xb4\x82\x4e\xe4\xf3\x8d\x09\x4d\x7a\x67\x78\xbe\x4d\xd1\xae\x7d\x9d\xe9\x29\x03\x5b\x1b\xca\x2b\xda\xce\xb6\x3c\x93\x1d\xa2\xe6\xcb\xf8\x21\xf3\xe6\x15\xc1\x9a\x1a\x9a\xcd\x6a\x02\x55\x61\xb5\xbc\xc6\x5f\x7b\x95\xb6\x18\x6f\x47\xaf\x87\xcb\xf4\x09\xd2\x5e\xff\x29\xae\x5f\xca\x10\x3a\xdf\xff\xd0\xc1\x48\x80\x3a\x82\x0f\x8b\x70\x30\xdc\xc8\x27\x3f\xf3\x3c\xd7\x6e\x63\x02\x4b\xeb\xeb\x4d\x9b\x8e\x95\x81\xfa\x86\x40\x05\x9f\x68\xc5\x52\x52\x5d\xd2\x37\xd4\x7f\x1c\xcf\x73\x97\x64\xf4\x0d\xf5\x57\x83\xb5\xa1\x70\xc0\x5f\xa9\xe7\xaf\x7b\x16\x30\x76\xf0\xff\xc2\xdf\xae\x05\x8c\xed\xf8\x5f\xf0\x6d\xfd\x0f\xf8\xfa\xff\x52\x4e\xf5\x02\xc6\x96\xfc\x04\xbe\xb4\x13\x58\xf1\x02\xc6\x32\xdb\x95\xe9\x18\x6b\x82\x9d\x9e\xcf\xd8\x47\x0b\x18\x7b\x7f\x01\x63\xee\xb2\xff\xf3\x7f\x6f\x2c\x60\xec\xa5\xff\x45\xdd\xc7\xfe\x03\xcf\xe3\x9d\xe0\x3f\xea\x04\xb6\x7f\x01\x63\xf7\xfe\x84\xac\xdd\x9d\xe0\x6e\x59\xc0\xd8\xf6\x05\x6d\xcb\x74\xac\x31\xc1\xca\x16\x30\x56\x59\xc6\xd8\x63\x9d\xfc\x85\x2f\x01\xd7\xfe\x36\xc9\xe3\x2d\xff\x81\xee\x3f\xfd\xdd\xfb\xbf\xac\x7f\xbf\x3c\x96\x94\x31\x76\xa6\x82\x31\xb5\x92\xb1\xa6\x4a\xc6\x50\xc9\xd8\xc9\x0a\xc6\xf6\x54\x30\xb6\xa4\x82\xb1\xdc\x0a\xc6\x62\x2b\x18\x3b\x57\xce\xd8\xa9\x72\xc6\x8a\xcb\x19\x3b\xed\x67\x6c\x4d\x80\xb1\xfd\x01\x71\xfc\x4c\x1e\x4f\x06\x18\x7b\x3a\xc0\xd8\xc5\x00\x63\x45\x55\x8c\xd5\x54\x31\x96\x5d\xc5\x58\x74\x15\x63\x05\x01\xc6\x56\x2c\x65\xac\x7a\x29\x63\x8e\x6a\xc6\x7c\xf2\xf8\x51\x15\x63\x6f\x57\x31\xb6\x55\x96\xd7\x2f\x65\xac\x5e\x9e\xd3\xdf\xda\x43\xe2\xef\xe0\x43\x8c\xcd\xde\xcf\xd8\x92\x07\x19\xfb\xee\x20\x63\xef\x3d\xca\x58\xdf\x83\x8c\xf5\xda\xc7\x58\xf7\x87\x19\xab\x7c\x98\xb1\x77\xf7\x31\xb6\xea\x51\xc6\x7e\xf1\x08\x63\xfb\x0f\x30\x86\x47\x18\xbb\x76\x2f\x63\x3f\xee\x65\x6c\xc9\x3e\xc6\xfa\x1f\x60\x2c\xeb\x31\xc6\xfa\x1c\x12\x7f\x9f\x3c\xc6\xd8\x85\x87\x18\x73\x3c\xc8\xd8\x97\x74\xfe\x18\x63\xf6\x43\x8c\x1d\x7b\x90\xb1\xdf\xee\x67\x2c\xf2\x61\xc6\xd6\xef\x63\xec\xd8\x63\x8c\x8d\x7b\x84\xb1\x99\x07\x84\xdc\x8c\xbd\x8c\xdd\xbb\x97\x31\xc7\x3e\xc6\x5e\x7e\x98\xb1\x4f\x1e\x65\xec\xcf\x8f\x89\x3f\xfb\x43\x8c\x3d\xfc\x10\x63\x87\xf7\x32\x76\xc5\x41\xc6\x46\x3c\xca\xd8\xda\x47\x18\x5b\xfe\x20\x63\x57\xed\x67\xec\xe9\xfd\x8c\x0d\xdf\xc7\xd8\x9f\x0e\x32\xf6\xf9\x01\xc6\x6c\x07\x18\x2b\x3d\xc0\xd8\x3b\x0f\x30\x36\x67\x2f\x63\x87\x1f\x64\x6c\xdd\xc3\x8c\x2d\x78\x8c\xb1\xec\x3f\x31\xf6\xc3\x6b\x8c\x9d\x7f\xdd\xf8\xdb\xff\x1a\x63\xa5\xed\x60\xf1\xb2\x1c\xfd\x32\x63\xc3\x5e\x6d\x8b\x3b\xfd\x0a\x63\x8b\x5e\x31\xca\x67\x5f\x6e\x8b\xff\xa9\xbf\x63\xff\x25\xed\x6f\x5e\x66\xec\xae\x97\x19\x4b\x78\xf9\x3f\xf3\xef\x90\xb0\xe0\xcb\x8c\x29\x5e\xeb\xb8\x32\x0b\x30\x15\x40\xf5\x24\xc6\x76\x58\x80\xa6\x29\x8c\x15\xc5\x02\xad\x53\x18\x6b\xb2\x00\xad\x3e\xc6\x0e\x5b\x80\xc3\x85\x8c\x7d\x63\x01\x4e\x4e\x65\x4c\xb5\x02\xe7\xa7\x32\x76\x99\x15\x28\xbd\x9c\xb1\x32\x2b\xb0\xeb\x72\xc6\xea\xad\xc0\xc9\xcb\x19\xdb\x64\x05\x9c\xd3\x18\x7b\xdc\x0a\x54\x4f\x63\xec\x34\xe1\xa7\x31\x76\xde\x0a\xbc\x3d\x8d\xb1\x38\x1b\x50\x7a\x05\x63\x83\x6d\xc0\xae\x19\x8c\xf9\x6c\xc0\xc9\x19\x8c\x95\xd9\x80\xd6\x19\x8c\xd5\xd3\x71\x26\x63\x47\x08\x3e\x8b\x31\xd5\x0e\x8c\x29\x61\x2c\xdb\x0e\x38\xe7\x30\x56\x66\x07\x0e\xcf\x65\xac\xc9\x0e\x9c\x9f\xcb\xd8\x76\x82\x97\x32\xb6\x87\xca\xa5\x8c\x9d\xb2\x03\x5b\xe7\x31\xd6\x6a\x07\xde\x9e\xcf\xd8\x65\x0e\x80\x62\x7b\xd8\x01\x34\xd1\xd8\x73\x00\x6f\x97\x31\x76\xda\x01\x9c\x5c\xc8\x58\x62\x04\x50\xba\x88\xb1\x82\x08\xe0\xed\x45\x8c\xd5\x47\x00\xe7\x17\x31\xb6\x3d\x02\x48\x5c\xcc\xd8\x9e\x08\x20\xbd\x9c\xb1\x73\x11\xc0\xe1\x72\xc6\x7a\x3a\x81\x31\x95\x8c\x95\x38\x81\xd2\x4a\xc6\xaa\x9d\x40\x22\x8d\x21\x27\xb0\xb5\x8a\xb1\x23\x4e\xe0\x3c\x8d\x3b\x17\xd0\xb4\x94\xb1\x52\x17\xe0\xab\x66\x6c\xab\x0b\x48\x0f\x32\xf6\x86\x0b\x38\x19\x64\x2c\x2e\x12\x68\x0d\x32\x96\x1e\x09\x34\xd5\x30\x16\x8e\x04\xaa\xeb\x19\x7b\x3b\x12\x28\xbd\x9a\xb1\x31\x51\x40\xf5\x06\xc6\x36\x45\x01\x4d\x1b\x18\xdb\x45\xe5\x8d\x8c\x1d\x89\x02\xde\xde\xcc\x58\x74\x34\x50\xba\x85\xb1\xdc\x68\x20\xfd\x3a\xc6\x36\x45\x03\x89\x37\x30\x76\x24\x1a\xd8\x7b\x03\x63\xe7\x09\x7f\x23\x63\xd9\x5d\x80\xa6\x9b\x18\xab\xef\x02\xb4\xde\xc4\xd8\xd6\x2e\x80\xf3\x56\xc6\xde\xe8\x02\x54\xdf\xc6\x98\xea\x06\x12\x6f\x67\xac\x8c\x8e\xf7\x30\xb6\xdf\x0d\x6c\x7d\x9a\xb1\xd8\x18\xa0\xf5\x39\xc6\xc2\x31\x80\xf3\x28\x63\x5b\x63\x80\xf4\xa3\x8c\xed\x8e\x01\x0e\x1f\x65\xec\x58\x0c\x70\xfe\x18\x63\x88\x05\x4e\xbe\xc1\x58\x75\x2c\x30\xe6\xcf\x8c\x1d\x8c\x15\x79\x44\x9c\xcc\x27\x94\x55\x33\xa1\x34\xc5\x2a\xde\xe8\x08\xe7\x0e\x45\xc0\x7b\x4a\x9f\x1b\x4a\x04\x05\x11\x9c\x23\x0d\xc0\x65\x00\x4e\x4d\x64\x2c\xd6\x02\xc0\x1d\x3b\xd9\x9d\x38\x35\x26\x6a\x85\x73\x3d\x26\x78\xc6\x0d\x1c\x91\x96\x4a\x74\x63\x00\x94\x01\x48\x9f\xcc\xd8\x8b\x04\x98\xe8\x8e\x6d\xb1\x4c\x72\x27\x6e\xb7\xe6\xb9\xd5\x2d\xb6\x3c\x77\xfa\x46\x7b\x81\x3b\xd3\xb2\x23\xd2\x9d\x5e\xe0\x56\xf3\xdc\x89\x13\xdd\xb1\x13\xdd\xce\xa9\x51\x48\x07\xb0\x0b\xc0\xd3\x93\x19\x73\xf1\xf7\xd5\xb8\x63\xb7\x58\x0a\xdc\x89\x1b\xad\x53\xdc\xaa\xe5\xd6\x48\x77\xe2\x14\x77\x6c\x9e\xdb\x39\x39\x8a\x9a\x85\x63\x94\x0f\x4d\x61\x2c\x43\xb6\x85\x74\x7c\x9b\xf8\xa7\x30\x36\x13\x1a\xff\x64\xe2\xbf\xc2\xad\xd6\xb9\x13\x7d\x9c\x9b\xf3\x42\x01\xbe\x99\xc2\x18\x7f\x0f\x52\x81\x3b\x96\x60\x71\x0a\xa0\xfa\x18\xeb\x27\xe5\x0d\xa6\x76\x28\x40\xb6\x8f\xb1\xd1\x8a\x68\xcb\x76\x4b\x9e\x3b\x71\x0b\xb5\x65\xa3\xcd\xda\x47\x89\x74\x27\xe6\x71\xf5\xf3\xa2\xc0\xf5\x2f\x55\x80\xa2\x42\xc6\xf8\x6b\x22\x26\xeb\xfa\x17\xb8\x55\x52\x24\x8f\x2b\x90\x77\xf4\x38\xb7\xf3\x26\x05\x58\x5f\xc8\x58\x1f\xa9\xc3\x22\xb7\x13\x89\x00\x76\x2b\xc0\xae\x42\xc6\xc6\xcb\x36\x6c\xb4\xd4\x47\x52\x0d\xc4\x73\x58\x01\x9e\x2e\x64\x6c\x8d\xe4\xb1\x2c\x75\x3b\x89\xe7\x94\x02\xa4\xc9\x71\x4f\x70\x6b\x6f\xab\xdb\x09\xf0\xb6\x7e\xa6\x00\xbe\x69\xb2\x3f\x25\x0c\x16\xa0\x72\x1a\x63\xf1\x12\x56\x44\xed\xb7\x00\xf5\xd3\x18\x63\x6d\xfb\xad\x80\xfa\x2d\x5f\xeb\xb7\x8d\x91\xee\xf4\x3c\x53\xbf\x4d\x8a\xb2\x14\xb5\x03\x91\x2d\xc8\x07\x2a\x2d\x80\x7a\x05\x63\xef\x49\x79\x37\x92\xbc\x1b\xac\x13\xdd\x6a\x8b\x6d\xa2\x3b\x7d\xbb\x7d\x92\x3b\x73\x8b\x63\x92\x7b\xcc\xc6\x88\x02\x77\x71\x69\xa4\x7b\x4c\x9e\x3b\x33\xcf\x9d\x3e\xd1\xad\x4e\x34\x89\xdf\xd1\x19\x9c\xda\x7c\xcc\x02\xec\xb9\x82\xb1\x7a\xdd\x4e\x96\x5a\x6a\x34\xf9\xef\x69\x0b\xf0\xcd\x15\x8c\xbd\xa8\xe8\xb8\x4f\x22\xdd\xce\xc2\x28\x80\xdb\x18\x56\xe0\xde\x62\xc6\x7e\xad\x48\x7b\x2d\x53\xb8\x8d\xc9\x36\x3d\xad\xc0\x98\x99\x8c\x0d\x37\xd9\x2b\xd3\x0a\x54\xce\x64\xcc\x6e\xf2\x8d\x02\x2b\xb0\x64\x26\x63\x1f\x12\x60\x3a\xf9\x46\x01\xf9\x46\x01\xf9\x86\xe5\x69\xcd\x33\xa6\x1e\x3d\xfe\x92\x18\x57\x61\x2b\xa0\xce\x62\x6c\x1b\xb7\xff\x3a\x37\x7f\x84\x18\xc0\x76\x8a\xc1\xb3\x18\x9b\xa4\x68\x76\x9a\xd8\xd6\x4e\x79\x64\xa7\x3c\x69\x27\xcb\x5f\x3a\x35\xd4\xe5\x51\xc8\x26\xff\xb7\x02\xbb\x4b\x18\xbb\x56\xda\xfc\x06\x92\xd5\x42\xb2\xb6\xdb\x0a\xdc\xe9\x5b\x48\xd6\x46\x87\x65\x9e\x18\x7d\x13\x4d\xa3\x4f\x8c\xef\x44\x1b\xf0\x59\x09\x63\x31\xfa\xf8\xcb\x97\xfe\x7b\x55\xa4\xe6\xbf\x97\x47\xad\x8a\x74\x27\xe6\xf3\x73\x62\xcc\x04\x50\x6c\x03\x8e\xcd\x66\x6c\xa1\x3e\xee\xf2\x24\xdf\x4c\x9d\x6f\x72\xd4\x2a\xed\x94\xd7\xb5\xc9\x06\x9c\x9b\xcd\xd8\x3f\xda\xf2\xd0\x58\xbf\x59\x67\x9a\x18\x65\x08\xc8\x13\x31\xe2\x69\x1b\xb0\x77\x8e\x6c\x63\xdb\x18\x51\x10\xe9\x4e\x2c\x90\x4a\x72\xda\x56\x1b\x10\x4d\xf3\x11\xda\xb4\x67\xb2\x5b\x6d\x6a\xd3\x06\xf2\x89\x38\x3b\xb0\x64\x2e\x63\x49\xba\x3f\xcd\xd5\xc7\x50\xa6\x1d\xa8\x9c\xcb\x58\x6f\xd3\x78\x29\xb0\x03\xf5\x73\x19\x8b\xb6\xb6\xb1\xf5\x24\xb2\x75\x9e\x61\xeb\x5f\x45\xba\xd3\x27\xbb\xd5\xc9\x9a\xad\x7d\x51\x96\xe5\x91\xee\xf4\x7c\x93\xf9\xf3\xa2\x48\xd7\x5b\xec\x40\xe6\x7c\xc6\xb6\x52\x05\x33\xcc\xed\x5a\xa9\x9b\x80\x1c\x98\x6c\x77\xc4\x0e\xdc\x32\x9f\xb1\xb5\x1d\xe3\xe4\x7a\x3d\x4e\x16\x45\x15\xea\x8c\x93\x44\xcc\x3c\x6f\x07\x68\xad\x93\x24\xdb\x41\x63\x25\xda\x01\xb4\x2e\x60\xec\x09\x63\xac\xbc\x1f\xe9\x76\xe6\x6b\x3e\x31\xd8\x01\x6c\x5d\xc8\x58\x10\x5a\x0c\xe4\x7e\x3e\x85\xfb\xf9\x34\x61\x46\xde\x88\x7c\x5e\x59\x01\x19\x8d\xfc\xba\xd2\x01\x1c\x59\x28\xc7\x27\xb7\x4f\xa1\xee\x8b\x26\xfb\xe4\x8b\xf0\xa1\x1b\x63\x4a\x94\xaf\x2d\x80\x64\x1d\x74\x00\x1f\x2d\x64\x8c\xef\xaa\x17\xea\xb6\xf6\xb5\x95\xb5\xba\x9d\x5b\x4f\x8e\x9a\xc2\x45\x4d\xd2\x00\x7c\xdc\x5e\x74\x00\x89\x8b\x18\xa3\xf9\x03\x53\xa9\xbd\x57\x46\xba\x9d\x05\x51\x96\xb9\x3c\x04\xf8\xdc\x4e\xdf\x51\x1f\x81\x17\x88\xba\x07\x53\x6e\xb1\x88\xb1\x77\xd1\x66\x7c\x4e\xd2\xc7\x67\x01\x8d\xcf\x7c\x1a\x9f\x93\xdd\x4b\x2c\xf7\x77\x3a\x3e\xf3\xc4\xf8\x6c\x8a\x00\x8a\x16\x33\x76\x97\x6e\x93\x49\xba\xcf\x98\xc6\xe7\x8c\x0e\x36\x91\x7e\x78\x38\x02\xd8\xbf\xd8\xe8\x3f\xd2\xef\x25\xd2\x6f\x31\x63\x1b\x2c\x9a\x7e\x3e\x5d\xbf\x49\xba\x7e\x32\x7e\xd4\x5b\x3a\x0f\xb4\x79\x51\x58\x42\xe3\xdf\x09\x5c\xf4\x33\xf6\x4f\xeb\x4f\xb5\xb5\x40\xc8\x5a\xaf\x58\x7f\xad\xb8\xb7\x2a\x93\xdc\x3b\x94\x49\xee\x5d\xca\x44\xf7\x1e\x65\x16\x41\xcb\x95\x4b\xd5\x41\xf3\xc2\x1a\xca\xc5\x02\x8c\x7d\x8c\x4b\xc4\xbb\x29\xba\x3d\xa7\xba\xeb\x2d\x37\x45\xba\xc7\xe4\x77\x22\x6b\xbe\x7b\xcc\xe4\x0e\x60\xb2\xc7\xfb\x32\xe7\x73\x77\xed\x28\x7f\x52\xc7\xfe\xda\xdf\xa9\xae\x13\xc5\x78\x49\x77\x01\xef\x2f\x37\xe2\x3e\xe5\x18\xd9\x2e\xe0\xed\xe5\x8c\x7d\xd2\x36\xfe\x14\xb9\x55\xcb\x9f\xb4\xe1\xc6\xfd\xdf\x05\xec\x59\xc1\x58\x95\xe5\xbf\x88\xeb\x4b\x2c\x6f\x5e\xca\x66\x14\x5f\x0f\xba\x80\x63\x2b\x19\x0b\xb7\x1d\x83\x3c\x0f\x99\xec\xce\xb4\xe4\x46\xf2\x09\x38\x4f\xf3\x96\x5c\x00\x1f\xb9\x80\xd8\x55\x8c\x1d\xd7\x7d\xad\xa0\x93\xb9\x60\xb2\x3b\xd7\x32\x3b\xd2\x9d\x59\xd0\xd6\xe3\x26\x45\xf1\x5c\x8e\xf2\xdf\xdd\xab\x18\xfb\xc2\xd1\x3e\xce\x58\xbf\xa2\xec\x67\xaa\x3b\xd6\xc7\x43\xda\xbd\xa6\x88\x2d\xe3\x46\x65\x24\x50\x7c\x2d\x63\xcd\x54\xff\xa2\xb6\xf3\xe3\x55\x9a\xae\x05\x47\x8f\xbf\xe4\x23\xc8\x16\xeb\x76\x0b\xb8\xde\xbb\x23\x81\xbd\xd7\x32\xf6\x08\xf1\x2d\xb8\x44\x1e\x52\xd8\x2e\xe9\x98\x1c\xb5\xc4\xed\x3c\x7a\xfc\xa5\x97\xc5\x7c\x74\x3a\x12\x88\xdb\xc0\x58\x0f\x6b\xc7\xbc\xad\xc0\x9d\x69\x4d\x52\xcc\x06\xbb\x9c\xe7\x56\x71\xa4\xf8\x66\xc6\x02\x5a\x6e\x55\x26\xc7\x7f\x14\x50\xba\x99\xb1\x04\xeb\x25\xfa\xd1\x3c\xbe\x8a\xad\xf3\x2d\x97\x98\xa0\x49\x56\x53\x14\x30\x78\x2b\x63\x33\xff\xab\xb9\xde\xea\xa1\x81\x54\xd0\x89\x2c\xea\x9b\x63\x51\x40\x6e\x33\x63\x77\x2b\x5a\xbe\x36\x51\xcf\xb3\x7d\x64\xa7\x69\xee\x5c\xcb\x6f\xda\xd9\xe9\xf2\x28\x3e\x77\x21\x1a\x78\xfc\x7a\xc6\xbe\x75\xfd\x94\x1e\x72\x8c\x1c\x54\xac\x0d\x9d\x37\x8a\xf7\x35\xc5\x8d\xe2\x68\xa0\xf2\x16\xc6\x6a\x92\xfe\x63\xbb\xa6\xb8\x0f\xc6\x58\xf6\xbb\x0f\xc7\x4c\x77\x1f\x89\x99\xec\x3e\x19\x33\xd9\x7d\x2a\x66\x96\xfb\x60\x8c\xd5\x6d\xbd\xd4\x10\xe0\x75\x3c\x1e\x0d\xd4\x3f\xcc\xd8\x0c\xc7\x7f\x51\xc7\xa9\x2e\x96\x42\xf7\xdb\x5d\x0a\xdd\xa7\xbb\x4c\x74\xb7\x76\x99\xec\x3e\xd7\xa5\xd0\x7d\xaa\x8b\xf5\xda\x4b\x86\x26\x3e\xde\x13\xbb\x00\x35\x87\x8c\xf8\x4a\xb0\xc1\xb4\x46\x33\xc1\x28\x06\xe4\x76\x01\x36\x1d\x62\xec\xfa\x8e\x39\xc8\x6a\x6d\x20\x50\x1f\x55\x77\x01\x3e\x3b\xc4\xd8\x84\x4e\xfa\x68\x0a\xf5\xd1\x14\xe9\x8c\xed\x32\x68\xf2\x95\x7b\xbb\x00\xe7\x1e\x67\xec\xd9\x18\xad\xbd\x85\x9d\xb4\x57\xc6\xe2\x7a\x6b\x91\xe3\x12\x2d\xa3\xdc\xe6\x5c\x17\x20\x6d\x34\x63\xcb\x34\xdf\xae\xe6\xa9\x0d\x0a\xc8\x15\xdc\x40\xe9\x31\xe9\xf7\x93\xf5\x3c\xdd\x98\x3f\x7c\x54\x8f\x4f\xd8\x35\xb7\xb6\xb3\x4a\xb8\x9d\x4a\xdc\xc0\xfa\x63\x46\xac\xfc\xf9\xf7\xf3\xef\xff\xd4\x6f\x47\x1f\x71\xcc\x95\x47\x05\x6d\x8f\xd1\x1a\xa1\x2a\x0e\x5d\x64\xf1\xfc\x23\xe2\xa8\x8d\xed\xd6\x1f\xc4\xd1\x2b\xcb\x4e\x79\xf4\xc8\xe3\x39\x89\xef\xd9\x0e\xff\xed\x8f\xac\x8e\x97\xad\xa2\xac\x8d\x81\xd8\x08\x71\x74\xc8\xf2\x18\x89\xd7\x2e\xe8\xdc\x20\x15\x8c\x92\xe5\x44\x79\x8c\x6f\xd7\x3e\xab\x7e\x26\x18\x2c\xed\x10\xda\x7e\x8e\xac\x0e\x3d\xe4\xb1\x29\xa6\x2d\x3c\xd3\xd5\x56\x6f\x9f\x54\xcc\xd5\xae\xfe\x0b\x4c\xb4\x47\x23\xfd\x51\x96\xcf\x49\x46\x26\xcb\x9a\x7d\xcf\xc9\xf2\x9d\x12\xff\xbd\x2c\x8f\xc3\xff\xdd\xdf\xf9\x1f\x3a\x87\x8f\x92\x7e\x30\x59\x1e\xe7\xc8\x63\x95\x3c\x2e\x97\xc7\x2d\xf2\x78\xab\x3c\xde\x2f\x8f\x4f\xc8\xe3\x71\x79\x7c\x53\x1e\x3f\x91\xc7\xef\xe4\xd1\x91\x22\x8e\x09\xf2\xd8\x4f\x1e\x47\xc9\xe3\x64\x79\x9c\x23\x8f\x55\xf2\xb8\x5c\x1e\xb7\xc8\xe3\xad\xf2\x78\xbf\x3c\x3e\x21\x8f\xc7\xe5\xf1\x4d\x79\xfc\x44\x1e\xbf\x93\x47\x47\xaa\xac\x5f\x1e\xfb\xa5\x76\x6e\x8f\x74\x45\xf4\x64\xd5\x0f\x8c\x59\x4c\xf0\xf2\x63\xe2\x18\x90\xc7\x6a\x79\x5c\x7b\xb4\x2d\xbc\x42\x1e\xcb\x24\xdf\xb7\x47\x64\x7b\x8e\x75\x5e\x5f\x7b\xf9\x55\x97\x90\x5f\xf5\xff\x52\xfe\x95\x12\x1f\x96\xc7\x65\xf2\x58\x23\x8f\xb7\x1e\x6d\x2b\x3f\x17\x6d\xe9\x6e\xfd\x0f\x2f\x8f\xa8\x93\x74\x05\x52\x9f\xd0\xb1\xb6\x47\x0d\x5e\xd1\x4e\xcf\x70\x3b\xbd\x2e\xf5\x6b\x4f\x67\x7d\x56\x1c\x1b\x65\x79\xc5\xb1\xce\xe5\x6b\x74\x2b\xfe\x83\xfc\x8a\xff\xa1\xfe\x65\xff\x43\xf9\xff\x53\xfd\x2f\x25\x7f\x4a\x7e\x7e\x96\x9a\x3e\xe5\x8a\xd9\x03\xd4\x31\x43\x86\x0f\xc9\x6c\x0f\x18\xa6\x0e\xcf\x1c\x36\x26\x73\xcc\x88\x61\x7c\x99\x2d\x5c\x58\xc4\xa2\x3d\x72\x4a\x78\x6d\x9b\x38\x66\xca\x21\xb0\xec\x12\x2a\x5f\xff\x10\x60\x13\xfc\xca\x7d\x11\x40\xf4\x4a\x0b\xf0\x8f\x48\x60\x4f\xd7\xb6\x84\x96\x08\x1b\x60\x7d\xbb\xc7\x48\xd2\xd7\x19\x71\xc6\x02\x58\x6d\x11\xff\xb4\x00\x36\xa7\x55\x71\xf6\x75\x01\x56\x4b\x44\x7c\x24\x60\x55\x1c\xfd\x79\xc9\x3e\x3b\x02\xb0\xdb\xec\xc1\xda\x30\xac\x4e\xfb\xe3\x76\xc0\xf2\xa3\x0b\xb0\xdd\x9a\x54\x4d\x12\xff\x1c\x09\xd8\x6e\xf3\xf2\xf3\xc7\x09\x7e\xa7\x87\xce\x1d\xce\xdb\xc1\xa5\x0c\x71\x01\x11\x74\xee\x7c\xc5\x09\xbc\x6d\x1f\xe6\x1c\x6c\x01\x5c\x99\x0a\x60\x1f\x11\xe1\x27\xad\x5c\x51\x76\xc0\x3e\xca\x75\x33\x51\xb9\x06\x52\x7d\xa3\x79\x21\xd6\x95\x6c\x03\xec\x63\x78\x21\xd1\xb5\x91\xc8\xc6\xf2\x82\xea\x5a\xe0\x02\xec\x59\xbc\x90\xee\x7a\x85\xe6\xaf\x71\xbc\x90\xe9\x8a\x8c\x02\xec\xd9\xbc\x30\xc6\xb5\x93\x30\x39\xbc\x90\xeb\x7a\x99\x04\xe4\xf2\x82\xcf\x95\x67\x05\xec\x79\xbc\x50\xec\x9a\x46\xf5\x4c\xe4\x85\x52\xd7\x02\xd2\xad\x20\x61\x92\x05\x58\xe2\x5a\x1e\x09\xd8\x27\xc7\x5d\x6e\x01\xaa\x5d\xbf\x20\x1e\x1f\xd7\xba\xde\xb5\x96\x30\x85\xbc\x10\x76\x3d\xed\x04\xec\x53\xa3\x68\x06\x69\x72\x1d\x20\xcc\xb4\x6e\xb4\x6a\x59\xef\x3a\xec\x00\xec\x57\xc4\xcc\x02\xb0\xc1\x35\x95\xd4\x99\xee\x9c\x61\x01\x36\xba\xee\x26\x9e\x19\x31\x7e\x0b\xb0\xd5\x75\x84\x0a\xf3\xba\xac\xa6\xcc\xc0\xf5\x84\x05\xb0\x2f\xe8\x51\x63\x01\x76\xb9\x3e\x24\x83\x94\x25\xad\xb0\x00\x7b\x5c\xdd\x48\xb7\x85\x5c\x9d\xbd\xae\x22\x12\xbd\xc8\x95\xcf\x57\xdb\x5b\xa8\xb0\x38\x72\x00\x80\xc3\xae\x97\xa9\x3d\x4b\xb8\x6e\x47\x5c\x41\x92\x56\xee\x5c\x6b\x01\x8e\xc1\xf2\x27\x27\xe0\x88\x48\xba\x8f\xa6\x74\xf2\x5d\xfb\x20\x77\xd4\x79\x1b\xf5\x58\xae\x85\xfe\x13\x22\x9a\x3a\x8c\xf4\xea\x32\x92\xfb\x8d\xc3\x39\xc6\x02\x44\x1d\xe2\x04\x4b\xe9\xf4\x74\x04\x9d\x36\x58\x24\x6d\x8b\x46\xdb\x1d\xee\x93\x4e\x20\xa2\xd9\x7d\x3b\x27\xa6\xce\x76\xd3\x54\x1d\x71\x3d\x87\xb8\x37\xd2\xf9\x36\x71\xce\x2f\xed\x79\xa2\x49\xcb\xe8\x77\x2c\xc0\x2b\x16\x20\x06\x11\x47\x39\xe7\x1e\x22\x7c\x9d\xc8\x46\x12\x99\xd7\x43\x58\xab\xd3\xfe\x08\x79\xe0\x13\x2e\xc0\x15\xd1\xed\x3a\xe1\xc7\x1f\x91\x1a\xd4\x77\x71\x56\xa9\x86\x02\xf7\x08\x17\x10\x79\x97\xdb\x66\x05\xdc\xaf\xdb\x80\xc8\x3d\xbc\x22\x77\x86\x05\x88\xbc\xbb\x2b\x39\xa9\x7b\x24\xd1\xdc\x2f\x68\xfe\x4c\x34\x07\x04\xcd\x50\xa2\x79\x84\xd3\x38\x6f\x75\x01\x3e\x65\x74\xec\xdf\xac\x80\xab\xce\x01\x28\x63\xa2\x6e\xe6\x6e\xfb\x8a\x02\x28\x63\xa3\x84\xdb\xfe\x68\x07\x94\xac\x28\xe1\xb6\xdf\x10\x66\x5c\x94\x70\xdb\x0f\x09\x93\x1d\x25\xdc\x76\x39\x09\xc8\x89\x12\x6e\x7b\x79\x04\xa0\x8c\x8f\xbc\x9d\xbb\xed\xd3\x56\x40\x99\x10\x25\xdc\xf6\x29\x2a\xe4\xf2\x42\x2e\x62\x53\xa2\x00\x65\x46\x97\x32\xd2\xc0\xba\xde\x2a\xe6\xbd\xd8\xbf\x3a\x01\x65\xa6\x84\xaa\x1a\x74\x9d\x05\x50\x66\x49\xe8\x11\x8b\x84\xd2\x62\x47\x29\x91\xd0\x25\x12\xda\xb5\x24\xd0\x04\x65\xb6\x84\x42\xa3\x1d\x43\xca\xcf\x91\xd0\x3d\x72\x96\x8d\x1d\x4d\xd0\xb9\x12\x9a\x2b\xa1\x96\x21\xd4\x36\x7f\xe2\x66\xee\x3f\x64\xf8\xd8\x26\x6a\x53\x45\xec\x4a\x1b\xd1\xed\xb2\x4b\xee\x93\x91\x04\xf5\x0a\x68\x93\x4d\x42\x7b\xba\x08\x3a\x40\x40\xab\x35\x68\x0d\xb5\xbd\x62\x84\x80\x6e\x95\x12\x9c\x11\x2e\x9a\xf2\x03\xb1\x13\xec\x80\xab\x1f\x59\xb1\x4a\x74\x43\xb7\x40\x6d\x25\x94\xa5\xb2\x1b\x6e\xa3\xea\xab\xb9\x49\x63\x5d\xe4\xb4\x4a\x90\x17\xba\xba\x86\x51\x03\xae\x74\x11\x7f\x9c\xab\xc6\x06\x28\x57\xc5\x4f\xb7\x53\x7a\xcd\xfb\x9c\x4e\xb9\xeb\x58\xe0\x70\x7e\x68\x03\x9c\x4b\x69\xf0\x28\xbf\x88\xfd\x23\xd5\x98\x4a\x5d\x70\x6b\x57\x6a\x3e\x5c\x7f\x23\xcb\xdf\xda\x83\x0a\x3e\xd7\x31\x32\xc1\x6d\x5c\xd4\x0e\x57\x15\xc9\xdd\xd5\xfd\x94\x9d\x06\xee\xdf\xa8\xd1\xb7\xbb\x48\xfa\x1e\xd7\x63\xd4\x03\x77\xc4\x51\xab\xf6\xba\x0e\xf0\x42\x4f\x2a\x1c\x74\x35\x13\xcf\x1d\xe9\x54\x38\x8c\x88\xd9\x76\x32\x25\xfd\xef\x3a\x0b\xca\xaf\x62\x48\x94\xcb\xba\x43\x33\x43\x72\x04\x90\xa9\xdc\x15\x3b\xcb\x01\xb8\x36\x50\x63\xf7\x48\x6f\x9c\x46\xb5\xed\x49\x10\x66\xa0\x24\x5b\xd9\xd3\x87\xa8\x62\x5d\xdf\x92\x99\xef\xe6\x7a\x24\xba\xf6\x90\x4d\xee\xee\x4e\x85\x9e\xae\x1c\xd2\xe3\xd7\x5c\x5d\xd5\xb5\x93\x04\xdc\xc3\xc9\xd2\xe1\x70\x72\x4f\x56\xa8\xa5\xf7\xc7\x90\xdb\xbb\xac\xf5\x52\x09\x87\xf3\x1f\x76\x20\x96\x26\x2b\xe5\x01\x3b\xd1\xbb\xac\xeb\xb5\x9e\xbe\x83\xcc\xf1\x80\x5b\x40\x2b\x65\x9f\xf2\xc1\xf9\xa8\x43\x5a\x78\x1d\x62\xb3\x49\xa5\xbd\x11\x77\x73\xb9\x7b\x34\xde\x29\x04\x7d\x50\x4a\x5c\xa2\xf9\xc3\x3d\x24\x71\x9f\x56\x8f\x06\xf5\x93\xb6\x0f\x49\xe8\x1e\x97\x84\x1e\xa0\x4e\xde\x2f\xa1\xf5\x1a\xed\xab\x24\xf7\xb1\x88\x9b\x39\x34\x5d\x83\x5e\x45\xd6\x3b\x14\x31\x9f\x43\x2f\xd3\xfc\x3c\x99\x6a\x7b\x52\x4a\x48\xd3\x68\x73\x88\xf6\xb0\x84\xaa\x1a\x74\x39\x59\xf9\xb7\x12\xda\x53\x83\xd2\x3f\xe5\x29\x09\x4d\xd4\xa0\x8b\x49\xc2\xef\xa4\x0e\xb1\x12\xea\x7c\xd7\x0a\x24\x2a\xbf\x8f\xfd\x3e\x02\xe8\x56\xde\x58\x05\xe5\x0f\xd2\xab\x6b\x02\xb5\x50\x9e\xe1\xbd\xe1\x74\xdd\x44\x4a\x3d\xc3\x8d\x1a\xdd\xad\x0a\xca\x91\x28\x8a\x9f\xb1\x70\x06\x23\x81\x25\xca\xd1\xd8\x17\x9c\x1a\xff\x31\xe9\x0e\x39\xc4\xf2\xbc\x18\x15\x42\xd8\x71\x2e\x2c\x56\x08\x3b\xce\x85\xc5\xb9\x2a\xc9\x60\x27\xa4\x6f\x24\x93\xe6\x27\x45\xa4\xea\x16\xac\x85\xf2\x02\xaf\x27\xdd\x35\x9e\x5c\xf4\x45\x4e\x95\xe9\x6a\xa5\xc1\xf7\xc7\x68\xf2\xad\x31\xae\xbb\xc9\x9d\x5e\xe2\x85\xdc\x6e\x75\x8d\x61\x28\x2f\x7b\x49\x19\x5f\xb7\x40\x5d\x15\x94\x57\x38\x4f\xb1\xeb\x76\xea\xad\x57\x79\xa1\xc4\xd5\x85\x4c\x71\x8a\x17\x4a\x5d\x45\xe4\x45\xaf\xf1\x42\x19\x1c\xce\xdf\x46\x00\x5d\xf3\xa1\xbc\x91\xf0\xa1\x93\xc7\x0a\xdd\xe7\xc8\x44\x7c\xa4\x7e\xef\x94\x7e\x34\x0c\xb1\x35\xd4\x96\x37\xed\x67\x38\xed\x1e\xcd\xd6\x6e\x52\xf7\xcd\x9e\xa2\x07\xaa\xa5\x84\xee\xa3\x49\x87\xcd\xd1\xbc\x63\xda\x67\x54\xca\xee\xcc\x48\x20\x8e\xb7\x73\x73\x34\xb7\x8d\x4d\xa2\xe2\x78\x7b\x37\x47\x7b\xa9\x8d\x79\x00\x68\xd0\xc4\xbf\x13\x05\xf8\xf5\x95\x49\x7d\x57\x40\xb9\x3e\xda\xde\xea\x02\x12\xa2\xa3\x81\x35\x00\x56\xd1\x22\x86\x45\x01\xfb\x00\xec\xa5\xc2\x57\x51\xc0\x29\x00\x94\xae\x24\x7c\x1a\x05\x7c\x07\xe0\x1b\x5a\xfb\x92\x80\xc4\xb5\x52\x5e\x61\x13\x90\xa4\x94\x28\xc3\x92\x94\x99\x2e\xab\x29\xdf\xf6\x8c\x95\x14\x5f\x4c\x03\x9c\x91\x44\x35\xdb\xfa\xb5\x32\x3b\x49\x29\xb1\x7e\xad\x94\xc0\x3b\x55\xe2\xef\x1f\x0c\x78\x37\xc8\x42\x81\x1b\xf0\xde\x2a\x0b\xe7\x1e\xa4\x81\xff\x3c\x0d\x88\x92\x68\x47\xfd\x9d\x02\x3a\x41\xd1\xcc\xe0\x8b\x02\xe2\x5e\x15\xe8\x4c\x6a\x71\x8a\x42\xd5\x00\x71\xc7\xc9\xd9\x67\x47\xc7\xd3\x94\xbc\x55\x01\xd6\x2b\x40\x32\x77\xac\x39\xd1\xbc\xfb\x3e\x57\x80\x4f\x09\x58\x51\x0d\x65\x6e\x34\x0f\xbb\xd9\x16\x60\x94\x05\xe8\x15\x24\x91\xa5\x82\x70\xa7\x05\xd8\x4c\xc0\xdf\x0b\x60\x3c\x01\x47\x5b\x01\xaf\x15\xe8\x55\x42\xc0\x79\x82\x72\xa9\x0d\x58\x60\x03\x7a\x7d\xe9\xe4\x40\x4e\xf9\xbd\x0d\xf8\xdc\x06\x78\xb6\x4a\xed\x2f\x3e\x08\xb8\xa3\xb8\x35\x2c\x21\xc0\x73\xbb\x84\xbb\xf6\x89\x9d\xf0\x24\x65\xb6\x73\x4d\x37\xa7\x9a\xe6\x54\xfb\x21\xf1\x91\xbb\x04\x76\xea\x72\x8e\x71\x59\x97\xbc\xa1\xd9\xb7\xe7\x0c\x6a\x63\x4e\xb4\xe3\xf4\xaf\x04\xe0\x7a\xdd\x3d\xa8\xbf\xe3\x22\x2d\x1c\xdd\x97\x4c\x70\x9d\x1d\x58\x47\x73\xc9\x7e\x3e\xa3\x47\x8f\x20\x63\x9d\xb5\x03\x1f\xdb\xb5\x96\x8d\x17\x8d\x98\xe0\x00\x46\x3b\x00\xef\xf7\x52\x6a\xc2\x3e\xc0\xa3\x98\x94\xff\xa3\xa1\xbc\x37\xd2\xa4\x3c\x7a\xdf\x47\x0a\x0d\x8c\x76\x28\xbe\x68\x40\xad\xa2\xf3\x14\x1a\x92\x29\x7c\xdc\x0e\x8c\x4e\xa7\x0a\x52\xf8\xb8\x1d\x18\x3d\x8a\x06\x6e\xca\x45\xf2\xe1\x41\xa2\x9f\x52\x5b\x48\xb9\xc1\xd1\x5d\x89\xdd\x65\xcd\x94\x03\x24\x35\x81\x18\x32\xa2\xbb\x89\x68\x94\x2b\xc1\x22\x95\x2b\x8d\x96\xc3\xcb\x8d\xde\xef\x13\x5d\x6a\xb4\x43\x69\x90\xd5\xa7\x46\x0f\xa1\xea\xd1\x7b\x0d\xd5\xdf\x3d\xda\xa1\xec\x8d\x06\x52\xf8\x7c\xdc\x3d\x7a\x14\xd5\xa9\xf2\xf9\xb8\x7b\x74\x21\x15\x52\x2e\x90\xc9\xe2\xa2\xbb\xf0\x44\xbf\x4f\x0e\x95\x9c\xd1\x3c\xfb\xd3\x16\x39\x2f\xea\x36\x8e\x75\x03\x71\x34\x94\x15\x67\xf4\x20\xe2\xbe\xd7\x01\xec\x76\x00\xbd\x2a\x69\x98\xbb\xa2\x79\x64\x72\x45\x00\xd6\x08\x20\xb9\x0a\x4a\x64\x34\x0f\x50\xa5\x11\xc0\xcc\x08\x20\x9e\x24\xfc\x45\xca\x15\x0b\x2a\x25\x2a\xda\x7e\x75\x17\x20\x61\x90\x1b\x78\x20\x02\xb8\x3b\x02\x48\xe8\xe5\x06\xbe\x89\x00\xce\x45\xc8\x7d\xa7\xb4\x31\x6e\x20\xd3\x09\x0c\x74\x02\x89\x8b\xd2\x84\x88\x3e\xfb\x44\xbf\x2c\xa7\xe1\x68\x59\x83\x24\x65\x86\x85\x3f\x6c\xe4\x39\xd2\xc7\xe8\xbd\xe6\x2e\x82\x6a\x0d\xe0\xf9\xa3\x09\x7e\xb7\x84\xaf\x05\x3c\xef\x4a\xf8\xd0\x7d\xc0\xe1\x2e\x72\x78\xc3\xd3\x5b\x56\x94\xbd\x0f\x78\xb3\x8b\xb9\x32\x62\x1a\x22\xb1\xbe\x7d\xc0\x05\x33\xd6\x65\x8d\x78\x4e\x5a\x2d\x71\x6c\x9a\xe1\x54\x82\x00\xe8\xeb\x25\x23\xfe\x2b\xaa\x8b\x42\x63\x3f\x65\x97\x83\x97\xd2\x78\x87\x54\x5a\x79\x61\x18\x99\xb2\x5f\x10\xca\xf9\x28\xee\xa4\xe8\x99\x4e\xe1\xb1\x35\xca\x91\x29\x63\x62\x40\xef\x97\x6d\x14\x40\xe4\xd3\x48\xe0\x85\x6b\x97\x19\xd1\xa4\xff\x2f\x96\x19\x1a\xa0\xa7\x93\xaa\x7e\x28\xca\x11\x7b\xa5\x80\xf6\xb0\x6a\x62\x5e\x8b\x05\xd2\x6b\xa0\xec\x17\x35\x5a\x76\x16\xa7\x57\x84\xa8\x18\x2d\x8a\x25\xc9\x15\x01\x2a\xc6\x52\xf1\x5a\x27\xb0\xda\x49\xc1\x04\xca\xc3\x51\x3c\x96\x9c\x72\x02\x2f\x12\x28\x44\x20\x37\x35\xa0\xb7\x0b\x48\x72\x01\xc9\xf5\x50\x0e\x44\xf1\x1c\xac\xd6\x45\x0b\x35\xc0\x73\xbd\x54\x60\xca\x42\x60\x70\x0c\x37\x39\x19\xc7\x7b\x48\xc2\x9f\x29\x02\x3c\xcf\xcb\xc2\xf3\x8b\x80\x19\x92\x68\x05\xe0\x8d\xb8\x4a\xc0\x5f\x9f\x0b\x78\xe3\x65\xe1\x95\x78\xc0\x3b\x49\x16\xa8\x35\x9e\x0a\x59\x38\xb7\x08\xd8\x61\xb0\x7b\x36\x4a\xf8\x7b\x7e\xe0\x01\x0e\x9f\x4d\xdd\xbd\x5f\x82\x77\x57\x00\x7f\xe0\xe0\x19\x04\x7e\xde\x04\xfe\xb3\x04\x67\xc2\xdb\x2a\xc1\x87\x27\x03\x9e\xe8\x1a\xc3\xc8\xff\x8e\x31\x1c\x6e\x94\x84\xd3\x00\xed\x1a\xab\xf1\x7a\xca\x6b\x0c\x91\xe9\x06\xf8\xda\x1a\xc3\x3d\x73\x63\x0d\x29\x2d\x26\xf2\x85\x3a\x5c\x32\xfd\xce\x84\x0c\xeb\xb2\xbc\x6f\x49\xf0\xe9\x72\xc0\x7b\xb1\xc6\xb0\x97\x27\x6e\x99\x51\xcb\xfd\xb1\x46\x5c\x56\x97\x19\x82\x8e\xea\x70\x29\xae\xbf\x44\x96\x90\x17\x75\x9f\x6a\xe7\x4b\x2b\x3e\x57\xe7\xde\x26\x30\x33\x1c\x9a\x27\x1d\x88\x07\x52\xcb\xc4\x82\x8b\xfb\x92\x75\xe7\xdb\x0d\xa9\xff\x8c\xe0\x80\x6e\x02\xf0\x7e\x43\xea\x6f\xc1\x01\x49\x02\x70\xba\x21\xf5\xa2\xc2\x01\x29\x02\xf0\x51\x43\xaf\x2b\x2c\x1c\x30\x80\x00\x34\xe9\x3d\xe9\x02\x52\x79\x12\x30\x4e\x13\xdc\xda\x90\x1e\xac\xa4\x72\x8c\x28\x7f\xd6\x90\x1c\xa4\x22\xcf\xa0\x3f\x8d\x04\x5e\x8b\xa4\x79\x90\x40\x09\x04\x7a\x3b\x0a\x78\x25\x0a\x48\xe5\x69\x43\x76\x54\x14\x29\x6b\xdd\xb9\x63\x65\xea\x21\xb1\xf4\x73\xfd\x81\x03\xce\x35\xa4\x9e\x10\x80\x44\x01\x58\xbf\x3c\xb9\x04\xca\xf8\x28\x0f\x25\x3e\xd3\xa2\x81\xc2\x68\x60\x40\x7d\x2c\x60\xe9\x2e\x9c\x7e\x5e\x54\x0c\x39\xfd\xb6\x68\x60\x63\x34\x90\x1c\x26\x50\x77\x02\x7d\x19\x0d\x7c\x1a\x0d\xc4\x67\xd5\x02\xeb\xe4\xc7\x56\x14\xec\x89\x05\x94\xc5\x51\xea\xf0\x6e\x40\x42\x51\x2d\xd0\xd0\x05\xa8\xed\x02\x49\x78\x87\x4e\x78\x8a\x08\xcb\xa3\x62\x17\x68\x84\xbf\xe9\x02\x3c\x42\x84\x9e\x42\x49\xd4\x38\x01\x68\xe8\xa6\xfb\x6c\xc9\x2e\xa3\x8f\xaf\xef\xa6\x87\x1e\xcf\x49\x09\x3f\x58\x0a\xfc\xba\x9b\xd1\xf7\xef\x4a\xf8\x3b\x15\xc0\x53\x12\xee\x1c\x06\xcf\xdf\x77\x19\x7e\xfd\x27\x93\x9c\xb3\xbb\x0c\x87\xfa\x5c\xc0\xed\x3b\x1f\x6f\xb8\xc5\x86\xc4\xc8\x5f\x19\xa2\xb8\x98\x4c\xd9\x9e\xf3\x77\x40\xfb\xf1\x7c\x2c\xca\x3b\xa8\xbb\x6c\x8f\xc3\xcd\x27\x34\x20\x3e\x18\x06\xe6\xef\x16\x54\x16\xe4\x12\xe1\x5d\x51\xf6\x30\x11\xae\x08\x03\x33\xdc\xc0\x74\x37\xd0\x83\x30\x69\x1b\xc2\xc0\x0a\x37\xd0\x48\x41\x6f\xb1\xe4\x72\x36\xd2\x7f\xcf\x41\xe9\x96\x87\x2f\x07\x0e\x74\x17\xb9\xd8\xce\xb7\x1b\x28\x17\xdb\xf9\x7e\x43\x92\x32\x83\x5c\x2e\x49\x99\x49\x8e\x96\xa4\x94\xf2\xf1\x34\x8f\x47\xa0\x4f\x24\x67\xbf\x52\xc0\xf3\x7b\x93\x21\x5f\xef\x6e\x18\x2c\xfe\x76\xc3\x00\x67\x4d\xf0\xeb\x6e\x37\xa2\x82\x25\x4e\xef\x8f\x03\x12\x4c\x69\x83\x27\x4e\x1a\x66\x09\xbc\xdd\xef\x30\xc4\x7b\xc7\x9b\x0b\x45\xe6\xc2\x7c\x73\x61\xb7\xa9\xe0\x79\xf8\x0e\x43\xf0\x4a\x21\xd8\xb9\x1c\x32\x99\xf2\xbe\x66\x26\xfd\x44\x16\x16\xee\x03\xee\x94\x3a\x2c\x57\xe0\x2d\xf8\xa5\x01\xf7\xae\x95\x85\x20\x15\x9e\x93\x85\x30\x69\xfd\x8e\x2c\xd0\x64\xf8\x97\x38\xdd\x15\xbc\x9f\xff\xd2\xa4\xdb\x77\xbf\x34\xb5\x33\x65\xb7\x21\x38\x26\xde\xa8\x70\xd0\x6e\x13\xc7\x4a\x59\xe0\x69\xf0\x35\xb2\x70\x96\x52\xad\xeb\x77\x1b\xbe\xe7\x7d\xd8\x54\xf0\x3c\xbd\xdb\x30\xfe\x55\xf1\x66\xef\xf3\x7c\x64\x92\xbd\x31\xde\xe8\x97\x73\x12\x4e\xc1\x6d\x4f\x7c\xbb\xe0\x56\x2f\x5d\x96\x07\x37\xbe\x18\xa6\x31\xcf\xb3\xac\x0a\xb1\xa8\x79\xc5\x28\x77\x77\xd1\x14\x1d\x74\xf1\xe0\x77\x6a\xad\xe0\xac\x91\x97\xfb\x94\xdd\xbf\x18\x0c\xa4\x0e\x76\xf2\xcd\x0c\x19\xa3\x3e\xab\xef\x75\xdc\xc2\x01\x7c\xee\x3c\xe8\x06\xee\x70\x03\xc9\x14\xb8\xae\x12\x44\xb7\xc5\x00\x3b\x63\x80\x5e\xdd\x29\xe8\x5c\xe5\xe2\x09\xd9\x99\x18\xe0\x43\x02\x26\x44\x70\x60\x22\x01\x03\xb1\xc0\xc8\x58\xe2\x26\x90\x4a\xa0\xf2\x6e\xc0\x15\xdd\x80\xe4\x2b\x09\xd4\x87\x40\xb3\xbb\x03\x23\xba\x03\xa9\x69\x24\xaf\x46\xd3\xe4\x5c\x7d\xea\x3c\x01\x90\x61\xf8\x9b\xfa\xd4\x04\x0b\x07\xc8\x30\x7c\xbe\x3e\xf5\x75\x85\x03\x52\x05\xe0\x62\x7d\xea\x15\x82\x62\x80\x00\xac\xbf\x5a\x84\xe0\x65\x9a\xd0\x4d\x57\x8b\xd8\xb9\xcc\x15\x23\x42\xe5\x8e\xab\x45\xec\x5c\xe6\xea\x25\x00\x7b\xc2\xbd\xc2\x64\x9c\x5a\x17\x4f\x11\x7f\x1f\x07\xfc\x26\x0e\x18\x70\xc4\x0d\x7c\xdb\x03\xe8\xf5\x05\x99\x34\x3f\x92\x07\xcb\x64\xea\x9d\x78\xc0\x33\xa6\x59\xd8\xf4\xdb\x46\x60\x76\x0f\xb9\x1a\xa8\x7e\x5d\x86\x10\x4f\xbe\x44\x1f\x2a\x07\x42\x3d\x8c\x49\x91\x27\x67\xde\x12\x89\xe5\xd3\x5f\xc0\x5c\xd8\xde\x6c\xb8\xa8\xf7\x01\x13\xc6\xf3\xb2\x2c\xd0\xf2\xec\x99\x1e\x86\x87\xbb\x76\x98\xd8\x7b\xdf\x6c\x14\x12\xff\xfc\x0b\x51\xd0\x17\x2b\x7f\xd0\x12\x3f\x0c\x38\x4d\x5d\x9a\x24\xa6\x86\xf2\xc8\x1e\xc4\xe6\x8f\x07\x66\xc5\x8b\xa9\xa1\x3c\xd2\x4b\xa0\x7f\xc7\x03\xdf\xc5\x8b\x44\xaa\x22\x92\x2f\xa0\x2e\x4b\x00\x32\x13\x44\x06\x5d\x19\xd9\x83\x32\xe8\xfa\x04\xe0\xca\x04\xc0\xb3\xe2\x46\x23\xbc\xf8\x13\xf5\xc4\xe1\x66\x09\x5e\xb7\x0f\xd8\x92\x68\x78\xb7\x39\x15\xf5\xdc\x79\xa3\x31\x84\xee\x4c\x34\x96\x34\x87\x25\x9c\x02\xb6\xe7\x88\x2c\x6c\xdf\x07\x1c\x4d\x34\x02\xfe\xcb\x12\x7e\xfb\x3e\xe0\x3d\x13\xfc\xc6\x5b\x0c\x85\xbe\x32\x14\xba\xe3\x16\x63\x18\x46\x26\x19\xc3\xf0\x3e\x13\x3c\x35\xc9\xc8\x70\x1e\x93\xf0\xfb\xf7\x01\x59\x26\xfa\xe3\xb7\x18\x21\x64\xa1\x80\x47\x84\xb0\x1c\xa9\x7d\x14\x91\xf3\xc3\xf3\xca\x2d\x46\xb3\x1a\x0d\x91\x89\xec\x16\xc3\x7d\x78\xdf\x9c\x7c\x5d\xef\x9b\x25\x5d\x80\x80\x47\xf4\xcd\x01\xe1\x76\xbf\x4f\x00\x7e\x9b\x20\xfa\xe6\x80\xe8\xae\x7f\x25\x00\x5f\x27\x00\xf1\x2f\x04\x81\x4f\xb7\xc8\x11\x8e\x1d\x5d\x00\xe5\x50\x64\x1f\xaf\x07\x48\xf8\x24\x08\x0c\xeb\x01\x64\xf4\x00\x12\xde\x0b\x02\xc1\x1e\xc0\xd2\x1e\x40\x0f\x22\x1b\xf8\x65\x10\x48\xfc\x4c\xb2\xbe\xb9\xbc\xed\x7a\x85\x3a\xe5\xe9\x2d\x86\xfd\x86\x7b\x74\xfb\xfd\xd5\x04\x2e\xd4\xc1\x89\xbe\xf6\xfe\xf6\xb5\xe1\x6f\xb9\xd1\x40\xd0\x2b\xda\x74\x44\xb4\x69\x47\x0f\x60\x7b\x0f\xd1\xa6\x23\xa2\x4d\x2f\xf6\x00\x8e\xf7\x90\x6d\x5a\xb8\x49\x6b\x53\x7d\x34\xa0\x3c\x1f\xd9\xe7\x0b\xad\x4d\xb6\x44\x71\xed\x9e\xb7\x29\x3b\x11\xc8\x4a\x04\x7a\x10\x99\x68\x53\xf9\xa6\x4b\xb7\x69\xf8\x26\x43\x79\x87\x57\x6f\x53\x89\x09\x9c\x6c\x80\xd7\x6c\x32\xfa\x6f\xa4\xd7\xf0\xac\x4d\x26\xf8\x34\xaf\xd1\xaf\x3f\xb6\xb7\x41\xac\x7e\x45\x75\x50\x9d\xdc\x11\xd0\x6e\x12\x78\x46\xda\xe3\x65\x61\x8f\x8a\x44\x60\x71\xa2\xb0\xc7\xcb\xc2\x1e\xf7\x24\x02\xb7\x27\xd2\x6c\x23\x59\xef\xc9\x03\xbc\xf7\x5c\x6f\x0c\x09\x0c\x3e\xe5\x05\xfa\x85\xa0\xfc\x55\x48\xc1\xa0\xf7\xaf\x13\x68\x6d\xff\xe1\xaa\x9e\x40\x3a\xdf\xde\xfb\x32\xd2\x4d\x6b\x79\xeb\xce\x83\x2b\xe2\x69\xa5\x9e\xb0\x55\xd3\x4d\x75\x03\xca\xd7\x91\xae\xc1\x3d\x81\x84\x2a\x42\x25\x01\x71\x49\x80\x37\x55\xd2\x38\x9a\xc8\x78\x4c\xca\x3e\xb4\x0f\x98\xd1\xb3\xcd\x50\xb6\xc9\x4b\xa7\x48\xac\x92\x2c\xbb\x2b\xda\xce\x64\x18\xb0\xde\x0d\xfc\xba\xa7\x68\xb5\x3d\x8a\x37\x71\x5c\x12\x30\x96\x2a\x7a\x6f\xab\xa9\x89\x17\xb7\x1a\xe6\xc5\xa0\x09\xb2\xd6\x7c\x59\x83\x3b\x59\x88\xe8\x29\x12\xd8\xf2\x24\x60\x51\x12\xe0\xb9\xf2\x3a\xa3\x0f\x3f\xe8\xa9\xf7\x61\xe3\x75\xc6\x58\xfb\x5e\x57\x1a\x89\x6b\xaf\x33\x2a\x91\x30\x0c\xc8\x8d\x01\x4a\x7b\x69\x16\xeb\x1b\xe5\xa6\x29\x93\x2c\x96\x5c\xb5\x2c\x0c\xa5\x9f\x48\xa3\xef\x4c\x02\x76\x25\x01\xe9\xb5\x75\x2b\xa0\xf4\x8f\x8a\xfd\xd2\x42\x54\x5b\xaf\xe6\x76\x6d\xde\xa6\xd9\x75\x7d\x0c\xa0\x0c\x8e\x72\x6d\x4a\x96\x76\x3d\x93\x04\x7c\x4a\xcd\xfd\xc5\x36\xb3\x5d\x1f\xbb\xde\x50\x65\x77\xb2\xe1\x66\xc9\x92\xea\xc8\x3e\xe0\x90\x80\x53\x8b\x0a\xb7\x19\x0d\x7d\x31\x59\x6f\xe8\x1c\x09\x7e\x69\x1f\xf0\x61\xb2\xcc\x2a\xb7\x5e\x0d\x4f\x50\x22\xde\xde\x07\x38\x7b\x99\xba\xcd\x1a\x09\x5b\x92\x32\x83\xd7\xf5\xee\x36\xa3\xdb\xfa\xf6\x6a\xdb\x75\x9e\x4f\x4c\x8a\x64\xf7\xd2\x14\x49\x7c\xa1\xbd\xbb\xcf\xd6\xdd\x3d\xfe\x87\x20\xb0\x71\xbd\x56\x3c\x18\xc9\xf7\x8a\x3d\xd7\xf6\x02\x12\x62\xae\x04\x62\x3d\x40\xb4\x47\x23\x1c\xa4\x13\x22\x8a\x6f\x17\x7b\xf6\x69\x84\x53\x3d\xc0\x64\x4e\x48\xb6\x0d\x5f\xab\xd1\x15\x13\xe1\xf7\x91\xae\xd3\xbd\xa4\x6d\x37\x78\x80\xf5\x1e\xc0\xbb\xfe\x5a\x93\x6d\x39\xd7\x97\x3a\xd7\x7a\xe2\xfa\x31\xd2\x95\xd0\x5b\x72\x3d\xe0\x01\xee\x23\xae\x1f\x3a\x70\xcd\xda\xa0\x6b\x4f\x5c\xbd\x22\x5d\x45\x1a\xd7\x4b\x1e\xe0\x45\xe2\x5a\xbc\xa1\x03\xd7\x46\x8d\xeb\x34\x71\x75\x8d\x72\x6d\xd0\xb8\xbe\xf4\x00\x5f\x70\xae\x8d\xed\xb9\x5e\xd6\xb9\x62\x29\xda\x3d\x15\xe9\x3a\xac\x71\x25\x78\x81\x38\x2f\xe0\x7d\xa7\x03\xd7\x3d\x9b\x34\xae\x3d\xc4\xf5\xa3\xcb\xf5\x4f\x8d\x6b\x9c\x17\x18\x4b\x5c\x8f\x6c\x6a\xcf\xe5\xdb\xac\x71\x9d\x22\xae\xe8\x48\x57\x9a\x2a\xb9\x96\x78\x81\x45\xc4\x55\xb2\xb9\x3d\x57\xca\x16\xbd\x8b\x68\x8e\xb9\x37\xb2\xcb\x7c\x8d\x6b\xb3\x17\xd8\x48\x5c\x23\xb7\xb4\xe7\x9a\xab\x73\x65\x12\xd7\xde\x48\xd7\x0e\x8d\x6b\x9f\x17\xd8\x4b\x5c\x15\x1d\xb8\x3c\xd7\x69\x5c\x47\x88\xcb\x13\xe5\x3a\xae\x71\xbd\xea\x05\x5e\x26\xae\x7e\xd7\xb5\xe7\xba\x4b\xe7\x3a\x47\x5c\xad\x91\xae\x8b\x1a\xd7\x37\x5e\xe0\x2b\xe2\x7a\xa8\x03\xd7\x56\x3d\x0a\x16\x53\x14\x7c\x3b\xd2\x35\x3c\x45\x72\x25\xf5\x04\x7a\xf4\x04\xbc\xb7\x6c\x6d\xcf\x75\xae\x59\xef\x2f\x1a\xe3\x43\x23\x5d\xd5\x1a\xd7\xf8\x9e\x40\x36\x71\x5d\x6c\x6e\xcf\xf5\xc6\xf5\xba\x47\x11\xd7\xd8\x48\xd7\x9d\x1a\x57\x45\x4f\xc0\x4f\x5c\xa7\xaf\x37\x73\xd1\x92\x73\xe1\xad\x02\x62\x85\x4a\x6b\xed\xa7\x5d\xae\x4f\x53\xe4\x92\x73\x6b\x4f\x60\x0b\x69\x49\x18\xbe\xe4\x7c\xb2\x27\x70\x88\xe4\xd4\x49\x2e\xb9\xe4\xec\x7a\x8d\x28\x4e\x59\x08\x20\x95\x6f\x37\x51\xf2\x0f\x6f\xaa\x44\xbc\xe7\x07\x3c\xf3\x65\xa1\xff\x3c\x60\x4c\xaa\x29\x52\xf0\x39\x74\x86\xcb\xda\x5f\xcf\xd8\x9e\x94\xa4\x8e\x29\xc0\xd5\x92\x74\x9d\x69\xb3\x73\x2d\xf8\x02\xf6\x7c\x7d\x92\x52\x4a\x99\x7a\x92\x32\x8f\xf2\x73\x78\x52\xd6\x19\x75\xec\xee\xac\x8e\x21\x7a\x1d\x59\x26\xd2\x13\x9d\x91\x5e\xa6\x93\xce\x32\x91\x7e\xd5\x19\x69\x96\x4e\x5a\x67\x22\x4d\xee\xd3\x09\x69\x9e\x4e\xba\xd5\x44\xea\xeb\x8c\x74\xb2\x4e\x7a\xb7\x89\x34\xd4\x19\xe9\x1b\x3a\xe9\x53\x26\xd2\x3b\x3b\x23\x2d\xd2\x49\x5f\x37\x91\xbe\xd0\x19\xe9\x0c\x9d\x34\x46\xc6\xd0\x03\xb9\xc0\xe9\x3e\xfa\xac\xf0\xb6\x04\xd3\x9a\xe0\xbc\x94\xb0\x6e\x1d\x3c\x83\xae\x35\xc8\x63\xd2\x8c\x6c\x5d\x82\x29\x09\xee\x9f\x66\x24\xbb\x77\x49\x38\x4d\x0f\x05\x69\xed\xd6\xa8\x0f\x4a\x24\xdf\xc2\xbb\x57\x86\x44\x5a\xfb\xd4\xa5\xb5\x5f\xfb\x3c\xb9\xc1\x70\x37\xef\xfb\xb2\x70\xb0\x14\xf0\x0c\x93\xe1\x8d\xe6\xe9\xbd\x69\x72\x42\xd9\xa1\x2f\xa9\xee\x91\x68\x9a\xf4\x9e\xd1\xf5\xf5\x3e\x24\xc1\x34\x05\x79\xfe\xb6\xc9\xa8\xfa\x1f\x1d\xaa\xfe\x6a\x93\xa9\xea\x1e\x9b\x8d\xaa\xbd\xfd\x64\x61\x54\x01\xe0\xb9\x7d\xb3\x21\x64\x68\xdf\xf6\x42\xf6\x6f\x36\x09\x79\xcd\x2c\xe4\x2d\x19\x5a\xce\x92\xb9\xd6\x6e\x37\x84\x84\x3b\x08\xd9\xb1\xdd\x10\xe2\x79\x7a\xbb\x21\xe4\xae\x0e\xa4\x27\xb6\x9b\x54\xfb\x52\x16\x6a\x96\x01\xaf\xb6\x23\x15\x9b\x39\xde\xb4\x16\x41\xd2\x40\x61\xb4\x50\x16\x1a\x27\x00\x5f\xf7\xd5\x6d\x16\x6a\x31\xfa\xd8\xb3\x5f\x16\x4e\xd6\x00\x89\xfd\xf4\x54\xd6\xfb\x9a\x84\xdf\x9b\x09\x78\x3e\x68\x31\x9a\x93\xdf\xaf\xbd\x8e\xdf\xb4\x98\x9a\x93\x7c\x83\xd1\x4f\x4b\xfb\xe9\x7e\x35\xe9\x06\xa3\x95\x9b\xdb\x48\xb8\x06\xf0\x16\xdf\x60\x6a\xe5\xaa\x1b\x8c\x56\xfe\xa6\x1d\xa9\x6c\xe5\xc3\x37\x98\x5a\xf9\xe7\x1b\x8c\xe6\xbc\xdb\xcf\xb8\x70\xe2\xb9\xd1\x70\xa8\xef\xfb\x49\x87\xca\xd5\x1d\x6a\x82\x09\x9d\xd8\x5f\xa2\x55\x1d\x7d\xde\x84\x1e\xad\xa1\x97\xfc\x49\x43\x4f\xde\x61\xa0\xe7\x68\xe8\x23\x3a\x3a\x60\x42\x37\x6a\x68\xe8\xe8\x2d\x3b\x0c\x7b\xee\xea\x2f\x55\x5e\x93\xa4\x94\x44\xac\x59\x37\x3c\x2d\x84\x54\x78\x8f\x48\x12\xbe\x15\xe0\xd9\x69\xb2\x70\xc5\x4e\x03\x73\xaa\xbf\xbc\x46\xa9\xc8\x6d\x35\xcf\x06\x89\xfd\x68\x1f\xd0\xca\xb1\x33\xf8\x52\xf4\xad\x9d\x46\x95\x51\xe9\x9d\x56\xd9\xf5\x26\x53\x95\x57\xde\x64\xaa\xf2\xa0\x09\x93\x9f\xde\xbe\xca\x57\x6f\x32\xaa\x5c\x90\x6e\x54\x39\x5d\x6e\x47\x50\xf0\xda\x92\xde\x49\xf0\x9a\xa7\x07\xaf\x6a\x13\xe9\xa3\x9d\x91\x2e\xd2\x49\xaf\x35\x91\xbe\xdf\x19\x69\x89\x4e\x7a\x87\x89\x34\x62\x40\x27\xa4\x7b\x75\xd2\x43\x26\xd2\xe1\x9d\x91\x56\x3f\xab\x91\xbe\x64\x22\x5d\xd8\x19\x69\xa5\x2e\xb5\xd5\x44\xba\xb5\x33\xd2\x1e\xda\x15\x7a\x8f\x72\x8b\x41\x7a\xa8\x33\xd2\xa0\x2e\xd5\x63\x22\xfd\x6b\x67\xa4\xb5\x3a\xe9\x08\x13\xa9\x6b\x60\x27\xa4\x61\x9d\x74\xa6\x89\xf4\xb2\xce\x48\xd7\xe8\xa4\x99\xa6\xbc\x7f\xd6\x40\xe9\xdd\xd7\xea\xe8\x6c\x13\xba\x41\x43\xa7\xeb\xce\x5f\x62\x42\xef\xd4\xd0\x3b\x5e\xd3\xd0\x41\x13\xfa\xa0\x86\x6e\xd5\xb9\x1b\x4d\xe8\x57\x35\x74\xfe\x51\xdd\x3b\x4c\xe8\x7f\xea\x75\x6b\x97\xe9\x3d\x2d\x26\x74\x97\x41\x12\x7d\xbb\xae\xf9\xed\x26\xf4\x60\x0d\xfd\x90\x8e\xbe\xcf\x84\xbe\x5c\x43\x9f\xd4\x35\x7f\xd4\x84\xbe\x52\x43\x6f\xd5\x35\xff\xbd\x09\xdd\xac\xa1\xcf\xe9\x01\xe7\x03\x13\x7a\xaf\x86\x1e\xa2\x37\xec\x73\x13\xfa\x84\x86\xae\xd7\xea\xf6\x7e\x25\xd1\x25\xfb\x00\x4f\xe4\xad\x06\xed\xd7\x1a\xed\xfb\x7a\x33\x7e\x25\xd1\x5f\xec\x03\xba\x0d\x96\xe8\x44\xdd\xbf\xf7\x4a\xf4\x75\x95\xc0\xc0\xc1\x46\x0a\xf0\xb8\x89\xad\xb0\x23\xdb\x51\x13\xba\xba\x03\x3a\xb1\x55\xa2\x03\x01\x88\x1d\x03\x8f\x44\x25\x29\x33\xb9\x9f\x95\x5a\x9c\x65\x49\xca\x3c\x25\x4b\xde\x4c\x70\xff\x60\x20\x63\x24\x00\x66\xc5\x90\x1e\xb4\x96\xea\xed\xea\xb2\x47\xbb\x60\xac\x5f\x1a\xa6\xf9\x29\xee\x2b\x2b\x47\x27\xd3\x70\xfb\xb6\x27\xf0\x79\x4f\x20\xf9\x0a\x28\xaa\x8b\x5f\x12\x9b\x9f\x0c\x94\x24\x6b\x7b\xb9\x29\x62\xa7\xf7\xc9\x64\xe0\xa1\x64\xb1\x95\x99\xea\xe2\x37\x03\xcc\xe8\x05\x8c\xeb\x25\x36\xab\xfb\x88\x2d\xe4\xfc\xde\xc0\xe8\xde\xda\xed\x2a\x69\x02\xf8\x66\x6f\xe0\xa5\xde\x32\x3d\x67\xf2\xa5\x92\x0a\x7f\xae\x44\x99\xe3\xb2\x6f\xc9\x90\xe9\xf9\x5c\x15\x98\xad\x02\x3d\x08\xc3\xd3\xf3\x6b\x54\x60\x95\x0a\x78\x6d\xf2\x81\x13\x91\x9e\xf3\x7b\x82\xd6\x6b\xcf\xa0\xf0\xe7\x56\x94\x45\x2e\xfb\xc5\x0c\x79\x4f\xd0\x13\x2a\xf0\xb8\x2a\xef\x09\xfa\x48\x05\xfe\xae\xca\x7b\x82\xe2\x52\x80\x6e\x29\xf2\x9e\xa0\xac\x14\x60\x4c\x0a\xd0\x83\x04\x24\xde\x23\xe5\xb5\xbd\x27\x28\xa4\xf5\x88\xac\xf5\xb0\x5e\x6b\x3d\xd5\xea\x77\xd9\x97\x0d\x91\xb5\x2e\x4e\x01\x16\xa6\xc8\x5a\x5b\x52\x80\x6d\x29\xb2\xd6\x43\x29\xc0\xa3\x5a\xad\xef\xa4\x00\x6f\x51\xad\x24\x20\xf1\xf5\x36\xb5\xce\x74\x59\x1b\x4d\x15\xd2\x22\xe8\x43\xbd\xc2\x3d\xfc\x1e\x4e\x97\xfd\x9e\x21\x72\x11\xf4\x63\x0a\x70\x31\x05\xf0\x7e\x21\x69\xc4\xf6\xc8\x13\xa6\x8b\xc2\xbf\x1b\x62\x6c\xcc\xf6\xad\x35\xe0\xaf\x0f\x31\xb6\x4d\x46\x49\xf8\xfd\xfb\x80\xb3\x43\x0c\x07\x2e\x90\xf0\x85\xfb\x00\xd7\xd0\xb6\x11\x0e\x9e\x22\x93\xb4\x94\xa1\x86\xb4\x52\x13\x7c\xac\x09\x1e\x30\x49\x9b\xc1\xe1\x62\xcf\xb7\x5e\xc2\x7d\xfb\x80\xba\x36\xb5\x5c\x03\x78\xd6\x4b\x6c\xc2\x3e\xe0\xba\xa1\x86\x6e\x3b\x25\x9c\x66\xd8\xbb\x24\xdc\xb9\x04\x9e\x77\x4c\xf0\x27\x35\x78\x31\x3c\x43\xea\x0c\xad\x5e\x1d\x6a\xa4\x6d\x7b\x25\x9c\x5f\xb2\xba\x50\x67\x54\xe6\x45\xbd\x28\xf0\xfc\x74\xa8\x2c\x3c\x93\x05\x78\x33\xdb\xec\xe4\xd9\xf5\x11\x9d\x5b\x6f\xb0\x8f\xc8\x34\x74\x9d\x23\xe1\x14\x5e\x66\x66\xca\x91\xbe\xeb\x15\x8d\xad\x5a\xa2\xf7\x46\x03\x57\x66\x1a\xdd\x75\x63\xbd\x61\x98\x1b\x33\x4d\x79\x9d\xf9\x9e\x94\xbd\x26\x66\xc9\x88\x9e\x83\x6c\x80\x72\x83\xd3\x71\x4a\xbc\xae\x17\xdf\xba\xb4\xd1\xff\x48\x16\x10\xc7\xaf\x23\xdd\xe0\x4c\xa6\xa1\xd9\x37\x15\x48\x49\x05\xe2\xe6\x09\xe0\x40\x02\x2e\x49\x05\xca\x08\xc8\xaf\x27\xdd\xe0\x1c\x49\xc0\xfb\x52\x81\xdb\x08\xc8\xaf\x29\xdd\xe0\x9c\x48\xc0\x19\x7d\x80\xfc\x3e\x40\x72\x31\x94\x1b\x9d\xfc\xee\xd6\x6f\xfb\x00\x5f\xf4\x11\x5b\x8e\x3b\x9c\x3c\x74\x4c\x4d\x03\xc6\xa7\x89\x7b\x47\x76\x38\xf9\x96\xe0\xb9\x34\xe0\x25\x02\xd5\xd5\x87\x09\xc8\xb7\x26\x6b\xfa\x01\xf3\xfb\x01\xbd\x46\x47\x00\xca\x4e\x27\x0f\x1e\xc7\xfa\x03\x03\xfb\x03\xbd\x56\xdb\x38\x90\x5f\x77\xdb\x95\x01\xec\xc8\x00\x52\x67\x3b\x01\xe5\x26\x67\x14\x35\xcc\xba\xf3\xf4\x35\xfc\xea\xf4\x15\xda\x0b\x4d\x71\x8a\x86\xcc\x5d\xce\xe8\x17\x86\xc9\xab\xd3\xee\x21\x80\x73\x88\x76\x59\x3e\x28\x09\xed\x88\xe5\x77\xe4\x3a\x7b\x7d\xa5\x11\x96\x0d\x01\x4a\x75\xc2\x4d\x92\x50\x41\x2e\x11\x3e\xe0\x8c\xf3\x0e\x97\x84\xb7\x0f\x01\x6e\xd6\x09\xc7\x34\x6a\x84\xf5\x44\xf8\xb8\x33\xb6\x40\x23\x3c\x33\x04\xf8\x90\x08\x3d\x2e\x29\x8d\x72\xcb\xca\xe1\x72\xe7\xf1\xfd\x6b\xf8\x1e\xc3\xdb\xd7\xc0\xdb\x53\xe2\x79\x2e\x39\x59\x16\x68\x81\xb0\x93\x13\x97\xd8\x97\x23\x84\x9e\x72\xa5\xf0\x99\xc4\x53\x1e\x72\x90\xe3\x67\xb8\xac\x3f\xe8\x4e\x39\x5c\x2a\xe4\xbd\x12\x78\x65\xb8\x74\x4a\x45\x1b\x5e\x8f\x37\x1a\xae\xf9\xf9\x70\x6d\xfa\x7f\x55\x63\x3e\x26\xd1\x34\x47\x45\x8f\x90\x68\xfd\x2e\x49\xcf\x0f\x8d\x46\xd5\xa3\x47\xb4\x1d\xba\x5c\x8d\x5b\x74\x35\xb2\x97\x1b\x63\x63\xfa\x08\xc3\xc9\x57\x2e\x37\x44\xac\xea\x4c\xc4\x9b\x9a\x08\xef\xeb\xcb\x0d\x65\xbc\xa7\x4d\x05\x8f\x75\x85\x28\xd0\x5c\x79\x64\x04\xf4\x15\x9e\x36\x51\x52\x42\x36\xcf\xb6\x02\xb7\xd8\xe0\x49\x5e\x61\x54\x78\xb6\xb3\x0a\x4f\xeb\x3a\xe7\x98\x48\x13\x2e\xeb\x84\xf4\x0e\x9d\x74\xbe\x89\x34\xaf\x33\xd2\xbb\x74\xd2\x55\x26\xd2\x9a\xce\x48\xef\xd5\x49\x6f\x33\x91\xde\xd6\x19\xe9\x7e\x9d\xf4\x49\x13\xe9\x73\x9d\x91\x1e\xd4\x49\xcf\x48\x52\x8a\x65\xef\x5e\x06\x7d\x59\x3a\xac\xc9\x64\xdf\xf1\xa6\x82\x67\x49\x93\x61\xdf\x5e\x23\xcd\xf6\xbd\x86\xdb\x37\xa4\xdf\x6f\x71\x87\xa4\xa4\x69\xda\xfb\x6f\x59\xb8\x25\x0c\x78\xbb\xac\x34\x1c\xc0\x33\x78\xa5\xe1\x76\x95\x23\xa5\x5f\x15\xeb\x6e\x97\xb7\xd2\x68\xcc\x8d\x23\x3b\x69\xcc\x6f\x75\xa7\x58\xbe\xd2\xa4\xf4\x46\x73\x61\xa7\xb9\x70\x97\xb9\xf0\xb0\xb9\xf0\x3b\x73\xe1\x05\x73\xe1\x2d\x53\xc1\xf3\x2f\x93\xc6\x09\xa3\xa4\xc6\xeb\x74\x9b\x5a\x57\x19\xe8\x91\x1a\xba\xf4\x94\x86\x8e\x31\xa1\x4b\x34\xf4\x61\xbd\xbd\x5e\x13\x3a\xa4\xa1\x4f\xeb\xe8\xfe\x26\xf4\x4d\x1a\xda\xa9\x09\xf7\x0e\x96\x68\x9e\xca\x8e\x31\xd1\xfe\x41\xa3\xdd\xa1\x8b\x2a\x30\xa1\xff\xa2\xa1\xd7\xeb\x03\xba\xd8\x84\xbe\xa8\xa1\x33\xf5\x66\x94\x99\xd0\xde\xd1\x12\xdd\xa4\xa3\xaf\x5e\x65\x74\xdb\xe4\xd1\x9d\x74\xdb\x1f\x74\x7b\xfd\x4a\x92\x5e\xd8\x07\x5c\x35\x5a\xdb\x83\xe0\x73\x9b\xde\xb7\x9e\x47\x4d\xd5\x5d\xaf\x55\xb7\x4b\xaf\xee\xb8\xa9\xba\xdf\x74\x56\xdd\x51\x5d\xd2\x97\xa6\xea\xde\x6d\x5b\x9d\x9e\xdf\x79\x6c\xab\xc5\x49\xc4\x43\x00\x6b\x4b\x74\x48\x5f\x56\xf4\x58\x6d\x48\x4a\x1b\xd3\x86\xe8\x15\x5d\xd2\x40\x93\xa4\xc2\xb6\x44\xa7\x74\xa2\xa6\xd5\x46\xeb\xaa\xc7\x68\x3e\xa1\xb7\xae\x65\xb5\xd1\xba\x9b\xc7\x74\xd2\xba\x37\x74\x49\xfb\x4c\xa4\x7f\xe8\x8c\xf4\xaf\x3a\xe9\x8b\x26\xd2\x33\x9d\x91\x1a\x13\xc7\x77\xa6\x46\xb8\xc7\xb6\x69\xc4\xc3\x3a\x51\x97\x35\x06\xd1\x88\xb6\x44\x76\x7d\xfd\xa4\x9a\x88\x4a\xdb\x12\xb9\x34\x22\x6f\xb6\x24\xaa\x9a\x47\x4b\xde\x35\x86\x6d\x36\x8d\xd5\x06\x84\x6e\x9b\x25\x26\xf4\x3d\x1a\x3a\x57\x5f\xdb\x35\x99\x44\x79\x77\x9b\xe5\x3e\x6e\x62\x7c\x5f\x63\xfc\x54\x6f\xcd\x11\x13\xfa\x82\x86\x8e\xd6\xdb\x71\x4e\xa2\x29\x9e\x75\xcf\xd2\xf3\x3b\x6f\xb6\xbc\x71\x88\x47\x8d\xa9\xe6\x42\xa9\xb9\x50\x65\x2e\x84\xcd\x85\x0d\xe6\xc2\xcd\xe6\xc2\x83\xa6\x82\xe7\x71\x59\xa0\x85\xe6\xcd\x46\xfd\x89\xcf\xaf\x35\xd4\x16\x57\xd1\x35\x53\x88\x1b\xa0\x28\xd0\xf3\x1b\x9e\x5c\xe8\xb9\x9f\xf2\xa5\x72\xa7\xe3\xb4\x5f\x0a\xd6\xef\xf4\x6c\x9c\x00\x0c\x2d\x26\x64\x1a\xa5\x71\x79\x43\x81\x11\x43\x81\xa1\x3c\x41\x2b\x77\x8e\x78\xdd\x02\x9c\x1e\x0a\xbc\x3f\x94\x22\x56\xb9\x61\x8a\x5e\xe3\x8c\x54\xf7\x83\x72\x43\xdf\x9c\x71\x52\x9d\x28\xdd\xc0\xe7\x4c\xe8\x32\x0d\x5d\xa8\xa3\xa3\x2a\xc4\x09\xcd\x33\x3b\xc7\xb5\xc9\xb2\xdd\xa6\xd5\x2f\x4d\xe7\xa5\x4a\x26\x2d\x7e\x33\xe1\xe9\x51\x61\xc8\x3c\xa8\xc9\xd4\x9e\x4c\x86\xa7\x9f\x09\xfd\xaa\x86\xee\xa6\x57\x99\x67\xaa\xd2\x96\xfd\x5f\x56\x39\xcd\x24\xb3\x4f\x76\x87\xb4\xa8\xcc\x84\x9e\xa8\xa1\xe3\xf5\x2a\x57\x98\xaa\x5c\xf1\xdf\x56\xb9\xd1\x24\xf3\xd6\xec\x0e\x9b\x09\x37\x99\xd0\xbf\xe9\x88\x3e\x64\xaa\xf2\x6c\xdb\x2a\x3d\xed\xab\xd4\xb7\x15\x3c\xcf\x98\x84\x46\xe6\x48\xa1\xb3\x75\xa1\xaf\x98\xd0\x03\x3a\xa0\x33\xbf\x35\xd5\x39\x5d\xa0\xe5\x03\x34\x46\xa5\xf0\xfc\x71\xa9\x21\xa4\x36\xa7\x83\xc3\xbc\x6b\x42\xb7\x68\x68\x9f\x8e\xfe\x76\xa9\x51\xc7\x73\x39\x6d\xda\x55\x74\x49\x53\x2a\xd5\x86\xcc\x0f\x72\x3a\xf4\x5e\xac\x09\x8d\xf1\x1d\x7a\x6f\x50\xb5\x51\xe5\xc8\xf1\x6d\xaa\x2c\xbe\x64\x95\xa3\x4d\x32\x4b\xc6\x77\xf0\xd1\xc9\x26\x74\x48\x43\x5f\xd0\xd1\x7e\x53\x95\x7b\xc7\xff\x97\xad\x5c\x66\x92\x79\x62\x7c\x07\x8f\x58\x63\x42\x7f\xd2\x11\x7d\x87\xa9\x4a\xef\x84\x9f\x76\x18\x51\x65\x16\x3c\xf7\x9b\x64\x66\x4d\xe8\xe0\x2f\xbf\x31\xa1\xe7\x69\xe8\x12\x7d\xc3\xeb\x55\x89\xd6\xa3\xd8\x5c\x7d\x23\xa4\xfb\xef\xed\x80\x52\xec\xe4\x8f\xf4\xc5\xca\xc8\x75\x5c\x22\x95\xdd\x07\x72\x81\x38\xfe\x5c\x4c\xb1\xd3\xeb\xe7\x1b\xae\x40\x42\x26\x30\x94\x3f\x1f\x53\xec\xec\xc7\x9f\x7f\xcb\x04\x8a\x33\x81\xb8\x95\x2e\x4e\x39\x94\x80\xcf\x64\x02\x4f\x66\x8a\xc5\xea\x0c\xb1\x58\xed\x37\x0c\xe8\x35\x4c\xdc\x58\x34\x43\x2c\x56\xb7\x0f\x03\x36\x0c\x03\xe2\xaf\x2e\x02\x0a\x65\xfd\x16\xec\xa1\x58\x3a\xdb\xe9\xaa\xcb\x05\x12\x6e\x2f\x02\xd8\x30\xe0\x07\x5a\x40\x6e\x2f\x02\xfa\x0e\x07\xfa\xd0\xda\x6f\x5d\x11\x30\x75\x38\xe0\x1b\x0e\xf4\x20\x9e\x81\xf7\x17\x01\x03\x0f\x15\x01\x89\x4b\xa5\xb0\xdb\xd7\x6a\x1b\x25\xfa\x15\x61\x65\x18\xb5\x64\x9c\x24\x70\xed\x03\x6e\xce\xe5\x44\xee\x10\xbe\x56\x66\xf7\xec\xa3\x5d\x4f\xf0\xde\x22\x69\xf8\x3d\x8d\x27\x4d\x05\x74\xbf\x93\xd6\xcc\x13\x9d\xfc\xfe\xd6\x1d\x4b\x04\x26\xac\x1b\xee\x9e\x3c\xcd\x70\x13\x9d\x1e\x22\xa9\x1b\x0e\x2c\x1b\xae\x19\x6e\xa2\xb3\x2f\x01\xef\x18\x0e\xdc\x34\x5c\x33\xdc\x44\xe7\x10\x02\x2a\x23\x80\xef\x87\x8b\x7b\x1d\xf3\x9d\xfc\xa1\x91\xf2\x11\xc0\x92\x11\xd2\x4a\x07\x96\x68\x56\xaa\x27\x2b\x4d\x76\xba\x7e\x91\x27\xad\x74\xdd\x08\x60\xf3\x08\x69\xa5\x87\x47\x00\x0f\x8d\x90\x56\xfa\xd3\x08\xe0\xd4\x08\xa0\x47\x7d\x5b\x2b\xbd\xb4\xe4\xa7\xad\xe4\xfd\x66\x89\xb9\xd1\x67\xc9\x5b\x7a\x0b\x6f\x51\xe5\xb7\x99\xee\xd6\x1f\xbb\x1b\x55\x00\xc4\x7d\xe4\xe4\x24\xfc\x31\x02\x36\x02\xf8\x76\x04\x10\xf7\x88\x8d\x03\xf9\x7d\xb8\xd7\x5c\x06\x84\x2f\x13\x8e\xa1\x0a\xc7\xf8\xe1\x32\xe0\xeb\xcb\x84\x63\xa8\xc2\x31\x1a\x46\x02\x35\x23\x81\xf4\x20\x94\x14\xa7\x7c\xd6\xa6\x60\xd8\x8b\x05\xc0\x4d\xda\x47\xa1\xf4\x33\xed\x05\x11\x4a\x96\x33\xf2\x8d\x89\x40\x82\x75\x12\xf0\xec\x48\xe0\x77\x23\x81\x84\xef\x0a\x80\xd8\x51\x80\x7b\x14\x90\x70\xa6\x40\x2c\x23\x46\x50\xe1\xfd\x02\x60\xc1\x28\x60\xde\x28\x60\x78\x7b\x61\x03\x63\x27\x01\x03\x93\xe9\xdf\x40\xfa\x37\x9a\xfe\x15\xd0\xbf\xe9\xf4\x6f\xfe\x24\x20\xf1\x1e\xc9\xf3\xcf\x6b\x4d\x03\x99\x46\xed\x4c\xcb\xce\x02\x72\xb2\xe9\x92\xe0\x60\x29\x70\x7e\xa2\x1c\x7e\x2b\xf4\x71\x5e\xa2\x8c\x86\x67\xa9\xa4\xf9\xb6\x11\xe8\x91\x2f\x69\x8e\xe8\x9b\x58\xeb\x24\xfa\xf0\x64\x60\x74\x7e\xc7\x5d\x0a\x4d\xef\x8b\x0f\x02\xd3\xf3\x8d\x84\xe1\xd1\x06\xc3\xbf\xab\x04\x5c\x99\x00\xcf\x0b\x12\x7c\x68\x1f\xb0\x2d\xdf\xd4\xeb\xa6\x3b\xe7\xbc\x6f\x48\x22\xbe\xa4\xec\x1a\x32\xda\xf0\x74\x7e\xe7\x6d\xc8\x09\x19\x6d\x78\x4f\xa3\x39\xa7\xb7\x61\x91\x44\xd7\x2c\x03\x6c\x05\x9d\x89\x90\x4f\x00\xdc\x10\x32\x94\xf6\x0a\x42\x65\x18\xbc\x4f\x4a\x30\x79\x98\xf7\x59\x59\xe0\xf7\xa1\xf7\xf4\x29\x80\x12\xe3\x74\x64\x2e\x14\xd0\x4f\xf4\x31\x48\xbe\x32\x34\x48\xc8\x1e\xe4\x3e\x1b\x46\x01\xd7\x8c\x12\x5b\xea\xb1\xc2\xa3\x9e\x19\x05\xfc\x6e\x14\x65\x93\x0b\x8d\xf6\x7a\x83\xe6\xc2\x2f\xcc\x85\x87\xcd\x85\x93\xb2\xc0\xd7\xe0\xef\x98\x30\x18\x71\x86\x86\xc9\x45\x07\x8f\x0d\xca\x86\x49\x80\xca\x6f\xe2\xbb\xe8\x48\xe4\x0f\x37\xf2\x21\x72\xd1\xc1\x9f\xe0\x49\xe1\x43\xe3\xa2\x63\x10\x2f\xfc\xc5\xc2\x0b\xa3\x88\xe7\xb2\x56\x0a\x0a\x3f\x38\xb8\xa6\x97\xed\xb0\xf1\x02\x7f\x7e\xe7\x32\x55\xe1\x05\x71\x1f\x74\xba\x28\xf4\xa2\x42\xbf\x1a\x3a\xed\xcf\x4f\x2b\xa0\xfc\xe8\xe0\xe1\xa3\x5f\x3d\x9d\xf2\x67\xcd\x46\x5e\x96\x4f\x82\xe6\x45\xf0\x5d\xc1\xcb\x7c\xa2\xe0\x11\x17\x07\x1d\x4e\x62\xec\x9e\x17\x09\x28\x1f\x0b\xed\xd5\xcb\x45\xbb\x3e\xd6\xad\x4a\x6e\x38\x94\x37\xe7\x63\x47\x32\x65\xae\xb4\x9a\xfd\x6e\x94\x36\xf2\x3f\x76\xf0\x29\x61\xe6\x68\xc0\x37\x5a\x1b\xf9\x1f\x3b\x32\x09\xf8\xda\x68\xe0\xc4\x68\x60\x68\x28\x50\x4f\xc0\x6c\xbe\x83\x39\x06\x98\x3c\x06\x88\xe3\xcd\xfd\xd8\x31\x99\xda\xbe\x65\x0c\xb0\x81\x80\xbc\xd9\x9f\x38\xdc\x04\x7c\x6e\x8c\x58\x84\x25\xd7\xd7\x85\xa0\x7c\x2a\x0c\x63\x19\x0b\xfc\x7b\x0c\xd0\xeb\x7a\xd2\xee\x53\x07\x7f\xea\xb9\x6a\x2c\xb0\x64\xac\x78\xa2\xe9\x53\x07\x0f\x45\x8f\x8d\x05\x0e\x8e\x15\x1b\xa5\xad\x8e\xae\xa4\xf8\x7b\x63\x81\xb7\xc6\xd2\xda\xfc\x72\x63\x04\xdd\x3c\x99\x3b\x9d\x6d\xe7\xae\x75\x0e\x24\xde\x25\x31\xdd\x1f\x32\x6d\x12\x73\x5c\x92\x32\xc3\xb6\x73\xcf\x3a\x07\x30\xe2\x16\xea\xb4\xa7\x1c\x3c\x28\x2a\x8e\x29\x5a\x6f\x3f\xe5\xe8\xcd\x9f\x24\xad\xa1\x53\xf1\x88\x2b\x7f\x66\xfc\x29\x07\x9f\x1f\xfb\x85\xa0\xfc\xce\x21\xe6\xc5\x12\x28\x4f\x3b\xf8\xf5\x21\x74\xff\x37\x59\x71\xa9\xb0\x7e\xe6\x14\x19\x5e\xf4\x6b\x4c\x87\x2f\xd7\xe6\x95\xa5\xa2\x5d\x3d\xb2\x80\xae\x59\xc0\xd0\xfa\x61\xe5\x04\x4c\x25\x4b\xad\xca\x02\x2a\x05\x30\x40\xc0\xc1\x04\x5c\x3e\x0e\xb8\x72\x1c\x01\x87\x73\x4a\xee\x64\xee\x6c\xe0\x47\x01\xe4\x94\xb9\x04\x7c\x35\x1b\x38\x91\x0d\xc4\x6d\x8b\xe0\x15\x4d\x25\x60\x56\x0e\x30\x24\x07\x18\xc5\x1f\x6b\xa8\x16\x5d\x62\xd9\x89\x51\xfc\xa9\x86\x6a\x47\x92\x28\x3b\x47\x9d\x10\xe5\x34\x51\x8e\x4d\xe7\xbd\x15\x74\xc8\xc7\x20\xf6\xaf\x4b\xbe\x8a\x8a\xbc\x9f\xde\xca\x01\x8e\xe6\x00\xa9\xfc\x82\xcf\x95\x1a\xcd\xc1\x75\xa9\xbf\xb0\x71\x40\x77\x01\x78\x7c\x5d\xfc\x41\x3f\x50\xe3\x13\x66\xb0\xe0\x88\x15\x50\xb6\x3b\xba\xaf\xf4\x01\x09\xcf\xf9\x81\x87\xc6\x03\x0f\x8c\x07\x86\x9f\x94\x26\x93\xef\xdb\x47\xda\xab\x7e\xe0\xe3\xf1\xc0\x07\xe3\x81\xc4\xd7\x25\xf6\x3d\xbf\xb6\x56\x86\x9c\x55\x3f\x94\x18\x05\xe7\x48\x76\x8b\xa3\xcb\x3f\x7c\x72\x56\x4d\x9e\x20\x32\x35\x3e\xab\xea\x05\x9a\x55\xf3\x27\x00\x79\x13\x80\x1e\xc4\x63\x9a\x55\xbf\x93\xc2\xb4\x59\x55\x9f\x16\xf8\xdd\xc7\xe0\x8d\x79\xa7\x50\x6b\x8c\x4a\x6d\xbd\xdf\x11\x33\xb9\x50\x36\xe6\xca\x09\x40\xd5\x04\x60\x78\xa9\xa4\xe9\x62\x6e\xcc\xbd\x13\x80\x3d\x13\x80\x44\x7f\x61\xdb\xc6\x2c\xd7\x1b\x53\x5f\xa8\x35\xa6\x98\x64\x3f\xe0\x70\x3d\x59\x28\x1b\xf3\xfe\x04\xe0\x5d\xad\x31\x7a\x81\x1a\x63\xc9\x15\xef\xe1\xea\x41\x3c\xa6\xc6\x6c\x2c\xfc\xc9\xc6\x78\xee\x94\x8d\x3d\xb7\x08\x78\xb5\x50\x6c\x61\xd2\xda\x5d\x91\xbd\xc5\x1f\x1a\x49\xf7\x19\x44\xdf\x18\x44\x9e\x7c\x09\x7f\x7e\x11\x10\x3d\xd5\x60\x9e\x6b\x62\xf6\x5e\x6b\x2e\xdc\x26\x0b\xbd\x1f\xa2\x80\x6c\xc6\x3c\x65\x2e\x9c\x32\x91\x79\x6c\xb2\x09\x34\x7a\x6e\x9d\x0a\xb4\xbb\xa1\x80\x3c\x8e\xdf\x22\xf8\xf8\xba\x24\xa5\xd4\xb6\x73\x07\x0d\xef\x79\x22\x04\x78\xb2\x0a\x0d\xcd\x0f\x98\x34\xbc\xa1\xd0\xd4\xbc\xc7\x0a\x8d\x66\xbc\x6e\x22\x3a\x6e\x22\xf2\x9e\x31\x17\x58\xa1\xa9\x19\x5d\xa7\x9a\x30\xbd\xcc\x85\xcb\xa6\x9a\x9a\xb1\x7d\xaa\x51\xcb\xd8\xcb\x0d\x23\xbe\x62\x82\xcf\x30\xc1\xff\x2a\xe1\x94\xf7\xd7\x5f\xae\xed\x60\xe8\x93\x32\x2e\x37\xd0\x37\x6a\xe8\x97\xf5\x1c\xc0\x2e\xd1\x34\xcd\x26\xf6\x32\xd1\x72\xba\x43\x1a\x1d\x46\xdc\x40\x11\xab\xbf\x9c\xed\xc8\xfd\x52\xf8\x53\xad\xfd\x1d\xc9\x14\x02\x52\xf8\x13\xad\xfd\x1d\x83\x78\x81\x3f\xcd\xda\xdf\x31\x9a\x17\xf8\x93\xac\xfd\x1d\x05\xbc\xc0\xc3\x48\xba\x08\x2b\x29\x27\x44\x81\xc7\x94\x14\x1e\x70\xd2\x45\x40\x49\x79\xcf\xce\x0b\x3c\x53\x56\x83\x95\x74\x9e\xcd\x11\x3c\x85\x4e\x77\x14\xf0\x39\xf1\x3c\x51\x0d\x10\xf1\xa4\xdf\x55\x74\xca\xd7\x36\xfd\x78\x2c\x1a\x20\xc2\x0a\x7a\xff\x96\xea\x77\x38\x1c\xca\x33\x45\x80\xca\x71\x0e\x87\x6a\x48\x73\x38\xf8\xc3\x5c\x29\x8b\x2c\xbc\x30\x8a\x8b\x8e\xa5\x42\x84\x14\x5d\x01\xc5\xe9\xe0\x4f\x6c\xa3\xfb\x66\x42\x7c\x66\xe7\x66\xd0\x9e\x10\x78\x57\xda\x48\xd9\xfd\xc5\x34\x9a\xe2\xac\x9c\x44\xa5\x79\x21\x23\x17\xe8\x4b\xcb\x2b\xfe\xb2\x9f\xcf\xec\x19\x34\x53\xdf\x92\x0b\x6c\x27\xe0\x18\x21\x6c\x2c\x01\x7f\xc8\x05\xbe\xce\xa5\x18\x4d\x1a\x7e\x66\xcf\xa7\x1a\x56\xe6\x01\xcb\x68\x91\xc1\xdf\x08\xf4\xb9\x9d\x3f\x0a\xc7\xf2\x80\x0b\x79\x22\x69\xfe\xa7\xdd\xa5\x05\x4f\x5a\x49\x7c\x61\xe7\xa9\x40\xce\x44\x60\xf4\x44\xc0\x33\x21\xdf\xf0\xb2\xcd\xd3\x8c\xac\x71\x46\xbe\xe1\x4b\xf7\x48\xf8\x35\xfa\xc3\xfc\x7b\x24\x96\xd2\xbc\xe7\xa6\x69\xbb\x87\x2f\x6b\x1e\xf5\xe7\x7c\xc3\x4b\x3e\xd0\xd0\x07\x35\xb4\xf7\xdd\x7c\xc3\xa3\xd0\xf3\x25\xea\xe3\xbb\x6c\x8e\x83\xf2\x55\x16\xcf\xc6\x68\xb6\x7a\x7d\xae\xf6\x16\x93\xbb\x6c\x3c\xc7\xb9\x7e\x22\xb0\x65\xa2\xf6\x16\x93\xbb\x6c\xa9\xb3\x1c\xc0\xab\x13\x81\xa3\x13\x81\x64\x7f\xc3\x52\x28\x7b\x6c\xfc\xf5\x2d\xf7\xe5\x03\xf3\xf2\xc5\xe2\x62\x8f\x8d\xbf\x1a\xe2\x9e\xc9\xc0\xce\xc9\xe2\x51\xc0\xbb\x6d\xdc\x28\x0d\x53\x80\xea\x29\x40\xaf\x5f\x51\x87\xdc\x6d\xe3\x0f\x2f\x9e\x9f\x02\x7c\x44\x40\xaf\x00\xf2\x9a\x5b\x7d\xc0\x9b\x3e\xa0\xd7\x2b\xfc\x8d\x43\xb6\xde\x04\xfc\xb8\x10\x78\xb4\x10\xe8\x35\xd8\xc1\x81\x3c\xdf\x4a\x2e\x02\x7e\xb8\x1c\xe8\x55\xe0\xe4\x40\x3e\xc9\x6f\x9d\x06\x2c\x9f\x06\xf4\x7a\x48\xb0\x8f\x21\xe0\xa2\x2b\x80\xa2\x2b\x80\x61\x4f\xd0\xc0\xbb\x4f\xb4\xda\xa2\x9f\x69\xaf\x4c\x55\x92\xed\x3d\xc7\x4f\x07\x12\x4e\x2e\xa7\x35\x07\x90\x3e\x1d\x48\xfc\xa5\xa4\x1a\xf4\x10\x8c\x38\x9c\x05\x78\x5e\x96\x66\xac\x9a\x07\x2c\x9c\x2e\x6d\x7f\xa5\xbe\xbb\x7e\xda\x84\x5e\xa3\xa1\xd7\xe9\xe8\xaf\x4d\xe8\x5f\x6a\xe8\x9d\x3a\xda\xb1\xc7\x40\x3f\xa5\xa1\x77\xe9\xe8\x21\x12\x4d\x59\xfb\xeb\x02\x6d\x8d\x3c\x65\x87\x67\x82\x09\x71\x56\x20\x2c\xce\x23\xf0\x44\xdd\x6d\xc0\xad\xc5\xd0\xef\x31\x18\x27\xe1\x5f\xec\x03\x52\x8b\x65\x3d\xbb\xf5\x7a\x0a\x4d\x6c\x63\x05\x5a\xf1\xc1\xb3\xd5\x04\x9e\xa1\x49\x2b\x86\x67\xd1\x3d\x02\x3e\xfa\x21\xa0\x9e\xc3\x4b\x5c\xd6\x1d\xfa\x1d\x58\x07\x24\xfa\x64\x0d\xb0\x55\xb2\x85\xd6\xc1\xfb\xb6\x84\x3b\x1b\x29\x86\xde\x6b\xc8\x78\xbc\xa3\x8c\x0b\x12\x9d\x19\x09\xbc\xae\x29\xac\xbd\x9c\x09\x9e\xa4\xfb\x0c\xee\xaf\x3a\x72\x17\x9a\xd0\x5d\x67\x74\x40\x6f\x30\xa1\x33\x3b\xa0\xbd\x7f\x96\xe8\xe5\xb5\x80\xe7\x7d\x59\x28\x78\x08\x28\x99\x21\x4c\x93\x09\xef\x0f\x12\x9c\x4e\x63\xdb\x76\xbf\x41\xd3\xa4\xd3\x78\xc6\xde\x6f\x18\xfd\xb6\x19\xda\xe6\x92\xbe\x6b\x77\x8d\x44\xfb\xf6\x01\x4f\x68\x5a\xe8\xb7\x49\x78\x6f\x92\x68\xbe\x26\xba\xdb\x24\xca\xfb\xee\xfd\x26\xfd\x3e\x32\xd5\xfd\xcd\x0c\x6d\x61\xe7\xe9\xf6\x80\x21\xbc\xfb\x4c\x29\x7c\xac\x56\xb7\xf7\x31\x89\x1e\x7f\x15\xad\xfb\x64\xe1\xba\x4a\xc0\x33\x67\xaf\x28\x5c\xbd\x14\x98\x3a\x53\x74\xde\x3a\xed\x16\xdf\x67\x1e\x34\xd4\x08\xce\xec\xb0\xf5\x79\xca\x84\xde\x3a\xb3\xc3\xd6\xe7\xe7\x12\x1d\x08\x00\xcf\xcc\xbc\xd4\xa6\xa0\x5c\x05\x18\xdb\x82\xdf\x9b\xa4\xfe\x75\x66\x87\xcd\x4f\xe7\x3e\x03\xfd\xc3\xcc\x0e\x9b\x9f\xfd\xf7\x19\x95\x0e\x9e\x85\x8e\xdb\x9e\xa1\x36\x7b\x90\x23\x4c\xd2\x2e\x9f\xd5\x61\xdb\x73\xa2\x09\x7d\xe5\xac\x8e\xdb\x9e\xa6\xca\x6e\xd7\x2a\x2b\xba\x64\x65\xcb\x4c\xd2\x0e\xcf\xea\xb8\xe1\x69\x42\xbf\xd5\x11\x7d\xa7\xa9\x32\x47\x09\x2e\x71\xcb\x9d\xbe\xd5\xb9\xcf\x24\xad\x6f\x49\x87\xad\xce\xdf\x9a\xd0\x05\x25\xed\xb7\x3a\x3d\xef\x99\x2a\x0b\x76\x5e\x19\x3c\xb4\x9a\xd3\x7c\x67\x4b\x89\xd9\x77\x86\xc1\x5b\xf7\x90\xc9\x85\xbf\x95\x85\xc9\x35\x80\xc7\xb2\x5f\x14\x26\x56\x03\x4f\x95\xc8\x65\xe2\x5e\xca\x11\x63\x24\x86\xc6\xc0\x1b\x6d\x30\x4f\xef\x37\xa4\x7d\x5d\xd2\xc1\x13\x5f\x36\xa1\xbb\xcd\xee\x70\xd5\xe6\x1f\xfb\x8d\xd6\x14\xce\xbe\xd4\xf5\x8c\x0e\x9e\xf8\x9d\x49\x6a\xf5\xec\x0e\xce\x61\x7f\xd8\x40\x5f\x37\xbb\xc3\x75\x9b\xb4\x87\x8d\x4a\x9f\x98\x8d\x8e\x97\x4f\xda\x3a\x47\xa6\x49\xda\x9f\x67\x77\x70\xfb\x09\x26\xf4\x37\xb3\x3b\xb8\xfd\x22\x53\x65\x7d\xe6\xfc\xc7\xca\xae\x34\x49\x9b\x38\xa7\x83\xab\xad\x34\xa1\xfd\x1d\xd1\x77\x9a\x2a\xdb\x39\xe7\x52\x9e\x68\x5c\xa5\xd9\x67\x12\x77\x70\x4e\x47\x57\x34\xa1\x5f\xed\x80\xf6\x4e\x3c\x20\x4e\x78\xec\x2a\x36\x15\x3c\x75\x07\x0c\x3d\xe2\xe7\x42\xec\x29\x1c\xa4\x3e\x9c\xc9\xdd\xc6\xd4\x62\xef\xbb\x66\x29\xad\x66\x29\xae\x47\x0c\x29\x8b\x35\x29\x87\xdb\x48\xb1\xef\x3c\xb8\xee\x16\x9b\x94\xe4\x79\xc4\xf0\x65\xef\x98\x47\x0c\xdd\x3d\xf3\x64\x81\xd2\xb6\x5d\x73\x65\x43\x7a\xea\x5d\x18\x30\xa1\x9f\xd4\xd0\x76\x0d\x9d\x78\xb5\x09\xcd\x51\xe3\xf4\x77\xbd\xf5\xfc\x15\xe5\xb6\x9b\x6d\x8e\xcc\x89\x02\xa0\x61\x94\xdd\xfd\x4a\x81\xa1\x35\x84\xe4\xef\x58\x98\x37\x1d\x98\x36\x5d\x24\x69\x5b\x44\x92\xf6\xd1\x74\xe0\xf4\x74\xc0\xbb\x44\x32\xcf\xa0\xc1\xd8\x3d\x86\x32\xf3\x26\x1b\x4f\xb3\x0f\xe6\x09\x4c\x83\x2e\xf6\x20\x89\x2d\x27\x82\x5e\x94\x64\x67\x16\x03\xfd\x8a\x45\x4d\x4d\x36\xbe\xe7\xfe\x48\x31\x70\x5f\xb1\xc8\x10\x57\xda\xf8\xfe\xcb\xc0\x19\x40\xda\x0c\x51\xf9\x2a\x51\xf9\xda\x19\x40\x68\x06\x90\x78\x41\x56\xd1\x7f\x5e\xdb\x0d\x72\xa0\xfb\xbd\x91\x80\x52\x27\x34\xc9\x95\x64\xf5\xba\x26\xc4\x40\x9a\xd4\xd9\xbc\xa4\xc9\x0f\x33\x80\xaf\x67\x08\x4d\xea\x6c\xfc\xad\x11\x6b\x66\x02\x0d\x33\x45\xb5\xf5\xa2\xda\x7f\xcd\x04\xbe\x9c\x09\x24\x6e\x6b\x57\xad\xfe\x88\x0b\x46\x58\x69\x6d\x53\x29\xaa\x55\x28\x21\x53\xcb\xa9\x9c\xa4\x6d\x36\x55\xda\xf8\x36\xbb\x1a\xa2\xd3\xa1\x04\xed\x17\x84\x12\x10\x15\xa0\xfb\x70\x4a\x4f\x07\xdb\xf8\xbb\x4d\x63\x27\x88\x5a\x9e\xd0\xb7\xf0\x7b\x2e\x04\x86\x86\x88\x60\x18\x9d\xd2\xd4\x92\x37\x0b\x48\x6e\x82\x92\x61\xe3\xef\xfd\x3c\x32\x0b\x78\x7a\x96\xd0\x7a\x88\x10\x7a\x7e\x16\xf0\xdd\x2c\x71\xc5\x62\xa8\xad\xfb\x24\x00\xd9\x25\x40\xcf\x12\x20\x3e\x95\xa4\xc8\xb7\x7b\x5b\x50\x4c\x69\xf0\x22\x5b\x97\xa8\xf9\x40\xc2\xb8\x85\xc0\x1d\xb3\x81\x5d\xb3\x81\x84\xcc\x85\xc0\xb1\xd9\xc0\x73\xb3\x01\x0c\x23\xae\xf5\x52\xb9\x08\xfd\x4c\xfb\x29\x3e\x5b\xd7\x69\x9a\x84\x33\xb3\x81\x4f\x35\x09\x31\x73\x80\x2e\x73\xda\x4b\x88\xd2\xcf\xb4\xcf\x03\x28\x85\xb6\xae\x37\x6a\x12\x2e\x9b\x03\x0c\x9f\x23\x25\x2c\x98\x03\xcc\xd3\x25\x6c\x9e\xa0\x69\xbe\x59\x97\x25\x25\x4c\xb5\x75\x7d\x4d\x93\xb0\x7e\x0e\x70\x8d\x26\xe1\xfe\x39\xc0\xbd\xba\x84\x5d\xba\x04\xed\x4c\xcf\xef\xa7\xd9\xe2\xbb\x2f\x90\x12\xfe\x38\x07\x78\x41\x93\xf0\xdd\x1c\xe0\x1b\x5d\xc2\x11\xc9\x67\xd3\xcf\x74\x09\xd3\x6d\xf1\xb3\x35\x09\x5e\x1a\x99\x73\xa5\x84\xc9\x73\x81\x82\xb9\x9a\x84\x73\xba\x0e\xda\x99\xf6\x59\x04\x25\xcf\xd6\xf5\x36\x4d\xc2\x55\x73\x81\xa0\x26\x61\xe7\x5c\xe0\x46\x5d\xc2\x77\xba\x84\xef\xda\x5b\x32\xdf\xd6\xf5\x1d\x4d\xc2\x13\x73\x81\xc7\x35\x09\x7f\x99\x0b\xbc\xa7\x4b\xb8\xa0\x4b\xd0\xce\x92\x35\x09\x93\x6c\x5d\xbd\x65\x52\x82\xa5\x14\x40\xa9\x94\x90\x5e\x2a\x5e\x2f\x24\x24\x24\x4a\x2f\x72\xea\x67\xda\x47\x13\x94\x39\xb6\xae\x0b\x35\x09\xd3\x4a\x81\xcb\x35\x09\xa1\x52\xe0\x6a\x92\xc0\xfd\x30\x33\x57\xb3\xe4\x7a\xfe\x7e\x61\x5b\xfc\xcd\x1a\xd7\xbe\x52\xe0\x56\x8d\x6b\xcf\x3c\x60\xfb\x3c\x8d\x6b\xbd\xee\xbd\x99\xb4\x30\x5c\x60\xeb\xfa\x82\xc6\xd5\x30\x1f\xa8\x9d\x2f\xb9\x1e\x9f\x0f\x3c\x36\x9f\x02\x66\x2f\xd9\x42\x5a\x8f\x7c\x50\x26\xd7\x23\xb9\xf0\x3c\x25\x25\x51\xa4\xc4\x42\x19\x2d\x9b\xb4\xe5\x71\xe2\x71\x13\x9a\xa3\xc6\x68\x28\x38\x9c\x34\xa8\x7a\x2f\xe7\x9b\x1f\x36\x87\x32\x65\x21\xa0\x36\xd1\x79\x2a\x8d\x49\xb5\x82\x4e\x07\x88\x01\x3e\x96\x16\xa5\xff\xb4\xf2\xf8\x50\x9f\x23\xf8\xd7\xea\x03\x9c\xd6\xf5\x34\xc0\xff\x69\xe5\x6f\xd2\xfb\x70\x3e\xf0\xd6\x7c\x6d\x6f\xe1\x9f\x56\xb1\x53\xbc\x00\xa8\x5e\x20\xc6\xf3\x17\x56\xbe\x6f\xd0\xa7\x0c\xe8\x5a\x06\xf4\xe2\x3b\x2b\xe7\xac\x7c\xdc\xff\xbb\x0c\xf8\x6b\x19\x30\xec\x3a\xbf\xb8\x49\x40\xfc\xb4\x33\xed\xdb\x1d\x4a\x4f\x5b\xfc\xc5\x85\x40\xc2\xbd\x7e\x20\x6d\x11\x90\xba\x08\x48\xb8\xdd\x0f\x14\x2e\x02\xa6\x2c\x82\x94\xf0\x77\xc9\xa7\xe8\x67\x11\x86\x84\x51\xa3\x16\x01\xa3\x49\x02\xe7\xac\x5f\x04\xd4\x12\x67\x3c\x71\xae\x1f\xaf\x3b\xb8\x02\x28\x49\xb6\xf8\x9a\x45\xb2\xbe\x5f\x2e\x02\x7e\xa1\xd5\xf7\xdd\x22\xe0\x1b\x9d\xeb\x88\xce\xa5\x92\x3b\xc4\xda\xe2\x7f\xad\x71\x0d\x5a\x0c\xf4\x5d\x2c\xb9\xb6\x2c\x06\x36\x2d\xa6\x29\x7d\x9b\xd4\xeb\x74\x39\x30\xc6\x25\xd9\xcf\x2d\xe2\x73\x2f\x7f\x77\x29\x46\xfc\x8a\xd4\xfd\x40\x58\x5f\x21\x14\xc5\xe1\x0f\xac\xa9\x3c\x3a\x73\x23\x7f\x60\x1d\x48\x46\x46\x77\x4a\xc8\x94\x1d\x82\xf4\xd4\x58\x39\xb4\xf5\x9d\x7e\xd2\x90\x3a\x6a\x87\x95\x5f\x46\xf8\xfd\x62\xe0\x37\x8b\xb5\x8e\xda\x61\xed\x4f\x32\xfa\x2e\x01\x92\x96\x88\x58\xbc\x53\xf4\xc9\xab\x4b\x80\x87\x97\x68\x1d\x75\x93\x00\x16\x94\x03\xfd\xca\x45\x87\xde\x2c\x3a\xf4\x57\x15\xc0\xba\x0a\x69\x89\x11\x59\x5a\xdf\xf1\x2b\x4b\xaf\x59\x93\xf6\x2f\x96\x96\x78\xa5\x12\x78\xb1\x52\x5a\xc2\x1d\x00\xa2\x03\x9a\xfd\x76\x67\x69\xfd\x55\x4c\x5c\x2f\x5b\x93\xfe\xae\x71\x8d\x0d\x00\x23\x02\x92\xeb\x96\x00\x70\x93\xce\x55\x36\x4e\xe3\xda\x41\x5c\xcf\x5a\x93\x12\x97\x48\xae\x17\x02\xc0\x51\x8d\x2b\xad\x0a\x48\xad\xd2\x7c\xe3\x48\xb6\x16\x76\xb4\x33\xdd\x37\xfe\x64\xed\x39\x73\x89\xd9\x37\x66\x57\x01\xb3\x88\xd3\x33\x38\xcb\xe8\xaf\x2b\x97\x68\x9b\x59\xf0\xec\x36\xc1\x37\x98\xe0\x17\x4d\xf0\x3b\x4c\xf0\x9e\xe3\x0c\xf8\x63\x26\x78\xb6\x09\xfe\xa2\x09\x5e\x66\x82\xff\xdd\x04\xdf\x6e\x82\xff\xdb\x04\xdf\x6f\x82\x77\xf3\x1b\xf0\xcf\x4c\xf0\x81\x26\x78\x72\xb6\x01\x9f\xe8\x37\x36\xef\x8a\x4c\xf0\xf9\x26\xfa\x1a\x13\xbc\xc1\x80\x27\xfe\xca\x04\x97\x32\x30\x62\x33\xa5\x35\xdb\xa5\x07\x1f\xf4\x8b\x40\xb3\xdd\xaa\x6a\x81\x66\xbb\x95\xef\x77\x61\xc4\x2f\x29\x13\xdb\x20\x09\xdf\xf3\x6b\x6f\x50\xdd\x60\xe5\x77\x8a\xf4\x6b\x82\xb2\xd1\x2a\x93\x8e\xa7\x48\x66\xad\x20\x8d\x95\xae\xde\xa4\x75\xe0\xee\x43\xe5\xda\x45\xad\x5a\x2b\xbf\x54\x7b\x7d\x15\xb0\xaa\x4a\xf8\x6b\x9d\xf0\xd7\xc9\x4b\x81\x51\x4b\x01\x4f\xef\xb1\x86\xce\xe3\x0d\xbd\x3d\x13\x4d\xf0\x39\x26\xf8\x54\x09\x2f\xad\x05\x6a\x25\xbc\x11\xf0\x5c\x69\xa2\xdf\x62\xa2\x5f\x69\xa2\xbf\xd3\xa0\x4f\xdc\x32\xb6\x13\x5b\x3d\x6e\x03\x94\x80\x34\x01\x61\xc4\xb5\xdd\x80\xb5\x3b\xdf\x45\x7e\x44\xa0\x7b\xf1\xf1\x3e\xa2\x84\x27\x1e\x56\xc7\xed\xfc\x7d\xb4\x15\x5a\x3c\x28\xb4\xf2\x0c\x38\xb5\x1b\x71\x4e\xb5\xf2\x8d\x6a\x97\x35\x53\xbe\xca\xbb\x5f\x05\x94\xcb\xa5\x19\x7b\xbe\x43\x23\x26\xdb\xea\xc8\x1c\x2d\x74\x59\xa8\x9b\x70\x77\x85\x16\x1a\xb2\xad\x71\x44\xfc\xd5\x52\xe0\x9f\x4b\xc5\xf5\xce\x1c\x2b\x4f\x73\x87\x55\x03\x83\xaa\x01\xef\x42\xc9\xce\x5f\x3f\x57\xf7\xff\x30\xf7\x26\xe0\x51\x15\x59\xff\xf0\xb9\x5d\x9c\xea\xdb\x49\x77\xa7\x3b\xb7\x3b\x24\x1d\x96\x10\xc2\x22\x42\x07\x22\x2a\x4b\x87\x45\x85\x91\x0e\xb8\x0e\xd2\x89\xdb\x8c\x48\x10\x41\x04\xd2\x41\x16\x49\xa0\x03\x88\x2b\x09\xb8\x8b\x01\x1d\x44\xc5\xa0\xb8\xa2\x0d\x0a\x8e\x5b\x50\x67\x5e\x67\xde\x19\xd4\x19\x1d\x75\x44\x47\xdf\x59\x54\xd4\x71\x1c\x7d\x67\xbe\xe7\x9c\xaa\x7b\xbb\xd3\x84\x99\xcc\xff\xf9\x7f\xdf\xf3\x29\xdd\x7d\xab\xea\xd4\xa9\x5f\x9d\x3a\x75\xea\xd4\x76\xa3\x03\x87\x77\x02\xdc\x34\xdb\xd9\x49\x2a\x5d\xa6\xe3\x79\x47\xbb\xbf\xa4\x06\x1c\x26\xa4\xf1\xf6\x6c\x65\xd2\x86\x89\x88\xed\x70\x0e\x13\x6a\x27\xfb\x7e\x93\x69\x78\xab\x93\x5c\xce\xe1\x36\xec\x26\x7e\xb1\xae\x90\x65\x27\x29\x96\x05\xce\x68\x74\xcd\xa5\x00\x95\xb3\x29\xd1\x22\xa9\x5c\x73\x19\xc0\xca\xcb\x00\x22\xeb\x34\xe5\x45\x0f\x01\x0c\xbc\xd4\x01\x35\xe4\x27\x59\xf1\xa5\x4f\xea\xc0\x0e\xaa\xc6\x5f\xb2\x52\x7e\xa8\x72\x18\xd5\x10\x19\x70\x72\x26\x7a\xbe\x13\x5d\x5c\xa9\xa3\x9d\xa1\xf6\x4a\x67\x3b\xa3\x4f\x3f\x1a\x0c\xfe\xe1\x92\xad\xea\xaf\x46\xc0\x1a\x47\xc8\x34\xd3\xaa\x3c\x97\x12\x07\xbe\x81\x00\x91\x79\x00\x85\xf3\x6c\xd5\xfd\x87\x6b\x38\x09\xb9\x65\x1e\xc0\xf2\x79\x4a\xee\xff\x74\xf1\xd6\xc0\xff\xce\x03\xf8\x9a\xa2\x16\x53\x54\x09\x45\x5d\x7a\x39\x40\xdd\xe5\x00\x91\x83\xba\x90\xef\x76\x02\x14\xce\xc9\x99\x62\x44\x7e\x7f\x62\xa6\x0d\x86\xcd\xc9\xb4\xcd\x1f\xb3\xe2\x23\x5f\x66\x05\xce\x9c\xe3\x28\xe7\x18\x71\x52\x26\x5e\x9d\x10\x24\x9f\xfc\xcf\x84\xf5\x3d\x97\x9c\x34\x5a\xa5\x4e\x71\xda\xa2\xff\x5c\x55\xbb\xf7\x5c\x65\x54\xbb\xf7\x2f\x07\xf8\xd5\xe5\x00\xa1\x8d\x1e\xce\xc1\x33\x89\xc6\xf9\x00\x73\xe7\xdb\x55\x7e\xcf\x55\x4d\x55\xee\xb7\x00\x20\xb0\xc0\x3e\xda\xf4\x9e\x2b\x4e\x91\xaf\x2c\x00\x78\x66\x81\xbd\xbd\xf1\xbe\xcb\x4f\xed\x7b\xf9\x15\x00\x97\x5c\x61\x6f\x6f\xbc\xef\x8a\x50\xe4\xaf\xae\x00\x78\xfd\x0a\x25\xb1\x0f\x94\xc4\x26\x2d\x04\x38\x69\x21\x40\xe4\xa1\xd1\x19\xf1\x8c\xaa\xef\xba\x71\x07\x91\xbd\x59\xa9\xd3\xea\xc1\xb9\xd9\xf6\xda\xe8\x4c\xcd\x7f\xec\xe4\x82\xd2\x5f\x65\xc5\x47\xbe\xd6\x81\xef\x1f\x04\x58\x5f\x6f\xcf\xb2\xa0\x14\xb5\x38\x49\x1c\xa5\xa1\xec\xc0\xc0\xac\x40\x24\x7e\x62\xa6\x0f\xbd\xa4\xb3\x9b\x43\x21\x72\xc5\x89\x19\xcd\x3a\x5c\xaf\xb5\xeb\x81\xd7\xb5\x0e\x45\x96\x67\x25\x8b\xb9\x3a\xb9\xd3\x4e\x2e\x5e\x77\x62\x8e\x5e\x82\xe3\x02\x5a\x6b\xa8\x1d\x3a\x5d\x6a\xe2\x7b\x82\x8a\xbc\xde\xd1\xcd\xc5\x73\x55\x4f\xea\x74\xf5\x27\xa1\x3e\xbe\x10\xe0\x91\x85\x00\xc5\xe3\x47\xe7\x70\x74\xb0\x80\xf5\x0f\xca\x79\xbf\xe2\x78\x40\xff\xd5\xed\x0f\x1d\x8e\xb1\xcb\x00\x42\x7c\x09\xec\x7e\x57\xd9\xb9\x12\xa0\xd7\x95\x00\x7f\x5a\x08\x10\xfa\x39\x6f\x2a\xbb\x4e\x20\x8a\xe2\x45\x00\x81\x45\x00\xa1\x6f\x25\x47\x56\x13\xb3\xe6\x45\x00\x57\x2d\x02\x28\xfd\xbd\xe6\xca\xab\x17\x7f\xcb\x0e\xf8\x4f\xc8\x0a\x94\x65\x07\xaa\xb2\x03\xa7\x65\x07\xce\xcb\x0e\x2c\xc9\x0a\x80\x34\x2f\x10\x00\x7d\xf8\x40\xdc\x0a\x97\x2c\xbb\x48\xa5\xd5\xbb\xec\xca\x1c\x9c\xa7\xbc\xa9\x15\x2e\x7e\xef\xf9\xc7\x8b\x00\x7e\xb7\x48\x1d\x10\x59\xe9\x62\x33\x75\xd1\x62\x80\xa9\x8b\x95\x2f\xb5\xd2\xc5\x3b\x42\xf3\x96\x00\x5c\xbc\x04\x60\x34\xef\x45\xad\x74\xf1\xe1\x9b\xc8\x0d\x9a\xf9\xee\x04\xc0\x2b\x97\x65\x16\xfb\xec\x57\x33\xdf\xa6\xd3\xf9\x0d\x12\xf7\xe9\xc0\x82\x2b\x00\xbe\x3d\x8a\x58\x2f\x57\xff\xd7\x45\x19\x55\x0c\xce\x73\x54\x31\xf2\xbd\x8e\xe7\x63\xc0\x59\xf1\x67\x5c\xac\xe2\x69\x88\x9a\x3c\x2f\x33\xa4\xed\xd2\xf1\x64\x10\x6b\xe7\xe9\xfe\x60\x42\xe4\x8f\x17\x67\x74\xf5\x2a\x45\x6f\x36\x3b\xef\x4c\x30\xf4\x09\x3f\x1a\x00\x6e\xd1\xb9\xf8\x25\x1e\xae\x66\x80\xc8\x65\x3f\xca\xf0\xdc\x35\xcf\xe9\x63\xc5\xeb\x7f\x94\xa3\x57\x8b\xec\x7d\x65\x38\xa1\x17\x9f\x00\x51\x7a\x65\x90\xf5\xa3\x01\xe3\x32\x17\x9f\x32\x2a\x4b\xd2\xe3\xc0\xcd\xfa\x58\xd2\x3c\x97\xfa\x4b\x0f\x27\x54\xd3\xd0\xf6\x63\x9d\xa7\xf4\x72\x95\xe7\xc7\xae\x3e\xd4\x5a\x60\xf5\xe5\x11\xc4\xc5\x47\x6c\x0e\x8c\x54\xc5\xdc\xeb\x58\x2e\x52\x01\x6a\x5c\xcb\x15\x22\x82\x3f\x2d\x01\xf8\xcd\x12\x80\xd0\x57\x6e\xce\xc5\xee\xc8\x53\x0d\x00\x3b\x1b\x00\xfa\x36\x82\x11\x52\x85\xce\x4c\x02\x4c\x4e\x02\xf4\x5d\x38\x67\x2e\x18\x61\xa5\x06\xc3\x1b\x01\x06\x93\x30\x0f\x8d\xcc\x08\x79\xc7\xe5\x19\x21\x4f\x1b\x95\xa9\xf8\x4b\x97\xeb\xca\x7f\xf2\x9a\xdd\xc1\x27\x54\x65\x92\x0f\xdb\xc9\x67\x39\xfd\x3f\x9e\x95\x2c\xe6\xeb\xe4\xd5\x4e\xf2\x79\x59\xc9\xe5\x76\xf2\x2a\xe7\x85\x76\x97\x54\xe5\x08\xbd\x38\xd3\x99\x1f\x20\x01\x1e\x34\x58\x44\x9f\x47\x55\xe4\xab\x8e\x88\xa6\x2e\x50\x22\x3a\x68\xf0\x3a\xd1\xce\x46\x80\x5b\x1b\xd5\x81\xcb\xd7\x0c\x96\xc6\xfa\xa5\x00\x4d\x4b\x95\x80\x5e\x33\xf8\x0c\xe6\x5f\x97\x02\xfc\x7e\xa9\x2d\xa0\xd7\x0d\x16\xd0\xcf\xaf\x02\x78\xf9\x2a\x80\xc8\xb7\xd1\x8c\xd6\xbe\x32\x3f\x63\x65\x5d\x95\x19\x6d\x8b\xf8\x2a\x33\x52\xfc\xd3\xfc\x8c\x14\x57\x56\x66\x6a\x92\xbf\xe0\x28\x29\x3e\x3c\x32\x93\x3c\x6c\xc1\x51\x72\x48\x8f\x3c\xb6\x1c\xea\xa8\xaf\xee\x51\x72\x58\xad\x21\x66\x56\xe5\x3a\xb5\x1c\xf6\x18\xec\xbe\xcc\x5a\x06\x30\x63\x99\x92\xc3\x33\x4a\x0e\xaf\x2e\x03\xe8\x5c\xa6\xe4\xf0\x8c\x92\xc3\xd7\xcb\x00\xfe\xbc\x0c\x20\x72\x6d\x56\x95\xd3\x0b\x32\x55\xde\x14\xcd\x54\x19\xfa\xb4\x93\xb2\xfe\xc4\x90\xdb\x46\xa8\xd8\xdf\x3a\xa5\x93\x15\xa8\x5c\x48\x89\xfc\x92\xda\x59\xcb\x01\xce\x5d\xae\x4c\xce\x76\x25\xdd\x9b\x97\x03\xb4\x2e\x57\x86\x69\xbb\xc1\x56\xe8\x67\xcb\x01\x5e\x5b\xae\xc6\xc7\xfb\x14\xc6\x23\xcb\x01\x3e\x5f\x4e\x16\x62\x44\x06\x50\xcd\x15\x19\xc7\x7e\xb0\x06\x64\x9f\x5b\xcb\xba\x88\xc4\xc7\x84\xfa\xbc\x49\x18\x5b\x0d\xb9\x6d\xb8\x22\xfc\xa7\x83\x71\xdc\x42\x3d\xef\x34\x78\xdd\x72\xe0\x0a\x80\xf0\x0a\x80\x4a\x7e\xeb\x40\xab\xc1\x2b\x97\xaf\xae\x00\x78\x6e\x05\x40\xe8\x77\xbd\x98\x0d\x1f\x5e\xfc\xd1\x4a\x80\x73\x56\x02\x44\xde\x1f\x9e\x91\xc6\xc7\x0a\x54\xd6\x2b\x5a\xbe\xd0\xa9\x03\x77\x02\xf4\x5a\x98\x6d\x6e\x68\xf4\xbd\x50\x57\x88\xcc\x4d\xe9\x5a\x1d\x30\xc9\xff\xde\x3a\x22\xa7\xcd\x33\x2e\xdb\x09\xef\x53\x6d\xd6\x1b\xca\x78\x90\xaa\xd1\x3c\x65\xbd\x51\xc8\xaf\x93\x3e\x61\x1b\xf5\x8c\x65\x3a\x75\xa9\x4e\x5d\x66\x94\x92\x53\x0e\x7d\x06\xf3\x1f\xe5\x30\x64\xe0\x38\xc5\x6c\xb4\x33\x03\xe7\x3d\xd3\x51\x3a\x7a\xfe\x43\x00\xfb\x17\xea\xa2\x6f\x7f\x49\x45\xea\x77\x19\x9c\xed\x32\xcf\x84\xc8\x19\x59\x94\x9f\xda\x94\x9f\x1c\x45\x79\x06\x44\xe6\x67\x51\x5a\x57\xda\x8b\x3d\x2f\xe7\x52\x8e\x85\xc8\x86\x2c\xca\x6a\x9b\x72\xd9\x51\x94\x27\x43\xe4\xbe\x2c\xca\xb9\x36\xe5\xee\xa3\x28\xc7\x43\xe4\xa7\x59\x94\x6d\x36\xe5\xe7\xdd\x95\xfe\x5e\x16\xe5\x5e\x9b\x72\xe4\x2b\xdd\x50\x7e\x9f\x45\xf9\x91\x4d\xb9\xe8\x28\xca\x71\x10\x29\x19\x96\xa1\x2c\x58\x64\xe3\x3c\x8a\x72\x30\x44\x4e\xca\xa2\x1c\x6b\x53\xbe\x75\x14\xe5\x54\x88\x9c\x9b\x45\x39\xdb\xa6\x2c\xeb\xcc\xa5\xac\x81\xc8\xa2\x2c\xca\x1b\x6c\xca\xa9\x07\xba\x52\x1a\xa7\x43\xe4\xc6\x2c\xc2\xa7\x6c\xc2\x45\x47\xb1\x1c\x08\x91\x9d\x59\x94\xef\xdb\x94\xb7\x1f\x45\x19\x85\xc8\x2b\x59\x94\x79\x8b\x6d\xdf\xef\x28\xca\x72\x88\x1c\xce\xa2\x1c\x6d\x53\x7e\x7e\x14\xe5\x64\x88\x18\xc7\x67\x28\x2f\xb2\x29\xc7\x1c\xcc\xa5\x9c\x04\x91\x7e\x59\x94\x1b\x6c\xca\xd5\x47\x51\x4e\x86\xc8\xf8\x2c\xca\xc7\x1c\x9c\xdd\xf1\x4c\x64\x51\xfe\xd6\xa6\xbc\x38\x57\x9c\x13\x21\xd2\x98\x45\x88\x4b\x34\x61\xe0\xd5\x5c\x96\x23\x20\xb2\x29\x8b\xb2\xd2\xa6\x9c\x74\x14\xe5\x14\x88\x3c\x92\x45\x59\x6b\x53\x6e\x38\x8a\x72\x2a\x44\x5e\xcf\xa2\x6c\xb1\x29\xdf\x38\x8a\xf2\x44\x88\x7c\x9a\x45\xb9\xcb\xa6\x34\x5f\xeb\x46\x3b\xe5\xf0\x0c\xe5\x9b\x36\xe5\xc8\xa3\x28\x67\x40\x64\x60\x16\xa5\xd1\x60\x0b\xfe\x28\xca\x2a\x18\x73\x5a\x16\xa5\xba\xb3\x7a\x14\xd5\x08\x80\x3e\xcf\x92\xd1\x3d\xcb\x90\xad\x43\x55\xaa\xbe\x3b\x09\xc6\x16\x7e\xa1\xc6\xa3\x43\x33\x5c\x16\xd9\xe5\xc5\x72\x1b\x65\x2c\x44\x7e\x9e\x45\x78\xa7\x4d\xf8\xc0\x8b\xb9\x45\x0e\x82\xc8\x1f\xb3\x28\x5f\xb4\x29\xdf\x38\x8a\x72\x28\x8c\xf1\x1e\x97\x53\x05\x38\xca\x00\xde\x06\xd0\xa7\x8e\x8c\xee\x14\x43\x9e\xa5\x19\x4f\x70\xaa\x30\x2f\xa9\x5e\x79\x31\xd5\xe0\x57\x5e\xfc\x73\x25\xc0\x77\x34\xa8\x2c\xd4\x94\x37\x27\x01\x4e\x4c\x66\x96\x6b\x96\xea\x78\x7e\xff\x71\x26\xbe\xf8\x26\x1d\xef\x0c\x17\xce\x55\x6c\xe8\x3f\x2b\x0f\xc0\xa8\x36\xa4\x71\x53\x12\xa0\x2c\x41\xcf\x15\xf4\x38\xf8\x2c\x30\x26\xa8\x72\x41\x9a\xaf\x21\x40\x9f\xd9\x04\x74\x94\x21\x0f\x0c\x51\x79\x13\x0e\x50\x1a\x94\x2a\xcf\xa6\xc4\x01\x94\xa1\xfa\x6a\x80\x13\xaf\x56\xa3\x74\x95\xc1\xb3\xd8\xf6\xab\x01\xb6\xd0\xa0\xf6\xe5\x90\x0c\xc6\xd2\xef\xb3\x02\xc5\xbd\xb2\xd0\xdb\x8b\x4a\xd6\xf7\x82\x5c\x60\x83\xff\x68\xde\xea\xc1\x8a\xe0\x64\xc7\x93\xfb\x5a\x97\x5b\x6a\x44\x09\xf3\x6b\x57\x03\xbc\x70\xb5\x92\x59\x1f\x85\x7d\xe4\x2a\x80\x61\xab\xd4\xa6\x5d\x5f\x05\x65\xe5\x2a\x80\xe5\x14\xb5\x9c\xa2\x78\x09\x62\xc7\x2a\x80\xf6\x55\x00\x91\x36\x5d\x04\x0d\xd7\x1b\x1b\xf5\x4c\xf6\x00\x44\x26\x0d\xc9\xc4\xdf\xdf\x98\x99\xe1\xd6\x65\xc5\xef\xcf\xc4\x97\x5e\xaf\xe3\xf9\xe0\xc4\xed\x59\x81\xe2\x3d\x43\x72\xda\x62\x44\x66\xb5\xe5\xd7\x2e\x00\xa3\xb7\x21\xcf\xd2\x28\x06\x3b\x02\x26\x6a\x72\x44\x7a\x2b\x57\xcd\x6c\x02\x20\xdf\x66\xcc\x52\x5b\x24\xea\x04\x9c\x47\x94\x38\xba\x9d\x10\x5f\x1a\x33\x01\xc6\x5e\xd2\x38\x17\x8c\x42\x43\x06\x34\xe9\x68\x87\xe9\x53\x9a\x69\xa1\x51\x44\x4c\xeb\x9a\x00\x7e\xd8\x04\x10\x19\x97\xc5\xf4\xde\xa5\x9a\xb1\x3b\x87\x71\xf1\x69\x9a\xea\x34\xa5\xdd\xc6\x28\x80\x13\x62\x79\x00\xae\x79\x86\xc1\x87\x2d\x0f\x2d\x05\x18\xd0\xea\x56\x31\x3c\x75\x19\x47\x33\xcf\x45\xfa\xcf\x1b\x79\x9c\x56\x94\xcb\xf4\x4b\xc3\x1f\x6e\x02\xd8\xd1\xa4\x5f\x27\x5e\xdc\x0c\x90\xd7\x0c\x50\xf1\xc5\x3c\x80\x27\x9b\x01\x1e\x69\xb6\xff\x1c\x4a\x85\x2d\xb1\x49\x94\x7f\xbe\xcb\x3b\xf9\x2a\xfd\xf2\x13\x58\x0d\xf0\x8f\x66\x00\x18\x45\x84\xb7\x39\x84\xf6\x93\xfd\x27\xcd\x8d\x2b\x5c\xde\xa5\x76\xa6\xa1\xab\x01\x2a\x56\xdb\xaf\x56\x79\xd1\xc9\x44\x51\xc6\x12\x97\x77\xab\x4d\x78\xc9\x6a\x80\x8b\x1c\xc2\x4f\x1d\xc2\xdd\x44\xd8\xe8\xf2\x76\xda\x84\x5b\x56\x03\xdc\x4e\x84\x91\x7d\x9a\x88\x9c\xd2\xf7\xaf\xca\x74\xd5\x0f\xb2\xe2\xff\x76\x55\x66\x51\xaf\xff\x20\x15\xff\x1d\x79\xcf\xe3\x12\x5e\x80\xd5\x3a\x66\x84\xd3\x6e\xd3\x96\xe9\x17\x33\xbd\xb5\x1a\xe0\x97\xab\x01\xc6\xec\xd2\x34\x4b\x75\x5b\x8c\x53\x7b\x30\x33\x01\xc6\xbd\x9d\x0f\xb0\x5b\x27\x9f\xe3\xb0\x20\x2f\x9e\xdf\x12\x35\x7c\x0d\x40\xc5\x1a\xfd\xfe\xa8\xb6\x35\x00\xd7\xaf\xd1\x6f\x96\xfa\x70\x0d\xc0\xbb\x6b\xf4\x3b\xa7\x26\xa6\x00\x4e\x4e\x01\x44\x7e\xa3\x59\x3d\xbe\x13\xe0\xb5\x65\xb6\xa9\xd7\x67\xfc\xba\x5c\x9c\x2a\x31\xce\xe6\x65\xb6\x31\xae\x2e\x3a\xaa\x6f\xe7\x9e\x9d\x9d\x8b\x34\xea\x1c\x80\x71\x64\xef\xca\xb4\x15\x18\xe8\x60\x9d\xb4\x5c\xbf\xb7\xeb\xde\x14\x40\x7b\x4a\xbf\xb2\xeb\xdd\x14\xc0\x6f\x53\x00\xc5\xc7\xe9\x0c\x66\xa3\xc3\x9e\x24\xb7\x10\xe0\x2c\x3d\x84\x54\x3a\xac\xd6\x2f\x07\x18\x7f\xf9\x42\x00\x63\x66\x8c\x28\xe6\x6a\x0a\xfb\x2f\xd7\x17\x51\x9a\xab\x05\x00\xf8\xfd\xa5\xe3\xa8\x99\x57\x0f\xcf\x51\x9d\x2d\x0f\x12\x97\xe9\xcc\x05\xc6\xd1\x94\x7e\x91\x9e\x8a\x56\x39\x24\xbf\x5c\xae\xdf\x93\x7d\x5c\x0b\xc0\xa0\x16\x5b\xf7\x77\x6b\x0a\x67\xc3\x78\xcb\x71\x2b\xb4\xee\xcf\x6e\x01\xb8\xb8\x45\xeb\xfe\xb6\x16\x80\x2d\x2d\xfa\xc5\xf9\x63\x9e\xd4\xb9\xf4\x8b\xf3\xb3\xff\x3e\x20\x8c\x7b\xff\x12\x80\x56\xbd\x82\xfb\x3f\x0e\x5b\x9a\x90\x14\x1d\xb9\x04\xe0\xc3\x16\x80\xb7\x89\x53\xd1\x6c\x80\x18\x11\xef\xd1\xc4\x8b\xed\x3a\x13\xd9\xac\xb5\x00\x33\xd7\x02\x54\xe5\xa6\x56\x50\xbe\xa6\xb5\x00\x2b\xd6\x02\x44\x9e\xd3\xa9\xe5\xb3\x01\x1e\x5e\x91\x99\xf3\xbd\xaf\xe3\x6f\x50\x27\xce\xc6\x3d\x7e\x09\xc0\xea\x31\x2a\x2e\xb3\x64\xdc\xba\x12\xa0\xe8\xa5\x4b\x00\xf6\xad\x05\x78\x62\x2d\x40\xd1\x2f\x2f\x01\x18\xb8\x0e\xa0\x74\x9d\xee\xb1\x77\xe8\x4c\x99\x27\x67\x7f\x7f\xae\xe8\x3f\x74\xa5\xee\x5f\xeb\xd7\x01\xa4\xd6\x01\x40\x35\x95\xf4\x3e\x00\xa4\x56\x02\x9c\x4c\xec\x4e\xa6\x02\x98\xd7\x53\x0e\x2f\xfb\xc9\xb9\x1a\x39\x4f\xf4\x4f\xda\xbc\x0e\xac\x03\xd8\x47\xbc\x4a\x6d\x32\xbe\xde\x59\x7a\x47\x76\x68\xdc\xee\x1f\x03\xb4\xea\x5d\x8f\x12\xa7\x46\xbf\x59\xa9\x6f\xa9\x7c\xbf\x0e\xe0\xaf\xeb\xf4\xa5\x94\x69\xeb\x01\xa6\xac\x07\x28\xbe\x47\x67\xf8\x6d\x66\x5b\x0a\xc6\x5d\xf3\x63\x75\xf9\x8a\xfe\xeb\xef\x70\xea\x75\x35\xc0\xf8\x3b\x7e\x4c\x3a\x35\x7e\x3b\xfd\xfc\x10\xc6\xbd\x74\x11\xc0\xfb\x7a\x57\x6a\x83\x43\x49\x83\x5c\xd1\x9b\x17\x01\x5c\xb3\x1e\xa0\x79\x3d\x40\xd1\xef\x2f\x02\xf8\xcb\x7a\x80\x8f\xd7\x03\x54\x53\xa6\x03\x06\xc0\x4f\x6c\xb2\x33\xaf\x01\x98\x71\x8d\x26\x4b\x5e\x03\xb0\xe4\x1a\x2d\xa0\x1a\x67\x97\xda\x7e\x72\x84\xfd\xa9\x88\xdc\x72\xb5\xfd\xe6\xa9\x6b\x00\x6e\xbb\x86\x44\x52\x53\x9d\x2d\x20\x91\x15\x2a\xee\xad\x03\x6a\x03\x38\x6b\x61\x1a\xc6\xf1\xa9\x8b\x09\xb9\xa2\xfb\x9a\x2a\x3c\xf2\x42\xae\xf0\xf8\x0b\x55\x85\x87\xd4\x02\xbc\xa1\x37\xfe\xe7\x39\x94\x77\xac\x02\x28\x1a\x5b\x0b\xf0\xf2\x35\x00\xfb\xa8\x26\xa7\xd5\x02\x4c\xd9\x00\x30\x7e\x03\x40\xd1\x99\xb5\x00\x2f\x6e\x00\x48\x6f\x00\x18\x56\x57\x0b\x30\x81\xd8\xfc\x49\xb3\xb1\xab\xb8\x76\x15\xc0\xc9\x44\x7a\x32\x65\x3e\x99\xd8\x55\xe5\xd2\x54\x50\xee\x89\xd7\x02\x8c\xe5\x57\x84\x17\x7f\xa4\xd3\xc9\x46\x3b\xcd\xb7\x78\x3a\xc0\xfb\xfa\xec\xfe\x56\x07\x63\xac\x49\x5f\x1c\x6a\xbb\x16\xe0\xda\x6b\xf5\x95\xa2\xbf\x5e\xab\x5e\x49\xce\x97\x8d\xe2\xd7\x01\x9c\x72\x1d\x40\xc5\x8e\xe9\x00\xbb\xae\x03\x78\x90\x02\x8f\x4f\x07\xf8\xf4\x3a\x80\x8f\xae\x03\x28\xfd\x7b\xd6\x3d\x84\xc8\xe8\xd3\x54\xe0\x07\x17\x02\x44\x9a\xc0\xde\x07\x29\xbd\x5c\xc7\xef\x9f\x0e\x50\xbc\x42\x07\x5e\xba\xc8\x21\x50\x10\x57\x9f\x96\x2b\xf0\x0e\x1b\xe2\xa0\xeb\x01\xfa\x5f\xaf\x21\x5e\x75\x3d\xc0\xe2\xeb\x35\xc4\x83\xd7\x03\xbc\x70\x7d\xf6\x85\xa7\x31\x9b\x35\x1f\x7d\xe1\x29\xcb\xf6\x28\xfb\x7e\x36\xc0\xb8\xa7\x6b\x00\xb6\x69\xba\x94\xb3\xca\xfc\x17\x1a\x3b\x5e\xad\x01\x18\x74\x03\x40\xf8\x06\xd2\xc6\x1a\x80\xc3\x37\x00\x1c\xa2\xc0\xe1\x1a\x80\xeb\x6e\x04\x48\xdd\x08\x50\xf4\x45\x0d\xc0\x17\x37\x02\x7c\x4a\x81\x7f\xd4\x00\xcc\xbc\x09\xe0\xec\x9b\x00\x8a\xf2\xa7\x03\xdc\x72\x13\xc0\x8d\x37\x01\x8c\x2f\x9e\xce\xb7\xec\xc6\x0f\xe2\x5f\x73\x7c\x15\xff\x06\xc6\x4f\xe0\xdf\xe2\x61\x35\x04\x3b\x41\x5f\xb3\xa7\x03\x4c\x20\x5c\x13\xa7\x28\x3c\xbf\xd3\xde\xc6\xc3\x84\x8a\xe8\xff\x76\x13\xc0\x57\x54\x02\x31\x19\xbd\x11\x60\xe4\x46\x80\x22\xe2\xbc\x64\x23\xc0\x42\x0a\x50\x71\x0f\x6f\x04\xd8\xb9\x51\x03\xf9\x64\x23\xc0\xe1\x8d\x1a\x62\x79\x2b\x40\xbf\x56\x0d\xfe\x47\xad\x00\x17\xb4\xea\x6a\x6d\x69\x05\xb8\xbd\x55\x57\xf8\x9d\x56\x80\x5f\xb6\x6a\x51\x5c\xd4\x06\xf0\xc3\x36\x80\xaa\x5c\x58\x15\x04\xfd\xe3\x36\x80\x43\x6d\x00\x15\x54\x85\xbb\x36\x01\x6c\xde\x04\x30\x91\xaa\xe2\xdc\xfa\xdb\x30\x55\xd1\xbb\xe0\x47\xe4\x18\xff\x40\xf6\xbe\x72\xb5\x6d\x82\x36\x01\xfc\x6d\x13\x40\xd5\xe7\x9a\x77\x97\x5b\x7f\x27\x6d\x06\xa8\xda\x0c\x50\xfc\xdd\x94\xae\x36\x89\xdf\x64\xce\x17\xe5\xf2\x35\x6f\x03\x5a\x89\xf7\xe9\x32\xf8\xe1\x6a\xad\x18\x0b\x37\x03\x2c\xd8\xac\x55\xe6\xe6\xcd\x00\x9b\x36\x6b\x65\x4a\x6f\x06\x78\x7a\x33\x40\xef\xd6\xae\xb7\xfe\x06\x4e\xed\xa2\x37\xca\x1e\xf4\x6a\x2b\xbe\xb9\x97\x73\x57\x6e\xe6\x94\x8c\xee\x7e\xb7\xda\x59\x4d\x8f\x34\x4f\xc9\x98\x12\x6b\x0d\x38\xf7\xc7\x1e\xd0\xf1\xfc\x5a\xef\xe8\xd4\x4c\x57\x29\x1d\x9f\x1d\x38\x43\x07\xf8\x32\xd9\x8f\xb2\x53\x1a\xb2\x52\x22\x2f\x4d\xcd\x94\xb2\x3c\x53\x4a\xe4\x9d\xa9\x19\x54\x6d\x99\xf8\xe2\xbe\xfa\x66\x9f\xe3\xb7\xdf\xe1\xf8\xed\x10\xf9\x26\xab\xaf\xbe\xbc\x06\x9c\x77\x9d\x1d\x97\x25\xec\xd2\x31\x53\xb2\xb0\x94\x6b\x76\x7c\xfd\x67\xdc\xab\xa7\x01\x04\xa6\xab\x18\x7f\xd0\xee\x41\xed\xf7\xe9\x6b\xf9\x5f\x6c\x06\x78\x77\xb3\xbe\xb0\xff\xce\xcd\x00\x9d\x37\xeb\xab\xfc\xe9\x5b\x00\x3a\x6e\xd1\x97\xfc\x2f\xbe\x15\xe0\xdc\x5b\x01\x26\x06\xa6\x90\xca\x6c\x6f\x9e\xd8\x97\x1f\x1e\x68\x9e\x38\x6c\x8a\xba\x6f\x3a\xf1\xe4\x29\xea\x52\x69\xc5\x69\x53\x00\xbe\xba\x15\xe0\xf0\xad\x00\x15\x67\x4e\x01\xf8\x9f\xdb\x00\x3e\xb9\x0d\x60\x62\x9d\x26\x61\x27\xf7\x07\xd3\xed\x4a\xae\xa6\x99\x36\xb8\xfb\xee\x4a\xe9\x71\x21\x7c\x3b\x80\xef\x76\xdb\x1b\xbe\xd4\x21\xdc\x4d\x84\x2e\x77\xdf\x43\x36\x61\xcb\xed\x00\x2b\x89\x90\xdf\x58\xb0\x75\xba\xad\xc2\xf6\x93\x65\xdb\xa8\x3c\xb7\x7f\x43\x8b\xae\xcc\x91\xdb\x01\xfe\x74\xbb\xae\xe6\xa9\x77\x00\x4c\xbe\x43\x0b\x60\xde\x1d\x00\x73\xef\xd0\xa2\xb9\xe9\x0e\x80\x1b\xee\x00\xa8\xca\x65\xd6\x93\x37\x16\x3c\xa6\xf3\xd8\x6f\x2c\xb8\x5a\xb7\x36\xdf\x7f\xec\x68\xb6\x11\x1b\x33\x6c\xc4\xf6\x93\x83\xb8\xd0\xed\x1f\xb7\x56\x23\xde\x73\x07\xc0\xa3\x77\x68\xc4\xe6\x9d\x00\xf2\x4e\x8d\x38\x7a\x27\xc0\xf0\x3b\x35\xe2\x73\xef\x04\x38\xfb\x4e\x80\xaa\x5c\x66\x3d\x41\x1c\x9a\xd1\x23\xc4\xc5\x0e\xe2\xe2\x5c\xc4\x96\x3b\xfc\x27\x1b\xf1\xd5\x77\x02\x2c\xbd\x53\x23\xfe\xd9\x9d\x00\xaf\xd9\x88\x8f\xdc\x09\xf0\xb9\x8d\xb8\xf8\x2e\x80\xa2\xbb\x00\xaa\x72\x99\xf5\x04\xf1\xf1\xdd\x20\x3e\x9b\xb7\xfb\xce\x21\x25\xb3\x11\x7f\xe0\x20\xfe\x20\x17\x71\xc4\xed\x6f\x5f\xa7\x11\x4f\xbe\x0b\x60\xfc\x5d\x1a\xf1\xa6\xbb\x00\x5a\xef\xd2\x88\x9f\xbe\x0b\xe0\xa9\xbb\x34\xe2\x77\xef\x02\xf8\x2d\x21\xce\x65\xd6\x13\xc4\x5f\xf5\x4c\xc6\xdf\x3b\x88\xbf\xcf\x45\x5c\xea\x0e\x9f\xb5\x5e\x23\x96\x5b\x00\x60\x8b\x46\x3c\x6b\x0b\xc0\xcc\x2d\x1a\xf1\xd5\x5b\x00\x56\x6c\xd1\x88\xef\xdd\x02\xb0\x6d\x0b\x40\x55\x2e\xb3\x9e\x20\x2e\x38\xa3\x47\x32\x1e\x79\x86\x8d\xd8\x7e\x72\x10\xe7\xbb\xc3\xae\x6b\x34\xe2\xd7\xb6\x00\xbc\x64\x23\xee\x73\x37\x40\xe4\x6e\x8d\x78\xf2\xdd\x00\x13\xef\xd6\x88\xe7\xde\x0d\x30\xe7\x6e\x80\xaa\x5c\x66\x3d\x41\x7c\x6a\xcf\x10\x3f\xec\x20\x7e\x38\x17\xf1\x30\xb7\xff\x09\x1b\x71\xeb\xdd\x00\xd7\xdd\xad\x11\x7f\x70\x37\xc0\x7b\x36\x62\x77\x3b\x00\xb6\x6b\xc4\xc3\xdb\x01\x86\xb5\x03\x54\xe5\x32\xeb\x09\xe2\xe7\xbb\x41\xdc\x8d\x56\x1c\x74\x10\x1f\xcc\x45\x7c\xbc\x3b\x5c\xbf\x41\x23\x9e\xd9\x0e\x70\x66\xbb\x46\xbc\xa3\x1d\x60\x7b\xbb\x46\xfc\x5a\x3b\xc0\x41\x1b\xf1\x17\xed\x00\x9f\x11\xe2\x5c\x66\x3d\x41\xfc\x4e\xcf\x64\x0c\x67\x2a\x32\xe1\x3c\x39\x88\xcb\xdd\xfe\xe2\x6b\x35\xe2\xc8\x56\x80\xd0\x56\x8d\x78\xfe\x56\x80\x79\x5b\x35\xe2\xb6\xad\x00\x1b\xb7\x6a\xc4\x4f\x6d\x05\x78\x62\x2b\x4d\x85\xcf\xfc\xcf\x11\x5b\x67\xf6\x48\xc6\xa5\x67\xda\x32\x2e\xcd\x45\x3c\xd0\x1d\x7e\xd5\x46\xfc\xde\x56\x80\xb7\x6d\xc4\x23\xb7\x01\x44\xb7\x69\xc4\x33\xb7\x01\x9c\xbb\x4d\x23\x5e\xb9\x0d\x60\xf9\x36\x80\xaa\x5c\x66\x3d\x41\x1c\xed\x06\x71\x37\x32\x4e\x9c\x65\x23\xb6\x9f\x1c\xc4\xcf\xb8\xfd\x57\x5f\xa7\x11\x6f\xdf\x06\xd0\xbe\x4d\x23\xfe\x7a\x1b\xc0\x97\x36\xe2\xd2\x7b\x00\x4a\xee\xd1\x88\x27\xdd\x03\x30\xe1\x1e\x80\xaa\x5c\x66\x3d\x41\x3c\xff\xac\x1e\xc9\xb8\xc1\x41\xdc\x90\x8b\x38\xed\x0e\x47\xaf\xd7\x88\xe7\xdd\x03\x30\xfb\x1e\xdb\x19\xb9\x07\xe0\xe9\x7b\x34\xe2\xf7\xee\x01\x78\xd7\x46\x8c\xf7\x02\x88\x7b\x01\xaa\x72\x99\xf5\x04\xf1\xfa\x6e\x10\x77\x23\xe3\x07\xce\xb2\xf5\xf8\x81\x5c\xc4\x55\x6e\xff\xfb\x36\xe2\xe8\xbd\x00\x43\xef\xd5\x88\x9b\xef\x05\x58\x75\xaf\x46\x7c\xdf\xbd\x00\x3f\xb9\x57\x23\x7e\xf5\x5e\x80\x4e\x42\x9c\xcb\xac\x27\x88\xf7\xfe\x5b\x19\xb3\xcb\xf4\x2b\x4d\x06\xf0\x3e\xb9\x4c\x27\xba\x07\x79\x6e\xd0\x2e\xd3\x37\xf7\x02\xfc\xf9\x5e\x9b\xf0\xef\x0e\x61\x00\x01\x8c\x93\xdd\x83\xaa\x6c\xc2\x1b\x7e\x02\x90\xfa\x89\x2d\x83\xe8\xd9\x76\xab\xd9\x4f\x8e\x0c\x4e\x75\xfb\x69\xee\xc5\x32\x28\xd9\x0e\x60\x6d\xd7\x32\xb8\x7c\x3b\xc0\x65\xdb\xb5\x0c\x5a\xb7\x03\xdc\xb4\x5d\xcb\xe0\xc9\xed\x00\x8f\x6f\x07\xa8\xca\x65\xd6\x13\x19\x4c\x3e\xbb\x47\x32\xa8\x3b\xdb\xae\xda\x24\xaa\xda\x54\xf7\x20\xdf\x8d\xba\x6a\x87\xb7\x03\xbc\xb5\xdd\x26\x6c\x76\x08\x17\x11\xe1\xe9\xee\x41\x27\xd9\x84\xb7\xdf\x07\x70\xd3\x7d\xb6\x0c\x1e\x77\x64\xf0\x78\xae\x0c\x66\xb9\xfd\x9f\xdc\xa8\x65\x30\x68\x07\x40\xff\x1d\x5a\x06\x4b\x77\x00\x24\x77\x68\x19\x6c\xd9\x01\x70\xe7\x0e\x2d\x83\xe7\x77\x00\xec\xdf\x01\x50\x95\xcb\xac\x27\x32\x78\xf9\xdf\xca\xa0\x7a\xce\x14\x80\x6d\x08\xf0\xde\x4d\x00\x15\xf5\x53\x00\x3e\xd9\x01\x70\x78\x07\x40\x45\xc3\x14\x80\xf2\xfb\x01\xfa\xdd\xaf\x25\xf0\x9e\x23\x81\x37\x48\x02\x17\xb8\x07\x8d\xb9\xc9\x5e\x98\xbe\x1f\x20\x71\xbf\x2d\xaa\x5e\xf6\x3a\x2f\xdf\x6d\x31\x2e\x72\x0f\xfa\xb1\x4d\xf8\xcd\xfd\x00\x7f\xbe\x9f\x6f\x36\x6a\xa2\xcf\x76\x02\xa4\x6e\x62\x7c\xf9\x0d\xfa\xaf\x40\x5f\x0d\xe5\x50\x3a\x39\x8b\x20\x72\xa1\x0e\xac\x79\x08\xe0\xb1\x9b\x8e\x3a\x47\x61\x8c\x84\xc8\x62\x4d\x52\xb4\x13\xe0\xd5\x9b\x32\xc7\x2f\x96\x67\xc5\xdb\xab\x28\xdc\x50\x1f\x9f\x63\x37\x94\xfd\xe4\x34\xd4\x09\xee\x70\xdb\x46\xdd\x50\x6b\x1f\x00\x68\x7a\x40\x37\xd4\xaf\x1f\x00\xf8\xef\x07\x74\x43\xfd\xfd\x01\x80\xbf\x3d\xa0\x1b\xaa\xec\x41\x80\x7e\x0f\x02\x54\xe5\x32\xeb\x49\x43\x7d\x7b\x4e\x8f\x4c\x4c\xdf\x73\x6d\xc4\xf6\x93\x83\xf8\x0a\xb7\xff\xd4\x56\x8d\x78\xda\x83\x00\xa7\x3e\xa8\x11\xdf\xf9\x20\xc0\xed\x0f\x6a\xc4\x07\x1e\x04\x78\xee\x41\x8d\xf8\xa3\x07\x01\x3e\x24\xc4\xb9\xcc\x7a\x82\x78\xe4\xb9\x3d\xea\x5e\xd3\xce\xb5\x35\x61\x24\xa9\xc2\x22\xf7\xa0\x05\xad\x5a\x15\x42\x3b\x01\xf8\x1e\x36\x13\x2e\x72\x08\x7f\x44\x84\x4b\xdc\x83\x6e\xb1\x09\x3b\x77\x02\x3c\xb7\xd3\x96\x41\xed\x0f\x6d\x19\xd8\x4f\x8e\x0c\x4e\x73\x87\x7f\xd0\x66\x3b\x38\x0f\x01\x9c\xf9\x90\xed\xe0\x3c\x04\xb0\xfd\x21\xdb\xc1\x79\x08\xe0\xe0\x43\xb6\x83\xf3\x10\xc0\x67\x0f\x01\x54\xe5\x32\xeb\x89\x0c\x16\xfc\xb0\x47\xad\xd6\xea\x20\x6e\xcd\x45\xbc\xda\xed\xff\xd6\x46\x1c\xe9\x00\x08\x75\xd8\x0e\x4e\x07\xc0\xbc\x0e\xdb\xc1\xe9\x00\xd8\xd8\x61\x3b\x38\x1d\x00\x4f\x74\x00\x54\xe5\x32\xeb\xd1\x2b\xf2\xba\x41\xdc\x4d\xab\xed\xfb\xa1\xdd\x18\xad\xd4\x18\x2d\xee\x41\xe5\x9b\x74\x63\x7c\xd4\x01\xf0\x76\x87\x4d\xf8\xa1\x43\x78\x80\x08\xd7\xb9\x07\xd5\xd8\x84\x77\xec\x02\xd8\xb8\xcb\x96\xc1\x81\x99\x8a\x4c\x38\x4f\x8e\x0c\x36\xbb\xfd\xff\xbb\x49\xcb\x60\xf0\xc3\x00\x65\x0f\x6b\x19\x5c\xf5\x30\x40\xe3\xc3\x5a\x06\x77\x3f\x0c\x70\xd7\xc3\x5a\x06\x3f\x7d\x18\xe0\xc0\xc3\x00\x55\xb9\xcc\x7a\x22\x83\xff\x9e\xd9\x23\x19\xfc\x69\xa6\x5d\xb5\xcf\xa9\x6a\xb7\xba\x07\x0d\xde\xac\xab\xf6\xc5\xc3\x00\x1f\x3d\x6c\x13\x5a\xe7\xd9\x84\x65\x6e\x00\xe3\x76\xf7\xa0\x33\x6d\xc2\xfb\x1e\x01\xb8\xeb\x11\x5b\x06\xf3\x35\xa1\xe1\x3c\x39\x32\x48\xb8\xc3\xc6\xcd\x5a\x06\x55\xbb\x01\x86\xef\xd6\x32\x68\xd9\x0d\xb0\x66\xb7\x96\xc1\x03\xbb\x01\x76\xec\xd6\x32\xf8\xf9\x6e\x80\xd7\x77\x03\x54\xe5\x32\xeb\x89\x0c\x9a\xce\xeb\x91\xe6\x7e\x76\x9e\xad\xb9\x9f\xe5\x22\x5e\xe8\x0e\x3f\x6e\x23\xfe\x66\x37\xc0\x17\x36\xe2\xd3\x1f\x05\x98\xfa\xa8\x46\x7c\xc5\xa3\x00\xf3\x1f\xd5\x88\x37\x3f\x0a\xd0\xf6\x28\x40\x55\x2e\xb3\x9e\x20\x76\xcd\xea\x11\xe2\x6b\x66\xd9\x88\xed\xa7\x02\x1b\xf1\x4f\xdd\x85\x73\xec\x95\xaa\x7d\x8f\x02\x3c\xf5\xa8\x46\xec\x7b\x0c\x20\xff\x31\x8d\xb8\xea\x31\x80\x91\x8f\x69\xc4\xb3\x1e\x03\x98\xf9\x18\x40\x55\x2e\xb3\x9e\x20\xbe\xb5\x1b\xc4\xdd\x38\xba\xed\x0e\x62\xfb\xc9\x91\xf1\x0b\xee\x92\xde\xb7\x6a\xc4\xab\x1f\x03\x58\xf1\x98\x46\xfc\x8b\xc7\x00\xfe\xcb\x46\xfc\xd7\xc7\x00\xbe\xb2\x11\xf7\x79\x1c\x20\xf2\x38\x40\x55\x2e\xb3\x9e\x20\x7e\xb4\x67\x32\xfe\xe7\x2c\xbb\x2f\xff\x33\x17\xf1\x0e\xb7\xff\xa0\x8d\x78\xca\xe3\x00\x13\x1f\xd7\x88\x6f\x7d\x1c\xe0\xe6\xc7\x35\xe2\x7d\x8f\x03\xa4\x1f\xd7\x88\x3f\x78\x1c\xe0\x3d\x42\x9c\xcb\xac\x27\x88\x0b\x13\x3d\xea\xcb\x55\xf6\x49\x11\x38\x8b\xba\xe8\x83\xee\x41\x47\x6e\xd5\x5d\xd4\xfb\x04\x40\xaf\x27\x6c\xc2\x06\x87\x70\x35\x11\x3e\xe4\x1e\x54\x7a\x9b\x26\xbc\xfa\x09\x80\xa5\x4f\xd8\x32\x78\x47\x13\xf6\x72\x9e\x1c\x19\xac\x71\x87\x7f\x76\x9b\x96\xc1\xcf\x9e\x00\x78\xe5\x09\x2d\x83\x7e\x4f\x02\xf4\x79\x52\xcb\xe0\xd4\x27\x01\x26\x3f\xa9\x65\x30\xef\x49\x80\xb9\x4f\x02\x54\xe5\x32\xeb\x89\x0c\x3e\xeb\x46\x06\xdd\xb4\xda\x3d\xb5\xb6\x9e\xd9\x4f\x0e\xe2\x57\xdd\xfe\xe6\xdb\x35\xe2\x4d\x4f\x02\xdc\xf0\xa4\x46\xfc\xe1\x93\x00\x1f\xd8\x88\x3d\x4f\x01\xb8\x9f\xd2\x88\xa3\x4f\x01\x0c\x7f\x0a\xa0\x2a\x97\x59\x4f\x10\x3f\x51\xdb\xa3\x9e\xb1\xcf\x41\xbc\x2f\x17\xf1\x6b\xee\xf0\xa8\x3b\x34\xe2\x59\x4f\x01\x9c\xfd\x94\x46\xfc\xc0\x53\x00\x3b\x9e\xd2\x88\x7f\xf6\x14\xc0\x6b\x36\xe2\x2f\x9f\x02\xf8\x82\x10\xe7\x32\xeb\x09\xe2\xff\xea\x06\x71\x37\x32\xf6\xd7\x69\x7c\xce\x93\x83\xf8\x66\x77\xf8\x43\x1b\x71\x9f\x3d\x00\x45\x7b\x34\xe2\x2b\xf6\x00\xcc\xdf\xa3\x11\x6f\xda\x03\xd0\xba\x47\x23\x7e\x7a\x0f\xc0\x53\x7b\x00\xaa\x72\x99\xf5\x04\xf1\x80\xba\x1e\x21\x9e\xe2\x20\x9e\x92\x8b\xf8\x7e\x77\xf8\x96\x3b\x35\xe2\x0f\xf6\x00\xfc\xd6\x46\x5c\xf5\x34\xc0\xc8\xa7\x35\xe2\x59\x4f\x03\xcc\x7c\x5a\x23\x5e\xf5\x34\xc0\xca\xa7\x01\xaa\x72\x99\xf5\x04\x71\xa2\x67\x88\x1f\xa9\xb3\xb5\xe2\x91\x5c\xc4\xbf\x74\xfb\x7f\x70\x97\x46\xbc\xe3\x69\x80\x6d\x4f\x6b\xc4\xdf\x3c\x0d\xf0\xb5\x8d\xb8\xef\x33\x00\xa5\xcf\x68\xc4\xa7\x3c\x03\x30\xe9\x19\x80\xaa\x5c\x66\x3d\x41\xfc\xd3\x6e\x10\x77\xa3\xc7\xaf\x3a\x88\x5f\xcd\x45\xfc\xdf\xee\xf0\xb7\x36\xe2\xf9\xcf\x00\xcc\x79\x46\x23\xde\xf7\x0c\x40\xfa\x19\x8d\xf8\x83\x67\x00\xde\xb3\x11\xbb\xd3\x00\x98\x06\xa8\xca\x65\xd6\x13\xc4\xef\xf6\x4c\xc6\x7f\x38\xdf\x46\x6c\x3f\x39\x88\xdf\x71\xfb\x1f\xda\xa2\x11\x8f\x4c\x03\x0c\x4b\x6b\xc4\x6b\xd2\x00\xcd\x69\x8d\xf8\xfe\x34\xc0\x7d\x69\x8d\xf8\xf5\x34\xc0\xab\x84\x38\x97\x59\x4f\x10\xff\xfd\xfc\x1e\xc9\xd8\x75\x81\x8d\xd8\x7e\x72\x10\xbf\xeb\x0e\x5f\x78\xb7\x46\xfc\x75\x1a\xe0\x33\x1b\xf1\xd4\xbd\x00\xa7\xed\xd5\x88\xe7\xef\x05\x98\xb7\x57\x23\x6e\xdb\x0b\xb0\x71\x2f\x40\x55\x2e\xb3\x9e\x20\x0e\x5f\xf0\xef\x64\x1c\x26\xc4\x3f\xd0\x64\x26\xec\xa6\xa1\x06\xcd\xc0\xf7\x36\xca\xa7\xf6\x02\x3c\xb1\x57\xa3\x7c\x6f\x2f\xc0\xdb\x36\xca\xaa\x7d\x00\xc3\xf7\x69\x94\x6b\xf6\x01\x34\xef\x03\xe8\x4d\x0c\x7a\xd4\xc3\xba\x41\xf6\x43\xfe\x2b\x59\x67\xbb\x96\x36\x97\x18\xe7\xe8\x57\xb0\x12\xbc\x0b\x35\xad\x80\xf7\x09\xde\x57\x6e\xff\x96\x76\x0d\x6f\xe7\x3e\x80\xed\xfb\x34\xbc\xef\xf6\x01\x7c\xbb\x4f\xc3\xab\x78\x16\xa0\xdf\xb3\x1a\x5e\xc3\xb3\x00\x8b\x9f\x05\xe8\xfd\x7e\x0f\xe1\x5d\xf9\xaf\xe0\x65\x0f\xe9\x84\xef\x39\x07\x5f\xc0\x04\x30\xfe\xec\xf6\xc7\xb6\x6a\x7c\x5b\x9e\x05\xb8\xf5\x59\x8d\xef\xcf\xcf\x02\xfc\xf1\x59\x8d\xaf\xe8\x39\x80\xc0\x73\x1a\xdf\x9c\xe7\x00\x66\x3f\x07\xd0\x9b\x18\xf4\x04\xdf\x1b\xff\x09\xbe\xcf\x1d\x7c\x93\x08\xdf\xc7\x6e\xff\x3b\x36\xbe\x9b\x9e\x03\xd8\xf0\x9c\xdd\xbc\xcf\x01\xbc\xfb\x9c\xc6\x67\xee\x07\x70\xed\xb7\x9d\xcf\xfd\x00\x33\xf7\x03\xf4\x9e\xd4\x43\x7c\xae\x0b\x7b\x86\x2f\x72\x97\xde\x76\xdc\x30\x05\xa0\x64\x5b\x6e\xbf\xd2\xc4\x1e\xf1\xbb\xcc\x89\x4b\x5e\x65\xa9\xe5\x3b\x68\xdf\x66\x65\x3e\xef\xd8\x99\x3f\xe8\x36\xf3\xdb\x33\x32\x99\x37\x1f\x3b\xf3\xaa\x17\xba\xcb\x7c\xed\x19\x99\xcc\x6f\x1c\x3b\xf3\xe1\x6e\x4b\xde\x91\x95\xd9\x77\xcf\x31\x33\x6f\xec\xb6\xe4\x79\x67\x66\x32\xc7\x8f\x9d\xf9\xe3\x6e\x4b\xbe\x2e\x2b\xf3\x35\xc7\xce\xfc\x69\xb7\x99\x1f\xca\xca\xfc\xe2\xb1\x33\xff\xa9\xdb\xcc\xaf\x67\x65\x36\xee\x3d\x66\xe6\xcf\xba\xcd\xfc\xe7\xac\xcc\x13\x8e\x9d\xb9\xfe\xc5\xee\x32\xfb\xcf\xca\x64\x5e\x79\xec\xcc\x47\xba\x2d\xb9\x32\x2b\xf3\xd3\xc7\xce\xfc\x75\xb7\x99\xcf\xca\xca\xfc\xf5\xb1\x33\x7f\xd3\x6d\xe6\xfb\xed\x85\xb0\x87\x00\x46\xff\xe4\xa8\x35\xc9\x12\xe3\xec\x48\x13\x2c\xd5\x11\x4d\xf4\xcb\x81\xe1\x43\x0d\x28\xb2\x20\xf2\x41\x56\xf6\x99\x47\x67\x87\x48\x64\x66\x86\xe0\xe6\xff\x9c\x7f\x4d\x56\xf6\x27\xbb\xe3\x1f\x3f\x2f\x43\xf0\xd5\x7f\xce\xbf\x61\x56\x46\x76\xa3\xb6\x1f\x53\x76\xdf\x76\x2b\xbb\xc7\x12\x99\xb2\x17\x6d\x3f\x66\xd9\xb9\xa5\xda\x37\xd1\xee\xa8\xcd\x94\xbd\xeb\xd8\x65\xc7\x9f\xef\xa6\xec\xd2\xef\x75\x66\xfe\xd3\x70\xf7\xd7\x65\x38\x7d\x73\x6c\x4e\xde\xee\x38\x45\xae\xd4\xee\x06\xff\xfd\xbb\xfb\xf4\x49\x9b\xb3\x9c\xb7\x34\x6f\x38\x3f\xc3\xfb\xdc\xfb\x8e\xc9\xfb\xbb\x6e\x25\xd4\x79\x7e\x46\x42\xab\xee\xb3\x17\xa8\xf9\xcc\xf2\xff\x66\xce\x2c\x1b\x27\x43\xf1\xfd\x7a\xf0\x38\xfe\xa1\x2c\x2a\xf5\x62\x6c\x3e\xbb\xc4\xe7\x75\xca\xb4\xb9\xcf\x9c\xe5\x1d\x6f\x6f\x1a\x34\xed\x07\x58\xb1\x5f\x0f\x2a\x7b\xf7\x03\xec\xd9\xaf\x87\x9b\xbf\xed\x07\xf8\x6a\xbf\x1e\x88\x4e\x3c\x00\x30\xea\x40\x8f\x06\x97\x31\x83\xba\x0e\x2e\x47\x9f\xc3\xa3\xf1\x45\x9d\xa2\x5e\xe2\x05\x78\x43\xff\x95\x9b\x6b\x1d\x70\x69\x02\xb7\xda\x0b\xb0\xf4\x00\xc0\xc2\x03\x00\x45\x37\x7a\x01\x7e\x7f\x00\xe0\x2d\x0a\xdc\xe9\x05\xb8\xe0\x79\x80\x1f\x3e\x0f\x30\x89\x39\x98\x3a\xf6\xc5\xe7\x01\x9e\x7b\x1e\xe0\x64\xa2\x67\x0e\x7d\x7e\x0a\xe0\xff\x29\x40\xf1\x0c\x7d\x48\xd3\x39\x76\x0e\x00\xe3\xb6\xcc\x06\xf8\x91\x4e\x78\xc4\x29\x7e\xcb\xfd\x00\x45\x3b\x67\x03\x3c\xff\x53\x80\x27\x7f\x0a\x50\xf4\xe4\x6c\x80\x99\x2f\x00\x4c\x7b\x01\xa0\xe8\xc0\x6c\x80\x4f\x5f\x00\x78\xf7\x05\x80\x8a\x9f\xcd\x06\x68\x7e\x11\x60\xf1\x8b\x00\x13\x88\x1d\x5c\xae\xd8\xd8\xcb\x3f\x37\xdd\xaf\xb3\xf4\x7d\x09\xa0\xe4\x25\xcd\xac\xee\x25\x80\xf3\x5e\xd2\xc5\xdc\xf6\x12\xc0\xe6\x97\x00\xaa\x72\xf3\x0e\x23\xee\x63\x0a\x74\xec\x12\x6f\xb6\x38\x0b\xbe\x34\x7e\xa8\x3a\xc4\x97\xc6\xcc\xf2\x51\x7d\xec\xa3\x6a\xa5\xbb\x75\x7d\xde\x26\x30\xe3\x9e\x9e\x0a\x70\x40\x5f\xa3\x3d\xc7\xb9\xc5\x30\xe6\x41\x80\xa2\x57\xa7\x02\xfc\xe6\x25\x80\x37\x08\xc7\x9b\x53\x01\x6a\x5f\x06\x38\xf3\x65\x80\xa2\xdf\x53\xca\xcb\x00\x6f\xbc\x0c\x50\xf1\xd9\x54\x80\x73\x5f\x01\x38\xfb\x15\x80\x61\x7f\x9f\x0a\x30\x81\x78\x1e\xd2\x3c\xfd\xf6\xa1\xc0\x07\x75\xbe\x15\xaf\x00\x2c\x7d\x45\x73\x7c\xea\x15\x80\xc7\x5e\xd1\x65\x7d\xf5\x0a\xc0\x5f\x5e\x01\xa8\x3a\x2a\x2f\x15\x31\xb5\x13\x60\x52\x27\x40\x05\x15\xd1\xd6\x09\xb0\xb1\x13\x20\xf2\xa5\x26\xf5\xec\x04\x58\xfe\x00\xd7\xdf\x6c\x06\xdb\x10\x98\xfa\xe4\xf7\xe1\x9d\x00\x77\xab\x54\xd7\x2a\xc3\xb9\xa2\xcf\x67\xe4\xfa\x2f\xce\x70\x78\x52\xd3\x98\x3f\x82\xc8\x09\x59\xf1\xaf\xab\x78\xe3\x74\x88\x5c\x92\x15\x7d\xd8\x26\x3f\x0b\x4a\xf7\xe8\xf8\xd3\xfc\x00\x91\xe7\x16\x67\x44\x6c\xaa\x03\xb7\xc6\x48\x28\xfd\x85\x8e\xe6\xeb\x42\xef\x66\x05\x00\x8a\xf3\xb2\xfe\xa8\x8b\xbe\x81\xc2\x37\x26\xb6\xdd\xad\x7b\xb3\xa3\x7d\x4b\x1f\xd4\x37\x26\xd2\x9d\x00\x4f\x74\x02\x8c\x79\x42\xd3\xc8\x65\xd9\xb7\x06\xde\xce\x07\x38\x70\x54\xee\xcf\x1f\xd4\x97\x25\xbc\x07\x01\xe4\x41\x7d\x59\xe2\xa2\x83\x00\x89\x83\xfa\xb2\xc4\xee\x83\x00\x3b\x0f\xea\xcb\x12\xae\x57\x01\xbe\x3f\x08\x30\xe6\x57\x9a\xd5\xb4\x65\xff\xae\xd3\x4e\x1e\xe8\x06\xa0\x8f\xfb\xd7\xde\x53\xae\x00\x00\xfa\xe4\xaf\x36\x0a\x4e\xd9\xef\x02\xa0\x8f\xab\xd4\xe5\x3f\xe5\xc7\xbd\x00\xe8\xe3\x3a\xc1\xe5\x9b\x3c\x18\x01\xe8\x93\x7f\xb5\xff\x14\xe1\x02\xa0\x8f\xab\xaf\xcb\x3f\x79\x89\x0b\x80\x3e\xf9\x6b\xfc\x93\x07\xeb\x6b\x44\xee\xbf\xfa\x4f\x1d\x0e\x00\xf4\x99\xfc\x45\x2f\x00\xfa\xe4\x2f\xf7\x9f\x46\xbf\x7f\xe8\x05\xe0\x85\xd3\xa2\x02\xa0\x42\xd0\xe3\x29\x33\x0d\x00\xfa\xb8\x07\x0b\xff\x29\x27\x48\x00\xfa\xb8\xff\x68\x78\x4f\x29\xea\x05\x40\x1f\xf7\x70\xe1\x3d\xe5\xcf\x1e\x00\xfa\xb8\x06\xb9\xfc\xa7\xf0\x5b\xa8\xa8\x0a\x67\x18\xde\xc9\xb5\x2e\x00\xfa\xe4\xd5\x14\x4c\x5e\xdc\x0b\x80\x3e\x79\x2b\xad\xc9\x33\x5d\x00\xf4\xc9\x4b\x14\x9c\xf6\x67\x03\xe0\x43\x83\xca\x9b\xec\xcf\x03\xa0\x4f\xfe\x0e\xff\x64\xaf\x04\xa0\x4f\xfe\x06\xef\xe4\x16\x13\x80\x3e\xbe\xa4\x77\xf2\x4a\x00\xa0\x4f\xfe\xf5\xde\xc9\x6f\x9a\x00\xf4\xc9\xff\xc0\x3f\x79\x97\x07\x80\x3e\xae\xdd\xc1\x53\x6e\x14\x00\xf4\x71\x4f\x35\xbc\xa7\x14\x23\x00\x7d\x5c\xf5\x2e\xdf\x29\xa6\x1b\x80\x3e\xee\xff\x75\x79\x4f\x99\x27\x01\xe8\xe3\x2e\x70\x79\x4f\x6b\x34\x00\xe6\x33\x8c\xd3\x28\xee\x62\xc9\x88\xfe\x8a\x00\xf4\xf1\x8f\xf1\x82\x11\x34\x06\xf9\xad\x7c\xe1\x2f\xf5\xcf\xec\x1d\x34\x02\xbd\x01\x5c\x45\x20\xfc\xe3\xf2\xc7\xe7\x8f\xcd\x9f\x66\x01\x88\x0a\xc8\xcf\x9f\x90\x2f\xfc\x00\xbd\x0a\x20\x3f\x1f\x00\x75\x8c\x09\x20\x29\x86\xa8\xdc\x83\x81\x7e\x4c\xcb\x10\xfe\xfc\x7c\x95\xdb\xb0\x00\x3c\xbe\x2c\x66\x63\xf2\x01\xf2\x32\xdc\x01\xf2\x2d\x10\xfe\x58\x09\x80\xd7\x30\xa6\x59\x44\xef\x1b\x00\xd3\xac\xca\x7c\x00\xff\xe8\xac\x8c\x13\x4b\x88\xa8\x60\x00\x00\x04\xba\xc6\xbb\x8a\x01\x82\xa3\x41\x98\x5d\xa3\x0a\x7d\x59\x51\x54\xac\x15\x35\x26\x96\x70\x46\x1c\x9b\x3f\xa4\x64\x9a\x15\x34\x0a\xdd\x93\x8a\x6f\x3b\xa5\x84\x4a\x0d\x21\xd8\x69\xd3\x2c\x57\xef\x3d\xa7\xf4\x06\x08\xf7\x35\x46\x59\xe7\x18\x4f\x9f\x82\x33\x7b\x27\xf2\x6b\x71\x16\xd7\xa7\x08\x61\x94\x4d\xd1\x3b\xdf\x98\xd9\x1b\xa0\xf8\xda\x94\x61\x04\x8d\x51\x16\x40\xc9\x75\x29\x03\x5c\xc5\x6d\xa7\x14\xd3\xa4\x42\x47\x53\xb6\xd2\x6b\x53\x06\x28\x9a\x3e\x5d\x91\x74\xc1\xd1\x57\xd7\x24\x1b\x47\xbf\xd1\x47\x63\xeb\xdf\x95\x47\x19\x43\x2b\xc3\xac\xcc\x00\x03\xb2\xeb\x04\x50\xde\x85\x4d\x31\xc0\xc0\xa8\x71\x4c\x79\x54\x8c\xce\xd4\x72\x50\x36\x9d\x2a\x69\xf0\xe8\x2e\x25\x0d\x51\x95\xbb\xe5\x94\x12\xaa\xdf\xd0\x2e\x75\x28\x06\x38\x8e\xc4\x44\xd9\x86\x11\x57\x80\xe3\xf3\x0d\x2a\x8c\x62\x86\xe7\x1b\xf4\x33\x82\x1b\x1d\x01\xa2\xd9\x65\x75\x41\x54\x89\x47\x4b\x66\xa4\x92\x30\x97\x4b\x34\xa3\x9c\x16\xa3\xac\x99\x36\xab\x52\x05\x02\x9c\x90\xab\x04\xaa\x3a\xa3\x47\x77\x91\xd4\x89\xf9\x06\xc0\x49\x98\x23\xae\x93\x91\xc1\x8f\xc9\x2a\x13\x60\x2c\x33\x34\xbb\x45\x3c\x2e\x6a\x8c\xea\x2a\xd5\xf1\xac\x3b\xc5\x00\xb1\x7c\x9d\x04\x50\x4d\x8f\x4a\x3c\x13\xec\x58\x0a\x4c\x1c\xad\x69\x27\x29\x02\x80\xc9\x51\xa0\x6e\xb0\xd0\x9f\xe9\x3e\xa7\x74\x89\x42\x8a\x3a\x35\x13\x05\x70\x5a\xd7\x2c\x00\x50\x7d\x31\x80\x0b\x06\x1b\x00\x86\xf1\x9d\xdf\x07\x86\xc1\xef\x1f\xa3\x70\x65\x63\xc3\x92\xca\x79\x0b\x67\x2f\x68\xbc\x74\x0e\x70\x60\xc1\xbc\x4b\x2a\xe7\xce\x9e\x5d\xb9\x6c\xcc\x49\x17\x9f\x34\x7a\xc4\xa2\xd9\x23\x16\xcc\x5b\xd8\xb8\x6c\xc4\xdc\x85\x8d\x95\x63\xa2\x55\xd1\x51\x5d\xc9\x75\xa0\xf2\x92\x79\xc9\x86\xa3\x63\x2a\x93\xcb\x17\xcd\x69\x00\x98\xbb\x68\x51\x94\xff\xae\x65\x43\xf2\xd2\x05\xf3\x2e\x89\x5e\x46\x85\x37\x24\x2f\xbd\x74\x4e\x7d\xf4\x32\x70\x01\x30\x5d\xf4\x32\x10\x14\xbd\xa4\x71\x76\xf2\xe2\xa9\xd3\xa6\x4f\x89\x5e\x06\xbd\x00\x32\x4f\x0d\xc9\x4b\xe7\x5d\xa9\x33\x2f\x6f\xb8\x78\xce\x92\x25\x0b\xe6\x35\x24\x55\xb6\xe4\xbc\x2b\xe6\x5c\x9c\x54\x74\xf4\x6c\x97\xb1\x64\xde\xc2\xb9\xea\x39\x76\x49\xe3\xbc\x05\xc9\x11\xf3\x16\x4e\x50\x48\x28\xa5\x41\x25\xd5\x2f\xbc\xe2\xc7\xc9\xd9\x97\xa9\x00\xe0\x68\xf0\xb8\xb6\xe9\x0b\x7b\xe2\x9a\x62\x03\xd1\xc2\xd1\x32\x88\x15\x07\xb1\x4f\x47\x0c\xab\x12\xd8\x27\x86\x55\x31\x0c\x27\x10\xa5\x39\xc0\x42\x9f\x58\x40\x44\x21\x69\x44\x63\xe8\x91\x95\x58\x28\x0d\xf4\xd2\xa3\x59\x88\x5e\x69\xe0\xb0\x5f\x23\xc6\x12\x18\x16\x05\x06\xa2\x1c\x83\x21\x69\xc4\x10\x65\xc9\x2e\xf4\x48\x03\xf1\x23\xf4\x8c\x47\x94\xd5\xb5\x68\x48\x2b\x55\x16\x47\x94\x62\x61\x3b\x7a\xac\x37\x89\x92\x42\x9f\x2c\x30\xcb\xa4\x81\xa1\x67\x29\x70\x57\x53\xcc\x0a\x85\xd0\x94\x81\x09\xad\x29\x0c\x89\xdf\x1b\x4c\x73\x47\x53\xdc\x8e\x36\xcb\x28\xe2\xb0\x61\xa0\xc5\xf9\xd7\xa2\x4f\x1a\xe8\x4e\x21\xca\x5a\x9b\xe5\xcd\x59\x5c\x28\xfc\xa9\xd1\xda\x0d\x55\x5b\x86\xaa\x1d\x3d\x52\xfc\xd1\x30\xb8\x46\xa1\x69\x18\xa8\x26\x82\xd6\x4c\xa9\xed\x14\xfe\x93\x61\x50\x2d\xd0\x3b\x0d\x8d\xb9\x65\xe8\xed\x4f\xb9\x1a\xeb\x31\x4f\x65\xca\x81\x4f\x89\x1f\x18\x46\xf7\xa9\x66\x94\x92\x3f\x54\xc9\x71\xf4\xc8\xfd\x5d\x8b\xde\x9c\x29\x1a\xa5\x14\xb7\x85\xcd\x32\xcc\x97\x21\xc4\x24\x0e\x6b\xc7\x92\x38\x1a\xe7\x23\xca\x4b\x65\x2a\x81\x52\x82\xc7\x65\xdf\x1b\xed\x8d\x3e\xe1\x31\x28\xcb\x55\x06\xfa\x44\x7e\x19\x4a\xd1\x18\xa5\xa7\x24\x3d\x25\xb1\xaf\xf0\xc4\xd1\x27\x97\xca\x68\x52\xd6\x52\x1b\xd7\xa1\xef\x74\xc4\xa5\x65\xe8\xeb\x97\xc0\x12\xe2\x65\x5f\x3f\x16\xef\xcc\x63\xfd\x28\x91\x41\x1c\x5b\x83\x25\x0d\x88\xd5\x49\x69\xd6\x60\x5f\xf3\x7c\x8a\x1f\x2f\x0d\xec\xcb\x91\x66\x1c\x47\x51\x4e\xfb\xda\x77\x18\xd1\xb2\x42\x38\x4a\xfa\xe3\xe8\x99\x94\x60\xa1\x9b\x24\xf4\x4d\x32\x8d\x79\xd2\x35\x33\x84\xb8\x44\xba\x2a\x2c\xdd\x86\xbb\x38\xd5\x23\x6b\xb1\xb7\x34\xda\x31\x4c\x0a\xd4\x22\x0d\xf4\xc9\x8b\x28\x06\xc3\xed\x58\x30\x05\xfb\xc4\x11\xc7\xa3\x47\xd6\x49\x33\x2e\x6b\xb8\x15\x0b\x5b\xa6\xa1\x47\xd6\x28\xa5\xac\xc5\xc0\x2c\x0e\x91\x24\x8d\xaa\x24\x49\x78\x49\x99\x4c\x63\x05\x61\xb3\xaf\xc2\x8b\x00\xeb\x33\xe6\x4b\x03\x2b\x66\x62\xe1\x66\xf4\x13\xe5\x2e\x0b\xc3\x84\xa1\x06\xc3\x54\x46\x63\x6d\x1b\x16\xca\x55\x94\x66\x5c\x5c\x5f\x86\x43\xe4\x26\xce\x35\x44\x16\xa0\x67\x06\x57\x88\xa0\xbb\x1b\xd1\x6a\x40\x94\x8d\xac\x17\x61\x69\x96\x33\x55\x58\x16\xa0\x7b\x20\xc7\xbb\xa9\x82\x8d\xe8\xa6\x4e\xd0\x58\xdb\xc2\x15\x35\xb6\x95\xa1\x47\xf6\xc7\x3e\x6f\x93\x18\xfb\xc8\x20\x62\x8d\x34\xf7\xd2\xff\x68\xc8\x67\x11\x7f\x80\x85\xd2\x3c\x93\x12\x0b\xb3\x13\xff\xd3\xff\xd1\x90\x7b\x11\xa7\x61\x3f\x69\x3e\x4b\xcc\x02\xd2\x42\x73\x04\xa2\xac\x63\xe1\x99\xb1\x9c\xc7\x10\x86\x64\x00\xcd\x58\x1d\x06\xc4\xf2\x34\x1a\x22\x2f\x86\x61\x39\x92\xaa\xc4\xaa\xb8\x1a\xbd\x2b\xd0\xa8\xc5\x13\xe5\x3e\x62\x77\xa2\x0c\x62\xd0\x1c\x80\x66\x12\x51\xfe\x0f\x0b\xf4\xf8\x38\x96\x25\x30\x3f\x86\x65\x49\xc4\x5a\xec\x37\x87\xba\x7e\x0b\x0e\xe4\x56\x6e\x61\x92\x7e\x66\x82\x9f\xcb\x38\xae\x11\x87\xea\x5f\xa5\x01\x33\x70\x30\x09\xa8\xa5\x3e\xca\x1d\x64\x71\x02\x07\x50\x33\xd7\x50\x9b\xcc\xa5\x66\x4e\x62\x80\x7a\x56\x23\x37\xb2\x47\x8a\x3c\x33\xc6\x2c\x06\xa7\xd1\x98\x41\xb9\x7c\x52\x24\xcb\xb0\x54\xf8\x3b\x95\x12\x96\x4a\x3f\x16\x54\x61\xe9\x18\x2c\xb8\x1c\x4b\xa7\x62\x41\x05\x7f\x5f\x40\xb0\x0b\xea\xd1\x4c\xa0\x21\xfc\x51\xea\x73\x49\x33\x8a\x11\x46\xd3\x86\x41\x69\x60\xef\x24\x7a\xe2\x8c\xf5\x64\x69\xa0\x95\x40\x4c\xa0\x37\x81\xe1\x49\x18\x90\xed\xac\x2d\xd6\x21\xf4\xc9\x1a\xec\x2f\x0d\x0c\x24\xf1\x54\x70\xf5\x72\x4d\xc1\xd3\xe9\x27\x8e\xa8\x7e\x48\xa9\xfd\xca\x64\xf6\x06\x57\x2f\x83\x3a\xdf\x5c\x8e\xa1\x9c\x7e\xea\xfc\x41\xb9\x99\x6b\x1d\x94\xd4\x62\xe1\x44\x8c\x54\xc0\xb2\xd0\x90\x81\x32\x0c\x4c\x40\x0f\x09\xac\xcd\xe2\x1a\xbb\xc7\x63\x7e\x75\x0a\x3d\x72\x83\x85\x79\x32\x58\x8d\xf9\x62\x79\x5c\x2b\x7d\x6b\x02\x23\x54\x44\x8a\x8a\x20\xed\x0c\xac\xc1\xbc\x0b\x29\x75\x1a\x13\x63\xbe\x58\x41\xed\x4c\x16\x80\x7b\xa3\xac\x26\xb5\x95\xa3\xa8\x34\x2a\xae\xa0\x0c\x03\x13\xa9\xb8\xc2\x4d\xe8\x19\x47\xe9\x16\x5b\x2f\x33\x81\xf9\xdb\xd0\x23\xdb\x70\xa4\x34\x3a\xd1\x37\x09\x3d\xf2\x73\xf4\xca\x38\x06\xa4\x38\x21\xc1\x3d\xc1\x27\x37\x63\x80\x9b\xa4\x86\xf3\xd8\xbf\xf9\x62\x8a\xc2\x37\x92\x89\x0e\x9a\x21\x65\x50\x8a\x5a\xd0\x97\xc0\xa0\x14\x5e\xd7\x40\x83\x75\x30\x28\x37\x51\xaf\x9e\x86\x41\x59\x8d\x1e\x31\x98\x4c\xa3\xa5\xba\x77\xbe\x14\x53\xd8\x64\x57\x77\x41\x1e\x2b\x23\x06\xd3\x5b\x75\xf6\x6a\xf4\x89\x21\x09\x46\x11\x64\x2b\x4f\xb2\x6e\xe4\x90\x68\x4c\x65\x88\x22\x44\xd2\x99\xa4\xe8\x99\x4e\x5e\xd1\x2f\xe1\x3c\xd6\x67\x1e\x0b\x32\x8f\x2b\xec\xc7\x3a\x66\x1d\x94\xd5\x32\x2e\x45\xd0\x61\x2c\x2e\xcb\xd0\xe6\x39\x8f\x75\x4e\x5c\x7e\x67\x06\x02\xf5\xb4\x02\xf4\xc9\xff\x62\xb4\xf9\x62\x1a\xe9\x93\x28\x4c\xa1\x47\x5c\x91\x96\x69\x1c\xca\x16\x4b\xbf\x2e\x4c\xbc\x00\x06\x37\x91\x85\x43\xc9\xa2\x9a\x97\x63\x09\xab\x12\x7d\xc5\x30\x4c\x3f\x09\xcc\x5f\x6b\xb7\x50\x5a\xb5\xd0\x16\x56\x98\x80\x14\x63\x72\x5b\xa8\x56\x77\x9e\x4d\xe8\x95\x62\x7f\xb3\x81\x79\xe2\x79\x88\x67\x35\xd9\x73\xcd\x71\x9b\x19\xfa\xe4\x5e\x73\x24\x6b\xbb\x4f\xd6\xe6\x34\x9d\x69\x57\x69\x93\x53\xcb\xab\xe2\x59\xad\x31\xdc\x6e\x8d\x36\x1d\x29\x16\x1f\x76\x28\x2f\x4c\xfc\x1f\xca\x5e\x45\xf5\x5c\xf0\x5e\x15\x17\x96\xb5\x58\xcc\xbf\x95\x34\x48\xb6\x6a\xd1\x9f\xd6\x41\x8a\xf5\x22\xc4\x1c\xcd\x2a\x20\x0b\x54\x43\x68\x17\x25\xd0\x27\xf2\xd2\x18\x16\xe7\xa4\xed\xa6\xe9\x4f\x4d\x63\xbf\x46\x40\x1c\xe4\xa6\xb1\x3d\x8a\xfb\x9a\x62\xa1\x10\x16\x4b\xf1\xa6\x61\xa0\xcf\xac\x61\xa3\xc5\xe3\xff\xe9\x47\x25\xa3\x9c\xc1\x08\x8c\x16\x1c\x2c\x3f\x66\x17\x2b\x81\x53\xb8\xdd\x6d\xe6\x31\xe6\x4d\x46\xd3\x8c\xe3\x94\x8d\x88\xf2\x35\xe5\x5e\x15\x92\x45\xa8\xb1\x53\x50\x6e\xe2\x01\x30\x70\x54\x2c\x35\x7b\x30\x27\xb6\x4e\x59\xae\xa3\x62\xbb\xa7\xe5\xd1\xf7\xa8\xd8\x12\xb2\x8e\x2a\x96\xc7\x94\x59\x1c\xfb\x6f\x8b\x60\x66\x31\x96\x31\xab\xa6\xb8\xb4\x03\x0d\xd1\x37\x1a\x8d\x62\x40\xcc\x6e\xe7\x67\x2c\x97\x6f\x21\x5a\x32\x86\x01\xf3\x79\x34\x84\x2f\x81\x1e\x79\x3f\x49\x4a\x24\x8f\xb0\xc1\x3f\xa2\xb5\xf8\x73\x8e\x5c\x41\x91\xeb\x75\xd4\x42\x2c\x97\x62\xa1\x81\x1e\x51\x58\x86\x65\xd2\x2c\xe6\xd1\xb8\x4c\x16\xa0\xf9\x36\x96\x8d\xa5\x6f\xef\x65\x88\xb2\x93\xcb\xc7\x24\x1e\x47\x46\xda\xac\xcf\x18\xeb\x36\x2c\x92\x06\xfa\x63\xe8\xed\x60\x03\x4d\xe3\x40\x20\x8d\x3e\xb9\xb6\xa5\x0d\x31\xdc\x1b\xfd\x32\x74\x1e\xd7\xc0\x23\xc3\xe8\x1d\xcb\x55\xf4\x73\xeb\xd7\xb0\x8b\x49\x83\x87\x47\xd9\x4c\x94\x97\x61\xa9\x34\xb0\xa4\x9d\x53\xf9\xb1\x23\xfb\xd1\xa8\x89\x47\xa3\x71\x1e\x7b\x96\x74\xa0\xc7\x62\x06\x1e\x79\x90\x4b\xa5\xde\x18\x56\xbe\xe1\xf3\x62\x41\x5a\x92\xbd\xba\x00\x4f\x10\x45\x04\xd7\x0a\x59\x78\x82\xf4\xa3\x77\x37\xd5\xc2\xbd\x06\x7d\x54\x01\x29\x7c\xf5\xfc\x6b\xa8\x0a\x89\x64\x8c\x7e\x48\x82\xd5\x4e\xbd\x0e\x73\xbd\x54\xb7\x3c\x9f\x5b\xd2\x27\x6b\x5a\xda\x5a\x10\x7b\xb3\x13\x83\xfd\xd1\xa8\x8e\x91\xdb\x40\x66\xb3\x1d\xfb\x4a\x03\x8b\xa4\xf0\xd3\x28\x6f\x61\x91\x0c\x62\x5e\x0b\x16\x74\x90\xcb\x40\x10\x49\x6d\x4a\x92\x6a\x46\x70\x16\x0f\xea\x15\x31\x6a\xb6\x38\xfb\xc6\x66\xa1\xf2\xaa\xe4\x37\x2c\x71\x9f\x9c\xfb\x91\x2e\x7a\x2b\x1a\xf2\x2a\x0c\xfe\x00\x8d\x51\x18\x3c\x9e\x46\xed\xc5\xed\x58\x49\x1e\x25\x0d\xe1\xd5\x4a\x44\x29\x7e\x24\xdd\xee\xdd\x81\x81\x18\xfa\xa4\x79\xab\x6c\x95\xb5\x9a\x87\xab\xdc\x12\xcb\xcd\x28\x96\x88\x81\x3c\xd8\x63\x89\x0c\x60\xde\x65\x68\x92\x2a\x79\x18\xc1\xb2\x34\xf7\x4d\xb2\x79\x3e\xea\x39\x9e\x5a\xf4\xdd\x4d\xf2\xa8\x6d\xc3\x3e\xd2\xc0\xf2\x24\x96\xa8\x8a\x18\x32\x5c\x8f\xbd\xe5\x74\xe5\x35\xf4\x96\x7e\x34\x6f\x46\x94\x5f\xab\x11\x90\x5c\x9c\x15\x8d\xcc\x2b\x3f\x89\xde\x8b\xeb\x89\xf9\x8a\x24\xfa\x2c\xd5\x58\x6d\xda\xa8\xfa\xe4\x97\x2c\x69\x0f\xeb\x4e\x18\xbd\xa7\x63\xde\x04\x2c\x1c\xcb\x96\x40\x59\xdd\x09\x4c\x10\xa9\xc7\x90\xb8\x2a\x46\xdc\x50\x8a\xfc\x84\xf2\x6d\x0c\x8b\x1a\x4b\x2c\x8c\x61\x88\xe6\x19\x23\xd0\x27\xcf\x67\x9f\xc4\x10\x05\x49\x1c\x4e\x96\x5e\x5c\x9e\xc4\x7e\xf4\x90\xa4\xaf\xb4\x1a\xf2\x3b\xd0\x23\x5b\x58\x50\x86\x14\xc5\xd4\xdd\x68\x3c\x0f\xa2\x6f\x2f\x86\xa3\x98\x1f\xc5\x21\xca\xf7\x20\xb5\x30\xa4\xd8\x7a\x75\x82\x1e\x2c\xfa\x0a\xf1\x17\x9a\x9c\x12\xa0\xef\x09\xe8\x13\xbf\x13\xad\xd4\x55\x29\x27\x4f\xd9\xee\xbe\x3a\x65\xcf\x47\x0e\x51\xf8\x7d\xa1\xe6\x5f\x46\x2d\x5a\xd3\xd0\xa8\x2e\x23\x8f\x76\x27\xb5\x4b\x14\x7d\xb3\x30\x3f\x46\x02\x0a\x52\x38\x86\xe1\x39\xc4\xa7\x9f\x34\xcf\xe6\xee\xd8\x4f\x16\x60\xe0\x4c\xec\x77\x9c\xed\x48\x2b\xbb\x3b\x9d\x35\x85\x9c\xb2\x8b\xd0\x23\x6f\x66\xbb\xe1\x8b\x45\x67\x61\x7e\x0a\x8d\x19\x4a\x9b\xca\x74\x23\x3a\xee\xdf\x74\xee\x32\x5e\xaa\xfe\x74\xd5\x7d\x0a\x46\xa0\xaf\x32\x81\xe5\xf2\x3e\x69\x90\x13\x2a\x2b\xad\x4c\xcf\x6a\xd4\x6d\xc4\xad\x1d\x2d\xc3\x61\x72\x2d\x49\x8a\x5a\x30\x1a\xc5\xa1\x51\x32\x80\xd1\x18\x06\x63\xf4\x3c\x23\x86\xc6\x56\x1c\xce\xfe\x2f\x0e\x97\x41\xf4\x3e\x8a\x9e\x31\xec\x15\xa9\xd9\x83\xef\x11\xf4\xc8\xa7\x35\xcb\x16\x0b\x83\x62\x8c\x79\x98\x6a\x91\x64\xff\x63\xb0\x1a\x13\xf6\x36\x27\x2d\xf6\x50\x4b\xc4\x8b\x90\xc0\xa8\xd8\xd7\x1c\xc3\xa1\x31\x0c\x46\xe9\xdb\x1f\xc3\x60\x12\x4b\xc4\x4b\x40\xda\x7d\x0f\x2b\x76\x58\x4c\x39\x44\xf5\x7d\x1e\x62\xac\x7c\x5e\x91\x6e\x8e\x63\x3e\xb7\x43\x27\xc4\xd1\x90\x21\x9a\x7b\x1a\xb5\x98\xd7\x8f\xcd\x89\x21\x2d\xf2\x47\xc4\x15\xed\x99\xd2\x91\x06\x76\x8b\x4d\x53\x89\x38\x00\x49\x0c\x8a\x49\x76\x71\x51\x06\x10\x16\x13\x13\x38\x54\x4c\x8e\xe1\x8c\xa8\xaa\x2f\xbb\x44\xcf\x83\x81\xbe\x92\x7d\xda\xa2\x9e\xd1\x15\x81\x3d\xbb\x0a\x48\xf1\x02\xf0\x00\x9c\x24\x3b\x6f\xdc\x8a\xc5\x62\x79\x19\x1a\x27\x96\x31\x90\x79\xb1\x0c\x90\xb0\x0c\x62\x9f\xea\x84\x1a\xc6\x5b\xff\x7d\x3c\x8a\x1a\x07\xf7\x68\x82\x3d\x5d\xc3\x3e\x22\x45\xa5\xd1\x35\xff\xa0\xea\x18\x06\x65\x8b\x48\xd6\x67\xe2\x4f\x95\x06\x9e\xd4\xc2\x0a\xef\x6a\xc5\x93\xc0\xd5\xab\xd7\x11\x0c\x4a\x73\x54\xd7\xac\x43\x27\x50\xd6\x5d\xb2\x23\x05\xae\x5e\x42\x8a\x06\xe5\x48\x09\x8b\x7d\x2b\x41\x26\x87\x7e\xa3\x2a\x18\xc3\xe3\xe9\xa7\x16\x47\xab\x90\x4f\x8a\xc1\xec\x40\xb4\x53\x75\xcf\xee\xec\xda\xea\x95\xc4\x5a\x34\x28\xe3\x97\xd4\xce\x28\x29\x89\x18\x97\xb2\x5b\xb8\xdd\x2c\xc3\x20\xf7\xb9\x53\x95\x07\xa7\x0a\x36\x74\xc1\x86\x2a\xd8\x88\x29\x22\xb2\x5e\xa2\x8a\x33\xb7\xb7\x2a\xe3\x2e\xc5\x9e\x66\xc2\xee\xb2\xd0\xaf\x8d\x7e\x09\x1b\xfd\x97\x20\x89\x3e\x39\x4f\xa1\xab\x94\xc2\xdb\x4a\x0a\x4c\x9d\x2c\x50\x4b\x76\x31\x81\x95\xa2\xb1\x1e\x51\xae\xc7\x4a\xe9\x47\xcf\x19\x51\x1a\xac\xfa\x68\x03\x21\xeb\x94\xc1\xeb\xe0\x2e\xa9\x6d\x5f\xc1\x56\x34\xea\x12\x65\x58\x7e\x23\xdb\x82\x95\x1d\x5d\x16\x39\xde\xeb\x65\x90\xcf\xd4\xca\xf6\xc9\xe5\xc3\x51\x52\x78\x8e\x38\x2b\x00\x68\x3e\x67\x9b\x5f\xb2\x6d\xb5\xaa\x2f\xf2\x78\x31\x1d\x7d\x62\x90\x6b\x30\x37\x2a\x96\xf3\xd4\xe4\xf3\xa6\xa4\x85\x51\xea\xe4\xaa\x37\x3a\x5d\xe4\x04\xb1\xd1\x88\xd9\x35\xff\xbc\xa9\xbe\x6b\xcd\x4f\xe0\x9a\x6f\x34\x68\x26\x7f\x95\xb6\x0d\x4a\x75\xa9\x16\x5d\xd7\x6c\x42\xe2\x6c\x9a\xdb\x8e\x4c\x24\xc8\x9c\x88\x73\x6d\x1f\xb5\xc5\x42\x8f\xa8\xd0\x4b\x40\x33\x28\x69\x4e\x9c\x93\x8e\xb7\x27\x55\x33\x70\x22\x21\xf3\xc9\x5d\xe8\x11\x21\x63\x9b\xee\xfa\xe2\xe2\x74\x57\x3a\xe1\x4b\xd1\xb4\x95\x28\x5b\xc8\xb4\xa3\xf8\xb2\x49\xeb\x74\x58\xdc\x40\x1a\x21\xbe\x6a\xea\xda\x19\xa3\x89\xac\x5e\xe8\x93\xe2\x46\xc3\x18\x43\xde\x6a\x82\x46\x40\xb1\x3c\x85\x28\xbe\x27\x1e\x61\x29\x5a\x0c\x03\xfd\xe2\x7f\x9b\x28\x37\x31\x39\x42\xf4\xeb\x0d\xc3\x21\xc7\xe1\x22\x94\xa2\x36\xa7\x31\x74\x48\x14\x07\x44\xb1\x34\x81\x43\x62\x58\x1a\xe3\xe7\x18\x79\xb7\xa3\xd9\xf4\x51\x1b\x8d\x96\xbe\x32\xf4\x4c\xe6\x55\x1a\x12\x1c\xab\xff\x56\x16\xad\x90\x06\x7d\x27\x59\xee\x62\x1a\x7a\xeb\x70\x80\x68\x8c\xa1\x4f\x04\x12\x98\x7f\x25\xfb\x4b\x4a\x06\x6d\x2c\x01\x9f\x5c\x87\x41\x51\x60\x1e\x42\x29\x7e\x44\xce\xab\x72\xfa\xcb\x0c\x94\xe2\x62\x43\x43\xe2\xa9\xc3\x00\x8a\xba\xc8\x8e\x4a\xaa\x7e\x35\x43\x75\x41\x29\x06\xa4\x50\xd2\x93\x38\x5f\x79\x32\xc2\xc2\x01\xba\x57\x96\xd2\x6f\xab\xea\x85\x41\xa6\x19\x68\x74\xea\x7c\x7f\xd0\xb9\x6a\x7b\x90\x6b\x10\xaf\x1e\x04\xcd\x98\x9e\xf2\xcc\x8c\xa3\x21\xa2\x29\x9e\x51\x5e\x1c\x43\x29\xe6\xda\xe0\xea\x29\x2a\x42\x78\xe7\x74\xa9\x42\x1f\x8a\x9a\x6d\x47\x99\xf1\x14\x45\x8e\xa0\xc8\x73\x9c\x7a\xb5\xa6\xa8\xc5\x86\x51\xe4\x4c\x2d\x8f\xff\x5f\x85\x09\x24\xf9\xd3\x62\xa4\xc1\x5e\x89\x45\x0a\x34\x1f\xa5\x88\x1b\x8e\x02\xb1\xde\x0c\x89\x6b\xd5\x19\xc0\xc1\x22\x31\x32\x8e\x43\x95\x03\x32\x4e\xcd\x3f\x59\xac\xf2\x61\xdd\x04\x33\x9c\x26\x28\xd5\x4d\x30\x44\x49\x7f\x80\xb2\xaa\x83\x99\x6a\x54\x4c\x25\x8b\x33\x63\x2a\x3d\xae\xc2\x9a\x4c\xa7\xc6\x54\x8a\x4f\x65\x31\x64\x1d\x0d\xf3\x71\x1e\x68\x66\x27\xc4\x32\x9a\x94\x55\xd1\xbc\xc9\x7e\x1f\x15\x35\x02\x39\x1a\x55\xb2\x00\x0b\x36\x63\xd5\xd8\x32\x2c\x98\x48\xc6\xcf\x1c\x81\x58\x97\x35\xff\x35\x0f\xa9\xa5\x99\x56\xc4\x39\xe8\x3d\x1f\x8d\xda\xb2\x58\x14\x03\xa5\x68\x9c\x50\x56\x16\xc7\x41\xdc\x45\x42\x38\x48\x16\xa0\x35\x0a\x07\x2d\x46\xef\x02\xc4\x99\xe8\x35\xcb\xb0\x3f\x19\x9c\x76\xfa\x8a\x65\xaf\xf4\xb0\x69\x3b\x4c\xfe\x8a\xf7\x9f\x68\xd4\xd6\x47\x71\xa8\x9c\xa0\x4c\xa1\x47\x19\x80\xa1\x57\x62\xe8\x6c\x1c\x2a\x96\xd7\xa3\xff\x4c\x35\xa4\x56\xdb\x70\xc6\x63\xbe\xcc\x8c\xb1\x3e\x82\xaa\xd6\xad\xbd\x09\x62\x19\xfa\x1e\x8d\xcb\xa3\x09\x0c\x4b\x93\xd7\xe4\xb4\xe3\xb4\x09\xc3\xd2\x87\x05\x83\x51\x36\x38\xd3\x57\xed\x9c\x07\x94\x0b\x92\x9f\xb4\x4b\xf7\xc6\xd9\xa3\xa4\xa6\x0e\x25\xd0\x98\xa0\xd7\xd8\x42\x5c\x86\x19\xd5\x8d\x38\x59\x35\x86\x34\xb8\x9a\xa2\x95\xfd\x4d\x31\x4d\xb5\x40\x87\x0a\xc5\x54\x88\xca\x59\x8a\x01\x19\xe5\x7a\xd0\x5c\x21\xd0\x8a\x28\x27\xa8\x75\xce\x8b\xd0\x27\x87\x31\x77\x63\x59\x6b\x0a\x03\xe4\xf0\xf6\xad\x26\x7b\xb5\x22\x81\xc5\xd2\xc2\xd0\x78\x36\x58\x9e\x22\x4b\xe5\xad\xe7\xbc\x86\x2c\xc6\x90\x14\x6f\xb8\x0e\x49\x83\x3d\x6f\xf3\x23\x25\x40\x9f\x34\x96\x62\xfe\x1c\xf4\x4d\xa3\xe2\x2c\x5b\x36\x34\x92\x28\x11\xd6\xe9\xdf\x0b\xf9\xd7\x2f\xeb\xd8\xb7\x1b\xcb\xee\x32\x4f\xf6\x0c\x67\x6c\x24\xeb\x7c\x1e\x25\xf3\x68\xbe\x3c\xa1\xd6\x5a\x03\x95\x3c\x37\xf1\x89\x15\x66\x8c\x7c\xe9\x02\xc3\x2c\xab\xa7\x39\x9a\x4f\x8a\x86\x32\x8a\xf1\xa5\xf5\x92\xbb\xfd\x6a\x31\x35\xf7\x37\x8b\xd1\x63\x69\x44\xad\x8c\x88\x1d\xa8\x43\x58\x4a\x5a\x91\xe4\xae\x14\x20\x9d\xad\xe1\x15\xcd\xb0\x6c\xe3\xb9\x57\x71\x07\x8e\xf8\x01\x7a\xc8\xf8\xe4\x27\xe2\xd1\x28\x96\x48\xb1\x3c\xc9\xf3\xa8\x12\x73\x13\xf6\x5b\x82\x25\x72\x7a\x2d\xf5\xdb\x53\xa4\x58\x58\xaf\x54\x49\x8f\xca\x86\x08\x9a\x85\x38\x83\x86\x1b\x69\x60\xde\xf9\x38\x63\x2c\x4f\xb4\x5d\xc7\x2b\x9a\x76\x1a\xd7\x03\x35\x68\x88\xb1\x51\x33\x19\x67\xff\xf1\xf4\x98\xa3\xf2\xb2\x9a\x1b\xa9\x48\x0d\xfc\x85\x6a\xf0\x42\xb9\x4c\x4f\x63\xab\xb1\x9c\xb8\x26\xd1\x4c\xeb\xd9\x8c\x5a\xa7\xa5\xc9\xad\xaf\x1a\x3d\x6b\xd8\xf5\x24\x1a\x5f\x12\xcd\x4d\xbc\x8b\x20\x47\x32\xcb\xb2\x1a\x2c\xa0\x91\x38\x89\x27\x72\x57\xc5\xb2\x28\x16\xd4\xa3\x2f\x81\xde\x5a\xf4\xcd\xe1\x6d\x24\x9e\x80\xcb\x79\xba\xb0\x6d\x7a\xf9\xb7\x85\xb5\x97\x1c\x75\x3d\xb1\xf2\xc9\x91\x2c\xa7\xc2\x76\x2c\xa8\xc4\x12\x52\x97\x1a\x76\x48\x9f\x5f\x41\x8a\x31\x36\xcb\xb9\x6f\xd1\x43\x11\x0d\x62\x79\xa2\x05\x69\xb0\xad\x4e\xb2\xc4\xa5\x78\xae\xd9\x50\xb2\x33\xa5\x61\x76\x90\xe9\x98\xa8\xdc\x8e\x10\x51\x4c\x57\x33\xcf\x56\x0c\x27\x31\x94\xe6\x95\xa2\x22\xa9\x1a\x2b\x6c\x71\x25\x4a\x78\x00\x2d\x49\x62\xa4\x8d\x57\xbe\x52\xb6\x47\x37\xbf\x13\x83\x16\xa2\xd8\xbb\xc2\x90\x64\x3c\xc5\x33\x68\x20\x86\xec\x7a\xd1\x38\x43\xf3\xcc\x41\xa2\x9e\x06\x8d\x67\x79\xe9\x30\x2c\x2f\xe0\x49\x5d\x49\x0d\x0e\x18\x8f\x61\x59\xa3\x54\x81\x9c\xe0\x33\x70\x94\x9a\x50\x17\xd3\x3c\xfd\x6c\x2c\x91\x21\x0c\xad\x56\x4b\x11\x16\x93\x05\x65\x25\x62\x11\x31\x6d\x55\x8a\xab\x91\xb4\xa1\x87\x46\x23\x9f\x65\x2f\xb9\x49\xf2\x72\xca\x0d\x1c\x26\xcd\x51\xd2\x30\xeb\x31\xef\xe7\x38\x8c\x3a\x5e\xde\x59\xf4\x4b\xee\x96\x6b\x98\x65\x85\xb1\xbf\xb4\xd0\xd3\xc0\xcd\xc9\x9b\x51\xbc\x74\xb1\x41\x7b\x4f\xeb\x74\xcb\x88\xc2\x0e\xa7\x6d\xf2\xa9\xd3\x78\xc5\xf7\xcb\x13\xff\xb6\x09\x82\x22\xc5\x5d\x8e\xda\xe0\xdb\xa6\xac\x36\xa8\x27\xf0\x6b\x0d\x43\xad\xb0\x10\x09\xf5\x18\x92\xf9\x2d\x38\x4c\x5a\x58\xd4\xc0\x6b\x88\xdc\x44\x69\x0c\xca\xb5\x38\x82\xba\x4d\x12\x43\x24\x98\x6b\x54\x36\x92\xc0\x5a\x3d\x53\x11\x0b\x3a\xa9\xf2\x28\xfe\xba\x9c\x9d\x8e\xeb\xa4\x81\x93\x48\xa9\xf7\xf1\x60\x63\xb4\xb2\x8f\x61\xc4\x54\x28\x89\x03\xd5\xe8\x15\x94\x6d\x58\xc8\x2b\xc5\x2c\xe1\xb0\xb2\x8c\x63\x6a\xb0\x4f\x2b\x0e\x8b\x61\x9f\x24\x16\xed\x25\xcb\x35\x27\x91\x46\x9f\xd9\x4e\xe6\x79\x2d\x77\x21\x12\x44\x91\x6d\x52\x5b\xf4\x92\xcd\x99\xbc\xd5\xe1\x4f\xa2\x37\x4d\xbd\xad\x3c\xa5\x24\xea\xae\xc5\xbc\xf1\x34\x17\x65\xd5\xfd\x3d\xb9\x73\xf2\xf8\xee\xe6\xa5\x8e\xdc\x7c\x62\x0b\x3b\xb3\x24\xb7\xf7\xba\xca\xcd\x23\x45\xbb\x61\x28\x4b\x93\xd4\xb6\x8f\x30\x5c\x80\xc7\x49\x0b\x0b\x1b\x32\xeb\x56\x87\xd1\x23\xd7\x65\x4c\xd2\x5a\xdd\xe9\xc4\x62\x5e\x6c\x42\xf1\xce\x72\x56\xe1\xed\xd2\x40\x1a\x63\x8d\xa5\x58\xae\x24\x35\x52\x49\xaa\x5c\x49\xaa\xbf\x92\x94\x8f\x77\x32\xd4\xf8\xa4\x7b\x67\x20\x81\xa7\x28\xf3\xd1\xad\x88\xd9\xa6\xec\xb5\x0d\x8b\xc8\x4b\xf0\xb4\xd9\xdb\x82\xf9\xb3\x48\x18\xe4\x97\x9b\xad\x71\xde\xa7\xdb\xd3\x1c\xe7\xa9\x93\x12\x1d\x55\x8a\x94\x2d\xd0\x82\x05\xd3\xd8\x5c\x04\x94\xe7\x32\x24\xca\x86\xb4\x4f\x0b\x96\xce\xe2\x85\xdc\x3e\xbc\xe0\x20\xc5\x65\xed\x38\x8c\xcc\x44\x12\x03\x69\xee\xb7\xbe\x5f\x30\x42\xab\x06\x83\x2c\x11\x31\x3a\x81\x86\xdc\x8f\x21\xf1\x74\x33\x7b\x6a\xd1\x76\x0c\x89\x4e\x38\xc4\xa2\xb5\x30\x24\x7d\xe8\x79\x14\x43\x27\xa3\xff\x2c\x0c\x89\x15\xf1\x7a\xf4\x7c\x8a\xe5\xb5\x6a\xfb\xba\x96\x3b\x31\xc6\x70\xf4\xb5\xe8\x15\x57\x45\x71\xb4\xf0\xd0\xd0\xd7\xe4\x2c\x28\x58\x7a\xd1\x47\x8d\x3f\x97\xf1\x6f\xa9\xf2\x8c\x46\xb3\xaf\xc0\x42\x75\x99\x65\x3c\x1a\xb9\xc4\xf2\x38\x4e\x38\x6a\xa3\x48\x8f\x67\xc4\x6f\xac\x0c\xa0\x35\x9d\x58\x16\xe1\x04\x29\x96\x1b\x88\x34\x48\xf8\x13\x3c\x93\xa9\x2c\x2b\x8b\xc6\x79\x1c\x8d\xa9\x15\x38\x5e\x86\xa1\xa1\x54\x2d\xe8\x6d\x45\x43\x16\x61\x68\x2a\x1a\x17\x94\x95\x45\xa3\x51\x44\xf9\x16\x8f\xa5\xf9\x7f\xc5\xa1\xdc\x18\xeb\x1b\x93\xce\x5e\x74\x21\x0e\x15\xdf\xe4\xb1\x6a\xac\x6d\x6c\xcd\x8a\x46\x29\xfe\x91\x47\xf5\x31\xcc\x4e\x32\x0b\x54\x41\x14\x4b\x0c\xcb\x0a\xc9\xd6\x76\xf4\x8a\x3c\x03\x03\xab\xd0\x10\x1b\x5c\x51\xb3\x93\xf3\x37\xd5\xab\xe5\x4f\x33\xc5\xf6\x00\xc5\xe5\x19\xea\x42\x2e\xe2\xf2\xb8\x8e\x08\x0a\x4b\xad\xe1\x50\x0d\x4a\xe3\xd8\x5f\x7c\x6a\x1c\xb6\xfd\x96\x76\x12\xaf\x48\x35\xc5\x58\x28\xf9\x23\x70\x68\x5c\xc1\x4e\x28\x7c\xa7\x4f\x30\x93\x31\xa6\xf9\x5b\x9e\xd1\x95\x66\x6d\x63\xbd\x43\x43\x0e\x85\xf8\x36\xcf\xa0\xbe\xc3\xf5\x0e\xa7\x72\xf0\x5d\x92\xc1\x17\x61\x7c\x97\xa4\x1c\x7c\xa5\x06\x77\x1b\x52\xb9\x60\x2a\xdb\xa3\xba\xa8\xd3\xa1\xc9\xcf\xa2\x39\x94\x4d\x63\xf1\x1e\xbf\x2a\x0a\xe5\x39\x39\xc5\x9e\x93\x29\x76\x18\x93\x9f\x93\x29\xf6\xf8\x6e\x8b\xf5\x48\x91\x3c\x82\x28\xea\x32\x39\xcb\x39\x67\x5d\x26\xe7\xc0\x63\x01\xce\x3f\x94\x85\x45\x04\x53\xc7\x0e\x95\x74\x09\x59\xff\x27\x21\x55\xc3\x17\x9a\x32\x40\x9f\x51\xc7\x38\x5e\x68\xca\x40\x4d\x1b\xc7\xaa\xe5\xd7\x4d\x87\x10\x45\x3c\x93\xbb\xca\xc0\xc8\x3a\xb4\xe2\x38\x92\xdb\xf7\x17\xc9\xd6\xac\xc3\x20\x11\xd1\x91\xcf\x13\x17\x95\xf8\x5f\xc9\x54\xd6\x19\x0b\x9f\x14\x0f\xe7\xab\xa9\xcd\xa0\x28\x06\xa2\x34\xcd\xe7\x2e\x12\xb8\x06\x3d\xf2\x5e\x44\xf1\x4c\x73\xa6\x9c\x17\xa0\x6b\x41\x07\x92\xd9\x9d\x21\x22\x9e\xcd\x2e\xe8\xb9\xdc\x82\xf6\x1f\xbb\xa0\xfb\x10\xc5\x7d\x59\x05\xbd\x49\x05\x5d\x93\x29\x68\x67\x4e\x8d\xfe\x9b\x0a\xb2\x13\x1f\xc8\x2a\x88\x57\x2c\x7e\x9d\xaf\x36\x4c\xfb\xc7\x30\x14\xab\x3b\x8f\xbb\x7d\x6f\x0a\xd7\x60\xef\xf1\xd8\x3f\xce\x96\x9a\x08\x8a\xeb\x30\xe4\x24\x47\xb1\x78\x2e\xf6\x67\x07\x8a\xb7\xc1\x7c\xb5\x58\x39\x1e\xc3\x29\x8a\xa8\xc5\x80\x5c\xc7\x4b\x59\x33\xea\xd5\xde\xcd\x08\x1c\x99\x52\xb2\xce\xf4\xa5\x18\xcf\x84\x77\xe5\x2b\xc7\xc9\x1a\xaf\x49\xfe\x2b\x99\xe9\x92\x0c\xf8\x8b\x3c\xc3\x69\xe5\x13\x78\x84\xc9\x4f\xb1\x47\x1b\x3e\x44\xee\xa8\x87\x6d\x2f\x8a\x8d\x59\x02\xf9\x73\x8e\xe4\x6f\xcd\x91\xfc\x1f\x48\x20\x69\x9d\x78\x73\x32\x73\x8c\x86\x9d\xf7\x4f\xf3\x79\x01\x0a\x23\x95\x58\x39\x12\x7b\xc7\xb1\x60\x2c\xd5\x3a\xc4\x52\x19\xd8\x8e\xbd\x63\x2c\x92\xb0\xb4\x70\x68\x2b\x86\x3b\x31\x28\x3e\x81\xa8\xa3\x72\x41\x29\x7e\xd3\xd4\xce\x16\x7e\x74\x82\x6c\x48\x9f\x18\x4e\x4b\x61\x49\x0a\x03\x33\x58\x28\x79\xf5\x5d\x6b\xfc\x5c\x76\x8d\x63\xd4\xd6\x62\x9f\x2d\x15\x5b\x70\x07\xb2\x05\x97\x2b\x95\x17\x81\xc5\x52\x91\x23\x96\xde\xed\x7a\x99\xc7\x16\xcb\x6a\xa3\xab\x58\x5a\x72\xc4\xf2\xf7\x6c\xb1\xac\xc9\x15\xcb\xf7\xff\x81\x58\x8e\x90\x26\x04\xa5\x98\x9c\x54\x72\xa8\xb7\xe5\x90\xc8\x92\x83\x2f\xd1\xb5\x8e\x3b\xb3\xeb\xc8\x6b\x22\xbf\xca\x15\xc3\x03\x59\xa2\x62\x6b\xbd\xc1\x6b\x20\x8a\x4f\xb3\x2a\xb9\x39\xa7\x92\x9f\x37\x74\xad\xe4\x8d\xde\xac\x4a\xfe\xa5\x21\xa7\x92\x1b\xbd\x3d\xaf\x64\x27\xb5\x3d\x4a\xf1\x6e\x53\xd2\x69\x8a\xb7\xc0\xe8\x94\xa2\x34\x47\xed\x6f\x4e\x66\x0f\x33\xdc\xc2\x1f\xe7\x56\xed\xd6\x7f\xd5\xc2\x7f\x51\x2d\xec\xcb\x6a\xe1\x2e\xaa\x64\x33\x59\x93\x5d\x10\x45\x3c\xe9\x35\xba\xd8\xd1\xc2\x8c\x8b\x89\x22\xad\xc4\x96\x68\xc7\x80\xf8\xa9\x32\xa9\x69\xc7\xa4\x86\xc5\x0b\xec\x16\xae\xe5\xf3\x28\xaa\x63\x93\x19\x68\xe7\xd5\xe8\xe7\x9a\xa3\x5d\x4b\x6e\xe9\x02\xdf\x23\xc5\x5d\xdc\x32\x87\xb2\x5a\x66\x87\x61\xa4\x30\x4c\x53\x0c\x8b\x9c\x37\x95\xef\xdd\x06\x9d\x2f\x70\x53\xea\x0c\xb3\x0c\x2d\xb1\xcd\xdb\xa9\xd3\x7e\xdb\xa0\x6b\x13\xd8\x92\x3a\xa3\x93\x04\x70\xaf\xd7\xc0\x88\xb4\x78\x5b\xc6\x69\x95\xb4\x6a\x95\x96\x2c\xd5\x33\xc5\xdb\x0d\xc9\x78\x32\x57\x8c\x6b\x8c\x9e\x89\xf1\x2f\x0d\x59\x62\x8c\x27\x54\x8b\xdd\xe0\xcd\x69\xb1\xcf\x1b\x72\x5a\xec\xb9\x1c\x61\xa3\x78\x2c\xab\xfa\xaf\x2a\x09\x3f\x96\x35\x68\xbd\x96\x3d\x68\xb5\x3a\xbe\x0a\x79\x66\x13\x13\x89\x78\x82\x75\xf4\xb3\x55\x09\x27\xc3\xcd\x47\x57\x80\x48\x7e\xd3\xd4\xee\x90\xdc\xdf\x4d\x1d\x35\xa7\x3e\x89\x1c\x78\x3b\xb3\xe0\xfd\x52\xc1\xdb\x99\x05\xef\xbf\x35\xbc\xb0\x52\x1a\xf2\xad\x18\x69\xd2\x41\x6a\x16\xc6\x13\xf1\x94\xfa\x98\x85\xa4\x57\xab\x11\xf7\x67\x8f\xe3\x79\x71\xf6\x5d\xcd\x76\x44\x79\x9f\x5e\x62\xb2\x7f\x77\x68\xc2\xfa\x2c\x57\x77\x1f\x8f\x26\xfd\x79\xb7\xf5\xa2\x14\xa2\xd8\x96\x85\xf1\x1d\x85\x71\x5b\x16\xc6\x77\x73\x31\x2a\x69\xa2\x14\x03\xd2\x7a\xae\x39\xd7\x61\x8d\xe2\x9b\x55\xc4\x0d\x03\x52\xac\x73\x19\xb1\x58\x07\xef\x8f\x5e\x49\x9a\xba\x41\x77\x06\xf4\x8b\xaf\x0c\x23\xd6\x8e\x5e\xc5\x98\x17\x9d\xeb\xb3\x9d\x9f\x60\x3b\x33\x0c\x4f\x40\xf7\x16\x76\xe6\x0b\x38\xbe\x96\x31\x14\xa8\x63\x0a\x05\xd2\x6a\xd0\x0f\xec\x84\xfc\x98\xaa\xb2\x31\x53\xc4\x9f\x8f\x2a\xa2\x3d\xdb\x67\x59\x4a\xa6\xfb\xd6\x0c\xf9\xc7\xff\x02\xd1\x04\x29\x36\x37\xa5\x79\xde\x21\x13\x18\x91\xe0\x71\x8d\xd4\x77\xc6\xc4\x46\x17\xcf\xf5\x30\x22\x03\x18\x18\x88\x91\xb1\xfc\xdd\x8c\xd6\x0c\xf4\xf2\x41\x43\x19\xd7\x47\x0f\x31\x29\xb7\x32\xd8\xc2\x76\x34\xe3\xbc\xb1\xcd\x8b\x76\x7b\xf9\x3b\xa5\x96\xee\x66\xa9\xe5\xfc\x76\xb9\x49\x55\xba\x0f\xb5\xea\xda\xb6\x0c\x83\xfe\xea\x1c\x44\xff\x24\xd9\xdf\xfe\x31\x7a\x36\xcc\x36\x1c\x2a\xc5\xbb\x49\x9a\x00\x58\x21\x1c\x2a\x0b\xb0\xe8\x6d\x1c\x3a\x16\x8b\xce\xc7\x21\x71\x2c\x32\x0f\x31\xd7\x5e\xf2\x23\x5e\x3c\xea\x25\x83\x3c\xd3\xeb\x55\xaf\x82\x49\x5e\x3d\xec\xa5\xce\x3f\x78\xa4\x91\xc8\x3a\xde\x57\x83\xd8\xf8\xb6\x56\x26\x11\x49\xa8\x95\x2c\xb3\x10\xf3\xf4\x4e\x2e\xca\xb5\xdc\x0b\xf2\x53\x38\xb0\x11\xf3\xc7\xa3\x65\x0e\xc0\x7e\x7c\x80\x98\x31\x0f\xac\xc7\x01\x09\x34\x68\xb4\x7c\x8f\x32\xc7\xd0\x2f\xea\x3b\xd0\x23\x77\xd1\x94\x54\x9f\xaa\xb3\xd0\x92\x1d\xd8\xaf\xb5\x1e\xc3\x31\xb4\x5a\xf5\xd2\x02\x25\x7d\x85\x01\xe9\x3a\xb1\x90\x27\xfd\x3e\xb5\x2b\x3b\x58\xa5\x07\x2d\x36\x4d\x13\x93\xd8\xbb\x1e\x4f\x8d\x63\x90\x7d\xa9\x93\x3a\xf0\xb8\x18\x9e\x74\x98\xfc\x82\x60\x2d\x8e\x9e\x83\x41\x59\xdd\x82\xc7\xf1\x46\xce\x8a\x04\x8e\xbf\xc8\xf9\xf5\xc9\x3a\xf4\x48\x4b\xb6\x91\x26\x61\x45\x12\xf3\x66\xf1\x92\x28\x49\xbd\x42\xcd\x6a\x86\xaa\x15\xa3\x46\xb3\x5d\x95\x9c\xe2\x92\x87\x48\x31\x3f\x4a\xb3\xbe\xa0\x14\x81\x24\x9e\x2c\x9e\xcc\x37\x13\x2c\xf8\x93\x65\x41\x67\x2b\xe6\xcf\xd0\xcb\x00\x07\x56\x1a\xd9\xcb\x7f\x6a\x25\xe0\xd9\x5e\x6a\x1f\xde\x1b\x3f\x0b\x8d\xda\x04\x0f\x89\x2b\x92\x7a\xe9\x6c\x2f\x1e\x4f\x5e\xe0\xde\x28\x8e\x91\xef\x30\xcb\x31\xb2\xc0\x8c\x62\xf1\x8c\x28\x96\x25\xd1\x17\x4b\xe9\x23\xaa\x41\xcc\xaf\xd6\xa5\x3c\xda\x5d\x29\xaf\x3b\xa5\xf0\x4c\xc6\xd4\xdd\xc7\x90\x56\x2a\xa6\x8e\x5c\x5e\xc5\x07\x18\xb0\xff\x5e\x3c\xb1\x03\x03\xd3\x9c\x33\x26\x3e\x79\x3e\x37\x0b\xef\x8d\xed\xe1\x99\x79\x44\x1a\x49\x2c\xee\xc4\x31\x7a\x9b\x10\xc7\xc8\x20\x16\xec\x45\x94\x6f\xea\xe3\xc0\x6f\xea\x0e\xfa\x66\x2d\xa1\x30\x0b\x69\xea\xd9\x5f\xad\x33\xf0\x94\x9a\x67\xa2\x6a\xc9\xa5\xf7\xc7\xe8\xd9\x8a\xc1\xc5\xe8\x91\xe6\x2e\x8a\xf9\xb0\xfa\x0b\xd2\x8e\x8f\x2c\x0c\xca\x82\x16\xf4\x7c\x25\x5d\x95\x96\xeb\x24\xfe\x17\xb3\xd0\x90\xa2\xaf\x6b\x88\x81\x9e\x1f\xa0\xb1\x19\x3d\x7d\xd1\xb8\x8a\xbe\xbd\x52\xcc\x4d\x31\xc3\x82\xae\xcc\x83\x5b\x8e\xe2\x8c\x41\x19\x68\x41\x43\x44\x8e\x24\xd4\x16\x88\xb8\x34\x86\x15\x1a\x97\x57\xae\x77\xd8\x1c\xa7\x56\x3e\x06\x75\x93\x34\x4c\x25\x0d\xee\x26\xa9\x4b\xe1\x66\xe3\x1a\x7b\xf1\xf5\x8b\xea\x0f\xf7\x12\x96\xb9\x64\x6b\xfe\x25\x01\x6b\xb2\x47\xbe\xd5\x03\x2a\xaf\xbc\xd0\x29\xf8\x78\x25\xe1\x21\xdd\x48\x78\x1b\x06\x17\xe5\xca\xa1\x51\x1d\xde\x0e\x2e\x59\x43\xc6\xc9\x23\x1b\x31\x28\xfd\x35\xe8\xf9\x3a\x4b\xe0\x63\x2d\xd7\x71\x21\xb4\xc4\xb5\xee\x4e\x7b\x0f\x7a\x14\xb1\x6f\xe7\x7d\x79\x43\x9f\x68\x89\xa3\xd5\xa0\xcf\x2f\x2e\x63\x65\xc9\x9f\xea\x6c\xd2\xf8\xf4\x61\x9f\xde\x64\x55\x65\x08\x4b\x4f\xe7\x51\x2d\x5f\xfa\x13\x68\x94\x94\x95\xd5\x63\x85\x5c\xc5\x4b\x83\x15\x49\xf4\xd7\x61\x05\xa5\xbf\x4a\xe9\x0e\x0b\x43\x8a\x9b\x17\x1e\x62\xf5\xb7\xb4\xb9\x31\x9a\xdb\xd1\xb3\x5e\x2d\x7d\x1f\xc4\x31\x6a\x01\x86\xda\xc9\x45\x5d\xe7\x22\x36\x6f\x2e\x29\x96\xb7\x6b\x3d\xee\x40\x9f\xbc\x50\xed\x72\x88\x15\xbc\x56\x1f\xaf\x8f\x46\xeb\xd9\xcb\xb8\xaa\xde\xd2\x2b\xb0\x7f\xd0\xfb\x20\xcb\xf0\x24\x6a\x80\x58\x34\x91\xc0\x22\x3e\x50\xaf\xaf\xb0\x8a\x23\x01\x43\x52\x9f\xfb\xd2\xb2\xc2\xea\x60\x1c\x1f\x3d\xe7\x43\x74\xde\x06\x16\x39\x8f\x27\x49\x0c\x6f\xc2\x11\x4b\x90\x8f\xea\x7f\x99\xd9\x3b\x68\x54\xeb\x93\x1d\xbc\x66\xdc\x8f\xac\x90\x6b\x50\x08\xfb\x2d\x21\x7f\x2a\x2c\x27\xd4\xf2\xea\xe3\x80\x43\x58\x1c\xc7\xb0\x5c\xab\x4f\x3b\xae\xe3\xdf\x3e\xf2\x5a\xe5\x17\x72\x7c\x0c\x51\xf4\x4b\x49\x31\xef\x90\x7d\x58\xcd\x10\xc5\xec\x72\x5c\xce\xbd\xc3\x3e\xc0\x36\x03\xfb\xc9\x10\xe1\x71\x8f\x43\x94\x4b\x55\x82\x5e\x1f\x8c\xab\xd6\x63\x9f\x8d\x4f\xe0\x50\x97\xdd\xca\x8d\xec\xde\xc8\x6b\xfb\x7e\x35\xb8\x84\xa2\x88\x31\x0c\x25\x10\x69\xec\x2a\x11\xb3\x53\x38\x54\x46\xb1\x24\x46\x73\x8c\x12\x42\xde\x82\x25\x62\x69\x02\xfb\x4a\x0b\x87\xcc\xc2\x12\xd9\xc8\xbb\x41\x23\xe3\xd8\xb7\x1e\x4b\x64\x0b\x0e\xa1\x69\x75\x12\xfb\xc4\xb1\x44\x2e\xe5\x50\x89\x6c\x14\xcb\xcc\x0e\x2c\x97\x16\x8e\x88\xe1\x69\x6b\xb0\x24\x2e\xcd\x97\x71\xa8\x64\x96\x43\x13\x58\x12\xc7\xbe\xd2\x7c\x82\x4d\x6d\x5f\xf2\x9f\xc4\x00\x33\xc9\x42\x2f\x91\x62\x76\x1a\x87\xca\x0e\x2c\xa9\x57\x9b\xc0\x29\x67\xfd\xba\x58\x9d\x4d\xe0\xf3\x3c\xbc\x21\x9a\x54\xe7\xce\xea\x76\x61\xf0\x3c\x36\xa7\xe3\xe2\x78\x7c\x0c\x83\x69\xd2\x82\x43\x58\xca\xa7\x15\xb1\x54\x06\x31\xaf\x46\x35\x67\x23\x9b\x4e\x2b\xc6\xe6\x9f\x37\x97\x97\x62\xc1\x38\xcc\x9f\x8e\x1e\xb9\x15\xfd\x72\x96\x3a\xf3\xb5\x54\x59\x58\xb5\xf0\x87\x7b\xd1\xa0\xc1\x71\x1a\x8d\xbc\x0f\xaf\x38\xa2\x8e\xfb\xd2\x08\xe5\xc3\x02\xb3\x37\x9a\xe7\x63\xc1\x4c\x44\x39\x51\xdb\xde\x2b\xf8\xb7\xef\x6f\x70\xa8\xb8\xc2\x2c\x44\xb7\x88\x74\xe2\x50\x51\x9f\x8c\x1d\xc2\xbe\xbc\x4d\x6a\xa9\x75\xc5\x5e\xf2\x20\x56\xf2\xaf\xc5\xdb\x76\xbd\x74\x74\x8a\xbe\x4c\x8b\xbe\xe7\x70\x72\x35\x7d\xd7\xaa\x71\x5c\x9f\x24\xe9\xb5\x96\xcd\x5b\xaf\x71\x9a\xd3\x74\x15\xab\x0f\x17\xf6\x4a\x68\xf6\xee\xec\x48\xb1\xfc\x90\x14\x9e\x04\x2f\x2f\xaf\x2b\x51\x97\x1f\xf4\x9e\x51\x99\xf0\xb4\xa3\x87\x06\x8a\xfc\x6a\xf4\xd5\x34\x62\xb0\x05\xdd\x53\x29\x99\x6b\x5a\x20\x8d\x1a\xe5\xe2\xd4\x50\xd0\x2b\x83\x58\xf6\x26\x16\xd6\xa1\x47\xfc\xe5\xca\x14\xf6\x13\xad\x81\xa4\x1a\x7f\xaa\x2d\x45\xe0\xc7\xe0\x3a\x76\x18\xa4\xf8\xc3\x95\x6a\xc7\x57\x7b\x1b\xd5\x5d\xef\x66\x48\x43\x8a\x5b\x02\x86\x76\x41\xb6\xf2\xfe\x9b\x3f\x85\x27\xa4\xd1\x9f\x40\x6f\x3b\x86\x5b\x30\xc8\xda\x7e\x0b\x0e\x97\xe2\x17\x18\x47\x14\x8f\xac\x48\xa1\x94\xe2\x75\x0a\x84\xac\x10\x0e\xa7\x9a\x88\x47\x56\x24\xcd\x0e\x69\xd5\x11\xdd\xcf\x99\xee\x31\x45\x77\x30\x8b\x8e\x3d\x67\xf1\xc4\x0a\x83\xa8\x0e\x30\xd5\x01\x45\xb5\x1f\xe3\xea\x2c\x99\xc1\xbb\x03\xcf\xaf\x30\xf6\xd6\xb2\x8c\x8c\x46\x7d\x73\xa1\xe1\x30\xa2\xf8\xb1\x99\x54\x02\x44\x4b\x9f\x08\xf2\xc8\x9f\x88\xf9\x66\x42\xb9\x48\x8f\xf0\xd1\x43\x33\xae\x42\xe6\x60\x0a\xfa\x0f\x69\xf7\xe9\x11\xae\xe5\x58\x29\xbe\x59\xc1\xa7\x00\xad\x10\x8e\x95\x7e\x34\x7f\x83\x85\xd7\x20\x4a\xd5\xdd\x71\x02\x06\x44\x41\x0c\x3d\xe2\xca\x38\x62\x1d\xe6\xcd\x41\x7f\x35\x7a\x4e\x67\xe1\xd6\xa2\x47\x86\xd0\x3d\x0b\xfd\x24\xbc\xb9\x16\xe7\xf0\xd7\xa2\x67\x16\xba\x63\xdc\x9a\x6c\xa2\x6b\xd1\x5a\x8f\xfe\x73\xa9\xb3\x50\x93\xa9\xc1\x5e\x9d\xed\xe5\xc7\x38\x3b\xd3\x7c\x04\x5b\x3d\xf2\x60\x21\xf2\x13\x58\x20\x96\xc5\x31\xd8\xac\x5b\x92\x86\x69\x2c\x7c\x1b\x03\xd5\x58\x30\x4d\x5d\x39\x08\xcb\x30\x06\x7e\xc0\x99\x0a\x65\x08\x8b\x6a\xb0\xdf\x58\x1a\x63\x4a\x26\x60\x70\x3c\x16\x55\xa3\xf7\x64\xe7\x0c\xb1\x52\x9f\x50\xad\xea\x74\xee\x0e\xbe\x6a\xb6\xd7\x56\x3c\x12\x2d\x55\x40\x5c\x60\x76\xf0\x01\x24\x75\x14\x56\x6d\xdf\x28\xa0\x83\xa5\xf8\x9d\xeb\x08\x45\x2c\xc7\xc1\xe7\xa2\x67\x32\x0e\x26\xa1\xc8\x2f\xad\x30\xd7\xdc\xad\xa6\x07\xf6\xf6\x24\x8a\xbc\x34\xaf\x90\x78\x92\xd8\xa7\x15\x3d\x31\xd6\x40\x53\x1a\xca\x33\xea\xab\x3c\xdb\x9f\xae\xe2\xf9\x4e\xb2\x1d\x4d\x91\x76\xa9\x43\x48\x2c\xf8\xad\xe8\xf9\x35\x41\x94\x51\x1e\x9c\xaa\x11\xc7\x2b\x3f\x8a\x40\x36\xb8\x86\xeb\x95\x6c\xb3\xd0\x99\x60\x69\xbf\xa7\x3c\xeb\xac\xff\x61\xbe\x42\xe2\x11\x0d\x66\xbd\xa9\xdc\xfa\x41\x8d\x58\x30\x0b\x03\x66\x05\x96\x4f\xe5\x43\x39\x83\x68\xc4\x38\x1f\x07\x2e\x66\xeb\x32\x58\xd7\xb7\x82\x7f\xdb\xd0\x27\xf2\xda\x69\x58\xf4\xa4\x71\x9a\x14\x3f\x07\x33\x26\x0d\xb3\x0c\xf1\x2c\x9c\x46\xde\xac\xf3\x4b\xe3\x94\x15\xaa\x65\xcf\xce\x8c\x63\x99\x1a\xba\xfb\xa8\x5d\x8d\xcc\xa6\xed\x3a\x76\xd9\x7d\x49\x2c\x6b\x55\x2a\xb0\xce\x72\x4e\xc4\xff\x61\xb1\xbd\x22\x30\x21\x1e\xe3\xc5\xf8\x5b\xfd\xdc\x13\xf9\xf9\xa3\x4c\x6a\x27\x49\xe0\x4e\x3f\x6f\x60\xf6\x91\x16\xf9\x90\xa1\x38\xaf\x5d\xa2\x78\x6f\xb1\x61\x93\xa5\xda\x31\x20\xee\xf2\xc7\xd0\x14\x1f\x2d\x4e\xf3\xba\xc5\x2d\xfe\xec\x3b\x76\x7f\x58\x1c\xeb\x72\x67\x4f\x17\x87\x9e\xd9\x14\xf2\xc5\x94\x59\x0f\xb7\x62\x51\x8c\x86\x94\xa2\x24\x0e\x8a\xd2\x33\x1f\x72\x9f\x4b\xe3\xc3\xa1\xb8\x73\xae\xc9\x23\xb7\xb1\x92\xf0\x3c\x75\x1b\xfa\xa4\x45\xb3\x02\x7b\xa7\xa6\xb8\x16\x7d\xe3\x59\xb0\x28\x7e\x99\x81\x18\xe7\xad\xcd\x9d\x7e\x83\xf5\xac\x98\x51\xfd\x32\x83\x2a\xfe\x2f\x53\x49\x4d\xc5\x1d\x3a\x35\x38\x0d\x0b\x78\x9d\xe2\xc3\xc5\x99\x25\x31\x3e\xf7\x72\xb7\xdf\xe0\x56\xe9\xc3\xf9\xdf\xcb\xe4\x3f\xc4\x1b\x7a\x7e\x03\x3d\x62\xde\x11\x42\x79\x18\x3d\xa2\xac\x35\x41\xb5\x8b\xb3\x0e\x16\x27\x49\x77\x8b\x63\xa4\xbb\x66\x47\xd6\x59\x5a\xb3\xdc\x99\x76\x93\x38\x87\xf0\xc0\xfe\x1e\x74\xa0\x21\x5b\xcc\x8e\x28\x06\xc4\x3d\xcd\xf5\xe8\x95\x66\x8c\x65\x52\x90\xc0\x40\x02\x0b\xa2\x3c\x5f\x2f\xb1\x85\x44\x4e\x27\x29\xf3\x9b\x59\x57\x4c\x0c\x2c\x7e\x1b\x3d\xb3\x64\xab\x4c\x61\xc0\x75\x62\x08\xbd\xf2\x88\xc3\x42\xe4\x77\x74\x09\xef\x42\x9f\x59\x86\x81\xc7\xba\x8b\x14\x9e\x2e\xb4\xe4\x39\x69\xe8\x21\x69\x26\xa4\xb8\x24\x99\x01\xb2\x29\x0b\x48\x54\x9f\x0c\x21\x2f\xe0\x55\x49\x4e\x9d\x58\xe2\x1a\x6d\xe8\xa3\xe9\x7b\x45\x30\xe1\xf0\x51\xfb\x67\x34\x8c\xef\xe5\x2d\xfa\xde\x87\x58\x42\xbd\xc9\x64\x7b\xe4\x23\x19\x3a\x36\x82\x87\xb2\xc4\xa7\x67\xd8\x9e\xa4\xdc\xcb\xae\xb5\x4f\x8a\x19\x66\x54\x26\x31\x20\xc6\x24\x8e\x01\x7b\x00\x4b\x9a\x26\x8b\x09\xfa\x2e\xaf\xc5\x81\x6a\xd6\x58\x4e\x73\xdd\x5a\x1c\xa0\xee\x33\x92\x57\x37\xa8\x16\xcb\x55\x68\x90\x4a\xab\xc8\xa4\x0d\x71\xd2\xc8\x35\x3a\xae\x16\x07\xab\xd0\x71\x2a\xdf\xd0\x4c\xbe\x0a\xb5\x87\x17\x55\xd3\x4f\x8f\x14\x3f\x69\x4e\xa1\x4f\xfc\x02\xd2\xa2\x36\x2d\xf2\xd3\xc2\x9b\x26\xc3\xf0\x32\xa4\x09\xff\xdd\xcd\x09\x31\x24\xbd\x47\xcf\x72\xbc\x69\xf4\x4b\x91\x9f\xc6\x3c\x79\x44\x4d\xb5\x13\xda\xed\x37\xd0\x5b\xaf\xf6\xd3\x8f\x28\x23\x71\x58\x4d\x70\xe4\x3a\xca\x91\x97\x93\xc3\xa9\xbd\x3e\x92\x74\x98\xf3\xb8\xe2\xec\xb9\xb8\x0e\xa3\xdf\x1e\x2c\x0e\x2b\x97\x5c\x19\x3f\xd7\x61\x9c\xa8\x57\x36\x26\xe9\xc3\x49\x09\x75\x36\x71\x2c\x07\xcd\x59\x7c\x10\x8d\xe2\xcf\x51\xf1\x03\x18\x82\x39\x0b\xfd\x72\x7f\x36\x82\x02\xa5\x4d\xfe\xc7\xba\xc2\xe2\xb3\x0b\x87\x59\x9b\x35\x2c\xbf\xbd\x74\x43\x8f\xd3\xed\x47\xfb\x96\x54\x7f\x6e\xb5\xfe\x49\xec\x47\x33\x13\xb1\x28\x2d\x82\x69\xf4\xec\xe1\xb4\x81\x9c\x36\x30\x89\xe5\x09\x3e\x22\x9e\x4b\xcf\xf3\x3e\x8a\x27\x13\xec\x11\x8b\xd3\xd9\x5d\xf0\x90\x3a\x00\x2c\x53\xea\x9b\x5a\xe9\xf2\x04\x7b\xfb\x7d\x38\x73\x9f\x24\xfa\xe4\xc3\x7a\x76\xad\x85\x38\x34\x49\xbe\xcc\xd0\x24\x0e\xae\x25\x0f\x9a\x66\x2d\x83\x95\x62\x0c\x52\xa1\x8c\x62\xf8\xb4\x62\x0c\x61\xc5\xb0\xd3\x8e\x77\xd2\xc8\xc7\x1d\x51\x8b\xc3\x28\xd4\xc8\x33\xab\xe1\x5c\x41\x7f\x5a\xb6\xa3\x47\x7e\x2e\xd3\xd8\x97\x4f\xdb\xad\xd2\x93\x9a\x77\xc1\x90\xbc\x57\x6c\x56\x5a\x7c\xa0\xb4\x42\x5a\x98\x97\x54\x9b\xfc\x2d\xca\xcb\x6d\xe5\x61\x52\xcd\xea\xf7\x6a\x1f\x76\x1d\x7a\xa4\x85\xc5\x09\x32\x47\x79\x77\x33\x2d\xdb\xa7\x28\x5b\x26\x2a\x6b\xad\x65\xe9\xbb\x35\x62\x62\xca\xbe\xab\x66\xcd\xa5\xf0\x2b\x90\xe4\x24\xd3\x35\x29\x84\xfe\xd3\x9d\x23\x67\x28\x37\xe7\xfc\xda\xcb\x4d\x7f\x40\xaf\xd8\xd5\xec\x1a\x68\xa8\x7e\xc8\xb7\xd7\xd7\x75\xf3\x1c\x3b\x46\x7c\xcf\x9e\x3d\xb2\x25\x03\x7a\x30\x83\x4e\xd8\x98\x7f\xb1\xf8\x30\x59\x14\xf1\x7b\xbf\xf2\x25\xf4\x61\xc1\xe1\x66\x88\x52\x6f\x5b\xdc\x61\xd9\x97\xe7\xc5\x7d\x4d\x86\x73\x53\x4c\x0d\xb7\x3e\x35\x57\x25\xff\x55\x5d\x28\x3b\x8a\x2e\xc1\x0b\xe1\x7e\x43\x4d\xcb\xa4\xb8\xca\x55\x6e\xe8\x09\x9a\x8a\xb8\x73\x71\x5c\x1d\x34\x0f\x29\xb7\x52\xb9\x14\x3a\xf1\xbe\x26\x23\xeb\x36\x48\xb1\x8a\xe4\x6b\x6c\xbc\x1e\x30\xe0\xdf\x96\xfc\x89\xdf\x08\x87\x95\x83\x22\xc5\xf6\x66\x57\xb9\xa1\x8e\xae\xa7\x30\xb0\x1a\x2d\xe1\x3b\x42\xde\xbd\xb5\x5b\xf9\xf8\xe2\xf0\xe2\x0e\x96\xc5\x47\x5d\x65\x11\x3a\x18\x43\x4b\x3c\xd3\x9c\x40\x6f\x03\xfb\xb9\x47\x5c\x1d\xe4\x2f\x8b\x9d\xae\x24\x7a\xc4\xa1\x55\xec\x47\x3f\xe8\x4a\xd9\x7e\x34\x1f\x1e\x79\x73\x95\xc1\xea\xde\x2f\x8e\x9e\x94\xdc\x8b\x28\xc6\x95\xa9\x01\xdc\xa8\x44\x14\x77\xac\x72\x1d\xc7\x8b\xb4\x0f\xbb\xea\x99\xd9\x7d\xcc\xec\xb7\x8a\xd9\xf6\x0c\x33\xa2\x79\x67\x95\x1a\x17\xf8\x68\x8d\x5a\x8c\xec\x1d\x43\x6f\x67\xd6\xad\xa9\xad\xec\x48\xa1\xf8\xd9\x2a\xce\xf1\x73\x50\x5c\xd7\xf5\x22\xae\xdf\xad\x64\xae\x6b\x7b\x75\xe1\xfa\xfd\x4a\x43\xaf\x14\x7f\xe9\x0c\x8c\x43\x54\x8f\x1e\x12\xc5\x3e\x31\xe7\x14\xa8\x4f\x2f\x01\xf8\xe4\xb6\x5d\x3c\x00\x05\xf4\x39\xdb\x76\xb5\xc4\x44\x73\x80\x6d\x6c\x72\xcb\x5b\x31\xd8\xc9\xeb\x23\x65\x3c\x3b\xae\xc7\x32\x9a\xd5\xef\x68\x66\x8b\x71\xa3\x51\xaf\x16\x78\xc8\x58\x98\xd5\x1a\xf9\x97\xbc\xbf\xd2\xc4\x7a\x2a\x9e\xd7\xd2\xd8\xc4\xd2\xf8\x8b\x92\x46\x5b\x57\x69\x7c\xb6\xca\xc8\x62\x33\x00\xab\x64\x48\x1d\x66\x09\x4f\x23\x6b\x13\x4e\xe2\xd0\x28\x86\x63\xfa\xdb\x37\x0b\x51\x3c\xbb\x8a\x5d\xb9\xff\x31\x14\xf7\x4f\x99\xfb\x6d\x8a\xfb\x27\x5d\xb9\xdf\xbe\xaa\xe7\x52\xb1\x6c\xb1\x70\xe5\x2b\x12\x18\x4c\x62\x45\x3d\x06\x63\x58\x11\xe5\xef\x5a\x0c\x26\xd5\x11\x98\x20\xcd\x02\x75\x2c\x7f\xfb\xe4\x9b\x88\xe2\x27\x57\x1a\xbc\xe0\x5e\x26\x7e\x13\x30\x70\x4c\x32\x81\xbe\x6a\x2c\xe3\x03\xa8\xb5\xb2\x13\x51\x5c\xdb\xc4\x5a\x12\x8d\x29\x25\xe1\xe6\xfc\xad\x6a\xce\xed\x5d\x9b\xf3\x9d\x95\xc6\x51\xd2\xfd\x4f\x5a\x4c\x5f\x38\x90\x49\x44\x31\x8d\x77\x34\x62\x4a\x5a\xb7\x71\xa1\x9f\xaa\x42\x6f\xed\x5a\xe8\xff\xfc\x7b\x1d\x9a\xf0\x7f\x47\x87\xf6\x28\x1d\xda\x95\xad\x43\x62\x88\x19\xe3\xfb\xc0\xc3\xc5\x3f\x5d\x49\xf4\x89\x35\xaa\x49\xff\xe1\x34\xa9\x19\x4b\x20\x8a\x5d\x2b\x59\x88\x6f\xe9\xe6\xff\x8e\x9b\x7f\x9d\xa2\xfd\x7b\xd7\xe6\x5f\xff\xff\x69\xf3\x3f\xe9\x34\x7f\x27\x37\x7f\x3b\xa2\xb8\x5b\xb5\xf8\xab\xba\x27\x5c\xcb\x60\xbf\x51\x60\x37\x74\x05\xfb\xb7\xae\x3d\xc1\xc2\x61\xd2\x52\x5d\x21\x38\x8d\x7c\x37\xc2\xd3\xa5\xcc\xb5\x2d\xac\x54\xbb\x57\x71\x11\xb7\xeb\x22\xf6\x72\x11\x2f\xa9\x22\xd2\x5d\x8b\x78\x79\x95\x61\xdf\x92\xd4\xae\x69\x8b\x5d\x24\xb9\x06\xb5\xe7\x5b\xae\x81\x4c\x2d\x76\x33\x97\x37\x14\x97\x47\xba\x72\xf9\xc5\x2a\x75\x0c\xc5\x63\x76\xd0\xbc\xc6\x63\xb6\x63\x11\x0d\xa9\xb2\x8e\x37\x1e\x57\xb1\xba\x6d\xd0\x70\x5e\x63\x46\x4f\x2a\x46\xaf\x76\x65\xf4\x54\x4f\x9b\x27\x28\xc5\x30\xfb\x32\xf6\x5b\x8c\x3c\x28\xdb\xf0\x44\xa9\x47\x2e\xd2\x39\xd9\xce\x83\x81\x6c\x54\xa1\xf1\x2a\xc4\xab\x4e\x52\x92\x1f\x27\x19\xd6\x9e\xa6\x2e\xfd\x2a\xc4\xe5\x93\x5b\xc8\x97\x30\xb6\xa2\x6f\xaf\xba\xe8\xdc\xc1\x37\x4d\x2c\x75\x04\x34\xd2\x42\xd3\x39\x9f\xfc\xab\x56\x91\xb5\xec\xde\x9c\xc0\xc7\x17\x06\xae\xc5\x3e\xa7\xa3\x4f\x4e\x20\x3f\x39\x8e\x3e\xfb\x84\xfb\x5c\x76\x57\xf5\xf5\x96\x35\xea\xb6\x41\x8d\x8a\x9b\x46\x5f\x29\xb5\x99\x16\x95\xd3\xd5\xdc\x76\x02\x1e\x3f\x0e\x7d\xb2\x8e\x5d\x20\x91\x48\xb2\xf0\xbe\x12\x24\xbc\x1b\xae\x66\xe1\x7d\x29\xba\x08\xef\xc6\xab\x55\x4d\x06\x1c\xc2\xde\x71\x1c\x10\xe3\xa9\x91\x92\x62\x66\x50\x89\xa9\xd5\xf0\x4e\xb5\x45\x10\xe3\x43\xbc\xe4\xf9\x97\x71\xf7\xbb\xcd\x48\x75\xd5\x38\x7b\xf4\xe9\xad\xfb\xb8\xbd\xc9\x53\xa2\x96\x5e\x54\xcd\x1a\xd5\xf1\x7c\x2d\x62\x51\xa3\xee\xaf\xcd\xd2\xa7\xf5\xd5\x4d\x0a\x8f\x24\xa7\x6d\xad\xb8\xb6\x49\x0d\xae\xff\xc3\x15\xb9\x55\x55\xe4\xd3\xae\x15\xb9\xcd\xae\x48\x12\x7b\xb7\xab\x8a\xec\x73\x2a\xa2\xd4\xa3\x4d\x7c\x6c\x24\x2c\x35\xb1\x1e\xbc\x14\x03\xa7\xf3\x0c\x99\x9c\xbd\xf2\x04\xfa\x97\x62\xc1\x6a\x2c\x8f\xd2\x4c\x56\x7d\x8f\xae\xc3\x82\xf3\xd8\x58\x8d\x96\x06\x2f\xc1\xf1\x41\xb9\x4d\x0c\xe5\x1b\x83\xa0\x5c\xdb\xc4\x50\xfe\x6a\x74\x81\x72\x5d\x93\xd6\x6c\xd7\xe8\x42\x56\xed\xb8\xd6\x6c\xb1\xac\x3d\xb7\x78\x99\x20\x27\xc4\xd6\x26\xb3\x0c\x51\xac\x6d\xe2\xdb\x6a\x47\xc0\x3c\x64\xcb\x15\xc5\x4d\xcd\xbc\xc3\x75\x59\xb6\xa4\x8f\x69\x34\xef\x51\x46\xb3\x9d\x3a\x7b\x39\x5f\x13\x55\xdf\x19\x93\x5e\x23\x99\x45\xdb\x97\x5a\x17\xff\x80\x13\xe8\xb7\x1d\x4f\xeb\xc4\x31\x71\xf4\xc5\x65\x1d\x6b\xa7\xd5\x8e\xc7\x99\x09\x3c\xb1\x0e\x87\x2c\xe1\xad\xc3\x13\xc9\x89\x37\xe3\x6a\x3b\xb2\x06\x4f\x90\x06\x8e\x24\x56\x4b\xd5\x39\x5b\x5e\x34\xf0\xa4\xf4\x16\x62\x8b\x78\x9c\x5f\x55\x24\x3e\x59\x65\xb2\x6d\xfe\xbd\xab\x43\x6c\x6a\x36\x5b\xff\x85\xb9\x27\xe9\x1c\x97\xc0\xa2\x24\x1e\x57\x4f\xc2\x3b\x8e\x57\x3d\x82\xb5\xd4\x46\x45\x0d\x19\xc3\xa9\xeb\x55\xff\x9f\xd7\x8b\xba\xe3\xd0\x37\xd1\x1a\x8f\x43\xd4\x6b\x51\x78\x93\x33\xd1\x93\xfa\xe8\x7d\x21\x97\xb8\xa7\x39\xc1\xe6\x7a\x94\xda\x80\x69\xe4\x63\xe2\x6f\x62\x58\xbc\x69\x98\xea\x0e\x05\x1f\xd6\xea\xb4\x2c\x75\x54\x7b\x68\x82\x7d\x90\xfa\x8c\x0f\x12\x94\x2d\xf6\xbb\x26\x3c\xea\x25\x2d\xc1\x8f\x30\x3c\x1e\x83\xb2\x9a\x0f\xc5\x25\xb0\x44\xbe\x8e\x03\xe4\x1a\x2c\x4d\x61\x58\xd6\xc9\x14\xef\xbc\x6e\x34\x0c\x8b\xdd\x3a\xb6\x36\xe2\xc6\xa6\x34\x06\x35\x0e\x43\x8a\x93\xcc\x42\x1c\x61\x9f\x17\x57\xfb\x2a\x45\x8d\x58\x3c\x8d\xf7\x33\x8b\x9c\x5c\xb3\x54\xa6\xbe\x2a\xd3\xf9\x66\x21\x05\xa5\x98\x63\x16\xea\x0b\x6a\x2d\xfc\xdd\xa1\xf6\xe6\x37\xaa\x1e\x1a\x97\xe6\x28\xe7\x2d\x1a\x63\x95\x65\x18\x1b\xa7\xef\xe1\x71\xb2\x1d\xc3\x63\x34\x71\x1b\x36\x8b\xcb\x1a\xa9\x2d\xdc\x50\xe7\xb8\x38\xd5\x97\x1a\x3d\x14\xc7\xa0\x34\xeb\x99\x53\x24\x91\xc4\x50\x0a\xa3\xea\x4c\xf6\x30\xa5\x0b\x41\x16\xe9\x3a\x3c\x4d\x5f\x99\xd4\xa7\x8f\x69\xae\xe9\xaa\x57\x2f\xad\x49\xaa\x50\x82\xb7\x0c\xec\xb4\x98\x7a\x49\xc2\x21\x15\x99\x66\x39\xb8\xd4\xb9\x6f\x57\x8d\xe2\x1a\x53\x91\xe3\x75\x19\xd5\x7c\x9a\x9c\xca\x08\x3a\xb7\xa4\xc8\x2a\xeb\xc8\x49\x8a\xb9\x4f\x1d\x83\x6e\xe5\x90\x1a\x49\x2d\xb1\xb5\x39\x4d\x13\x84\xa0\x68\x71\x75\x24\xb4\xd0\xaa\xff\xdf\x16\x5a\xe2\xdf\x0b\xad\xb7\xc6\x7e\x5a\x96\x98\xe2\x5d\x44\x18\x53\xa1\x0e\x55\xcd\x98\xfd\x16\xa0\x4a\x25\x2d\x1d\xc9\xa1\x54\x8e\x08\x2b\x95\xec\x46\x28\x92\x6e\x45\x98\x54\x41\x9d\xaa\x92\xea\xfe\xa5\x40\x49\xa7\x17\x1e\xe6\xad\x8e\xde\xad\x14\xf8\x75\x53\xab\x1d\x3a\x99\x95\xf5\x33\x68\xe5\x6b\xb3\x46\x5a\x8d\x41\x96\x78\xb9\xc9\x40\x6f\x83\x7c\xec\xff\x78\xf2\xfb\x7f\x63\x12\xed\xdd\x8b\x81\x39\x68\xd5\x61\xc1\x12\x0c\x4b\xf1\xb8\x61\xd6\xd3\x00\x97\xa0\x86\x2d\x49\xe2\x70\xde\x37\xe4\xef\xba\x71\x18\x96\x13\x78\xf4\x0b\xcb\x5a\x65\x6d\xf8\x2e\x6d\x49\x02\xc3\xaf\x62\x64\x3c\x86\x6b\xb0\xe4\x3c\x0c\xcb\x6a\x8b\x1c\x7c\x5e\x0f\x12\xdf\x37\x11\xb9\x69\x71\xfb\x87\xa5\xd8\x60\x1c\x96\x66\xa1\x4e\x1c\x90\x95\xe6\x93\xe2\xbb\xa6\xc3\x18\x14\x8d\x69\xd1\x61\xa4\xc5\x7d\x4d\x69\xac\x52\x3d\xfb\x80\x8b\x3a\xba\xd8\xdc\xd4\x8a\x3e\xf1\x96\x91\xc6\xb0\xb8\xab\x39\x8d\x3e\x71\x7b\x73\x5a\xbd\x2e\xe6\x89\xe6\x34\x06\xc5\xe0\xb4\xf8\x14\x28\xfe\x21\x23\x2d\x39\x0b\x39\x9c\x3e\xb1\x99\xc6\x33\xf1\xe5\x2a\x5e\x6b\xfb\x54\x31\xbb\x9e\x2c\x9f\x98\x97\x46\x4b\x8a\x0d\x4d\xdc\x0b\xac\x9d\xce\xf7\x83\x3a\x80\x52\x84\xf9\xf2\x86\x14\x6b\x32\x7b\x5e\xe8\x15\x1f\x06\x68\xdc\xbc\xfb\xca\xfa\x84\xf8\x47\xc0\x10\xa9\x2b\x93\xf6\x3e\x58\x12\x7b\x8b\xef\x03\x06\x5a\x62\xed\x95\xed\xf6\x2b\xc9\x78\xda\xfc\x5d\xc0\xb0\xbe\xa4\xf2\xe6\xb8\x26\x19\x7b\x72\x4a\xd4\xdf\x7d\xa5\xb8\x57\x1c\xee\xba\xbe\x93\x27\x43\xe8\xdb\xc2\x0b\x36\xa6\x7e\xb1\x17\x2f\xf2\xb8\xaa\x88\xea\x59\xb6\xf0\x66\xdc\xbe\x51\xde\x5b\xad\x41\xf0\x9d\x2e\xf2\x23\x3a\xd0\x17\x77\xf6\xe6\x51\x5e\xa7\x4e\x9b\x25\x78\x81\x28\x20\x3b\xd0\xe4\x17\xfc\x5d\xab\xf7\x16\xf4\x6b\xaf\x3c\xf2\x61\xca\x6d\xaa\xeb\x92\xec\xf8\xb4\x60\xb0\xc1\x71\x41\xf3\xa7\x25\xea\xd1\x7a\x95\xe4\x73\xff\x02\xbd\xa7\x60\xa4\xcc\x28\xa2\xf4\xd9\x21\x6a\xd1\xb7\xac\xec\x75\xf7\xed\x0b\x32\x87\x49\x0b\x79\x2f\xe1\x6d\x4b\x5f\xac\x49\xb1\x87\xe5\xb9\xa7\x85\x2f\x36\xb3\x73\x7a\xb9\x61\x2f\xec\xac\xb3\x4f\x30\x85\x5a\x79\x5d\x8e\x61\xb5\x35\xaa\xd7\xf9\x88\xf1\xfc\x42\x97\x53\x5d\xc7\x19\x76\x22\xde\x12\xd7\xd7\xaa\xcd\xb9\x7a\x15\xd3\xe0\xbd\x3d\xa1\x2e\xce\x88\x76\x15\x4a\xaa\x50\x54\x85\xb4\x47\x97\x50\x74\xea\x06\xb6\x7c\xbb\xcb\x9e\xaa\xef\x1e\xf4\xcc\xb4\xb7\xe5\x0a\xd4\xb6\x9c\x77\x8d\xda\x90\x0b\x51\x2b\x9d\xc7\x9b\x57\x41\x69\x61\x61\x8d\x0e\x59\x7a\x19\x4e\xbd\xba\xa7\x48\x2d\xd2\x05\xd4\x95\x7f\x75\xf6\x23\x8a\xa5\xa6\x45\x0e\xb4\x4f\x2d\xa4\x5b\xd2\x6a\x9b\x56\x8b\x65\x7c\xc7\xa4\xac\x16\x83\x09\x2c\x39\x8c\x41\xae\xb4\x34\xf4\xb1\x33\xb3\x8d\x37\x8f\xa9\x66\x05\xf4\x9b\x56\x35\x1e\x21\x97\xf2\xb0\x5a\xde\x41\x83\xbe\x47\x1d\xff\x41\xdf\x11\xb4\xda\xd0\x53\x83\xc3\x56\x53\x9c\x55\x4b\xb9\x62\xe4\x99\xa0\x14\xdf\xcf\x77\x36\x3b\xb0\x40\xfc\xd3\xea\x60\xe7\xa4\x40\x06\xd5\x81\x9a\x56\x7b\xc5\xea\xfb\xf9\xc7\x78\x89\xc9\xe9\xfc\x12\x13\x5d\x15\x8f\x14\x6b\x42\x31\x1e\x23\x3c\x71\xbd\x34\xcf\xc7\x2a\xcc\x8f\x9c\x37\x85\x55\xf3\x2e\x65\x5a\xbf\x44\xa7\x46\x1f\xff\x70\x4d\x2e\x90\xcb\xd9\x31\xe3\x8c\x11\xb5\x42\x3b\x81\xab\x63\xed\xc5\xf2\xf1\xe8\x99\xae\xbc\xcd\x1a\x8b\xdd\x76\xcf\x11\xbe\x8d\xcb\x3a\x22\xbc\x31\xf4\x8b\x64\x14\xbd\x75\xe8\x11\xf7\xcd\x4f\xa1\x57\xbc\x1d\x52\x3b\xa1\x25\x7a\xdf\x92\xe7\x32\x24\x7d\x5e\x59\xae\x41\x4b\xb7\x9c\x14\xed\xf3\x33\x7d\xfb\xa8\x57\x0d\x4a\x43\x8a\xdf\x85\x8c\xda\xda\xbd\x32\x84\xee\xd3\x11\xe5\x52\xe7\xda\xfe\x2e\xbd\x44\xce\xda\x32\x4b\x5e\x66\x5f\xcf\x17\xdb\x9a\xd3\xce\xfc\x61\x90\x9a\x1e\x0d\x8a\x62\x30\x8d\x3e\xf9\xa5\xe8\x4b\xc6\x6a\xf9\x11\x1a\x06\xe3\x58\x1c\x8b\xa3\xf5\x85\x2d\xe4\xfb\x17\xb4\x76\x2f\x64\x9e\xdc\x99\x31\x0c\x8a\xb4\x65\xc4\x31\xf0\x0a\x9a\xe2\x95\x05\xed\xb1\x04\x1a\xa2\x3d\x64\x24\x5a\x0f\xe3\x28\x29\xbe\x05\xf5\xda\xa0\xe1\xd4\x33\xcc\x65\xd8\x57\xae\x57\x9b\xd2\x6a\x3f\xdd\x63\x26\x71\xbc\xbc\x86\xeb\x3a\x5e\x16\xa0\xe7\x59\x75\x73\xb9\x16\xb1\x96\x05\x5e\x90\x42\x5f\x2b\x6f\x9f\x72\x2f\x8f\xa1\x47\xac\xe0\xd3\xcf\xbc\xc7\x73\x1c\x7a\x65\x9a\xa7\xcb\x7c\x0d\x55\x56\x2b\xc3\x76\x19\x4f\xb0\xfc\x13\xb0\x1f\x35\x4e\x25\x59\x10\xb1\x9c\x73\x23\xef\x79\xf2\xfb\x74\xaa\x13\x65\x38\x4c\xae\x24\x70\xc3\x64\x10\x0b\x5a\x70\xd8\x78\xfa\x1e\x7e\x11\x16\x88\x15\x31\xb5\xb3\x5b\x6d\x59\x61\x1e\x35\xfb\xa7\x18\x84\xfd\x86\xb0\x7c\x75\x00\x90\xa6\x0e\x51\x72\x2e\x7d\xcc\x77\x34\x83\xa8\xb4\xd8\xb1\x78\x88\xed\x49\x24\xa6\xec\x57\x81\xb8\x24\x81\x03\xea\x31\xbf\x43\xef\x2d\x76\xf2\x89\xa2\x62\x69\x86\xf8\xdc\x9f\x19\xc3\x31\xea\x6e\x27\x19\xa7\x34\x9f\xd8\xbe\xaa\x5d\xc7\x55\x5b\xfc\x10\x9a\x8e\xc5\xe3\x71\x0c\x3b\x9d\x6a\x7f\xb5\xf8\x0b\xa2\x5b\x76\x28\x43\x97\x2f\x45\x03\x9b\x9f\x01\x29\x25\x62\x8a\x64\xe1\xb7\x24\xca\xa2\x34\xde\x2d\x8e\xd9\xaf\x97\xdb\xbd\x55\x2f\xe0\x3f\xb4\x48\x1f\xf9\x1b\x2a\xfd\x18\xac\xc2\xa1\x8b\x30\xf8\x09\x06\x6a\xb1\x40\xbd\xda\x92\xf4\x26\x54\xab\xce\x03\xa8\x57\x20\x05\x6b\xb1\x50\x85\xc8\x14\xfb\x2f\x20\xd3\x12\xdc\xa2\x36\x2b\x97\xda\x56\x72\xab\xda\x67\xaf\x43\xef\x79\xce\xd9\x21\x5f\x2d\x7a\x84\xe7\x30\xfa\xc4\x55\x09\x2c\xf8\x16\x7d\x62\x79\xb2\xa6\xa1\x05\x83\x2d\xe8\x7b\x08\x0b\x96\xa1\x6f\xd1\x9a\x6a\x2c\x58\x8f\x56\x23\xfa\xae\x5c\x83\xd6\x59\x8b\x77\xa1\xef\xce\x16\x74\x7f\x6e\x9f\x14\xe1\x21\x88\x78\x59\x17\xa0\x67\x91\x6d\xfc\xf8\x7d\x3c\x8d\x98\xaf\x0e\x90\xe8\xc3\x10\xaa\x67\xf9\x6b\x31\x38\xcb\x79\x4f\x57\x41\x2d\x96\xad\xc4\x82\x73\xd1\xbb\xd8\x39\xee\x8b\xea\x55\x5b\xaa\x04\xf4\xca\x10\x16\xfe\x8a\x2a\x60\x59\xac\x49\x11\xe1\x4d\x60\xa1\x58\x1a\xc7\x7e\xab\x8f\x71\x04\xa5\x50\xd7\xd8\xa7\x7f\x83\xfa\x37\xd0\x25\x7e\x1b\xb9\xdd\x62\x51\x27\x8e\x3e\x8c\xc3\x45\x30\x89\xbe\x65\x38\x40\xcc\x4f\xa2\x4f\x44\x52\x58\x2c\xea\x93\xe8\xe3\xa9\x7f\x7f\xbe\x51\x64\xa0\x97\xcf\xe1\x5d\x93\xf5\xbc\x21\xeb\x99\x26\x50\x76\xc8\xa7\x2b\x1b\x88\x93\x6d\xbb\x59\x5f\x03\x6c\xe4\xb7\x43\xb9\xa4\x98\x65\xf0\xe2\x46\xf6\x6d\xc3\x36\x9d\xb7\x4c\xed\x17\xa4\x94\x75\x35\xcb\xf8\x75\x6a\x76\x52\xa6\xf0\x32\x9e\xa7\x6d\x1b\x87\x03\x45\x23\x01\xce\x8f\x4b\xea\xa2\x22\x69\x60\x91\xe0\x0b\x0a\x5b\x49\xef\x1a\x0c\x29\x5a\x5d\xea\x20\x37\x9b\x10\xf3\x2c\x6d\x4e\xcd\x51\xea\xa5\x09\x72\xb6\x8a\x98\xa8\xa0\xcc\xe4\xad\x56\x3e\x5c\xda\xce\xb5\x18\x2c\x0d\xac\xe0\xf9\xfe\xe6\xa6\xd4\x31\x6a\x9b\x81\x98\xfb\x9c\x2d\xab\x73\xba\xcb\x1d\xc3\x80\x14\xc3\x53\xfa\x8d\x88\xc2\x93\x12\x37\x37\xbb\x06\x19\x66\x6d\x96\xf8\xc4\xb0\xd6\xbd\x62\x5a\x42\x9a\xa1\x71\xb2\x96\x67\x9d\x3e\xb9\xf5\x35\x69\x86\xc7\x66\x82\xf6\xaf\x08\x75\x60\x80\x7c\x46\xdf\x34\x69\xf3\x20\x16\x03\x5a\x7b\xb9\xd0\x10\xaf\x2f\x33\x50\xf0\x3a\x56\xba\x17\x69\xd2\x63\x6e\x6e\x17\xb1\xf0\xd0\x51\x8c\x4e\x4d\xd7\x8a\xaa\x0e\x9e\x4e\x52\xf0\xac\x43\xb5\x58\x26\x79\x4d\xb2\x6f\x92\x5f\x0f\xa0\x67\x99\x8d\x87\x79\x5b\x9b\x5a\xb8\x68\x2f\xf6\xbe\x5b\xb9\x56\xe2\xe9\xa6\x44\x76\x15\x4e\x34\x0b\xf5\x1c\xd9\x1f\xa3\x39\xb2\x95\x76\xae\xa9\xfa\x92\xb2\x8d\xfb\x42\x69\x12\x03\x2d\x58\x32\xcb\xd9\xdb\x08\x3f\x40\xa2\x5a\x67\xa4\xc8\x68\x51\x1b\x4c\xb2\x17\xf8\x6e\x6e\x6a\xad\x15\x4f\x1b\xed\x6c\x0d\x83\x72\x1b\x8f\xa3\x21\x7e\x09\xc7\x05\x96\x1a\xc9\xfa\x26\xb1\x30\x89\x7d\xe3\x58\x9a\x70\xe6\xc5\xa5\x49\x0c\xc5\x69\x68\x89\x4c\xe6\xeb\x45\xc5\x09\x7d\x87\x7d\x2b\x7a\x44\x01\xcd\x12\x8c\x23\x14\x7f\xb9\x7a\xcf\x5c\x50\x6e\xad\xe3\x53\x8a\xc1\x46\xec\x4f\x73\xf6\xad\xc2\x17\xe5\xe3\x36\xf4\xb8\x2c\x91\x55\xea\xcd\x66\xb5\xd8\xdf\x4c\x89\x21\xde\x68\x75\x1d\x1f\xc0\x81\x75\x58\x7e\x3a\x0e\x9c\x8e\xc3\x4f\xa7\x71\xdd\x52\x8b\x7e\x25\x49\x9a\xe4\x55\x62\xb1\x0c\x60\x70\xad\xac\xc3\x22\x19\xc2\xf2\x25\x58\x54\x87\xe5\xe7\x11\x63\xcb\x7e\x1f\x46\x50\xae\xc7\xb0\x8c\x31\x7a\xf5\xc6\x48\x53\x59\x80\xbe\xfc\x66\xac\x79\xae\xf1\x86\x53\x9e\xb9\x24\xab\xb8\xa7\x9d\xe2\x78\xde\x65\xc6\xf8\xe0\x12\x15\xbc\x0c\x4b\x16\xb9\x2a\xac\x86\xea\x35\x44\x5b\x72\x3e\x96\x7f\x80\xa5\x67\x62\xc9\xe2\x9a\x35\x35\x38\xbc\x19\xfb\x5f\x80\xa5\x5b\xb1\x64\x09\x0e\xff\x8c\x79\xd0\x7f\x32\x21\x5d\xd1\x90\x6b\x9c\x45\xff\xaa\x43\xd2\x55\x51\x28\xcd\xb5\x58\x4c\xbd\x0b\x83\xc2\x97\x92\xa2\x4f\xbb\x53\x8b\xe9\x58\xbe\x9a\xeb\xa2\xeb\xdc\xa5\x2e\xbc\x76\x83\x25\x52\xac\x30\x30\xb8\xe2\xff\xe1\xee\xcd\xe3\xa4\xa8\xce\xbd\xf1\xd3\x33\x0c\x0e\x2e\x08\x82\x8a\xc6\x65\xa2\xa8\x98\xc0\x38\x0c\x08\xb8\x45\x60\x18\x60\x64\xeb\x9e\xee\x19\x06\x9c\xa1\xec\xe9\xae\x9e\x69\xe9\xe9\x6e\xba\xaa\x61\xc6\xa8\x83\x0b\xe0\x2e\xb8\x6f\xd8\xb8\x25\xa8\x28\xe4\xc6\x18\xe3\x92\xe0\x7a\xd5\x6b\x22\x71\x49\xdc\x12\x31\xe1\x46\xcd\xa2\x24\x2e\x31\x2e\xf1\xf7\xa9\xaa\xe7\x7b\x4e\xf7\x39\xa7\x7a\xc6\xf7\xbd\x7f\xdc\xdf\x5b\x9f\x0f\x3c\xdf\xf3\xd4\xf7\x3c\xcb\xd9\xaa\xa6\xaa\xba\x6a\x68\xe5\x4f\xfa\x6f\x75\x8f\x95\xa3\x13\x55\x23\x87\x3e\x4a\x49\x7a\x43\x70\xcc\x69\xa7\x55\xed\x3f\xf4\x1a\xf7\x68\x51\xff\x6c\x55\x9d\x7b\x59\x64\x9c\x73\x1e\x3f\x70\xc6\xc7\x56\x9f\xac\xb5\xe5\xf5\xf5\xe9\xcf\x3d\x57\xb9\xb9\xbf\x8d\xb7\xc9\xaa\xaa\x83\x56\x14\xb7\x89\x27\x16\x78\x0d\x72\xd0\xe9\x55\x87\xe7\xab\x0e\xda\x58\xd4\x20\x6e\x7b\x8c\xa2\xf6\x98\xee\xde\x74\xdc\xc5\x2a\xea\xdd\xf9\xbb\xe3\xdc\x2d\xb8\x2c\x74\x64\xf5\x7a\x0c\xae\xa1\xe7\x0f\xad\x5c\x76\x9a\x77\x90\x3c\x70\x5e\xd5\x68\xab\x6a\xd4\x84\xaa\xd1\x0b\xaa\x46\x0e\xbd\x95\xae\x1a\x9c\x59\x3c\x94\x1c\x07\x13\x46\x39\x83\xb3\x74\xec\x25\xdb\x06\x63\xc2\xb1\x50\x62\xed\x37\x43\x2b\x8e\x1b\x5d\xb5\x7f\xe5\xa7\xac\xcd\x7b\x56\xf5\x34\xf7\x2d\x40\x4e\x13\x38\x8d\xdb\xe0\x5e\xa4\xa8\x73\x2f\x52\xd4\x27\x9c\x86\xde\x7f\x68\x65\x6f\xf5\x69\x55\x23\x7f\x52\xb5\x7f\xe5\x9b\xec\xe1\x8a\xbd\x59\x20\x50\xf5\x6d\x36\xac\xa2\xee\x08\x3a\x44\x5f\x78\x90\xfb\x3e\x9e\x6f\x7b\x6f\x83\x5e\xea\x3d\x87\x6b\xe3\x4c\xe9\x58\xf7\xb6\xcc\x5d\xfd\x76\x55\x55\xe5\x9b\xcc\xbd\x74\xba\xa9\xff\x7c\xf1\x40\xa2\xfb\x86\xd3\xb7\x58\xc0\x7b\x6c\xa9\xca\x1e\xfa\x5c\x55\x60\xe8\xa8\x8a\x3d\x58\x20\x90\x48\xa6\x4c\x23\x9a\x8e\x1b\x96\x1d\xcf\xe4\x6d\x36\x6f\x81\x69\x47\x99\x69\xc5\xa2\x59\x33\xee\xec\x4c\x47\x7b\x4c\x96\xc8\x64\xcd\x34\x33\x0c\xcb\x8e\xc6\x96\x1b\xb1\xee\xe5\x46\x22\x9a\x4c\xb1\xb8\x99\x48\xa6\x4d\xc3\xca\x9a\x31\x8b\x19\x56\x77\x26\x67\x77\xe6\x13\xac\xcb\xb4\x1b\xba\xa3\x39\x66\x34\x2d\x32\x52\x99\xd8\x72\xc3\x66\xe1\x60\x63\x43\x98\x59\x76\x2e\x65\xa6\x99\xdd\x93\x75\x68\x96\x9d\x4b\xc7\x7a\xb2\xcc\xb2\xe3\x66\x2e\xc7\xd2\xf9\x9e\x2c\x55\xea\xcc\x27\x0c\x33\x1d\x67\xee\x9b\xeb\x1b\xd3\xf1\xb0\xb9\x22\x6f\xa6\x63\x26\x33\x7b\x6d\x23\x93\x35\x2c\xd3\x66\x56\xb7\xa0\xf0\xfd\x4e\xe5\x55\xb9\xa4\x6d\xba\xfb\x72\x66\x36\x15\x8d\x99\x46\x3c\x99\x33\x63\x76\x72\xa5\x69\xac\x4a\xda\xdd\x46\x67\x2a\x9a\x5e\x6e\xa4\x92\x69\x93\xe5\xcc\x68\x7c\x41\x26\x6e\xce\x32\xad\x58\x2e\x99\xb5\x93\x99\x34\x33\x12\x39\xd3\xcc\x99\x96\x91\x4a\x5a\x36\xcb\x99\x56\x3e\x65\x4f\x24\x59\xcf\x8c\x44\x2a\xda\x65\xb1\xb6\xb9\x76\x4f\x8a\x25\xad\xc5\xdd\x49\xdb\xb4\xcc\x2c\x8b\x9b\x29\xd3\x36\x8d\x9e\x68\x2c\x97\x61\x59\xaf\xb9\x7a\xa2\xb9\xe5\x66\xce\x62\x8e\xab\x74\x86\x19\x86\xfb\x96\xfe\x64\xda\x48\xb8\x31\x8a\xf8\x9c\x66\x4c\x3a\xce\xe5\x00\x2d\x96\xb4\x66\x99\xa9\x64\x8f\xd3\x58\x0b\x53\x2c\x9a\xb7\x33\xd6\xaa\xa4\x1d\xeb\x66\xe1\xe5\xc9\x6c\x30\x63\x59\xc9\xce\x94\xd9\x90\xe9\xe9\x31\xd3\xb6\xc5\x7a\x1a\xd3\x71\x96\xad\x77\x92\x37\x7b\x6d\x33\x1d\x9f\x99\x4f\x14\xf9\xed\x31\x7b\x62\xd9\x3e\xd6\x33\x23\xd7\x15\x36\xb3\x2c\xd5\xe5\x36\x53\x2c\x9a\x4a\x65\x62\x2c\x99\xb6\xec\x1c\x4b\x64\xf2\xae\x09\xcb\x8e\xe6\x6c\x64\x15\xf3\xec\x33\xc3\xb0\x93\x3d\xe6\x59\x99\xb4\xc9\x56\x45\x73\x69\xe6\xa4\xd9\x94\x6e\xc8\xe7\x72\x66\xda\x9e\x95\xcc\xb1\x9e\x68\xcc\xb1\x68\xd9\xb9\x84\xc3\x64\x96\x33\x80\xec\x4c\x3a\xd5\xc7\x8c\x7c\x3a\x6f\x99\xf1\x7a\x67\x67\x2c\x6a\xd3\x77\x13\xe6\xb5\x58\x66\x8e\x25\x92\xe9\x38\xe5\xe0\x24\x10\xcc\x65\x62\xa6\x65\x45\xcc\x5e\x9b\xa5\x9d\xf1\x17\x8f\xe6\xba\x2c\x96\xb7\x72\x3d\x99\xb8\xd7\xc9\xb1\x4c\xdc\x8c\xad\xb4\x59\x8f\x17\xbb\x43\x72\x1c\x27\xad\x6e\x33\x95\x75\x83\x4b\xa6\xbb\xa8\xd3\x58\x2a\x93\xee\xaa\x71\xff\xcb\xa7\xad\x64\x57\xda\x8c\xd7\x24\xd3\x36\xb3\x96\x27\xb3\x2c\x9a\xeb\x72\x2a\x5b\x2c\x18\xcd\x59\x26\x1a\xd4\x9d\x04\x49\x2b\x93\xb7\xb3\x79\xbb\xa8\x01\x2d\x3b\xeb\x34\x60\xd1\x17\x1a\xbc\xf1\x1a\x8d\x2d\xcf\x67\x8d\xce\xa8\x65\xd2\xd4\x71\x55\x56\x2a\x6a\x75\x9b\x16\x8b\x27\x73\xee\x2c\x9a\x65\x26\xa2\xf9\x94\xdd\x14\x67\x0d\xf9\xdc\xac\x64\xae\x29\x1d\x9b\x1f\xb5\x6c\xd7\x5c\xda\x99\x02\x89\x6c\x2e\x99\xb6\x13\xcc\x70\x27\x5e\x86\x25\xad\x64\xda\x09\xc0\x1b\xd2\xf4\xf5\x09\x1a\x56\x2c\x9f\x76\x67\x63\xcc\x99\x69\xb4\xcb\xdb\x63\x78\x95\x16\xb5\x44\x82\x2d\x91\x86\x45\x0b\x23\x8d\x6d\x11\x66\xa6\xe3\x96\xb9\xc2\x21\xba\x33\xde\x8d\xa7\x21\x95\x49\x9b\x61\x77\xf2\x5a\xc9\xb3\x4c\xc3\x66\xc9\x44\xca\x5c\x69\xa6\x58\x36\x96\xca\x58\x5e\x4b\x3b\x53\xc4\xcb\x8c\xba\xc5\x99\x2f\x4e\x67\x45\xd3\x71\xa7\xf5\x62\x4e\x87\x4e\x74\xfe\xab\x77\xf9\x56\x74\xa5\x37\xfb\xdc\x11\x5b\xc7\x9c\xd9\xc4\xbc\x75\x23\xed\x74\x68\x2a\x13\x8b\xa6\xdc\xc1\x11\xeb\x36\x63\xcb\x8d\xa4\x15\x77\x07\x78\x32\xee\x4d\xeb\x6c\xaa\x9e\x75\xf7\x65\x1d\x13\xf5\x8c\x06\x9e\x91\xc9\x19\xab\x9c\x99\xc6\xb2\x19\xab\x9e\xb9\xd0\x59\xac\xe2\x49\x2b\x9b\x8a\xf6\x19\x2b\xcd\x9c\xe5\x4e\x5d\x6f\x7c\x18\xf1\x68\x5f\x2a\xd9\xd5\x6d\xb3\xee\xa8\x45\x8b\x53\x51\x17\xe6\x9c\x2e\x74\x42\xa5\x86\x4c\xc6\xcd\x15\xf9\x68\x4a\x64\x9b\xb5\x73\xcc\xec\x4d\xda\x6c\x61\x26\x6c\xc7\x9b\xd2\x31\x6f\xa9\xca\xf6\xb1\x86\xb9\x33\x9a\xc3\x8d\x11\x23\xdc\x32\x33\xdc\x18\xa1\xd1\x67\xc6\x9d\x05\xab\x74\x00\xb9\xc3\x3a\x19\xf7\x66\x51\xb4\xd3\x72\x1a\x1d\xd9\x98\x71\x36\x23\x1e\x8f\x64\x9c\x35\xd2\x49\x59\x2c\x5a\x6e\x33\x77\xe6\x13\x4e\x6f\xb0\x34\x19\x67\x66\xaf\xe9\x4e\xd1\x55\x66\xbc\x68\x28\x3b\x23\x38\x67\xf6\x64\x56\xf2\xe9\x69\x89\xf6\x77\x0d\x25\xe3\x6e\x0b\xbb\xd3\xbd\x91\xcd\xca\xcc\xc8\x25\xed\xee\x9e\xc6\x95\xd1\x14\x3e\x0a\xe2\xad\x04\x2c\x9d\x71\x5b\xd4\x6d\x9a\x9e\x2c\x33\x7b\xb3\xd9\x68\x2e\xda\x63\x79\x5d\x58\xcf\xb2\x13\xbd\x3c\x92\x96\xdb\xbe\x89\x2e\xd3\x8e\xd1\xc2\xeb\xe8\xc5\xea\x8b\x95\xd3\x69\x8e\xec\x44\x67\x10\x14\xad\x79\xd9\xbc\x53\xcb\xad\xe1\xfa\x6d\x36\x13\x2c\x93\xb7\xdd\xf1\x6b\x18\xd9\x68\xfc\x78\x5a\x28\xdd\xea\x09\x77\x84\xa6\x32\x99\xe5\xf9\xac\x47\xb6\x1c\xb6\xb3\x44\x45\x73\x5d\x9d\xcc\x58\x69\x47\x3b\x53\xa6\x91\x49\x24\x9c\x36\x8c\xe6\xba\x4c\xe6\x2c\xa2\x76\x77\x32\xdd\x65\xb1\xb4\xb9\xca\x5b\x8a\x69\x89\x76\x08\x2b\x59\x34\x1e\xe7\x6b\x59\xd2\xea\x71\x26\xb9\x45\x53\x2e\x61\x66\x12\x2c\xda\xd3\x99\x74\x33\x49\xbb\x0d\xc0\x16\xcc\x68\x68\x5e\xc4\x12\xd2\x64\x70\xf2\x4a\xbb\x2b\xd2\xc2\x4c\x43\xde\x99\xc5\xb3\x93\x39\xcb\x66\x2b\xf2\x19\xdb\x74\xd3\x71\x8f\x84\x6e\x0f\x35\x79\x13\x73\xb6\xd3\xfd\x8b\xbd\x55\x69\xbe\x3b\xc3\x68\x2d\x58\x94\x65\x79\xcb\xf4\x96\x07\x33\x1d\xef\x61\x86\x91\x76\x86\x9f\x6b\x8b\x59\xdd\xc9\x84\xdd\x94\x66\x73\x16\xb6\xd4\x34\x4c\x9c\x5a\xe3\x7e\xa4\xa6\xa6\xbe\x6e\xe2\xb4\xba\x69\x93\x26\xd6\x4c\xe8\xb1\xf3\x69\xf3\x94\x2e\x33\x6d\xe6\x92\xb1\x9a\x09\x3d\xd1\x5c\xac\xfb\x94\xde\x69\x53\x26\x4c\x99\x5c\x33\xa1\xab\x66\xc2\x22\x6f\x4a\xae\x4a\xc6\x4d\x23\x1e\x75\x96\xb4\xb8\xbb\xe0\xbb\x13\xcf\x9b\x76\x69\xb7\x99\x68\xf9\x73\x57\xdc\x54\xd4\xf2\x3a\xa5\xcb\x74\xd6\x74\x37\xb4\xbc\x15\xed\x32\x59\x6c\xb9\x73\xf4\x5e\x10\x5d\x6e\xd2\xd0\x0d\xe7\x3b\x9d\xc6\xef\x8e\x7a\x6d\xd9\x13\xed\x33\xba\x9d\xe1\xe7\x36\x4e\x30\x93\xf5\xd6\x96\xd9\x39\x53\x1c\xa1\xb2\x4e\xc5\x86\x9e\xb8\xd3\xd5\xb3\xdc\xd3\x07\xef\x80\x60\x99\xce\xd0\x0b\x67\x53\xc9\x58\xe9\x24\x72\xcf\x20\x58\x36\x97\x89\xb1\xb0\x69\x87\xed\x68\x3a\x1e\xcd\xb9\x07\x6d\x66\x64\x52\x71\x0c\x00\xcb\x8e\x27\xd3\x6e\xb6\xb3\x9b\xe6\x37\xba\x36\xbb\x73\xac\xc1\x5d\xc8\xb3\x19\x6b\x22\x56\x3e\xe7\x34\xc3\x70\x0e\xbc\xee\x41\xc7\xd9\x3d\xc3\x09\xb6\x61\xd1\x82\x05\x8d\x0b\x23\xae\x72\xa1\x73\xc4\x99\x91\xeb\x72\x16\xbc\x54\xa6\xcb\xe9\x22\x1c\x36\x68\xb0\xda\x67\xb9\xad\x42\xab\x6e\x3c\x99\x63\x69\x81\x2d\xaf\xf3\xe2\x45\xf3\xda\xe9\xd1\x64\xda\x48\xc6\x7b\xb1\xb4\x4d\x64\xb1\x1e\xdb\x9d\x4d\x6e\x83\x60\x54\xba\xa7\x52\xc9\x04\x5b\xb0\x68\x56\x23\x4b\xda\x66\xae\x29\x6e\xa6\xed\x64\x22\x69\xe6\x1a\xbd\x25\xd5\xce\xa4\xe8\x30\x6c\xd8\xce\x84\x70\x8d\xc6\x32\x69\xfa\xb4\x10\x8e\x11\xe2\x94\x87\x16\x39\x2b\x9b\x72\x4e\x62\xbc\xc3\xa5\xdb\xe7\x29\xf7\x8c\x2e\x93\x48\x18\x36\xb3\xcc\xb4\x7b\xf6\x67\xd9\xb9\x78\x3e\x4b\xe3\xb7\xe4\x60\x49\x8b\x7f\x43\x26\x6d\x3b\x83\xa4\x27\x9a\x4c\xb3\xa8\x9d\x49\x52\x97\x35\xa5\x13\xc9\x5e\xcf\xae\xbb\x3f\x98\xb7\xba\xbd\xde\x8f\x67\xbc\x55\xa3\x69\x61\xd1\xd1\xc8\x88\x75\x3b\x06\xbc\x93\xa9\x7a\xd6\x64\x35\xa5\xb1\x1a\x7a\xe7\x94\x71\x23\x99\xe6\x53\xd5\xe9\x14\xb7\x21\x98\x11\xcb\xe7\x8c\x58\x26\x95\xef\x49\xbb\x63\x59\x34\x0d\xcd\xa7\xc6\x5e\x67\x4a\xb9\x69\x4d\x99\x6c\xd8\x2c\x9b\x33\x2d\x33\xb7\xd2\x4c\x25\xd8\x71\xdd\x99\x1e\xf3\xb8\x6c\xf2\xb8\x59\x99\x55\xe9\x54\x26\x1a\xb7\x8e\xeb\xca\x66\x27\xd4\xd7\xd6\x1f\x7f\x9c\x95\x8b\xf1\x13\x4f\x77\xed\x9c\x95\xa1\x99\xcb\x1a\xdc\x51\xe8\x6c\x38\x19\x9f\x46\x32\xc0\x5a\x00\x37\x92\x1c\xc2\x3e\x0a\xb4\xd0\x65\xb5\xd2\x4d\xad\x1c\xd1\x55\x8e\x68\x2a\x47\x69\xff\x39\x24\xf7\x64\x95\x36\x7d\x4a\xb8\x0c\xfb\x6c\x92\x15\x2c\x5b\x33\xb0\xcd\x0a\x36\x71\x10\xb6\x02\xac\x45\x97\x9b\xb3\x65\x6f\xf5\xe4\xf7\x6f\xe5\x54\xc0\x5b\x0b\x50\xb5\x02\xde\x51\x10\x29\xb7\x14\x50\xda\x2a\x88\x03\xb9\x59\xc7\xdd\x44\x00\x37\xf2\xda\xe1\x8d\x1a\x37\x11\x8d\x9b\xb0\xde\xc9\x06\xb2\x78\x03\x77\x12\x04\xdc\xc4\xeb\xb6\xab\xe6\xda\xf5\xe6\x36\x51\xdd\xb7\xb9\xb9\xe6\x5d\x04\x97\xf1\xba\xcd\xab\xa5\x98\x03\xac\x59\x36\x54\xc1\x58\x20\x20\xc5\xf8\xf8\xad\xd8\x57\x57\x78\x5c\x72\x54\xc9\xce\xea\x2f\xa0\xf0\x09\xf7\xbe\xf4\xdf\x04\xd7\x14\x8a\x78\x28\x5c\x5e\x10\x06\x11\xcb\xfd\xc5\x44\xff\xa8\xdc\xff\xbd\x2f\xb0\x91\xa6\x5c\x94\xcf\xf3\x80\x4e\x07\x7c\x8b\x07\xfe\xfd\x40\xe1\x2d\xa5\xd9\xda\x00\xa7\xf2\x36\x3a\x1d\x70\x76\xa1\xa8\x2a\x0a\xf3\x38\xaf\x0d\x30\x2c\xaa\x02\x76\x16\x57\x45\x61\xb5\xda\x33\x17\x88\xaa\x80\x6b\x8a\xab\x0e\xa6\x05\x35\xbd\x5a\xc9\x58\x45\x05\xe1\xc1\xb5\x57\xc7\xf3\x52\xd3\x38\x34\xe0\x11\xdc\x53\xc7\x88\x32\x01\x6d\x2d\xd2\x69\x22\xe2\x31\x95\x8b\x68\x29\x77\x15\x59\xaa\xb4\xa5\xdd\xcf\xdb\x72\x8d\xe0\x0d\xae\x8d\x22\x72\x44\x8c\x22\xda\x49\x5f\x69\xfd\x70\x23\xa8\x2d\x80\x8b\x78\xf3\x84\x01\x43\x7c\x38\x59\xfd\x05\x14\xba\x05\x4f\xeb\x85\xfb\xf8\x8c\xfb\x88\x00\x26\x79\xdd\x56\xc0\x33\x49\xea\x17\x6f\xcc\x0d\xd8\x3c\xb8\xa8\x15\x8f\x26\x7c\x92\x58\x16\x00\x67\x72\x55\x08\x70\xae\x98\x05\xfa\xb0\x19\xc3\x67\xfd\x1e\x39\x92\x37\x0e\xe0\xec\xb1\x50\x75\x00\xce\x19\x2b\x02\x6f\x29\xa0\x74\xa6\x20\xea\x9d\xfc\x8e\x2c\xbe\xcd\x9d\x04\x01\x67\xf2\xba\xad\xaa\x39\x9f\x15\x7d\x32\x11\xa6\x71\x62\x10\x70\x06\x57\x85\x67\x48\x31\x07\x58\x50\x36\x54\x31\x50\x74\xa3\xbf\x51\x74\x15\x45\xc6\x44\xcd\x76\xb5\xa6\xb2\xe6\x57\x95\x04\xf2\x07\x7c\x6a\xd1\x19\x82\x28\x8c\x14\x69\xc0\x60\x87\x7f\x66\xde\xf6\x18\xbd\x42\xa0\x62\x39\x8f\xf9\x01\x82\x37\xa6\xb8\xea\x2e\x82\x2f\xa6\x7c\x33\xf3\x96\xe9\xdf\x12\xe1\x0d\x4e\x0c\xfe\x81\xe0\xdf\x85\x2a\xd0\xe3\xc1\x51\x3d\x03\x44\xf7\x17\x0a\xe5\x1f\x3c\xba\x20\x60\x0d\x37\xd7\xde\x97\x92\x03\xf6\x39\x62\x06\x95\x64\xc3\x6a\xb2\x61\x35\x59\xed\x8c\xae\xd0\xe8\x6a\x6e\xf0\xe4\xdc\x1b\x50\xb5\x19\xf0\x43\x92\x95\x6c\xc3\x03\x39\x14\x86\xdf\xc8\x5d\x4c\x27\x18\xe2\xaa\x66\xc0\xf8\x8d\xbc\xea\xae\x1c\x0a\x4f\xde\x52\x64\xef\x5f\x54\x98\xb1\xb1\x48\x89\xc2\x7c\xbe\xe0\x84\x01\x97\x14\xf3\x62\x54\x58\x5e\xac\xcc\x52\xe1\xa2\x62\x25\x0a\x1b\xb8\x45\xe5\xa8\x83\x2d\xc0\x8a\x8e\xdd\xec\x4f\xd7\x7b\xda\x0f\xae\xc7\xde\xe0\x02\x29\x39\x67\xe9\x02\xbe\x94\xb7\xc1\x32\xc0\x2b\x79\x1b\x9c\x1b\x28\xac\xbc\x89\x82\xbb\x49\xd4\x9d\x75\xb3\x87\xa3\x37\x0b\x1d\xf0\x79\x37\x73\x7b\x4f\x10\x7c\x4e\xa8\xae\xa5\xc6\xbb\xf1\x16\x51\x13\x78\xf3\x2d\xa0\xb5\xa2\xc1\xff\xc8\x55\xfa\x41\xeb\x2e\xcc\x88\xf0\xae\x9b\x78\xfd\x77\x08\x0e\x13\x9e\x01\x21\x2b\x59\x36\x50\x40\x61\x32\xe7\x05\xd1\x43\x89\x8d\x65\x5d\xdf\x29\x0d\x8d\x00\x6b\x97\xfb\xd6\x77\x6a\x30\x36\x9f\xc6\x64\x98\x8f\xdd\x60\x58\x1a\xbb\x01\xd6\x2a\x8f\xb4\x00\x6b\x95\x87\x4a\x99\xd3\x6c\xc6\x96\x91\xa9\x1e\x92\xd5\x6c\x25\xcb\xb2\x23\x26\x1e\x52\x80\x66\x5d\xd1\x1e\xdb\xdd\x73\x13\x69\xb6\xf3\x30\xc2\x23\x36\xca\x61\x84\x11\x46\x9f\x50\xe9\x02\xe8\x97\xdc\xec\x01\x37\x93\x25\x93\x15\xcc\xf2\xc9\x01\xf1\xdc\x7e\x83\x60\xde\x4d\xf8\x51\xd1\x7a\x72\xbb\xf8\x1b\xfc\x4c\x19\x1a\xa1\x03\x09\x4e\x14\x2a\xb5\x2f\x43\xb2\xa1\x0a\xb6\x94\xfa\x67\x19\x1f\xcc\xfd\xea\x9f\x69\x01\x83\xf6\xda\x03\x8c\x65\x65\x3b\xea\x5c\x4f\x4e\x38\x97\x57\x04\xfc\x39\x57\x19\x7b\xf6\x7b\xf0\x93\x7e\xae\xfa\xaf\xd5\x1e\xfc\xed\x6a\x5e\x11\x70\x27\x57\x19\x7f\x26\x38\xfa\x3c\xae\x9a\x4e\xb0\x43\xa8\x7e\x74\xb9\x07\x1f\xbd\x9c\xab\xee\xde\xe0\xc1\x47\x36\x70\xd5\xf8\xab\x3d\x98\xb8\x46\xc4\x75\x9d\x07\x4f\xe6\xcb\x90\xa1\x34\x8d\xbb\x62\x75\x52\x2e\xab\x48\x3a\x7f\xc6\xbe\x29\x25\x55\xc1\x4e\x28\x74\x90\xc1\x8f\xaf\x83\xee\x7b\x9a\x73\xb5\x0a\xad\xc5\x7a\x8d\xc5\x7a\x8d\xc5\x7a\xed\xdf\xfd\xdf\x64\x43\xcf\xfc\x5e\x74\x1a\xe0\x9e\xa2\x87\x10\x45\xab\xe8\x0e\x40\x6b\xb5\x68\x06\xe0\x7e\xd1\x91\x80\xf7\x8b\x9a\x80\xff\xb5\x5a\xa4\x81\x4e\x3d\x49\xf4\xe4\x2b\x04\xdf\x38\x4f\x38\x98\x72\xbe\x87\x4f\x3e\x5f\xe8\x3e\x26\xfc\x79\x91\x6e\xca\x05\xc4\xbb\x40\xe8\xee\x25\xfc\x60\x91\xee\xe2\x0b\x3d\x7c\xd9\x85\x22\x94\xef\xae\xf1\xf0\xc4\x35\x82\xf7\x3e\xe1\x0f\x8b\x74\x27\xae\xf5\xf0\xf4\xb5\x42\xf7\x15\xe1\xca\x75\x42\x77\x3e\xe1\x8b\x8a\x74\xcb\x2f\xf2\xf0\x8a\x8b\x84\xee\xed\x4b\x3d\xfc\xf1\xa5\x22\x16\xe0\x7d\x2e\x13\xbc\x03\x08\x2f\x29\xd2\xbd\x73\xad\x87\xff\x76\x2d\x6f\x3e\xa6\xdd\x02\x25\xa5\xb7\xa8\xc9\xde\x3f\x1f\x7b\x83\x07\x52\x86\x47\xad\xe1\xaa\x5f\x13\xfc\xbd\x50\x5d\x7d\xb1\x07\x0b\x17\x23\x86\xba\x02\xf0\xd6\x8b\x41\x0b\x03\xfe\x94\x64\x25\xb3\x02\x85\x47\x29\xee\x67\x2e\xe3\xe6\x1a\xaf\xf0\xe0\xa2\x2b\xb8\xea\x26\x9a\x99\x77\xf2\x19\x1a\x04\x7c\x99\xab\x94\x63\x49\x40\x93\x64\x69\xf9\x0b\x72\xc1\xae\x14\x91\x9f\xb9\xde\xc3\xb9\xf5\x42\x07\x7c\xce\x7a\xd8\x68\x7f\x95\xe0\x47\x45\x34\xe0\xaf\x38\x2d\x08\x38\x64\x03\x92\x3e\x37\x50\xd8\x9f\x0a\x57\xf2\x35\x68\x19\xe0\xd5\xc5\x3c\x14\x36\x72\x5e\x10\xcb\xd6\x93\x1b\x84\x5b\xe0\x5f\x71\x5a\xfb\xd1\x57\x79\x70\xd6\x55\x82\x06\xbc\xe0\x2a\x6e\x0d\x30\x72\x15\xbc\x9e\x13\x28\x98\x54\x78\x8b\xf3\x3a\x00\x77\x16\xf3\x50\xf8\x50\xd8\x63\x3e\xdb\x78\x6a\xe7\x89\xa2\x4b\x27\x4a\x4d\x1f\x60\x1d\xc8\x6d\x3b\xcf\xa3\x83\xdb\xbe\x9a\xab\x74\xf6\x31\x02\xaf\xe7\xa3\x2d\x08\xf8\x12\x57\x95\x39\xd7\x70\xb6\xb3\x28\x90\x0b\x79\x40\x41\xc0\x97\xb8\x2a\x8c\x63\x44\x5e\x8c\x44\xc0\xc7\xb9\x2a\x0c\xf8\xb4\x60\x3d\xad\x8c\xd7\xf0\x47\x04\x87\xf0\x79\xaa\x3d\x0f\xb9\x96\x68\x37\x0a\x63\x37\x2a\xc6\xb4\x27\x6b\x9b\x29\xee\x9f\x8a\x94\xb6\x13\x7c\x41\xa8\xf4\xcd\x71\x23\x11\x36\x0a\xe2\x46\xa5\x39\x5a\xd5\x14\xb4\xad\xfc\x3c\xad\x84\x2f\xae\xe5\xc6\x00\xbf\x5a\x5b\xb6\xe6\xbb\xb4\xfb\x2f\xa2\xe6\x5f\x94\x9a\x4a\xf2\x81\xf2\x35\xff\x5a\xae\xe6\xbb\x0a\x49\x1b\xd8\x74\x3a\x48\x34\x5e\xc8\xcd\x03\xa6\x2f\x2c\x5b\xb3\x83\x76\x47\x45\xcd\xa8\x52\x53\x9b\x52\x99\x9a\x9d\xe5\x6a\x76\x28\x24\x6d\x60\x97\x5f\xe2\xc9\xf5\x97\x70\xf3\x80\xf7\x5c\xe2\x5b\x33\x30\x98\xfa\xd7\x8b\xfa\x80\xb7\x72\x55\x18\xf0\x0e\x92\xee\xb5\x8a\x3b\x14\xd7\x9a\xd9\x71\x00\x1d\x32\xbf\x7d\x11\x48\x9a\xfb\x03\x6f\xd2\xce\x2f\x38\x49\x3b\xe6\x7b\xe8\x90\x6c\xaf\xe3\x34\xc0\xf3\xd7\x95\x89\xc1\xd9\x42\x74\x68\x6e\xb9\x94\xd7\x05\x7c\x9b\xab\x5a\x1f\xa3\x59\xf2\x5c\xb9\xd9\x72\x31\xf1\xaf\xb9\xd4\x3f\xa5\x80\x38\x15\x14\x67\xce\xc1\x0b\x94\xb3\x2b\x5d\x9e\x81\xef\xd2\x6e\x71\xfa\x16\xc4\x09\x97\x38\x9b\xd6\xd4\xb4\x69\x67\xff\x79\xfe\x81\xb1\xbf\xd3\xce\x2f\xcb\x91\xc2\x74\x86\xb1\xec\xfc\x72\x24\x3a\x33\x5b\x76\x41\x19\xd2\x2f\x69\xe7\x6b\xe5\x48\x77\xd0\xb0\xbf\xff\xc2\x32\xa4\xb9\x74\x3e\x13\x5e\x53\x86\xf4\x6d\x5a\x13\x26\xaf\x2d\x43\x5a\x4c\x3b\x3b\xcb\x91\x0e\xa6\x11\x75\xd4\xba\x32\xa4\x02\xed\xbc\xa7\x1c\xe9\x22\x1a\xd5\x57\x97\x9b\x02\xbb\xe9\x28\xf8\xc5\xc5\x65\x48\xaf\xd0\x4c\x7b\xfb\x12\x5f\x52\x05\xc3\x9f\x1d\x69\x92\xc3\x58\xe5\xfa\x2a\xf9\x1c\x4b\x6c\x7d\x44\x5b\x7d\x1d\x6c\x06\x01\x6f\xe0\x2a\x9f\x49\xc5\xd8\x26\xba\xec\xf7\x13\x7e\xf9\xaf\x05\x70\xdf\x2c\x54\xed\x80\x07\x90\xf4\x2e\x28\xa3\xf4\xa0\x20\xea\x9d\xac\x4b\x7b\x72\x24\x27\x76\x8c\x94\x2c\x0e\x63\x95\xbd\x94\x24\x54\x93\x05\x5b\x36\x58\x89\x3d\x01\x91\x41\x80\x9c\x38\x27\x62\xc0\xa7\xa6\x79\x03\x00\x36\x70\x55\x10\x70\xb5\x60\xc1\xe7\x74\xee\x3b\xdc\x4a\x70\x53\x56\xd8\x97\xe3\x19\xa2\x4d\x1b\x91\x1d\x54\x14\x99\x45\xb8\x5f\x84\x01\xb8\x0f\xf7\xd9\xaa\x36\x41\x2b\xe0\x69\x45\x61\x20\xb4\xe5\x45\x3a\xe0\x3e\x5e\x35\x08\x78\x55\x11\xed\x2a\x29\xab\x00\x0b\x02\xde\x5b\x44\xbb\x57\xe9\x62\xbf\x33\x50\xb7\xef\x5e\x2a\xd3\x17\x7b\x93\x74\xcf\x6c\xd1\x24\x16\x6f\x86\x0e\x4b\xea\x0d\x97\x27\x67\xa3\x0b\x5d\xd7\x21\x64\x34\xc0\xd8\xcf\x29\xa4\xcf\xf9\x00\xef\xf8\x5c\x8a\xb2\x92\x65\xcf\xe5\x61\xc2\x7d\x35\xdb\xf0\x50\xff\x35\x43\xea\x0f\x29\xc8\x63\x42\xec\x91\x87\x86\x66\xa4\x06\xd8\xd7\xf0\x92\x81\x46\x77\x83\x3d\xb0\x07\xed\x1e\xce\x69\x41\xc0\x43\xb8\x4a\x33\x8d\x57\xd3\xce\x3b\x48\xee\xc9\x2a\xad\xc7\x3d\xa8\x69\x15\xb0\x6f\x27\xb9\xb7\x98\x76\x43\x8f\xd4\x3c\x43\x20\x5b\xd7\x3f\x43\x20\x5b\xd5\xa6\xf8\x10\xed\x7c\x59\x04\x9a\xf7\x0f\x14\xec\x97\xb8\xe3\x73\x34\xe1\x69\x58\x63\x06\x66\x69\xc3\xfb\x23\xed\xfc\xa0\x6c\x37\xb1\x1d\x74\xdd\xf0\x2f\x16\xa7\x01\x56\xdb\x08\x22\xaf\xab\xc9\xab\x7e\xcc\xab\x46\x3e\x56\xaa\xda\xda\xaa\xaa\x11\xd4\x08\xb0\x10\x60\x1b\x57\x85\x01\x1f\xa0\x48\x9c\xf5\x3a\x54\x40\xe9\x85\x3c\x27\x02\xfe\xa5\xaf\x98\x88\xd2\x3f\xfb\x38\x11\xf0\xe9\x73\x8a\x89\xdf\x34\xd6\x66\xc0\x85\x5c\xd5\xba\x50\x13\x6b\xb3\x26\xd6\x56\x5d\xac\xcd\x9a\x58\x5b\x75\xb1\x36\xfb\xc4\x7a\x53\xaf\x27\x1f\xe9\x45\xf5\xe0\x5f\x09\xd6\x88\xec\xa7\x9d\xe5\xc1\x59\x67\x71\x55\xb9\xc4\x9b\x28\xa5\x10\xcf\x32\x18\x92\xb2\x0c\xb0\xf6\xff\x54\x52\x0c\x02\xb6\xad\xe4\x2c\x63\x65\x69\xd6\x01\xd6\x2e\xe7\xe7\xf3\xb7\xcc\x40\x5b\x99\x28\xe3\x5c\xd5\x0e\xd8\x27\x46\x17\xe0\x79\xa2\x22\xe0\x6d\x62\x58\x02\xde\x2b\x6c\x01\x7e\x4b\x0c\x40\xc0\x89\x5c\xd5\x02\x38\x8d\xa4\x7b\x15\x0b\x85\x93\x44\x63\x01\xc6\x84\x35\xc0\x2b\x05\x0b\xf0\x4e\xae\x0a\x01\x3e\xa0\xce\x83\x57\x85\x0a\x23\xe2\x19\x31\x32\x30\x22\x8e\xe1\x7d\xd1\x02\x38\xbe\xaf\x28\x58\xb9\xa5\xd5\xdb\x93\x68\xfb\x67\xf9\xc4\xaf\x2b\x00\x8b\x7c\x3a\x62\x52\xf0\x0e\x4d\x8d\xbe\x03\xd1\x2f\xe5\xc3\xa6\x03\xc3\x46\x8c\xeb\x0e\x39\x21\xc7\x18\xb0\x18\x5d\x1d\xea\xe8\xd2\x1c\xd4\x58\xd1\x63\x4a\xff\x9b\x36\xb4\xe1\x3f\x8b\xda\x15\x78\x08\x1f\x4f\xfd\x81\x02\x0a\x47\xf1\x56\x34\x8e\x92\xc6\x93\xcb\x53\x5b\xdb\x40\x6b\x0f\xe1\xad\x6d\x00\x4e\xe6\xaa\x66\x74\x40\xaf\x50\x01\xae\x15\x15\x01\x5f\x12\x2c\xc0\xea\x55\x9c\x05\x78\x08\x57\x35\x03\x8e\x13\x2c\xc0\x93\x05\x0b\x70\xae\x60\x01\x2e\x11\x2c\x40\x53\xb0\x00\xfb\x04\x0b\x70\x8d\x60\x01\x5e\x27\x58\x80\xb7\x0b\x16\xe0\x83\x82\x05\xf8\x84\x60\x01\xbe\x27\x58\x80\x1f\x08\x16\x60\x1d\x1f\xd6\xcd\x80\x66\xaf\x88\x9e\xe0\x8d\x82\x75\xa3\xb2\xce\x1b\x18\xfb\xff\x12\x2c\xc0\xf1\x7c\x3a\x18\x80\xd3\xb9\xaa\x19\x70\xbe\x60\x01\xe6\x05\x0b\x50\x4c\x2d\x03\x53\xab\x82\x1f\x46\x9a\x01\x2b\xb9\xca\x00\x1c\x2e\x58\x80\xfb\x0a\x16\xe0\xc1\x82\x05\xf8\x2d\xc1\x02\x3c\x5a\xb0\x00\x8f\x11\xac\xf1\x04\xa7\x0a\x16\xe0\x34\xc1\x02\x6c\x10\xac\x06\xe5\xb8\x68\x00\x2e\x12\x2c\xc0\xa0\x60\x01\x9e\x2e\x58\x80\xed\x82\x05\x98\x15\x2c\xc0\x5e\xc1\x02\xbc\x45\xb0\x00\x7f\x24\x58\x80\x4f\x0b\x16\xe0\xaf\x05\x0b\x70\xb7\x60\x01\xa6\xbe\xcf\x59\x80\xab\xb8\xaa\x19\xb0\x57\xb0\x00\x2f\x13\x2c\xc0\x1b\x04\x0b\xf0\x6e\xc1\x02\xfc\x89\x60\x01\x3e\x27\x58\x80\xef\x08\xd6\xdc\xb3\x3d\x18\x39\x9b\xab\x1e\x20\xb8\x5d\xa8\x00\x3f\xe4\xaa\x66\xc0\x51\x7c\xcd\x37\x00\x4f\xe2\xaa\x66\xc0\x82\x60\x01\x6e\x16\x2c\xc0\x9f\x09\x16\xe0\x53\x82\x05\x28\x8e\x32\x3e\x77\xd0\x18\xfb\x87\x74\x5c\x1c\xca\xce\x65\xd5\x5f\x1f\xac\x2c\xcd\xd0\x63\x7d\x7e\x43\xd2\xd7\x48\x87\x6a\xe8\xf5\x5e\x71\x5a\xba\x4c\x9c\xa9\xe2\x8c\x66\xab\x50\x01\xfe\x82\x1f\x6f\xf4\xcf\x15\x24\xa5\xb3\x28\x87\xd7\xab\x9c\xac\xf8\x5c\xf9\xbd\x50\x39\xb9\x6a\x45\x9d\x6b\x44\xdd\x6b\x94\x73\x85\x15\x9a\x40\x02\x45\x07\xc7\x21\xa2\x32\x4e\xaf\x9a\x84\xaa\x49\x3a\x1c\xfa\x3f\x32\x81\x6d\x67\xd4\x93\x07\x77\xc2\x48\x0b\xe0\x7b\x31\xa8\xc2\x80\x1f\x73\x55\x0b\xe0\x27\x24\xbd\xcb\x4b\x28\x6d\x8c\xf3\xba\x80\x85\x78\x31\x11\xa5\x17\xbb\x39\x71\x70\xe1\x45\x00\xc7\x73\x95\xf6\xb2\xfd\x08\xaa\x79\x6c\x94\x87\x0c\x78\x22\x57\xf9\xde\x9a\x1b\xa1\x50\x23\x80\x67\x73\x55\x18\xf0\xc2\xa8\x48\x2d\x52\x40\xe9\x37\xd1\xb2\xa9\xe9\xdd\x84\x00\xfb\xb9\xaa\xbd\x5f\xe3\x26\xa4\x71\xe3\xfb\x68\xd2\x4c\xa2\x34\x8a\xc4\x01\x2f\xe0\xaa\x65\x17\x48\x6e\x02\x2c\xa8\xfa\x58\xe6\xe7\xa3\xac\x97\xf3\xb8\xaa\x03\x26\xb7\x08\xd5\x8f\x08\x3e\x29\x54\x4f\x4a\x8e\xdd\x0b\x4b\xb2\xc3\x00\x6b\xa2\xdd\x5d\x24\x75\x97\x3a\x54\x96\xf6\x6f\x2f\x95\xa4\x19\x56\xeb\xcf\xf0\xe4\x0f\xce\x00\xc9\xf7\x87\x17\x2a\x35\x02\xf8\x7b\xae\x0a\x03\xfe\x81\xa4\x37\x82\x78\x69\xe0\x11\xa4\xba\x09\x01\xbe\xc3\x55\xed\xef\x68\xdc\x84\x34\x6e\x34\x23\xe8\x63\x22\x55\xf8\x4f\x9a\x00\xbb\x9f\x48\x2f\x80\xac\xed\x07\x99\xa5\xed\x07\x95\x54\xe6\x0e\x75\x8d\xf7\x0b\x20\x36\x25\x07\x72\x0b\xe0\xf9\x5c\x15\x06\x9c\x69\x89\xec\x5b\x0a\x28\xdd\xcb\x2f\xed\x84\x01\x1f\x2f\x21\x0e\xec\x7e\x3c\xf7\x15\x01\x7c\x82\xab\x5a\x01\x3f\xcd\x09\xab\x91\x02\x4a\x8f\x72\xf7\xad\x8f\x6a\xdc\xeb\x7e\x78\x84\x0d\x79\xad\xe5\xbe\x82\x80\x2f\x8a\xec\x01\x5f\x16\x6d\xf4\xa9\xd4\x20\x45\xa9\x8b\x70\xf4\x43\x2e\x80\x6c\x9e\x12\x4e\x01\x9f\x15\x1e\x00\x5f\x11\xcd\xa0\xcf\xe1\x2a\x22\xdc\x48\xb2\x92\x6d\x98\x55\x40\xe1\x76\x5e\xbb\x19\xf0\x8e\x62\x9e\xbf\x39\x51\x53\xb3\xc4\x5f\x25\xd9\xd2\x5f\x8c\x96\x59\x3e\x2d\x82\x2f\x84\x86\x3b\x40\x6b\x01\x3c\x80\x16\x4b\xff\x61\x74\x36\x31\xd7\x74\x88\x48\x80\xd7\x77\x94\xf5\xcb\x58\xcd\x3c\x4f\xd6\xce\xe3\x9e\x01\x4f\xe7\xaa\x76\xc0\xaa\xf9\x03\x05\x53\x6a\x75\x11\x37\x11\x01\xfc\x35\x57\x85\x01\xdf\x9c\x27\xac\x46\x0a\x28\x7d\x21\x88\x5f\x68\xdc\x97\x1b\xd3\xaa\xfb\x10\xe0\x4b\x5c\xd5\xfa\x92\xc6\x7d\x88\xbb\xff\x52\x10\xbf\xd4\xb8\xd7\x5f\x02\x55\x1d\x37\x2f\x92\x2a\x57\xb2\x0d\x37\xf4\x0f\xae\x6e\x9b\xa6\xee\xe6\x41\xd6\x5d\xa2\xa9\xbb\x4d\xa9\x1b\xa0\x9f\x02\xb5\x11\xf9\x31\x5e\x7f\x19\xe0\xe3\xf3\x50\xff\xdc\x40\x01\x85\xd7\x04\x0f\xed\x55\x31\x9f\xab\x64\x2f\x43\x4a\x62\xcc\xa0\x02\xab\x2b\x00\x3f\xcf\xed\x75\xc0\x1e\xe3\xf6\x34\x8f\x25\xb5\xa9\xc9\x3d\xa4\x6d\x18\x0c\xdc\x57\xc4\x58\x86\x83\x7f\x0b\x95\xae\x26\x63\x75\x73\x3c\x79\xe3\x1c\x10\x5b\x00\x37\xce\x41\x0e\x16\xc7\x87\x15\x0d\xa3\x72\x73\x43\xe7\xe2\x0c\xee\x22\x02\xf8\x24\x57\x2d\x03\xfc\xdd\x5c\xe1\x21\x52\x40\x69\x66\x13\x27\x02\xe6\x4f\x2b\x26\xa2\xb4\xe9\x34\x4e\x04\xfc\x51\x09\x11\xa5\x8f\x05\x11\xb0\xaa\x64\x8a\xa2\xb4\x9f\x18\x06\x80\x87\x95\x10\x07\x93\xfb\x46\x9e\x68\x08\x70\x36\x4f\xa9\x75\xb6\x26\xa5\x10\x4f\x49\x44\xda\xaa\x8b\x34\xc4\x23\x3d\x4c\xcc\x66\x4d\x28\x25\x57\x34\xd5\xb8\x9a\x01\x83\xe4\xa3\x92\x6d\xb8\xa8\x1f\x85\xe2\xc8\x9a\x79\x64\x7d\xc5\xd4\x3e\xa9\x07\x3c\x2a\x4a\x6c\x5e\x11\x95\x49\x4d\xeb\x51\xe5\xd6\xf5\xa8\x3e\x9b\x9a\x40\x1b\x60\x9a\xb7\x57\x7b\x5a\x13\x7e\x1b\x0f\xff\xb0\x81\x26\x87\x9f\xab\x25\x80\xef\xd3\xf0\xac\x60\xab\x38\xae\x6e\xe2\xe1\xaf\xee\x47\xe1\xcc\xd3\x04\xf1\x4c\x29\x28\x97\x28\xc7\xe4\x10\xe5\x2d\x20\x49\x67\xdb\x4e\x91\x3c\xcd\x27\x6b\x5d\xa1\x8a\x22\x99\x3d\x17\xfc\x65\x0b\x09\xae\x10\xaa\xcd\x04\x5f\x13\xaa\x20\x45\xdb\xdc\x24\x8c\x5d\x45\xf8\x27\x62\x06\x3e\x46\xf0\xf7\x42\xb5\x37\x85\x7f\xb2\x3a\xab\x98\xff\xfc\xd1\xfd\x91\x14\xa8\xe0\xb9\xbc\xc6\x1b\xbc\x23\xa5\xc4\xaf\x7b\x8e\x33\x80\x9a\xff\xc5\x6b\x06\x5f\x56\x8c\x69\xce\xca\xff\x48\x3b\x3f\x2f\x6a\xc5\x81\x59\xba\xf3\x8d\x40\x05\x6f\x3d\x53\x4c\xf0\xd7\x95\x16\xd3\xce\x50\xd4\x5c\xca\x69\xc1\xa8\x62\x4c\x13\x7e\x96\x76\xae\x6b\x2a\x17\xbe\xcc\x2a\x77\x9b\xaf\xc1\x13\xf5\x0d\xa0\xb6\x00\x3e\xc3\x55\xcb\x00\x9f\x23\xe9\x1d\x14\x50\x7a\x43\x10\x07\xef\x26\x02\xf8\x04\x57\xb5\x3f\xa1\x71\x13\xd1\xb8\xd1\x4d\x62\x2c\x7a\x8a\xa3\x10\xe0\x45\x5c\xd5\x0a\x78\x3b\x49\xa7\x19\x81\xb7\x0b\x9a\xea\xb9\xfc\x93\xc1\x8a\xef\x66\xc0\x87\xb9\x2a\xfc\xb0\x94\x64\x80\x05\x01\x5f\x15\x2c\xc0\xd7\x05\xeb\x75\x25\x1a\x7d\xaf\x2a\x51\xb4\xd5\x4b\x35\x2b\xd9\x86\x2d\x3e\xeb\xed\x7c\xa2\x04\x85\x5f\xc0\x67\xb9\xca\x50\x1b\x46\x7b\xd5\x13\x6f\x6d\xfd\x19\xff\xb5\x74\x0b\xe0\x8a\xad\x9e\x1c\xf8\xf4\x02\x46\x5e\x10\x3f\xb9\x06\xfc\xd7\x6d\x50\xb5\x02\xd6\xdf\x8e\x14\xfb\x7b\x0b\x28\x0c\xf9\x81\x70\x16\x29\xa0\x34\xe2\x07\xbc\x36\x60\x71\x54\xea\xe1\x9e\xaf\xc6\x18\x6b\x83\xbf\x75\x18\x28\x7a\xdf\x80\xbb\xfd\x8e\xe2\xfd\xf3\x6d\x88\x77\x65\x75\x01\x85\x87\x6e\x87\x43\x03\x70\x07\x57\x85\x01\x97\xdc\xc1\x59\x80\x29\xae\x0a\x03\x5e\x2e\x58\x6f\x13\xfc\xd3\x9d\x5c\x05\xc8\xee\xe2\x15\x01\xcf\xe7\x2a\x03\xf0\x87\x82\x05\x38\x84\x37\xa2\x21\xb7\xab\x9b\xd2\xe1\x54\x98\x20\x78\x8b\x08\x2e\x16\x2a\xc0\x4e\xae\x0a\x03\xbe\x20\x58\x80\xaf\x0a\xd6\x2e\x82\x9f\x0a\x16\xe0\x8c\x1f\x72\x16\xe0\x42\xae\x32\x00\x63\x82\x05\x78\xa7\x60\x01\x3e\x29\x58\x80\xe6\x66\xce\x02\x7c\x88\xab\xc2\x80\x91\x7b\x39\x0b\xf0\x42\xae\x0a\x03\xbe\x2b\x58\x80\xa3\xb7\x70\x16\xe0\xd1\xf7\x73\x16\xe0\xe4\xfb\xd1\xd4\xfd\xd5\x05\x14\x6c\xce\x0b\x03\x6e\x16\x55\x01\xef\x2f\xae\x8a\xc2\x93\x42\x39\xa2\x80\xc2\xf0\xad\xdc\x1e\xa0\xb9\x55\x24\x4f\x70\x85\x60\x31\xcd\x56\xfa\x0b\x9b\x4f\x90\x30\xcf\x32\x08\x88\x6c\xdd\x3f\x0e\xf7\xba\xbf\x34\xf5\x4a\xb6\xa2\xa2\x80\xc2\x0c\x9e\x54\x64\x86\x94\xba\xfb\xd7\xe1\xe5\x52\xf2\x6e\x65\x14\x5e\x17\x95\x5f\x97\xf2\x74\xff\xfa\x3a\x98\x0a\x87\xf3\xb4\xca\xfc\x9a\x11\x73\xec\x66\x92\xce\xe1\x04\xf8\x6e\x31\x25\x01\xef\xe3\xaa\x16\xc0\xfb\xef\x80\x6f\xab\xbf\x80\xc2\xdb\xa2\xaa\xec\xb1\xe8\x49\x0c\xfe\x42\x8e\xe5\xd2\x12\xe8\x44\x01\x7c\x39\xb7\xbf\x61\x53\x3f\xec\x0e\xf9\x41\x91\x52\x9e\xbd\x4e\x6d\x79\xf2\xba\xc4\x45\xd2\x5c\x75\x95\x28\x3c\xc6\x6b\x4f\x2c\x00\x2f\xd9\x5a\x44\x5c\x22\x8d\x20\x87\x28\x0f\x21\x97\x38\x70\xc6\x83\xcc\xf7\x4e\x5d\xbe\x77\x0e\x36\xdf\x3b\x79\xbe\x5f\x14\x2b\xbf\x90\x16\x19\x27\x0d\x60\x73\x6b\x11\x51\xce\x4d\x77\x15\xb8\x22\xf0\xcd\x1f\x44\x41\xf2\x9d\x45\xe1\xcb\x9d\xe0\xb6\xe3\x63\xca\xa2\xb9\x74\x97\x94\x91\x53\x17\xf8\xe4\x1f\x16\x05\x8f\x8c\xf6\xda\x2c\x88\xc0\x53\xf8\x62\xb7\x14\x30\x54\x44\x03\x6e\x11\xb4\x16\x69\x99\x74\x67\x38\x0a\x9f\x14\xd5\x05\x1e\x76\x37\xaf\x0b\xf8\xf0\xdd\x82\x06\xfc\x73\x41\x03\xfc\xdb\x3d\x45\x2e\x50\x18\x7e\xaf\xa8\x0b\xbc\x1f\x5f\x79\x97\x02\xd6\xdd\x5b\x54\x17\x85\xc7\x8b\xea\x02\xbf\x2a\xea\x02\xfe\x74\x8b\xa0\x01\x3f\xc2\xd7\xb8\xa5\x80\xcf\xdf\x57\xe4\x02\x85\x25\x5b\x45\x5d\x79\x96\xb8\x3d\xa9\x99\x26\x77\x96\x9b\x26\x78\xb7\x51\xf1\xbf\xc1\x4e\x9a\x1b\x74\x93\xe6\x86\xc1\x4e\x9a\x1b\xf8\xa4\xd9\x6b\x73\x91\x52\x1e\x3a\xee\x2f\x6e\xa5\xfe\x77\x89\xf2\x00\x70\x88\xf2\x08\x70\x89\x0f\x4b\xfd\xed\x10\x81\x1f\xbf\xb7\x88\x28\xf7\x99\x43\x94\x3b\xcd\x25\xfe\x54\xea\x22\x87\x08\x5c\xd4\xf0\xea\x35\xcf\x92\x67\xe3\x06\xdd\xd4\xce\x1f\xff\x72\x4b\x3b\xba\xc1\x34\xb4\xc3\x5b\x24\xcd\x6e\x47\x87\xe9\x2d\xc6\xfb\x2a\x8e\xeb\x8a\xf2\x07\x16\x4b\x93\x7a\x21\xe2\xff\x2c\xab\x4a\xb6\xe1\x1a\xdd\x00\xba\x66\xb0\x03\xe8\x9a\x7e\x39\x31\x57\x89\xcc\x42\x9b\x8b\x94\x21\x69\x59\x71\x52\x03\x2e\xea\xb1\x6b\x34\x57\x8b\xaf\xa4\x93\xd8\x9b\xf8\x79\xad\xef\xad\x4b\x67\xab\x9b\xe9\xc9\x59\x33\x39\x1d\xd0\xe0\xaa\x66\x40\x93\xe4\x50\xe7\x2f\x9c\x89\x87\x14\x50\x7e\x4e\x50\x01\x7f\x2d\x0c\x02\x7e\x2c\x58\xba\x50\x2e\xa0\xdd\xeb\x49\x56\xb3\x2c\xcb\xbb\x2f\xb6\x80\xe6\x66\xee\x3e\xcf\xb2\xec\x88\x32\x7f\x62\x6d\x9b\xe1\xc9\x37\x67\xf0\x28\x00\xbf\xe6\xaa\x56\xc0\xbd\xc8\xae\xf7\x87\x1b\x4a\x63\x78\xb8\xad\x80\x47\x94\x10\xbf\x89\xfb\x88\xea\xbe\x5d\xe7\x3e\xc2\xdd\x1f\xca\xdd\xb7\x1f\xaa\x71\xef\x77\xe5\xf6\x05\xc5\x75\xe8\x3d\x82\x9f\x0b\xd5\x5e\x92\x45\xcd\x3b\x32\x86\xf8\x66\xe3\x0c\x70\xe0\x5d\x24\xdd\x67\x6b\x51\xf8\x1b\x57\x9e\x13\x28\x7c\xaa\x24\x1e\x86\xf7\x03\x66\x16\xf1\xf4\xe9\x38\xdb\x74\xaa\x79\xa6\xe8\x4c\xc0\x2b\x45\x6b\x02\xde\x34\x43\x34\x52\x4b\x01\xa5\x7b\x05\x11\x70\x5b\x09\xf1\x9b\xb8\x8f\xa8\xee\x3b\x74\xee\x23\xdc\xfd\x7d\x82\x78\x9f\xc6\xbd\xda\x99\x95\x5a\xd7\xee\x2f\xf4\x15\xdf\x61\x78\xb9\x4b\xa8\xb4\x99\xb8\x8b\xdd\x88\x53\xbd\xc2\x41\xa7\x82\xdc\x02\xf8\x37\xae\x0a\x7f\x40\xf0\xb6\xe9\x5c\x05\x78\xf7\x74\x84\x68\x55\x14\x50\x78\x66\x7a\x59\xd7\x87\x91\xb9\x9f\x71\x5a\xf0\x61\x82\x4f\x0b\x95\x12\x2f\xaf\x77\xd4\xa9\xa2\x01\xb4\xa9\x0d\x19\x52\x5d\xbd\xf7\xde\x23\x46\x8c\x1e\x3d\x66\xcc\x21\x87\xd4\xd4\x8c\x1d\x3b\x6e\x1c\xed\xba\x01\xa9\x90\x1c\xc2\x72\xdb\x59\x61\x3b\x95\x9e\x13\xda\x9d\xac\x50\x47\xc1\xd4\xf1\x1c\xab\xeb\x74\xca\x89\x3a\x65\xbd\x4e\x39\x49\xa7\x9c\xac\x53\x1e\xaf\x53\x4e\xd1\x29\xa7\xea\x94\xd3\x74\xca\x13\xb8\xf2\x7b\x42\x79\x62\x61\x35\x15\x2e\xe5\x6d\x1f\x02\xbc\x8a\xab\x9a\x6f\x21\x78\x97\x60\xc9\xcd\x1e\x60\xf3\x68\x67\x88\xe4\x50\xb6\x42\xfb\xe4\x9e\x60\xa6\xca\x74\x78\xe5\x6a\xea\x8e\xd5\xbc\xc3\x4f\x54\x4d\x29\x2c\x8d\xa9\x2a\xbe\x73\xcd\xa9\x3c\xf3\x1a\xc5\x96\x4a\xd3\xbe\xdc\x0e\x3b\x2f\xe3\x61\x9d\xa0\xf9\x25\x81\xcc\xd2\x0e\x69\x79\x38\xee\xe1\x0c\x47\x7d\x8b\x81\x7a\x67\x39\x7b\xf2\x40\xde\xc3\x19\xc8\x7a\x7b\xa0\xbe\x50\xce\x1e\x66\xfe\xa7\xe5\x7a\x40\x61\x69\x5b\x0d\x3b\xbf\x28\xd7\x03\x2a\x4d\x6b\x0b\x3b\xf7\x98\x0e\x8d\xae\x07\x64\x96\xd6\xd4\x18\xda\x79\xf8\x74\xff\x0c\x55\x96\xce\xd4\x90\xa1\xd5\x7b\xee\x3d\x7c\xc4\x7e\xa3\x0f\x18\x73\xf0\x21\x87\xd5\x1c\x31\xf6\xe8\x71\xff\xef\xad\x20\xff\x27\x59\x07\x58\xf0\xff\x47\x2a\x39\x43\xe7\x30\x29\x2f\x91\x58\xd7\xe4\x75\x72\x28\xcb\xf9\xcc\x36\x58\xb8\xc5\xdf\x51\xf1\x96\x3d\xc5\x93\x6b\x4f\x01\xbd\x05\xb0\xf2\x7b\x50\x85\x01\xab\xb9\xaa\x65\x18\xc1\x83\x05\x0b\xf0\x10\x92\xde\x29\x0e\x4a\x7f\x13\xc4\x72\x01\x89\x90\x2e\xe1\x21\x45\x00\x87\x70\x1b\xad\x43\x94\x90\x22\x80\xc3\x8a\xfc\x47\x0a\x28\x7d\x4b\xd4\xfd\x96\x26\xd0\x88\x26\xd0\x01\xde\x5e\xe3\x6c\x97\x52\x64\x4f\xf3\x60\x83\x80\x2f\x8a\xf8\x11\xc3\xfe\xdc\x74\x10\xde\xc6\x09\x55\x1d\xc1\x53\x85\x6a\x29\x41\x4b\xa8\xce\x21\x78\x93\x50\x6d\x25\xf8\x13\xa1\x7a\x98\xe0\x7f\x0b\x95\x1c\x7a\xb9\x4b\x65\x48\xeb\x63\x92\xee\x75\x03\x29\x09\x47\x27\x67\xe1\xe8\x80\xc7\x73\x5d\x5d\x01\xa9\x9d\x56\xc4\x03\x5e\xaa\xa6\xfb\xfd\x22\x1a\xf0\x39\x6a\x13\xdc\x57\x44\x03\x46\x5b\x0c\x65\x59\x77\x86\xa0\xfc\x50\x11\x17\xf8\x61\xb5\xbd\xde\x2b\xa2\xbd\xa7\x0c\x08\xcd\x54\x7a\x42\xed\x6d\x7f\xd2\x4b\x98\x5a\x2c\xbf\x4d\x6d\xf8\x8a\x3f\xd0\xee\x3f\x08\xda\x66\x85\x16\x28\x69\x8d\x4b\x30\xe6\x9d\x74\xa7\x8d\xad\x9e\x76\x74\x01\xaa\xcb\xc5\xcc\x00\xbc\x5e\x65\xab\xe6\xcf\x91\xc8\xfb\x88\xb7\x4d\x0c\x3d\xd2\x2f\x1e\xb4\xdf\x93\xaa\x07\xa8\x7e\x29\xe2\x01\x7c\x7d\x30\xf1\x3c\x2c\x91\x4b\xe2\x79\xc8\xf7\x52\xef\x8e\x13\x3c\xf9\xd1\x09\x30\xd4\x02\xb8\xea\x44\xa8\x5a\x2f\x26\x38\xec\x24\xae\x1a\x41\xf0\xad\x93\xb9\xca\xcf\x87\xde\x4f\x04\x70\x2d\xf7\xd3\x01\x08\x7f\xde\x92\x83\xd2\x3e\xdc\x7b\x07\x20\xa2\xf0\x88\x6a\x4c\x3e\x6f\x6a\x96\xb7\x8a\xa2\xff\x8f\x39\x51\x4e\x3f\x7c\x3d\xc1\xb7\x84\xea\x9f\x04\xab\x78\x4c\xe1\x03\x09\x1e\x2b\x54\x53\x09\xce\x17\xaa\xc5\x04\x7b\x85\x6a\x1d\xc1\x82\x50\xfd\x90\xe0\x1b\x42\xf5\x2e\xc1\x0a\x9e\x5c\x18\x70\x1f\x92\x95\xec\xdc\xfe\x02\x0a\xa3\x04\x0f\xf0\x58\xa1\x9a\x43\x70\xa9\x50\x75\x11\xbc\x5a\xa8\x00\x1f\x10\x2a\xc0\xc7\x8a\x7d\xa2\x20\x1a\x5e\x39\x7a\xb9\x23\xb0\x42\xf7\xc2\x61\x77\xa3\xe6\x8c\x93\x74\x5f\x7a\x48\xf8\x85\x22\xdd\x0b\x92\x0e\xeb\xd7\x0b\x4a\x0f\x05\x31\x18\x8e\x39\x49\x54\x3f\x46\xe9\xa2\x20\xe0\x9c\x22\xda\x1c\xa5\xdb\x82\x80\x37\x15\xd1\x6e\x52\xfa\x2d\x08\xf8\x55\x11\xed\x2b\xa5\xe3\x82\x80\xf6\xc9\x82\x06\xbc\x96\xeb\xea\x0a\x6b\xa5\x76\xd5\xbf\x46\xa2\xdc\x36\x9e\x1a\xe4\x7b\xa2\x61\x00\x1b\xb8\x2a\x74\x0b\xc1\x07\x05\xeb\x10\x8a\x7a\xac\x48\xee\x64\x82\xb3\x84\xaa\x5d\x19\xcd\xc1\xcb\x08\x5e\x25\x54\x5b\x94\xd1\x1c\xfc\x2b\xc1\x7f\x08\x95\x3a\x70\x83\x87\x2a\x03\x37\x38\x5f\x19\xb8\xc1\x94\xd4\x9a\x01\x16\x5c\xab\x8c\xe5\xe0\x46\x65\x2c\x6b\x4f\xf9\x26\x51\x13\x9c\x84\x25\x4f\x2c\xba\x27\xa9\x0d\xe7\x5f\x7f\x36\x49\xed\x41\xcc\x53\xa0\xd5\x7f\xa0\xba\x82\xea\x6e\xe1\x0a\xf0\xc7\x2a\x5b\x35\x7f\x8b\x44\x1e\xd4\xd1\x09\x9d\x99\x38\x49\xf1\x00\x55\x92\x77\x57\x28\x29\x75\x7d\xd9\x78\xe4\x71\x32\xd0\xd1\xa9\xe2\x31\x65\x99\xd1\x1c\xe4\x85\x03\x6f\xd7\x08\x3a\xa2\x1c\x26\x0e\x66\x80\xc7\x71\x55\x78\x12\xc1\x85\x42\xb5\x98\x60\x4c\xa8\x00\xcf\xe1\xaa\x56\xc0\xf3\x4e\x40\x4c\x2b\x2b\x0a\x28\xac\x15\x55\x01\x2f\xe2\x3c\xab\x62\xc0\x69\x7b\x38\x91\x27\x71\x3b\x41\xc0\x53\x44\x3a\x33\x09\x36\x09\x56\x93\x92\x4e\x0b\xd2\x49\x0a\xd6\x99\x04\x7b\x85\x0a\xb0\x5f\x54\x94\x23\xf7\x99\x23\x75\x53\x3d\xd9\x36\x95\xd7\x04\xbc\x9c\x64\xb9\x0b\xb7\x67\x12\x27\xcd\xab\x07\x01\x2f\xe3\xaa\xd6\xcb\x24\x8b\x15\x2c\xab\x37\x47\x5b\xcd\x14\x4f\xce\x98\xc2\x83\x02\x6c\x9d\x52\x1c\x14\x4a\x2b\x05\x11\xf0\xb7\x25\x44\x94\xde\x17\x44\xc0\xe3\x06\x4a\xd3\x1d\x91\xeb\x8f\xf7\x0a\x5b\x8f\xe7\x06\x00\x3f\xe2\xaa\x65\x80\x95\x25\xce\x51\x1a\xc9\x9d\x97\xf9\xd9\x9f\xea\x28\x02\xf8\x39\x57\xb5\x03\x56\x88\x7c\x00\x8b\x7d\x47\x34\xbe\xd5\x27\x20\xdd\x3b\xbc\x8c\xdd\xae\x78\x0e\x03\x3e\x02\x77\xcc\xaa\x79\x44\x49\x3b\xac\x3a\x51\xce\x19\x2a\xb9\x1b\x39\x41\xe7\xe0\xb8\x55\x71\xdd\x21\xbb\x0e\xb0\xb0\xea\xb9\x43\xf5\x5c\xf6\x34\x71\xfa\x64\x4f\xae\x9b\xcc\x9b\x0d\xf0\xbb\x3c\x9c\x55\x1c\x4f\x41\x77\xb2\x0d\xdb\xfa\x51\xe8\x29\x22\x02\xdb\x62\x54\x00\xae\x29\xa2\x95\xdf\xd4\xa0\x22\x80\xc7\x89\x1e\x3f\x4e\x8a\xc9\xeb\x5e\x39\xa8\x00\x6b\x07\xec\x15\x03\x08\xb0\xaf\xa4\x6e\x9f\x14\xe9\x00\x4f\xb8\xeb\x02\x0d\x01\x4e\xe0\x36\x5a\x27\x68\x02\x0d\x69\x02\x6d\x55\x03\x0d\xe9\x02\x0d\x69\x02\x1d\xe0\x3a\x85\x1a\x68\x33\xe0\x24\x31\x59\x27\x69\x02\x6d\xd6\x04\xba\x4c\x0d\xb4\x59\x17\x68\xb3\x26\x50\xdf\x89\xae\x86\xd8\x06\xd8\x53\x64\xb3\xad\xa0\x3a\x6f\xeb\x95\xdc\x78\xc4\xc1\x7b\x5a\xa2\xf3\xb4\x44\xe3\x69\x89\xce\xd3\x92\x32\xc7\xbf\x3e\xc5\x57\x07\xe0\xf1\xdc\xaa\xb1\x80\x60\x0b\x57\x05\x5b\x94\x56\x37\xd4\xc6\xd4\x3f\x28\x3c\xc9\x93\x3f\x9e\x04\x5a\x0b\xe0\x49\x93\x45\xd4\x9a\x95\xbd\xa2\xc2\x7b\xac\xb6\x78\x63\x8c\x6d\xaf\xf7\x76\x43\x06\x58\x0b\xe0\xef\x48\x56\xb2\x7c\x75\x01\x85\x3f\x71\xa5\x55\x5d\x40\xe1\x4b\xa1\x1c\x51\x40\x61\xbf\x49\x5c\x39\xa6\x80\xc2\x58\xa1\xac\x29\xa0\x30\x45\x28\xc7\x15\x50\x98\x2b\x94\x75\x05\x14\xe2\x42\x39\xad\x80\xc2\xca\x49\x48\xdc\xda\xce\x0a\x28\x5d\x26\xb4\xcf\xb2\xc2\xf5\x54\xba\x63\x52\x51\x4e\xba\x06\x2e\x6e\x8b\x77\x78\x9b\x44\x00\xaf\xe1\xed\xde\x0a\x78\xfd\x24\xd1\xee\x11\xc5\x91\xdf\x77\xaf\xb4\x6e\x42\x80\xd7\xf1\xda\xed\xd7\x69\xdc\x84\x34\x6e\x34\x67\xf4\xde\x56\x43\xb3\x72\xcd\x32\x50\x5b\x00\xd3\x67\xa0\x35\x36\x6c\xee\x47\xe1\x2c\xf1\xf3\x74\xc0\xb5\xc5\x3c\xd9\x7e\x25\x40\xa0\xf4\xc7\x38\xf0\x6b\x92\x74\x8e\x7c\xc0\x69\x92\x7b\x3a\x06\xaf\x19\x52\x93\x65\x47\x14\xa0\x5b\xcd\xe3\x6c\xbd\x87\xe0\x7f\x0a\xd5\xbe\x34\x2d\x20\x8b\x54\xfb\x19\x08\x68\x65\xa0\x80\xc2\xa1\x82\x37\x8e\xe0\x78\xa1\x02\x7c\x82\xab\xda\x0f\xa6\x5c\x5b\xc5\x4f\xc8\x01\xd3\xe2\xa7\xe7\x72\x63\xe9\x6f\x21\xa3\x41\x2e\xa3\xf8\xaf\xe3\x79\x04\xe1\xf9\x14\xe1\x79\x36\xc1\x7a\xe1\xb9\x5e\x0a\xa6\x92\xad\xec\x2f\xa8\xe1\x0c\xe2\x42\xf6\xf6\x3a\x4f\xfe\xba\x0e\x95\x5a\x00\x47\x4d\x84\xaa\x0d\x70\x2c\x57\x85\x00\xe7\x0a\x56\x13\xc1\x53\xf8\xc0\x6d\xfb\x1e\xc1\x26\x31\x96\x4f\x23\xd8\x22\x58\xad\x04\xa3\x42\x05\xd8\x49\x72\xe0\x9f\x39\x20\x93\x4f\x79\x26\x11\xc0\x23\x8b\x8c\x44\x0a\x28\x1d\x2d\xa6\x31\x60\x6b\x09\x51\x0d\x2b\xa2\x0b\x4b\x7d\x9e\x42\xf7\x16\xd8\x32\x0d\xfd\x15\x57\xb5\x01\x9e\xc2\x5b\x35\x82\x56\x3d\x52\x44\xa1\x66\xd0\x06\x28\xda\x3e\xa2\xb6\x7d\x44\x6d\xfb\xc8\xff\x65\xdb\xb7\x50\x74\xa3\x89\xbb\xb7\x77\x87\xaa\xfa\xdb\xc7\x56\x7f\x7d\x70\xa1\x5e\x8a\xaa\x74\xaf\x1c\x4d\xe9\x5e\xad\x3b\x7a\xd0\x17\xc5\xdd\xb5\x9e\xfc\xac\x96\x37\x2a\xe0\xe3\xc7\x41\x15\x06\x7c\x8a\xa4\xfb\x8c\x38\x0a\xbf\x15\xbc\xd7\x08\x7e\x26\x54\x9f\x49\xaa\x4a\x66\x9d\x5b\xf8\x8a\x0a\x07\xf2\x7e\x0b\x1f\x42\x30\x28\x54\x80\x2d\x75\x45\x5e\x51\x78\x58\xf0\xf4\xed\xca\x58\x35\x79\xd9\x9b\x47\x13\x04\x3c\x8b\xab\xda\xbe\x4f\xf0\x3e\x31\x86\xee\x27\xf8\x33\xa1\x52\x9a\x91\x83\xc0\x60\xfc\x4d\x15\xfe\x00\x33\x82\x85\x10\x9e\x15\x2a\xc0\x17\x78\xb3\x65\xfb\x0b\x28\xfc\x46\xf0\xd0\xe2\x37\xf1\x50\x83\x80\xb7\x8b\xe8\x01\x45\x8e\xc1\xc1\xe5\xe8\x66\x87\xf0\x76\x20\x3f\xef\xde\x2a\x8d\x32\x44\x70\x41\x9d\x6e\x2f\xb4\x97\xf0\x3e\x1c\xf6\x75\x41\x0e\xa7\xb4\x8a\x1c\x86\xbb\xb9\x97\x89\x57\xd3\xc8\xbc\x48\x0c\x56\xc0\xcd\x5c\x15\xfe\x31\xc1\x17\x85\x0a\xf0\xb7\x5c\x15\x01\xdc\x55\x8b\xc0\x34\x6f\x9b\x64\xec\x2a\xda\xbd\x9b\xd7\xd4\xdf\x0e\xac\xa8\xe0\xd4\x5b\x05\x15\x70\x27\x57\x35\xef\x54\xfc\xe6\xfa\x0b\xbb\x14\x2f\xda\x47\x2e\x37\x4d\xf0\xe4\x2f\x27\xf0\xfc\x01\xbf\x4f\x35\xf5\x2b\x8d\x13\xe2\x97\xc4\x1c\x45\x4c\xe7\xc0\x0a\x6c\x73\xbf\x9a\x3f\xdd\xe4\x8a\xda\x2b\x40\x43\x69\xe7\x0a\xd1\xe8\xba\x0c\x8a\x72\x19\xef\xc9\x67\xc7\xf3\x5c\x00\x4f\xe2\xe9\x75\x00\x9e\x3c\xa1\x38\x3d\x94\xe6\x88\x76\x00\xbc\x4a\xd4\x05\xbc\xba\xa4\x2e\x4a\x05\x41\xfc\x66\x61\x46\x00\x4f\xe0\x16\xda\x4f\xd0\x84\x19\xe1\x61\x46\x38\x31\x02\xb8\x5e\xd4\x5d\x2f\x05\x34\xf0\xef\xfc\x75\x51\x85\x00\xa7\x72\xcb\xad\x53\x35\x51\x85\x78\x54\xb3\x38\x31\x04\x78\x85\xa8\x7b\x85\xa6\xf1\x42\x9a\xc6\xd3\x9e\x20\xb5\xd1\xb3\x7c\xe7\x8d\x03\x2d\x7c\x3e\xc1\x2b\x84\xca\x2f\xb9\xed\xc7\x78\xf2\x8d\x63\x40\x6d\x01\x3c\x80\xd7\x6e\x05\x3c\x70\x9c\x08\xb0\xa5\x80\xd2\x18\x4e\xd4\x3c\x4e\x3d\x84\x76\x8e\x20\x59\xc9\x56\xae\xf7\xbd\x90\xbc\xfa\x68\x4f\x5e\x76\x34\xb7\x08\x58\xc1\x03\x0c\x03\xde\x7b\x4c\x71\x34\x28\xfd\xf4\x98\x01\x92\xde\x40\x16\x4f\xe5\xc4\x20\xe0\x3d\x5c\xd5\x0e\xf8\xa0\x60\xc9\x86\x02\x6c\x1e\xed\xbc\xc3\xdf\x27\x72\x05\x75\x81\xb0\x07\xd8\xc9\x55\xad\x80\xfd\x82\xd5\xaf\xf8\xf0\x39\x4f\x0e\x52\x5e\x51\xd1\x78\x80\x79\xae\x6a\x03\x5c\x49\xb2\xdc\xd9\xd2\x08\xe2\x1c\x25\x2c\x02\x9e\x20\x2c\x02\xce\x1a\x84\x45\xc6\xb2\xf4\x35\xd9\x4b\xf9\x57\x65\x5b\x00\x0f\x3a\x0a\xaa\x30\xe0\x61\x5c\xd5\x72\x38\xc1\x3e\xc1\xf2\xf3\x51\xea\xe9\x6a\xee\x29\x02\x78\x30\xb7\xd1\x7a\xb0\xe2\x29\x02\x4f\x73\x85\x0a\x30\x22\x2a\x02\x2e\x21\xe9\xfe\x51\xb3\x44\x09\xd2\xa7\xb3\x1e\xa7\x48\x76\x8a\x8f\xeb\xfe\x89\xe0\x70\x5e\x37\xa8\x9a\xd3\x1c\x0d\xc6\x4a\xe1\xfa\xbd\xf0\xf0\x7a\xb2\xbf\x85\xbb\x0c\xcd\x95\x72\xf0\xf9\xfb\xfb\x29\xaa\xb1\x43\xd4\x5c\xae\x44\xa6\xad\xf9\x3e\xd5\xf8\x54\xd4\x0c\x1c\x25\xa7\xe9\xf3\x37\xff\x6a\x22\x6c\x16\x63\x00\x70\x8b\xe8\x19\xc0\xfb\x48\x96\x1f\x7d\xdb\x88\xf5\xb8\xb0\x09\xf8\xa5\x18\x57\x1c\x8a\x71\x0f\x58\x31\x88\x41\x0e\x27\xaf\x8a\x28\x01\x5f\x17\x49\x03\x16\x5b\xf4\x3b\x14\xc1\xe2\xaf\x44\xf5\x5f\x49\x16\x87\xb1\xca\xe9\x43\x02\x25\xaa\x62\xcb\xfe\xef\xbc\xdf\xa6\x84\xd6\x0c\xf8\x95\x18\xc5\x5f\x29\x4d\xd2\xa6\x6b\x12\xfd\xeb\xea\x6b\x68\xed\x1f\x27\x0e\x14\x80\x0d\x25\x47\x14\xb5\xea\xb1\x12\x4f\x3b\x03\xba\xe8\x70\x7c\xae\x38\xbd\xd1\x85\x91\xa5\x3f\xdc\xae\x12\x17\x09\x01\x6f\xaf\x2f\x5b\x73\x1b\xed\x7e\x40\xd0\x00\x7f\x32\xe0\x5f\x9d\x6a\xe5\x88\xae\x72\xb9\xd3\x90\xf5\x74\xbb\xeb\x6e\x71\xc3\x0f\x70\xc2\x34\xa8\xc2\x80\x8d\xd3\x8a\x43\x42\xa9\x8d\x13\x5b\x00\xcf\x12\x75\x55\x9f\x3f\x25\x07\xe3\xca\x91\x18\xfb\x15\xd1\x7e\x2b\x6e\x26\xee\x24\xb8\x37\xaf\xe9\xfb\x70\xe7\x6a\xa2\x5c\x21\x82\x03\x7c\x95\xab\x5a\x01\x7f\x53\x92\x18\x4a\x01\x71\x87\x78\x20\x37\xd7\x70\x9b\x11\xc0\x57\x44\x7a\xaf\x68\xdc\x44\x34\x6e\xb4\xed\x70\x23\xd1\xee\x11\x49\xef\x26\xf8\x51\xf9\x76\x78\x90\x76\xff\xa7\xa0\xc1\xe7\x1f\x06\x6a\xc1\xf5\x14\xd4\xb5\xe2\x1e\x32\xe0\x66\x11\x2f\xe0\xdd\xe5\x6f\x2b\xa3\xe6\x4d\x82\x76\x1b\xc1\x1f\x96\xaf\xe9\x6c\x3b\xe9\x49\x81\x7f\xf3\xe7\x3b\x5a\x00\xa7\xf0\xa7\x0e\xc3\x80\xd3\xf0\xac\xac\xdb\x95\x28\x75\x0b\xe2\x40\x6e\x2a\xc4\x93\x8c\x80\x53\xb9\xaa\x75\xaa\xc6\x4d\x44\xe3\x46\x33\x62\x40\xea\x28\x17\x8b\x4a\xd2\x58\x9a\xa7\x90\xca\x3c\xe4\xbc\x83\x9e\x84\x7e\x8b\x3f\x11\xdd\x02\x38\x41\xfc\x2a\x0f\xb0\xae\xe4\xf7\x59\x28\x4d\x16\x44\xc0\x13\x06\xf9\x43\x2e\xb8\xdf\xc5\xdd\x47\x00\x8f\x13\x3f\x0f\x3b\x4e\xe3\x3e\xc2\xdd\x4f\x13\xc4\x69\x1a\xf7\xe5\x16\x38\xd5\x7d\x08\xf0\x3b\xe2\x87\x5b\xdf\xd1\xb8\x0f\x71\xf7\x93\x04\x71\x92\xc6\xbd\xdf\x11\xf0\xaf\xe4\x67\x58\xd1\xef\xc8\x80\x0f\xe2\x26\x83\x87\x13\x1c\x2b\x54\x7e\xc9\xec\xa4\xb7\xe4\xfc\x5d\xbc\x63\x09\xb0\x71\x16\x54\xed\x80\xa7\xcd\x12\x31\xb6\x14\x50\xea\x17\xc4\xc1\x3a\x89\x00\x36\xf1\xba\xcb\x9a\x24\x73\xe5\xfb\x41\xb5\x19\x02\x9c\xc3\x6d\x76\xcc\xd1\x04\x1e\xd2\x04\xae\xfc\xa9\x5f\x11\x08\x70\xd3\x67\x11\xcd\x69\xed\xb3\xa4\xaa\x7e\x4f\xd9\xed\x4d\xfb\x67\x70\x17\x61\xd5\xab\xcf\xc2\xb1\x9a\x08\x57\x71\x62\x0b\xe0\xd5\x25\x1d\x80\xd2\x8d\x82\x38\x58\x8b\x11\x9d\xc5\x88\xc6\xa2\xcf\xbd\x34\xd5\x62\x48\x67\x31\xa4\xb1\xe8\x7b\xdb\xcc\xdb\x1f\x60\x9b\x88\xfa\x0f\x91\x16\xe0\xa7\x22\x2e\xc0\xaf\x44\x27\x02\xd6\x34\x8a\x08\x5a\x0a\x28\xfd\xa6\x91\x13\x01\x2f\x9f\x5d\x4c\x44\xe9\xfa\xd9\x9c\x08\x78\xc4\x9c\x62\xe2\xe0\xc2\xdf\x2c\x62\xdd\xac\xc4\x6a\xe8\x62\x8d\x68\x62\x35\x74\xb1\x46\x34\xb1\x1a\xba\x58\xfd\xe7\xcf\x26\xa5\x45\x43\x80\x87\x72\xe7\xcb\x00\x8b\xa3\x0c\xf1\x28\x8f\x10\x2f\xce\x1c\xbc\x9b\x66\xc0\xfd\xc9\x4a\x05\x5b\xc5\x31\x2c\x57\xb2\x0d\xeb\xfb\x65\x37\x7e\x4f\xba\xa8\x3e\xda\x00\x51\xd5\xf7\x55\xb0\xfa\xfa\x4b\x00\x0f\xe2\x4d\xd1\x7e\x90\xa6\x29\x96\x68\x9a\x42\xbb\x06\x9e\x4a\xb4\xcf\x67\x23\x95\x0d\x63\xbe\x26\x7c\xe8\x1c\xa1\x1b\x4c\x5d\xf7\x85\x1b\x72\x65\xed\x7b\x69\x7c\x3c\x57\x6b\x3c\x57\x0f\xa6\x6e\x80\xb5\xca\x55\x7d\x4e\x64\xd5\x9a\xed\x6a\xcd\x41\xb5\x94\xdb\x73\x9a\x6c\xf5\xdd\xa9\x66\xbb\x8a\xa9\xd9\xea\x87\x91\x1a\xf3\x32\x35\x66\xfd\x40\x77\xef\x86\x9c\xfa\x3f\x30\x77\x3f\x2f\x21\xca\xee\xcb\xcc\x68\x9f\x08\xbe\xf9\x4a\xf7\x79\x09\x51\x17\x41\x99\xf5\xaf\xa2\xc2\xfd\xab\x7e\x0d\x39\xbd\x99\xc7\x11\x04\xfc\x21\x57\x85\x00\x1f\x11\x73\x7e\x4b\xff\x23\x4a\x0a\x21\x04\x7b\xf9\x6c\x45\x75\x3d\xef\xe6\xba\xc2\x1f\xa5\x04\x9c\x23\xb3\xda\xf5\xba\x57\x52\x7a\xdb\xfb\xe4\xf1\x2f\x8d\x82\x7b\xba\xe2\xba\x63\x33\xc1\xb7\x85\x4a\x1d\x25\xea\x9d\x83\x40\x05\x37\xcc\x44\xb7\x5f\xa4\xd8\xd7\x3d\x82\x14\x40\xcd\x8f\x44\x7b\x7e\xa1\x18\xd3\x5c\xf2\xdd\x8b\x76\x1e\x33\xbb\x5c\xfa\x2a\x4b\x5d\x87\x54\xd6\x80\x97\x34\xbd\x6d\x04\xbd\x36\xfa\x3a\xfe\x8e\xe9\x16\xc0\x47\xb9\xaa\x1d\xf0\xed\x92\x97\x9e\xa3\x14\x5c\xc0\x89\x80\x8f\x71\x55\x0b\xe0\x3f\x97\x70\x16\xe0\x97\x4b\x8a\xcd\xa1\x74\x45\x3b\x27\x02\xfe\xbc\xbd\x98\x88\xd2\x61\xfc\x8d\xee\x03\x3c\xb7\x58\x9a\xea\xe9\x3c\xaf\xc8\xe9\x4a\xaa\x61\x24\x15\xe6\x19\x84\x01\x5b\xb9\x2a\x02\xd8\x46\xd2\xbd\xcb\x8b\x82\xc9\x79\x41\xc0\xa6\x66\x6e\xed\x82\x66\xb9\x3d\xc2\xc8\x7c\x23\xcf\x3c\xac\xe6\x38\x40\x77\x22\xbb\xd3\x78\x2a\x21\xc0\x5e\xae\x6a\x45\xff\x5c\xc0\x43\x6c\x05\x5c\xcb\x53\x59\x19\x28\xa0\x70\x91\xc8\x2f\x50\x40\xe1\x3a\x91\x1f\xe0\xdd\xc2\xde\x60\x82\x14\x5d\xd0\x0c\xf8\x86\x78\xc9\xf9\x1b\x9a\xd1\xd6\xac\x19\x6d\x1d\x41\xa5\x63\x9a\x01\x45\xbb\x69\xe6\x7a\xe9\x8b\xad\xae\xa5\x96\x85\x0c\xb0\x65\x80\xbf\x20\x39\x9a\x9d\xcb\xf6\xab\xae\x19\xbb\x92\x1d\x30\xba\xba\x66\xec\x84\x71\x01\x76\xc0\xa8\x42\x35\x9d\xcd\x43\x06\xd8\x32\xc0\x13\x5a\xca\x55\x9c\x46\x8d\x04\x19\x60\xcb\x00\x97\xb7\x96\xab\x78\x33\xdd\xea\x87\x0c\xb0\x65\x80\xbf\x6e\x2b\x57\x51\xed\x8a\x8d\xd2\xd4\x2a\x33\xc0\xe6\x28\xbd\x16\x54\x5b\x3e\x08\x18\x25\xb9\x17\xb3\x59\x75\xcd\xd8\xea\x9a\xa3\xf3\xec\x08\x28\x7b\x39\xdf\xf7\xaf\x8c\xb8\x32\x6a\x83\xbd\xd2\x10\x0d\xb0\x20\xe0\x55\x24\x87\x3b\x27\xbe\xdc\xdd\xc4\x43\xae\x52\x06\xa6\xe6\x9d\x1a\xce\x56\x20\x37\x9b\xf9\x60\xdb\xb0\xa5\xbf\x80\xd2\x8f\xc4\x40\x05\xfc\x8f\x12\xa2\x6a\xb4\x20\x55\xad\x60\xe7\x2a\x39\x0a\xd6\x7f\x88\x95\x67\x30\x24\x9f\x8f\x9c\x06\x28\xcb\x3d\x17\x14\xc7\x86\xd2\x28\x31\x3d\x00\x47\x2f\x28\x9f\x44\x40\xaa\xaa\x4f\x22\x20\x59\xd3\x26\xa1\x92\x7c\x56\xeb\x31\x44\xf8\xb6\x88\x6d\x5b\x7f\x01\xa5\xef\x88\x24\x00\xbf\x5b\x42\xf4\x37\xf8\xdd\x72\x8b\xd3\x18\xc9\x7e\x05\xd3\x9d\xb8\xaa\xa6\x7c\x7a\xe2\x1d\x22\xfc\xb9\xa4\x81\x51\xfa\x48\x24\x01\xf8\xf1\x00\x3d\xf1\x8e\x54\x55\xdf\x13\xef\x48\xd6\xb4\x3d\xa1\x92\x7c\x7a\xe2\x4b\x22\x54\x2d\xe4\xb1\x6d\xeb\x2f\xa0\x34\x7c\x21\x4f\x02\x70\xdf\x12\xa2\xbf\xc1\x7d\x79\x55\x4d\x4f\x80\x04\xa3\xfa\x9e\x50\x4d\xf9\x7d\xb3\x82\x08\x27\x94\xc4\x86\xd2\x4c\x91\x04\x60\xc3\x00\x49\xd4\x49\x3c\x6d\x12\x75\x92\x7d\x7d\x12\xaa\x29\x9f\xe1\x74\x3f\x11\x7e\x2a\x62\xdb\xd2\x5f\x40\x69\xbb\x48\x02\xf0\xf1\x12\xa2\x6a\xf4\x7e\xa9\xaa\x7e\x38\xdd\x2f\x59\xd3\x0e\x27\x95\xe4\xd3\x13\xcf\x11\xe1\xa5\x92\x06\x46\xe9\x4d\x91\x04\xe0\x5b\x03\xf4\xc4\x73\x12\x4f\xdb\x13\xcf\x49\xf6\xf5\x3d\xa1\x9a\xf2\xfb\x8e\xf4\x22\x4f\x0c\x5b\xc4\x63\xdb\xd2\x5f\x40\x69\xbf\x45\x3c\x09\xc0\x51\x25\x44\x8d\x51\xa9\xaa\xbe\x27\x98\x64\x4d\xff\x54\x88\x42\xf2\xe9\x89\x6f\x11\x61\xac\x88\x6d\x5b\x7f\x01\xa5\x09\x22\x09\xc0\xda\x12\xa2\xbf\xc1\x5a\x5e\x55\xd3\x13\xdf\x92\xec\xeb\x7b\x42\x35\xe5\xd3\x13\x6d\xf4\x97\x54\x34\xc8\x63\xdb\xd2\x5f\x40\xe9\xcc\x20\x4f\x02\x70\x79\x09\x51\x35\xda\x26\x55\xd5\xf7\x44\x9b\x64\x4d\xdb\x13\x2a\xc9\xa7\x27\x2c\x22\x9c\x2d\x62\xdb\xd6\x5f\x40\x69\x8d\x48\x02\x70\x6d\x09\xd1\xdf\xe0\x5a\x5e\x55\xd3\x13\x96\x64\x5f\xdf\x13\xaa\x29\x9f\x9e\xd8\x4c\x84\x1f\x95\x34\x30\x4a\x0f\x8b\x24\x00\x1f\x19\xa0\x27\x36\x4b\x55\xf5\x3d\xb1\x59\xb2\xa6\xed\x09\x95\x54\xe6\x8f\xc4\x57\x88\xf4\x3e\x27\x87\xf0\x67\xda\xc5\xfc\x2f\xb7\x10\xe0\x8d\x24\xf7\x65\xe7\xb2\x6b\x86\x78\x27\x9d\x67\x3b\x27\x9d\xd8\xf1\x00\xaf\xd3\x3c\x87\xfe\xb4\x9b\xb7\xc4\xa7\x8e\x7f\x50\x9f\x53\x30\x23\x42\xdc\x1a\x0c\x3f\x2c\x1c\x00\x3e\x4f\x72\x1f\xd6\x5f\xec\x00\xea\x3f\xf1\x1a\x91\x0f\x28\x94\x7f\x2c\xd1\xd6\x50\x9b\xd2\xd9\x6a\x29\x88\xa9\x24\xbd\x1e\x44\x69\xba\x08\x11\x70\x46\x09\x51\x35\x5a\x2b\x55\xd5\x77\x75\xad\x64\x4d\xdb\xd5\x2a\xa9\xec\xf5\x80\xa5\x44\x4b\x73\x7a\xf0\x58\xba\x8b\x32\x3e\xc2\x55\x80\xb5\x24\xf7\x61\x2b\x58\x51\xb7\x41\xfd\x3d\x92\x4a\xbf\x62\xc7\x12\x6e\xb2\xb9\x6a\xa9\x07\x87\x2d\xf5\xa9\xe3\x1f\x73\x3f\xc5\x7a\xad\x68\xe8\x7f\x52\x4b\x7c\xc5\xff\x6c\x6d\x06\xdc\x87\xc7\x5c\xd2\xb3\x50\x1f\xcb\x43\x8a\x9c\x4d\xa1\xac\x5e\xaa\xad\x21\x87\xe1\x8d\x85\x1f\x53\x10\x8f\x96\x74\x31\x4a\x4f\x8b\x10\x01\x9f\x19\x60\x2c\xfc\x58\xaa\xaa\x1f\x0b\x3f\x96\xac\x69\xc7\x82\x4a\xd2\x8e\x85\xf1\x34\x1d\xea\xf8\xb4\x08\x02\x5e\xc0\x55\xda\x6b\xc9\xb8\x92\x33\x4f\xd4\x9c\xa7\xd4\x2c\x73\xad\xe6\x6d\x0a\xec\x63\x1e\x60\x28\x4a\x17\x0b\x12\xfc\x3a\x42\x08\x70\x25\x49\x65\xa8\x60\xc7\x06\x51\x67\x0d\xf5\xe1\xc5\xdf\x7c\x78\x0d\xa1\xc8\x0f\x12\x8b\x1d\x0c\x5f\x27\x1c\x00\xde\x45\x52\x1a\x2c\x50\x6f\x17\x35\x70\x61\x8b\x0d\x6e\x78\x79\x1b\x96\xaa\x0f\x49\x7a\x47\x3c\x94\x3e\x13\x4b\x1f\xe0\xbf\x4a\x88\xfe\x06\xff\xc5\xab\x6a\x0e\x8d\x7f\x92\xec\xeb\x0f\x8d\xaa\x29\x9f\x43\xe3\xa1\x34\x04\x8e\x0e\xf3\xd8\xb6\xf4\x17\x50\x3a\x4e\xcc\x59\xc0\xba\x12\xa2\x6a\xf4\x50\xa9\xaa\x7e\x8e\x1c\x2a\x59\xd3\xce\x11\x95\x54\x76\xbd\x6c\x22\x5a\x07\xa7\x07\x7f\x41\x0d\xf8\x04\xbf\x80\x15\x04\x7c\x8a\xa4\xb4\x5e\x42\xfd\x0a\x49\x65\x70\x62\xc7\x87\xdc\x64\xf3\xa8\xd3\x3d\x78\xe0\xe9\x3e\x75\xfc\x63\xce\x52\xac\xeb\x78\xcc\x91\x4b\xc9\xf0\x06\xee\x20\x02\xb8\x89\xc7\x5c\x32\x3c\xa1\xfe\x85\xa8\x31\x91\x42\x39\xfe\x74\x6d\x0d\x7d\x30\x38\x04\x74\x92\xf4\xc6\x29\x4a\xcb\xc5\x01\x02\x30\x55\x42\xf4\x37\x98\xe2\x55\x35\x03\x7a\x89\x64\x5f\x3f\xa0\x55\x53\x3e\x03\x7a\x3d\x11\x6e\x14\xb1\x6d\xe9\x2f\xa0\x74\xbb\x48\x02\xf0\x8e\x12\xa2\x6a\x74\xbd\x54\x55\x3f\xa0\xd7\x4b\xd6\xb4\x03\x5a\x25\x95\x1d\xd0\x8f\x12\x6d\x87\x38\xda\xbf\xb8\xd8\x83\x2f\x2d\xe6\x2a\xc0\x57\x48\x4a\x03\x1a\xea\x5d\x24\x95\xc1\x89\x1d\x5f\x73\x93\xcd\xe3\x68\xd4\x8c\xff\xe6\x03\xfa\x8f\x14\xeb\xe7\xe2\xd8\x7d\x34\x19\x1e\xcf\x1d\x44\x00\x4f\xe2\x31\x97\x0c\x4f\xa8\x97\x8a\x1a\x87\x50\x28\x35\x83\x1b\xd0\xde\x3b\xbf\xb0\xc2\x3f\x47\xd2\xeb\x62\x94\x5e\xe2\xeb\x7f\x33\xe0\xcb\x25\x44\xd5\xe8\x76\xa9\xaa\x6e\x2c\x08\xd6\xcb\xdc\x81\xe6\xdb\x36\x2a\xa9\xec\x58\xf8\x2b\x9e\xc8\x11\x2b\x59\x90\x0e\x5a\x61\x7e\x83\x26\x08\xd8\xc2\x4f\x99\x4b\xc6\x02\xd4\xa6\xdf\x49\x3e\x76\xac\xe6\x26\x9b\x4f\xa1\x06\x9f\xf1\xcd\xc7\xc2\x28\x8a\xf5\x3b\x62\x5d\x1a\x45\x86\x0f\xe2\x0e\x22\x80\xe3\xf4\xa7\xf9\x50\xcf\x11\x35\x16\x53\x28\xa7\x7f\x93\xc5\x0d\x37\x2c\x6c\x92\xde\x9a\x85\xd2\x39\x62\x35\x07\x3c\xb7\x84\xe8\x6f\xf0\x5c\x5e\x55\xb3\xb8\x2d\x97\xec\xeb\x17\x37\xd5\x94\xcf\x58\xc0\x41\xe7\xb3\x92\xd8\x50\x0a\x88\x19\x0c\x58\xb1\xb8\x7c\x12\x30\x58\xb1\xb8\x4c\x12\x1f\x4a\xf6\xf5\x49\xa8\xa6\x7c\x56\xe8\x75\x44\xb8\x4c\xc4\xb6\xa5\xbf\x80\xd2\xb5\x22\x09\xc0\xeb\x4a\x88\xaa\xd1\x75\x4a\x55\xcd\x3d\x94\x75\x92\x31\xed\x02\xad\x92\x7c\x3a\xe2\x56\x22\xfc\xb0\xa4\x7d\x51\xda\x26\x72\x00\xfc\xd1\x00\x1d\x71\xab\xc4\xd3\x76\xc4\xad\x92\x7d\x7d\x47\xa8\xa6\x94\x24\xbc\xe5\x11\x2b\x7e\x75\x1b\x8f\x6d\x4b\x7f\x01\xa5\x91\xfc\xde\x5d\x33\xe0\x7e\x25\x44\xd5\x28\x0c\x82\xaf\x5f\x1e\xc1\xda\x8f\x3b\xd0\x2c\x8f\x2a\xc9\x77\x79\xac\x27\x4a\x03\xa7\x46\x00\xe7\x90\xdc\x83\x9d\xcd\xb2\xce\x62\x05\xc5\x12\x92\x55\xde\x8e\x57\xa9\xf8\x56\xa9\x5a\xef\xcf\x22\xd2\x1a\xee\xaf\x0d\xf0\x3a\x9e\xb8\xcd\x6f\x74\xbe\x5a\xa4\xd3\x6f\xf0\xfa\xdf\xa2\x79\xb7\xf5\x17\x50\xfa\x40\xf4\x03\xe0\x87\x25\x44\x7f\x83\x1f\xf2\xaa\x9a\xc1\xf4\x96\x64\x5f\x3f\x98\x54\x53\x3e\xb3\xfa\x36\x5a\x9f\xef\xe1\xcf\x4a\x38\x63\x04\xa5\x1f\x8b\x03\x0a\xe0\x03\x25\x44\xd5\xe8\x6d\x52\x55\xfd\x79\xd7\x6d\x92\x35\xed\xb4\x56\x49\x3e\x83\xe9\x51\x22\x3c\x2d\x62\xdb\xd6\x5f\x40\xe9\x57\x22\x09\xc0\x17\x4b\x88\xfe\x06\x5f\xe4\x55\x35\x3d\xf1\xa8\x64\x5f\xdf\x13\xaa\x29\x9f\x24\x86\xd3\x1f\xb1\x07\x2e\x2d\x8e\x0d\xa5\x9a\xa5\x3c\x09\xc0\x6f\x2f\x2d\x9f\xc4\x70\x89\xa7\x4d\x62\xb8\x64\x5f\x9f\x84\x6a\xca\x27\x89\x46\x22\x2c\x2c\x89\x0d\xa5\xc5\x22\x09\xc0\xb6\x01\x92\x68\x94\x78\xda\x24\x1a\x25\xfb\xfa\x24\x54\x53\x3e\x73\x62\x2b\x11\x1e\x12\xb1\x6d\xe9\x2f\xa0\xf4\xb8\x48\x02\xf0\x89\x12\xa2\x6a\x74\xab\x54\x55\x3f\x27\xb6\x4a\xd6\xb4\x73\x42\x25\xf9\xf4\xc4\xf3\x44\x78\xb9\xa4\x81\x51\x7a\x4b\x24\x01\xf8\xbb\x01\x7a\xe2\x79\x89\xa7\xed\x89\xe7\x25\xfb\xfa\x9e\x50\x4d\xf9\xf4\xc4\xbb\x74\xaa\xb8\xfb\x74\x1e\xdb\x96\xfe\x02\x4a\xff\x3a\x9d\x27\x01\xf8\x79\x09\x51\x35\xfa\xae\x54\x55\xdf\x13\xef\x4a\xd6\xb4\x3d\xa1\x92\x7c\x7a\xa2\x82\xf4\x7b\xf1\xa7\xcc\x9c\x06\x46\x69\x34\x7f\x40\xa6\x19\x70\xff\x12\xa2\xbf\xc1\xfd\x79\x55\x4d\x4f\x54\x48\xf6\xf5\x3d\xa1\x9a\xd2\xf4\xc4\x1c\xd2\xb5\x71\x92\xf2\xd0\x93\xd7\x5d\x20\x9e\x46\xd2\xfd\x9d\x31\x0a\x8b\x79\xed\xd0\x62\xc9\xa0\xcb\x2b\x6f\x72\x01\xa7\x5a\x81\xc2\x02\xc5\x64\x44\x35\xa9\x79\x6d\x87\x2e\x15\x9f\x93\xac\x0e\xd2\x77\x8a\xbe\xd8\xd2\x5f\x40\x29\x2d\x3a\x0d\x30\x53\x42\x54\x8d\x76\x48\x3c\x4d\xa7\x95\x7a\x36\x8b\x33\x31\x15\xc7\x11\xd9\xb1\x36\x63\x9d\x5f\x9f\xb9\x86\x27\xb5\x9e\x2c\x49\x04\xa5\x17\x45\xc6\x80\x3b\xca\x66\x1c\xe0\x06\x77\xf8\x67\x5c\xea\xf9\x99\xe2\x4c\x9e\x51\x1c\x47\x64\xc7\x3e\x7d\xac\xfa\xf5\xc9\x78\x37\xe9\x3f\x29\x49\x04\xa5\x00\x7f\xc4\xaf\x19\xb0\xa2\xa3\x7c\xc6\x30\x58\xd1\x31\x40\xc6\x20\x7e\x56\x9c\xc9\x67\x8a\xe3\x88\xec\xd8\x27\x63\xd5\xaf\x76\x29\xaa\xa1\xdd\x63\x39\xad\x05\xf0\x28\x9e\x99\xdf\x03\xde\x6a\xe5\x88\xae\xb2\xfe\xf9\x74\xb5\x72\x48\x57\x59\xff\xbb\x37\xb5\x72\xb3\xae\xb2\xfe\xf7\xdc\xce\xb6\xa3\x9b\x40\x92\xe7\x0d\x78\x54\xb2\x38\x6f\x94\x8e\x15\x44\xc0\x25\x25\x44\x3f\x4f\x3a\x5f\x11\xc0\x23\xb8\xaa\xf5\x08\x8d\xfb\x88\xc6\x7d\x44\x76\x5f\xe6\x01\x58\xd5\x73\x48\x97\x65\x48\xe3\x26\xa4\xcb\xd2\xef\x47\x88\x7f\xf7\xf7\x53\xc3\x55\xed\x0b\x24\x8b\xbe\x5f\x7c\xe3\x1f\x18\x56\xed\xf2\x5e\x3a\x90\xab\xc2\x80\xdf\x22\xe9\xbe\xfb\x0b\x85\x6f\x0b\x1e\x32\x9a\x2e\x54\x80\x0b\x8a\xab\xca\x71\x0e\xf0\x20\xf4\x19\x44\xdb\x20\x82\x04\x7c\x4d\x24\x0f\xf8\x66\xc9\xa0\x41\xe9\x6b\x41\x04\x1c\x7e\x26\x37\x07\xb8\xef\x99\xc5\x75\xbf\x59\x4c\x11\xc0\xdf\x88\x61\xf3\x1b\x4d\x4c\x11\x4d\x4c\xad\x80\x95\x3c\xa6\x48\xa5\x26\xa6\xf2\xaf\x22\x52\x63\x0a\x01\xbe\xc1\x55\x1d\x6f\x68\x62\x0a\x69\x62\xea\x00\xac\xe2\x31\x85\xaa\x34\x31\xf9\xbf\x39\xc2\x1b\x62\x17\x48\x51\x39\x83\x12\x78\x33\xf7\x16\x7c\x90\xe0\x33\x42\x85\x98\xfe\x20\x54\x80\xbb\x8a\x87\xd3\x2e\x49\x19\x60\x41\xc0\x7f\x72\x5e\x56\xff\x95\xcc\xff\xab\x24\x51\x79\x4f\xd1\x6b\x7b\x0e\xba\xd7\xfe\x07\x86\xe1\x76\x7a\xe3\xfa\xbb\x59\x6e\x02\x70\xf4\x0a\xa8\x5a\x01\x3f\x5d\x51\x6c\x15\xa5\xaa\x1c\xaf\x0b\xb8\x5f\x6e\x00\xf7\xf4\x9a\x3d\xb8\xff\x07\x77\x1f\x01\xdc\x45\xd6\x2b\xd9\xd9\xfd\x85\x5d\x9a\x00\x22\x3c\x80\x61\x3c\x80\xc8\x30\x4d\x00\xda\xc6\xf3\x0d\x20\xa4\x06\xb0\xe1\xd1\x7e\x5d\x00\x21\x4d\x00\x21\x5d\x00\xba\xae\xdf\x47\xe2\xe9\x7f\xec\x1d\xd8\x35\xc8\xf8\x06\x57\x57\xdf\xb8\x9a\xba\x45\xb5\x07\x37\x32\x76\x95\x8c\x0c\xbd\xc5\x4a\xe2\xec\xc1\xab\x07\x01\x5f\xe0\xaa\x8e\x17\x24\x8b\xba\x3f\xe2\x02\x07\x2b\x24\x9f\xe3\xeb\xa6\x8d\x9e\xfc\xf1\x46\x9e\x05\xe0\x03\x1b\x8b\x43\x46\xe9\x67\x82\xa8\xb7\xb8\x9d\x08\x2f\x0b\x22\xe0\x2b\x25\x16\x51\x7a\xfd\x9b\x5b\x8c\xe8\x2c\x46\x34\x16\x7d\x7e\xe6\xad\x5a\x0c\xe9\x2c\x86\x34\x16\x7d\x7e\x80\xa1\x5a\x6c\xd6\x59\x6c\xd6\x58\x54\x5e\x7d\xf8\xbb\x23\x3d\x39\x9c\xde\xd8\x34\x92\xe4\x68\x92\x41\x92\x67\x8e\xd5\xc7\xd2\x2f\xbd\x75\xeb\x18\x7a\x71\xd0\x24\x92\x27\x92\x0c\x1e\xa5\xaf\x8f\x77\x4d\xbd\x4e\x52\x7e\xd1\x94\xbc\x29\x6f\x98\x92\x5e\x2f\x25\x6f\x77\xd1\x2b\x55\x5e\x20\xb9\x83\xe4\xcb\x24\x77\x92\xc4\xcb\x5b\xe4\x0d\xdf\x97\x99\x26\x7d\xa7\x26\x78\xa2\x9e\x8f\x2f\xc1\xdc\x21\x7d\x6c\xe6\x11\x92\x3f\x97\xbe\xa8\x24\x6f\xf8\x72\x4b\x4c\xfa\x38\xcc\xea\x93\xf4\xfc\xf1\xf4\xb2\x91\x89\x24\xa7\x93\x3c\xe3\x14\x3d\x7f\x35\x7d\xaf\x6c\x9d\xf4\xa9\x36\x7c\xa9\x4e\xde\xb6\x7f\xaf\x54\xe2\xbb\x69\x3b\x7d\xf8\x27\xd1\x57\x47\xa7\x93\xc4\x97\x6e\xe3\xd3\xf5\x7c\xf9\x13\x94\xff\x5b\xe4\x74\x9f\x78\xf1\x25\xcb\x8b\xa4\x6f\x62\xde\xe2\xc3\xdf\x2d\xfd\xc8\x54\xfe\xb9\xaa\xb2\x91\x7e\x0f\x92\xf2\x6f\x46\xe5\xed\x59\xfa\xc1\xec\x6b\x24\x53\x73\x4b\xa5\xbc\x81\xf7\x36\xc9\x3f\x92\xfc\x7c\x8e\x9e\xdf\xd6\xe4\x49\x93\xe4\xeb\x92\x94\x37\xf0\x52\x24\xb3\x24\xd7\xf9\xf0\xe5\x1f\xae\x45\xa5\xdf\x91\xc9\x9b\xfc\xd3\x33\xfc\x88\x6c\x9b\x0f\x5f\x7e\x6c\xf8\x46\xe9\xf1\x5c\x79\x93\x9f\xe8\x95\x1f\xce\x95\x37\xf9\x49\x55\xf9\x99\x52\x79\x93\x9f\x12\xdd\x47\x7a\x86\x55\xde\x7e\x45\xbc\x3e\xf2\x9f\x90\x9e\xe0\x93\x37\xf9\xd9\xc5\x95\xd2\x33\x82\xf2\x26\x3f\x56\x28\x3f\x21\x28\x6f\xf2\x93\x66\xf2\x33\x61\xf2\x26\x3f\xe5\xb5\x49\x7a\x06\x4d\xde\xe4\x07\x7f\xe4\x47\x74\xe4\x4d\x7e\xe8\x46\x7e\x7e\x46\xde\xe4\x87\x49\xe4\xc7\x3e\xe4\x4d\x7e\x90\x63\x9c\xf4\x98\x89\xbc\xe1\x8e\x6c\x42\xba\x05\xfa\x96\xf2\x06\x68\x6f\xb3\xa4\xdb\xa7\xf2\xad\x53\x79\xc3\xc5\x57\xf9\x9a\x6e\x87\xcf\x85\x73\xf9\x1a\xac\x29\x5d\xca\x94\x37\xf9\x0a\xe6\x33\xd2\x85\x40\x79\x93\xaf\xff\x7d\x26\x5d\x46\x93\xb7\xdb\xe8\xf5\xff\xf7\x90\xbc\x9f\xe4\x0b\x67\xe8\xf9\xc7\x46\x3d\x59\x4b\xb2\x89\x64\x57\x54\xcf\xff\x17\x5d\x01\xc1\x65\x94\x3a\xe9\xda\x84\xbc\xbd\x93\xf6\xe4\x7b\x24\xbf\x26\x79\x48\x46\xcf\xb7\x49\x7f\x36\xc9\xd5\x24\xd7\x91\xbc\x82\xe4\x1d\x3e\xf5\xb7\x90\xfe\x01\x92\x0f\x91\x7c\xd9\x87\xff\x5b\xd2\xbf\x4d\xf2\x8f\x24\x3f\xf4\xe1\x2f\xa4\x3b\xed\xcb\x48\x6e\x26\x79\x2f\xc9\xff\x20\xf9\x0b\x9f\x3b\xf2\x49\xd2\xf7\x91\xec\xcd\x7b\x72\x7d\x5e\xcf\xbf\x90\x78\xb7\x90\xbc\x9e\x78\x9b\x7d\xf8\xd3\x48\xdf\x48\x72\x3e\xc9\x30\xc9\xa5\x24\xbb\x7d\xea\x7f\xd6\x5f\x2a\x2f\x58\xed\xc9\xb5\x92\xbc\x5b\x92\xf7\xaf\xd6\xdb\xfb\x2e\xe9\x6b\x25\x19\x92\x64\x2b\xc9\xd1\xe7\x95\x4a\x79\x8b\x93\x3e\x45\xd2\x26\xb9\xce\x87\xff\x0e\xe9\xdf\x27\xf9\x77\x92\x5f\xf9\xf0\x1b\xce\xf7\xe4\x3c\x92\x61\x92\xc6\xf9\x3e\xfc\x0b\x88\x4f\x32\x4c\xd2\xb8\x40\xcf\x7f\x8c\xf4\x4f\x93\xfc\x25\xc9\xd7\x7d\xf8\xc3\x2e\xf4\xe4\xc5\x24\xff\x71\xad\x27\xff\x7d\xad\x9e\x1f\x21\xde\x52\x92\x1d\x24\x3b\x2f\xd4\xf3\xaf\x26\xfd\xcd\x24\xef\x20\xb9\xd5\x87\x3f\x6d\x8d\x27\xa7\x93\x9c\x4b\x32\xb2\x46\xcf\x1f\xb9\xd6\x93\x07\x91\xfc\x36\xc9\x13\xd7\xea\xf9\x73\x48\xbf\x88\xe4\x62\x92\x31\x1f\xfe\xd5\xa4\xff\x8a\xe4\xc9\xd4\x2e\x73\x7d\xda\xe7\xf7\xc4\xdb\x45\xf2\x5d\x92\x7f\xf5\xb1\xbf\xe7\x3a\x4f\xee\x47\xf2\x60\x92\x47\xaf\xd3\xf3\xaf\x24\xfd\xf5\x24\x0b\x24\xef\xf5\xe1\x0f\xbd\xc8\x93\xc3\x49\x1e\x40\xf2\x88\x8b\xf4\xfc\x5e\xd2\xaf\x26\x79\x11\xc9\x6b\x7c\xf8\x4f\x91\x3e\x70\xb1\x27\xe7\x5d\xe9\xc9\x36\x92\x2f\x91\xfc\xfd\x95\xfa\xfa\x87\x50\xbd\x5f\x92\xfc\x8a\x78\xe3\xd7\x7b\x72\xd4\xd5\x54\x26\xf9\x1c\xb5\xfb\xab\x3e\xed\xbf\x93\xec\xbc\x47\x72\x37\xc9\x2f\x2f\xd6\xf3\xef\xb9\xc4\x93\xff\x41\xf2\x80\x4b\x3d\xf9\x31\xc9\xc7\xae\x2d\xf5\x2b\x6f\x7d\xc4\x3b\x8f\xe4\xc5\x24\xaf\xbd\x54\xcf\x7f\x8a\xfc\xbc\x40\xf2\x15\x92\x3b\x2f\xd1\xf3\xe5\x76\xcc\x5e\xe3\xc9\x97\x49\x7e\x44\x72\x88\x4f\x7c\x1d\xd7\x79\x32\x4e\xf2\x4c\x92\x19\x92\x2b\x49\xae\x26\x79\x09\xc9\x1b\xae\xd3\xdb\x3b\xf6\x06\x4f\xee\x26\xc9\xe8\xd2\xc4\xdc\x8d\x7a\x7e\x3f\xf1\x6e\x22\x39\x79\x00\x3e\x78\xb7\x93\xbc\x9b\xe4\xf6\x1b\xf4\xfc\xcf\x6e\xf2\xe4\xd0\x9b\x3d\x79\x20\xc9\x89\x24\x63\xe4\x67\xb9\x8f\xbf\x49\xb7\x78\x72\x2a\xc9\xa5\x24\xed\x5b\xf4\xfc\x10\x3d\x59\xb7\x84\x64\x94\xe4\x39\x3e\x4f\xdc\x6d\xa2\xeb\x33\x2b\xb6\x7a\xb2\x8e\x78\x1b\x7d\xf8\xe5\xb7\x4a\x16\x60\x9b\x35\x1f\x85\xae\x64\x15\xec\x29\xad\xbe\x92\xed\xd2\xea\x87\xb0\xea\x4a\x9d\xbe\x8a\x4d\xd3\xea\x87\xb2\x11\x7b\xe8\xf4\x7b\xb0\x5b\x35\xef\x4d\xab\x64\xd5\x6c\xb7\x56\x3f\x8c\xd5\x0d\xd3\xe9\xf7\x64\xbd\xfb\xea\xf4\x7b\x31\x56\xa3\xd3\xef\xcd\x6a\xb4\xfa\x7d\x78\x0b\x97\xea\x87\xb3\xcf\xb6\xea\xf4\xfb\x32\xb6\x5d\xa7\x1f\xc1\x1e\x7a\x43\xa7\x1f\xc9\xc6\xfc\x4e\xa7\xdf\x8f\xbd\xf7\x95\x4e\x3f\x8a\xed\xd6\xea\x47\xb3\xcf\xb4\xfa\xfd\xd9\x6b\x5f\xeb\xf4\x07\xf0\xfb\xe6\xa5\xfa\x03\x59\x4d\x40\xa7\x1f\xc3\x76\x56\xea\xf4\x07\xa9\xc6\x5d\xfd\xc1\x3e\xfa\x6f\xf9\xe8\xf5\xcf\xce\x57\xb2\x43\x7d\xf4\x87\xf9\xe8\x0f\xf7\xd1\xab\x9d\x1b\x70\x2f\x8b\xfe\x5d\x69\x9d\x6a\x1f\xfd\x70\xf7\xe6\xd6\x3e\x7c\x06\x3e\x4f\xfa\xc3\x49\x8f\xef\x0d\x0c\xa3\x66\x3a\x9e\xf4\x78\x8f\x3d\x7d\xc6\xc3\x7d\x1d\x99\xa3\xc7\x8b\xd7\x71\x21\xe1\x6c\x37\xa6\x31\xac\x8e\x5e\xe3\x8e\xe1\x7b\x19\xe9\xf1\x7a\x77\x4c\x83\xdb\x5c\xfd\x81\xac\x8e\xfa\x0b\x9f\xf1\xda\x4a\xf6\xf1\x36\x57\x5c\xf0\xff\x2f\xd2\xe3\x85\x4e\xc3\x47\x7a\xf2\x6d\xe4\xd5\xe3\x95\x0f\xa0\xf9\xfa\xbe\x4f\x3b\x7c\x4c\xfc\x1d\xd2\xbc\xf8\x94\xf4\xec\xc8\x52\xbd\xd3\x1e\x8e\x7e\xba\xa4\x3f\x3c\xe0\xe5\x55\x4d\x79\x61\x74\xd5\xba\x7a\x75\x9c\xb7\x90\x9d\xf5\x92\x9d\xa8\xcb\x57\xe7\x8b\x5f\xfc\xab\x5d\xfe\x48\x76\xf6\x5f\xe4\x3d\x7a\xfe\x15\x6e\x60\xfb\x29\xf1\x14\x5c\x3b\xea\xbc\xdb\x4c\x7c\x39\x9e\x47\x5c\xbd\xba\x0e\xfc\xca\xb5\xa3\xce\xc7\x5d\x01\xc7\xca\x3e\x6c\x37\xad\x33\x55\xa4\xff\x98\xf4\xdb\xe8\x9d\xdc\x7c\xc4\x57\x78\x7a\xbc\x21\x1b\x27\x58\x23\x49\x9f\xa5\x2b\xc0\xe7\x90\x9b\xb1\xae\x5e\xdd\xa6\x10\x1f\xaf\xa3\xc5\x0c\x9f\x07\xfb\x74\xe4\xc3\x09\xc6\x32\x1f\x3b\x2b\x2b\x98\xb3\xba\xb1\x33\x86\x94\x8e\xcf\x0b\xc8\xce\xee\xef\x95\xda\x5f\xef\x63\xe7\x87\xae\x9d\x03\xd9\x76\xef\x45\x88\x0c\x17\x32\xef\x23\xfb\x87\x48\xf6\x1f\xf1\xb1\xb3\x83\xf8\x71\x89\xff\x3a\xe9\x47\x48\xf3\xeb\xbf\x7d\xec\x7c\x5e\xa1\x3d\x84\xb8\xf3\x66\x64\xd1\x3a\x09\x3b\x3f\xaa\x72\xf8\xea\xba\xfa\x9d\x4a\xaf\x1d\xf0\x72\xff\x3f\x93\xbe\xbe\x52\xef\x77\x36\xd9\xef\x96\xe2\x5c\x44\x76\xf0\xfd\x17\x12\x6c\x31\xe9\xf1\x55\xf6\x5e\xd2\x9b\xa4\xc7\x2b\x5a\xcf\xa7\xe3\x79\x2f\xd9\xdf\x2d\xc5\x7f\x29\xec\x90\x61\xfa\x40\x2b\xbb\x12\x7a\xba\xf2\xf2\x0c\xe9\x0b\xa4\xdf\x41\x7e\xf7\xa6\xf5\xe4\x3e\xe4\x4b\x9f\x72\x38\x9b\xf8\x8f\x55\x3a\xb3\xe2\x40\xf6\xac\x74\x7c\x79\xaa\xd2\xeb\x77\x26\xf5\xfb\xb3\x3e\xed\xf3\x96\x8f\xfe\x03\x67\xbe\x54\xa8\xc7\xeb\xdd\x3e\xfc\x21\x43\xbc\x38\xf1\x69\x24\x5c\x78\x1b\x39\x44\xcf\xbf\x83\xf8\x35\x74\xb3\xf8\x36\x4a\x63\x02\xec\xd0\x47\x1e\xfe\x48\xfc\x19\x3e\x76\x5a\x7c\xf4\x49\xb2\x83\xcf\x57\x90\x60\x36\xe9\xf1\x58\x08\xd2\x3b\x1b\x7e\x69\x9e\x62\x7e\x6d\x20\x3d\xbe\x7b\xf6\x3c\xc5\x79\x3d\xe9\xf1\x55\x79\x5c\xa8\xbb\x0d\x79\x91\x02\xeb\xcf\xfd\x43\xbc\x71\x92\x95\xe6\xd1\xcf\x7c\xe2\xff\x15\xec\xd0\x6f\xf3\x13\x34\xde\xde\x41\x5e\xb7\x7a\xe5\x53\x29\x9e\x21\x55\x7a\x3b\x07\x56\xd1\x78\xa3\x3b\x49\x0f\x12\xff\xd8\x2a\x2f\x9e\x5e\x69\x5e\x4c\xf1\xb1\xd3\x44\x76\xf0\x11\x62\xfc\x61\xb5\xcc\x87\x7f\x0e\xf1\x77\x9c\xe3\x95\x53\xd4\xa0\x57\x92\xdf\xd5\x52\x3b\xdc\xec\x63\x67\x6b\x95\x37\xce\xe5\x75\xe0\x51\x1f\xfe\x8e\x2a\x6f\xfc\xd7\x54\x96\x8e\xff\xdf\xf8\xf0\xff\x42\xf1\x6c\xa2\x78\x9e\x25\xfd\x27\xa4\xef\xae\x2a\x8d\xf3\x2b\x1f\x3b\xfb\x0e\xa5\x7c\xe9\x33\x42\x9f\xd0\xf9\xf5\x11\x43\xf5\xeb\xde\x44\xe2\x4f\xbf\xde\x2b\x87\x86\x7a\xb2\x61\xa8\xde\xfe\xe2\xa1\xce\x31\xe9\x40\x36\x4e\x6a\x87\xb8\x0f\x7f\x15\xd9\xdf\x46\x27\x32\x8f\xd3\x89\xd1\xf9\xa4\xc7\x53\x22\xcd\x64\xee\x0a\xc7\x7e\xd1\x79\x38\xf2\xdd\x48\xfc\xba\x99\x5e\xf9\x29\xd2\x9b\x7b\xf8\xb4\x27\x8d\xf3\x71\xd2\xb8\xba\x0b\xf1\xd0\x44\xc4\xbc\xf8\xd7\x50\x9a\x17\x55\xa5\xfc\x07\x88\x8f\xef\xc8\xe1\x04\xe3\x31\xd2\xaf\xa6\x89\x6b\x92\xfe\x97\x3e\xed\xf0\xce\x50\x6f\x3c\x4c\xa6\x76\xc3\x9f\x61\x3f\x08\xd0\xf8\x97\xfc\xde\x14\x70\xc6\xdb\x18\xb6\x79\x58\x69\x3b\xff\x84\xc6\xf3\x26\x3a\x7f\xa3\x4f\x4a\xb2\x0f\x28\x9e\x11\xf4\x74\xd2\x81\xb4\x6e\x7f\x46\x79\xad\x96\xc6\x0f\xdb\x83\xfa\x9d\xbe\x88\x40\xdd\xc0\xf6\xdc\xc3\xe3\x5f\x24\xc5\x33\x9a\xf4\xeb\x25\xfd\x01\x64\xa7\x8e\xee\x38\x7e\x40\x7e\xc7\x55\x7b\xf9\x6e\x0a\x94\x8e\xff\x23\x89\x1f\xa4\xe3\x08\x6e\x3c\x8f\xf7\xe9\xc7\x19\x7b\x78\xed\x20\x1f\x5f\x16\x50\x3c\x63\xa5\xf9\xdb\x8a\x78\xa8\x7d\xe8\xb4\x98\x9d\x4e\xfa\x11\xc7\x7a\xe5\xc9\x14\x67\xd4\xc7\xef\x2a\xe2\xe3\x43\x9a\x68\x9f\x73\x49\x8f\x4f\xec\xde\x4e\x61\xad\xd9\xc3\xcb\x77\xb5\x34\xdf\x2f\x85\x1d\x1a\x3f\xfb\x93\xfe\x6a\x1f\xbf\x3f\xf0\xd1\xff\x0c\x79\x51\x42\x18\x6f\xcf\x92\x9e\xd1\xdf\x0b\x38\x3d\xfd\x2d\xe9\xf1\xf5\x26\x9c\x76\xbf\x03\xfd\x14\xaf\xbc\x2f\xc5\xff\x3e\xc5\x7f\x86\x74\xbc\xfe\x10\xfd\x45\xf1\x63\xbc\xfd\x93\xf4\xf8\x86\x0d\x8e\x53\xc3\xaa\xbd\x7e\xb9\x41\x1a\x27\xa3\xaa\xf5\x79\x1d\x43\xe3\x64\xba\x34\x4e\x26\x54\x53\x9c\xf3\xbc\xf2\x7f\x93\xfe\x04\xb2\x5f\x23\xf5\xfb\x1c\xe2\xe3\x23\x84\x53\x29\xaf\x66\x1f\xbf\x5d\x3e\xfa\x3e\x1f\xfd\xe5\xd5\xde\xba\x27\x8f\xc3\x1b\xc9\xef\x7a\xba\xe3\x85\x1b\x31\xf7\x90\x1e\x1f\x22\x7d\x93\xf4\x0f\x91\x7e\x1b\xcd\x3b\x3a\xed\x60\x4f\x57\xeb\xd7\xe7\xb7\x61\x9f\xbe\xc9\x8f\x1b\x09\x1f\x50\x3b\x8c\x91\xda\xe1\xdf\xc4\xdf\x49\xe7\x75\x1f\xd2\x7a\x3e\x7c\x18\xcd\x77\x3a\x01\x6c\xa4\x34\x0e\x1e\xa6\xcf\xb7\x96\xf8\xf8\xf8\x12\xfc\x4e\x25\x3d\xbe\xf9\x82\x1b\x71\xa7\x0e\xa3\xe3\x57\x15\x4e\x04\x3c\x71\x1a\xf8\x74\x27\x0f\x17\x5e\xd3\xa4\xaf\xa3\xf3\x93\x3f\x91\xfe\xfb\xa4\xdf\x24\x9d\xff\x6c\x1c\xc6\xb4\x3f\x63\xfd\x19\xfc\x0e\x2b\x6d\x87\xff\x74\xd6\xc3\x0a\xf5\x7a\xd1\xe3\xc4\x67\xd2\x71\x81\x25\xd3\x49\xbb\x36\xc6\xba\xb2\xd9\xda\x18\xcb\x64\xcd\x74\x53\xba\x21\x9f\xcb\x99\x69\x7b\x56\x32\xc7\xd2\x99\x55\xdd\x49\xdb\x34\x2c\x3b\x17\xeb\xc9\xd6\x66\xa3\x39\xbb\x76\x22\xcb\x99\xd9\x54\x34\x66\x1a\xf1\x64\xce\x8c\xd9\xc9\x95\xa6\xb1\x2a\x69\x77\x1b\x9d\xa9\x68\x7a\xb9\x91\x4a\xa6\x4d\x22\x4e\x64\xab\x72\x4e\xed\x64\x3a\x96\xca\xc7\x4d\xa3\x27\x9a\x5b\x6e\xe6\x68\x67\x3d\x73\x98\xe9\x8c\xd1\x99\x4f\xd4\x4e\xae\x9f\x52\xc7\x4c\x2b\x16\xcd\x9a\xf1\x44\x32\x65\xa6\xa3\x3d\xa6\xa3\x9c\xc8\x52\x51\xcb\x8e\x75\x47\x73\xb5\x93\xa6\x4c\xad\x67\xe1\xe5\xc9\x6c\x30\x63\x59\xc9\xce\x94\xd9\x90\xe9\xe9\x31\xd3\xb6\xe5\xd9\x9b\xc2\x66\x65\x66\xe4\x92\x76\x77\x4f\xe3\xca\x68\xca\xd3\x4d\x63\xb3\x32\x4d\x9e\x6f\x16\xcb\xd9\x96\x9d\x4f\x24\x6a\x63\x2c\x6e\xe6\xcc\xae\xa4\x65\x9b\x39\xc3\xee\x31\x62\xa9\x4c\xda\xb4\x98\x61\xc4\x33\x46\x57\x2a\xd3\x19\x4d\x19\x71\x3b\x93\xb3\x8c\x68\xbe\x97\xc5\x32\x3d\xd9\x94\x69\x9b\xf1\xda\xa9\xf5\xd3\xa6\xe8\x49\x46\x22\x99\x4e\x1a\xd1\x5c\x2e\xda\x67\x98\x69\x3b\xd7\xc7\x12\xb9\x68\x8f\x69\xc4\xf3\x3d\x3d\x7d\xcc\x30\x8a\x4a\x86\xd3\xda\x25\x54\xc3\x98\xdd\x3c\x63\x41\xa3\xd1\xb8\x70\x96\x61\x30\xa3\x94\x10\x67\xc6\xac\x25\x0b\x67\x2c\x68\x6a\x28\xdd\x63\xd9\xd1\x9c\xcd\x0c\x63\xce\xc2\x16\xa3\x71\x2e\x59\x98\x3b\xab\x99\x19\x73\xe6\x2f\x9a\x39\x63\xbe\xb1\x68\xf6\xec\x70\x63\xc4\x88\xcc\x98\x39\xbf\xd1\xb1\x9a\x4a\x76\xc6\x8c\x98\x95\x77\x43\x65\x4d\x56\x53\xba\xa1\x3b\x9a\xb3\x4c\x9b\xf5\x44\xed\x58\x77\x63\x3a\x1e\x36\x57\xe4\xcd\x74\xcc\xf4\x14\x61\xc7\x01\x57\x25\x72\xa6\x39\x7d\xfa\x9c\xf9\x4d\x33\x1b\x8c\xfa\xda\xfa\xda\xe3\xb5\xdd\x40\xdd\x67\x74\x46\x63\xcb\xad\x54\xd4\xea\x36\x2d\xe6\x0c\x9b\xa8\x65\xc6\x7a\xb2\xa5\xf5\xd3\x34\x22\xe2\xc9\x9c\xc5\x66\xc4\xe3\x91\x0c\xe2\x49\x65\x62\xd1\x94\x9d\xec\x91\x1c\xce\x6b\xb1\xcc\x1c\x6b\xc8\xe7\x66\x25\x73\x4d\xe9\xd8\xfc\xa8\x65\x3b\xc6\xd3\xb1\x6c\x9f\x64\x39\x9a\x4a\x65\x62\x66\x9c\x51\xbf\xcf\x4e\xa6\x4c\x8f\x29\xc7\x60\x34\x45\x16\x18\x62\x28\x44\x16\x34\x38\x03\x21\x12\xed\x74\x2b\xc4\x33\x79\xbb\x94\xdf\x65\xda\x4e\x90\x6e\x4e\xb2\xd7\x60\x2e\x93\xca\x74\x2d\xca\xb2\x55\xd1\x5c\x3a\x99\xee\x62\xc9\xb8\x99\xb6\x93\x89\xa4\x99\x6b\x4c\xc7\x59\x38\x9b\x4a\xc6\x4c\x34\x98\x9b\x8a\x65\xc7\x93\xe9\x52\x23\x9d\xf9\x2e\x96\x33\x7b\x32\x2b\x4d\x23\x86\x36\xf5\x7a\x03\x1d\x11\x4f\x5a\xd9\x54\xb4\xcf\x58\x69\xe6\xac\x64\x26\xcd\x0c\x33\x1e\xb5\xa3\x2c\x62\xf6\xb2\x44\x2c\x95\xb1\xa4\x46\xb3\xec\xac\x12\xa9\x65\xe7\x52\xa6\xe4\x78\x76\xce\x14\xbd\x68\x38\xa3\x2b\xb6\xdc\x88\x75\x2f\x37\x12\xd1\x64\x4a\x50\x27\xb3\x9c\x19\x8d\x2f\xc8\xc4\xcd\x59\xa6\x15\xcb\x25\xb3\xb6\x13\x83\xd3\x1c\xdd\xb9\x52\x83\x59\x4d\x30\x49\x6b\xb1\xb3\x92\x58\x66\x96\x59\x66\xda\x69\x5e\xed\xe2\xe0\xd8\x5b\x98\x62\x66\xaf\x6d\xa6\xe3\x33\xf3\x89\x92\x59\xcd\xa2\x79\x3b\x63\xad\x4a\xda\xb1\x6e\x96\x48\xbb\x6d\x23\x39\x76\x06\x51\x43\x4f\xdc\x59\x59\x66\x99\x89\x64\xda\x64\xb1\x4c\xda\xb2\x73\xf9\x98\xcd\x1d\x89\x55\x8b\x5c\x26\xba\x4c\x3b\x56\x6a\x68\x41\x74\xb9\x49\x43\x32\x9c\xef\x74\x06\xe6\x2c\x33\x11\xcd\xa7\xec\xc6\x5e\x7b\x51\x96\x25\xb2\x79\xb9\x86\xeb\x1a\x1d\x67\x58\x59\x33\x96\x4c\x60\xe6\xb9\xf3\xd5\xe8\x89\xca\x3d\x1e\x74\xea\x60\x58\x2c\x30\xed\x28\x33\x7b\xcd\x98\x33\x82\x57\x99\x71\xe6\x02\xc9\x8b\x61\x38\x3d\x4e\x0b\x80\xb7\x28\x97\x12\x1a\x5c\x33\x89\x6c\x2e\x99\xb6\x13\xa5\xbb\x28\x36\x33\xce\x92\x89\x94\xb9\xd2\x4c\xb1\x84\x99\x49\x28\x03\x39\x66\x5a\x96\xd3\xc9\xce\x88\x88\x3a\x4b\x90\xd1\xd5\x93\x49\x53\x0e\x46\x69\xcc\x34\x94\x73\x76\x26\xa5\xc4\x69\x65\x8c\xee\x68\x3a\x9e\x32\x59\x8f\xd9\x53\x32\x0e\x27\x4e\x76\x8f\x3a\x76\x77\x32\xdd\x65\xc1\x65\xc4\xec\xb5\x99\xd1\xb4\xc8\x70\x67\x86\x91\xb7\xcc\x38\xb3\xba\x93\x09\xbb\x29\x2d\x1d\x85\x58\xda\x5c\xd5\x13\x8d\xe5\x32\xc5\x0b\x9b\x63\x90\xf5\x68\x5a\x6c\xb1\x37\x23\xe7\xbb\x09\xbb\xc1\xbb\x9e\xd2\xae\x05\x8b\x25\x92\xe9\x78\x93\x33\x59\xd1\xc1\x4d\x71\x16\x76\xb5\x4e\x43\xce\xc8\x39\x01\x66\xb2\xe1\xac\x19\xb3\x9c\xe1\xaf\xeb\x91\x4e\xcb\xa2\x0e\x59\x98\x09\xdb\xf1\xa6\x74\x8c\x39\x5d\xcd\xf2\x56\xb4\xcb\x5d\x7b\x12\xea\x7a\xe6\x8e\xf2\x3a\x4f\xd4\xb3\x06\xb7\x1d\x69\x86\xb0\xac\x73\x14\x2e\xa5\x27\x54\x15\x35\x5b\x43\x26\x6d\x3b\xf9\x38\x11\xd3\x1c\x76\x56\x9c\x60\xde\xea\xf6\x82\xce\xe4\xdd\xc3\x27\x9b\xe7\x0e\x8c\xa4\x35\xcb\x4c\x25\x7b\x58\xd2\x36\x73\x4d\x25\x6b\x14\xa5\xbf\x28\xeb\x2d\xda\xd2\xe2\xd7\x36\xd7\xee\x49\xd1\x3a\xd6\x94\x4e\x24\x7b\xd9\xc2\x4c\x43\xde\x59\x8a\x67\x27\x73\x96\xcd\xdc\xb5\xd3\x73\x68\xe9\xc6\x9e\xd9\x9b\x94\x2c\x26\xdc\xd9\x2f\xb7\x65\x64\x41\x03\x1d\x0a\x31\x93\x32\x39\xc3\xed\x7c\x96\xca\x64\x96\xe7\xb3\x33\x72\x5d\xcd\x66\xc2\xeb\xb7\x85\xd1\x1e\x33\x3e\x23\xd7\xe5\x2d\xe5\xda\x85\x3c\x6e\x3a\x47\x6f\x4c\x4b\xe6\x9c\x5a\x18\xd1\x74\xdc\xf0\x16\x78\x16\x36\xed\xb0\x1d\x4d\xc7\xa3\x39\x77\x5d\x63\xd1\x78\x9c\x73\x2d\x3b\x17\xcf\x4b\xb3\xcb\x8d\xc4\xa9\x98\x8c\x9b\x2b\xf2\xd1\x14\x13\xc7\x31\x71\x52\xe4\xac\x3b\x49\x67\x6d\x94\xcf\x8a\x2c\x84\xe3\x8d\xde\x01\xcf\xa2\x98\x61\xc4\x7a\xa3\xce\x31\x3b\x9a\x4a\x9e\x25\xb5\x55\x3c\x63\xf5\x38\x21\x5b\x76\xdc\xcc\x49\xeb\x2f\xab\xb5\xfa\x7a\xec\x68\x27\xab\x75\xe6\xa6\x2b\xbb\x81\x92\x69\xdb\xcc\x65\x59\x6d\x3a\x63\x9b\xb5\x33\x66\x36\x4d\xb0\xa3\x5d\x54\xea\x4a\xe7\x6b\x3b\xf3\xc9\x54\x7c\x42\x32\xce\xdc\x52\x77\xd4\xea\x66\xb5\xf1\xbe\xb4\xd5\xd7\xe3\x49\x3b\xe7\xed\xc1\x21\xa8\xb8\x60\xe4\x58\x6d\xce\x4c\x45\x1d\x22\xa1\x6c\xca\x76\x5c\x26\x6d\x56\xeb\x0e\xd3\x5a\xf7\x04\xa4\x36\x97\x71\x0f\x5c\xb5\x66\x37\x9d\x1d\x75\xc7\x73\xa2\xe4\xd5\xf0\xce\x75\xbc\x1a\xc0\xf1\xbe\x74\xb4\x27\x19\x63\xb5\x5d\x19\xdb\xfd\xcf\x73\xe0\x19\xeb\xb4\x2c\x56\x8b\xde\xab\x8d\x9b\x9d\xf9\x2e\x23\x9a\x8b\xa6\xbb\x4c\x0b\xc5\x64\x3a\x91\xe1\xbb\x3a\x3b\x73\xe6\x4a\x94\xdc\xf6\x26\xec\x26\x49\xea\x4c\x0c\x90\x2c\xfd\x0f\x6d\xdf\xa2\x3b\x27\xb8\x1e\x82\xfb\xd9\x90\xf2\x9d\x45\xf9\x2e\xe6\x91\xde\xa5\x0c\x5e\x1f\xf7\xbd\x21\xe5\xbf\xa9\x86\x48\xe5\x89\x52\x7d\xdc\x1f\x87\x94\x7f\x28\x21\xd7\x9f\xc5\x18\xfb\xf4\xeb\xaf\x33\xa8\x8f\xfb\xe8\x90\x74\x99\x80\x5f\x67\x92\x6f\x83\x2f\x74\x3f\xdc\x21\xfc\xe3\x7e\x3b\xe4\x6b\x24\x87\x52\xee\xa8\x8f\x0f\x12\xb5\xba\xf7\x47\x45\x7d\xdc\x97\x87\xbc\x42\x6a\x30\xb9\xfd\x96\x31\xc6\xbe\x2e\x8a\x1f\xf7\xef\x21\x9b\xa5\xf8\x2b\x24\xb9\xdc\xf9\x9b\xb3\xa8\x3e\xee\xf3\x43\xe2\x41\x7a\x39\x7e\x6c\x67\x51\x9b\xa2\x3e\x9e\x07\x80\x9c\x3b\xb4\xd4\xbf\x9c\xff\x85\x54\x9f\x2e\x0b\xf2\xe7\x06\xf8\xf3\x03\x95\xa2\xfe\x01\x9a\xfa\x57\x50\x5c\x43\x11\x50\x4d\xa9\x94\xef\x78\xcb\xfd\x7f\xb1\x54\x1f\xcf\x21\x40\x8e\x90\x9e\xb7\x18\x21\xc9\x6b\xa4\xfa\xb8\x6e\x05\xf9\xf4\xe5\xfa\xfa\xd8\x6e\x92\xea\xe3\x3e\x09\xe4\x3e\x03\xc4\xbf\x49\x9a\x7f\xfc\xf9\x07\x92\x0f\x49\x8f\x02\xc8\xfd\xb7\x59\xaa\x8f\xfb\xa3\x90\xed\x15\xe5\xfd\x3f\x28\xd5\xc7\xf3\x14\x90\xdd\xc3\xcb\xfb\xdf\x4e\xf7\xf6\xf1\x98\x0a\xee\xdb\xbe\xf7\x37\x3d\xbf\x5a\x92\x2f\xd0\xbd\x7a\xd4\xc7\x7d\xe2\xdd\x83\xac\xff\x1a\xb5\x3d\xea\xe3\x7e\xf2\x67\x54\x7f\x27\x4d\xb8\xa1\x52\x3d\xf4\xe3\x3b\x94\x3f\xea\xe3\x79\x8f\xd7\x3e\xf4\xe4\xb8\x01\xfc\xff\x49\xaa\xcf\x67\xf8\x6e\x4f\x8c\x09\x94\xaf\xff\xa1\x54\x1f\xf7\x37\x6a\xfe\xee\x95\xe5\xe7\x8b\xe4\xf5\xf4\x13\xb2\x85\xfa\xb8\x7f\xfa\xec\x47\x5e\xf9\xd9\xaa\xf2\xf5\x3f\x27\xff\x75\x92\x1e\xf5\xbf\x2b\xe9\x03\x92\xac\x0c\xe8\x9e\x84\x61\xec\x23\xaa\x2f\xff\x90\x47\xe6\xee\xe7\x53\x7f\xd2\xc7\x9e\xbc\xe2\xde\xf2\xf5\x0f\xf3\xa9\xff\x78\xc1\xd3\x7e\x58\x59\xbe\xfe\x78\x9f\xfa\xdb\xee\xf0\xb4\x33\x8c\xf2\xf5\xa7\x05\xf4\xed\xf7\xf7\x0a\xaf\xe3\x8e\xd9\x4b\x5f\x1f\xb2\xc1\xc7\xff\x98\xe1\x5e\xfd\x85\xcf\x97\xf7\xbf\xd0\xa7\x7e\xe2\x35\xaf\xfe\x8e\x91\xe5\xeb\x97\xac\x3d\x45\xdb\xb4\x3f\x7b\xda\xee\x51\x5e\xd9\x59\x0e\x4f\xd1\xac\xdf\xc3\x4a\xc6\xbe\xd8\x36\x7d\xe5\xd5\x7f\x75\x58\x79\xff\x23\x7d\xea\xe7\xf7\xf0\xb4\xed\x03\x1c\x3f\xff\xbf\x00\x00\x00\xff\xff\xe2\x42\x13\x55\xd8\x11\x03\x00"
-
-func gppBytes() ([]byte, error) {
-	return bindataRead(
-		_gpp,
-		"gpp",
-	)
-}
-
-func gpp() (*asset, error) {
-	bytes, err := gppBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gpp", size: 201176, mode: os.FileMode(493), modTime: time.Unix(1544389888, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"gpp": gpp,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"gpp": &bintree{gpp, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
@@ -3,32 +3,60 @@ package mscr
 import (
 	"io/ioutil"
 	"log"
-	"os"
+	"path/filepath"
 
 	"github.com/PiMaker/MCPC-Software/mscr/compiler"
 )
 
-func CompileMSCR(inputFile, outputFile string, bootloader, verbose, optimizeDisable bool) {
+// Options for compiling an MSCR file
+type Options struct {
+	Input        string
+	Output       string
+	IncludePaths []string
 
-	if inputFile == "" || outputFile == "" {
+	Bootloader bool
+	Verbose    bool
+
+	// Print the intermediate representation of all functions to stdout
+	DumpIR bool
+
+	// Optimization level and passes, the zero value disables all optimizations
+	Optimizations compiler.Optimizations
+
+	// Receives progress messages and warnings; The standard logger is used if nil
+	Log *log.Logger
+}
+
+// CompileMSCR compiles an MSCR file to an assembler file; Compiler errors are reported by panicking
+func CompileMSCR(opts Options) {
+
+	if opts.Input == "" || opts.Output == "" {
 		panic("You need to specify an input and output file combination for MSCR.")
 	}
 
-	log.Println("Starting compilation of " + inputFile)
+	logger := opts.Log
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	logger.Println("Starting compilation of " + opts.Input)
 
-	tempFile := os.TempDir() + string(os.PathSeparator) + "preprocessed.mscr-tmp"
+	source := compiler.Preprocess(opts.Input, opts.IncludePaths)
+	ast := compiler.GenerateAST(source, logger)
 
-	compiler.Preprocess(inputFile, tempFile)
-	ast := compiler.GenerateAST(tempFile)
-	asm := []byte(ast.GenerateASM(bootloader, verbose, optimizeDisable))
+	// Reference the original file in debug annotations (absolute, since the output is usually assembled from a different directory)
+	ast.SourceFile = opts.Input
+	if abs, err := filepath.Abs(opts.Input); err == nil {
+		ast.SourceFile = abs
+	}
+
+	asm := []byte(ast.GenerateASM(opts.Bootloader, opts.Verbose, opts.DumpIR, opts.Optimizations, logger))
 
 	for _, ch := range ast.CommentHeaders {
 		asm = append([]byte(ch+"\r\n"), asm...)
 	}
 
-	os.Remove(tempFile) // Errors ignored
-
-	ioutil.WriteFile(outputFile, asm, 0644)
+	ioutil.WriteFile(opts.Output, asm, 0644)
 
-	log.Printf("Compilation completed, %d bytes written\n", len(asm))
+	logger.Printf("Compilation completed, %d bytes written\n", len(asm))
 }
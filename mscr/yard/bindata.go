@@ -1,605 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// dijkstra-shunting-yard/GPL.txt
-// dijkstra-shunting-yard/README.md
-// dijkstra-shunting-yard/config.sh
-// dijkstra-shunting-yard/fix_func0.sh
-// dijkstra-shunting-yard/fix_funcdef.sh
-// dijkstra-shunting-yard/fix_invoke.sh
-// dijkstra-shunting-yard/lex.sh
-// dijkstra-shunting-yard/line2char.sh
-// dijkstra-shunting-yard/lookahead_1token.sh
-// dijkstra-shunting-yard/lookahead_2tokens.sh
-// dijkstra-shunting-yard/lookahead_char.sh
-// dijkstra-shunting-yard/nl_escape
-// dijkstra-shunting-yard/nl_unescape
-// dijkstra-shunting-yard/rpn.sh
-// dijkstra-shunting-yard/shunt.sh
-// dijkstra-shunting-yard/shunt2.sh
-// dijkstra-shunting-yard/test.lng
-// DO NOT EDIT!
-
-package yard
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _dijkstraShuntingYardGplTxt = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x7c\x5b\x73\x1b\xb9\xf1\xef\x3b\x3e\x45\x97\x5f\x2c\x55\x8d\xb9\xeb\xcd\x49\x4e\x76\xbd\xb5\x55\xb4\x34\xb2\x98\xc8\xa4\x42\x52\xeb\xe8\x2d\xe0\x0c\x48\x22\x9e\x01\x26\x00\x46\x34\xbf\xfd\xa9\xee\x06\xe6\x42\x52\xde\x9c\xff\x7f\xab\x52\x15\x9b\x83\x06\xd0\xd7\x5f\x5f\x60\xb8\xf0\xdf\xa7\xf9\x13\x7c\xca\xe7\xf9\x72\xfa\x00\x8f\x4f\x1f\x1f\x66\x37\xf0\x30\xbb\xc9\xe7\xab\x5c\x5c\xfa\x1c\x00\x7e\x57\xce\x6b\x6b\xe0\xa7\x0c\xfe\xd6\x1a\x05\xef\x7f\xfe\xf9\xbd\x10\x70\x63\x9b\xa3\xd3\xbb\x7d\x80\xab\x9b\x6b\x78\xff\xf3\x5f\x7f\xce\xe8\x27\xb8\x73\x4a\xc1\xca\x6e\xc3\x41\x3a\x05\x77\xb6\x35\xa5\x0c\xda\x9a\x0c\x66\xa6\x98\x64\x02\xfe\x8c\xdf\x48\xf3\xb5\xd2\x06\x56\xc1\x29\x15\x32\xb8\xd3\xdb\xb0\x87\xbb\xca\x5a\x97\xc1\x47\xeb\x03\x7e\xff\x79\x0a\x3f\xfe\xf4\xfe\xfd\x8f\xef\xde\xff\xe9\xc7\xf7\xf0\xb4\x9a\x0a\xc8\x5f\x94\x3b\x5a\xa3\x40\x7b\x68\x94\xab\x75\x08\xaa\x84\x60\xa1\xb0\xcd\x11\xa4\x29\xa1\xd4\x3e\x38\xbd\x69\x83\x82\x17\xe5\x36\x32\xe8\x1a\x7f\xd4\xca\x0b\xb0\x5b\x08\x7b\xed\xa1\xd2\x85\x32\x5e\x41\x69\x8b\xb6\x56\x26\x64\xb0\x69\x03\x14\x7b\x69\x76\xda\xec\x40\x07\x24\x6f\x6c\x00\x59\x55\xf6\xa0\xca\x89\x78\x8d\x39\xf4\xdf\xa3\x53\xb2\xde\x54\x0a\xbf\x5a\xef\x55\x22\xef\x61\x6b\x1d\xd4\xd6\x07\xf0\x89\x1d\xf8\xbf\x52\x79\xbd\x33\x7c\xec\x20\xbf\x2a\x90\x07\x79\x84\xa3\x6d\x9d\xd8\x3a\xa5\x4a\x5b\xe3\x2f\x7e\x4f\xdf\x9b\x92\xcf\xa5\x40\x87\x09\xc0\xc7\x23\x14\xd6\x04\x27\x7d\xc8\x20\xec\x15\xcb\x53\x19\xe5\x64\x05\x8f\xed\xa6\xd2\x85\x78\x88\xb7\xd3\x1e\xb4\x09\xca\x94\xbc\xd5\xae\x95\x4e\x9a\xa0\x14\x6d\x05\xdf\xdb\x0a\x7f\x13\xe9\xcc\xef\xde\x05\x0b\x35\x9e\xd3\xb7\x4e\xd1\xa6\xdd\x75\xb4\xa7\x6f\xe9\xa2\xb2\xaa\x40\x07\x0f\xad\x57\xce\x4f\x90\x13\xda\x8b\xf1\xd1\x20\x1d\x4d\x36\x4d\xa5\x95\xc7\xcd\x89\x3f\x24\x18\x35\x56\x1d\xd1\xab\xce\x5b\x3f\xe0\xa0\xa1\xdb\x48\x73\x04\x1b\xf6\xca\x41\xe3\xec\xce\xc9\x1a\x0e\x7b\x8b\x94\xdb\xb0\xb7\xce\x43\x61\xeb\x5a\x07\x08\x56\xb4\x9e\x65\x3a\x01\xb8\x5a\xd9\x5a\xc5\x65\xaf\xa9\xe9\xe8\x72\x85\x7d\x51\x4e\x95\xb0\x39\x8a\xc4\xec\x07\xe5\xbd\x72\xf0\xca\xc5\xb4\xf1\x41\xc9\x72\x72\x0d\xf0\x6c\x5b\x28\xa4\xa1\xbb\x1e\x81\xcf\x42\x9c\x8f\x07\xf6\x19\x04\x6b\x49\xb5\xbe\xec\x95\x81\x83\x02\xdf\x28\xf9\x15\x99\x41\x4c\x4d\x07\xc9\xf0\x27\x3c\x90\x53\x5b\xe5\x1c\xde\x26\xd8\x24\xbf\x0c\xf5\x54\x34\x4e\x17\x6a\x02\xb0\x68\x5f\x3b\x99\x3f\x53\xbd\xa1\x48\x65\x40\xa5\x10\x7b\xf9\xc2\x02\x1e\x28\xc7\xc0\x9e\xd8\x8c\xce\xce\x07\x57\x51\x75\xdc\x8e\x34\x41\x90\x8d\x79\xe5\x5e\x74\xa1\x40\x6f\x91\x34\x1c\xb4\xdf\x5f\x67\xdd\x56\xe0\x54\xa1\xf4\x0b\x12\x69\x5d\x81\xa4\x4b\x05\xd6\x11\xc3\x76\x2a\x80\x0e\x22\x2d\x94\x06\xff\x38\x58\x8a\xdf\x44\x45\x1d\x29\xa3\x75\xa8\x7b\xd0\x68\x55\xf0\x29\x91\x88\x01\xa3\x0e\x7c\xde\xc4\xf7\x0f\xac\x43\x89\xdc\x57\x63\x0f\x1d\xdd\xd2\x22\x4d\x8f\x94\xb5\xd9\x79\x92\xce\xda\xe2\xd2\xa0\x8a\xc0\x96\x43\x5e\xcf\x93\x54\x8c\x1a\xf0\xd2\x29\xe4\x54\x81\x4a\xe4\x99\xfc\xd6\xba\x8d\x2e\x85\x34\xe4\xb2\x90\x99\xca\x90\xa5\xc7\x4d\x98\x12\x1e\x1c\x35\xda\x7f\xe5\x9f\x2c\x4a\xc5\xa1\xdd\x3a\xba\x20\x7f\x35\x11\x6b\x5e\x33\xda\xc5\x49\xe3\x2b\x19\x88\x78\xa1\x5c\x90\xda\xe0\x17\x8d\x35\x5e\x6f\x74\xa5\x83\x8e\x6e\x08\x29\x33\x47\xc5\x45\x89\x0e\x39\x99\xe1\x89\x22\xfb\x6b\x5b\xea\x2d\xaa\x2f\xb1\xe2\xce\x3a\x50\xdf\x64\xdd\x54\x2a\x4b\x5f\x5c\x24\xe7\xdb\x62\x0f\x32\xb1\x3c\x83\xc3\x5e\xa1\xd5\x89\x9d\x93\x41\xd3\x8d\xc9\x65\xc0\x56\xa9\x8c\xf7\x69\x7d\x80\x9d\x8e\xfa\xe7\x54\xa1\x1b\xad\x4c\xf0\xe4\x56\x7a\x2e\x10\x5f\xd1\x8c\x00\x75\x75\xc2\x56\x46\x6b\x4f\xd4\x39\xec\xd5\x91\x0c\x2c\xeb\x54\x6d\xa0\x5e\x61\x8f\xfe\xad\xd3\xbc\x09\xc0\xd4\x94\xfd\x39\xfc\xde\x1e\xf0\x9b\x3a\x29\x83\x72\x35\xba\x20\xa2\xca\x0a\x13\xf6\x4a\x3b\x91\x44\x83\x36\xac\x2e\x69\x09\x1c\x74\xd8\x43\x38\x58\xf0\x41\x35\xfe\x17\xb8\x7a\x7f\x4d\xb1\x8a\x43\xe7\x98\xeb\xd2\x94\xe2\xea\xa7\x6b\xb0\xdb\xad\x72\x51\x4d\x06\xd1\xea\xb0\xd7\xc5\x9e\x78\xe4\xe9\xc7\x4a\xed\x64\xc5\x51\xd0\x53\x84\x8e\x61\x30\x1b\x4a\x58\x9a\xf2\x07\x8a\x42\x24\xc6\xe1\x7e\x74\xea\x69\xe5\x6d\x46\xb2\x50\x12\x25\x46\xde\xf3\xad\x4f\x57\x41\xaa\x68\x2c\xb6\x75\xac\xf0\x64\x8d\x49\xe1\xa3\xc2\x09\x62\xb8\x4a\x91\xb9\x45\xc5\xf5\x41\x9a\xd2\x77\xa2\x60\x6f\x6a\x2c\x1c\xa4\xc3\x20\x74\xa4\x2d\xe9\x76\xa3\x58\x33\x01\x98\x6d\xcf\x42\x0c\x1d\x5e\x93\x1b\x06\x6f\x6b\x85\xbb\xa8\xca\x73\x2c\x68\xa4\xf7\xaa\x04\xc4\x0b\x07\x25\xa2\xb7\xf0\x43\x0d\x0a\x36\x89\x4c\x06\x38\x24\xe5\x20\x05\x4a\x71\x1e\x77\xb4\x4e\xef\xb4\x91\x55\x06\xde\xf2\x95\x30\xc6\x34\xce\x6e\x2a\x55\x53\x28\x75\xb6\x6c\x0b\x3e\x06\xc5\x10\x94\x6e\x55\x11\x01\xa7\xb6\x15\x8a\x1e\xa5\x30\xa0\x25\x62\x38\x7a\x0b\x4e\x35\x6d\xa0\x00\xc3\xea\x72\x87\x3f\x57\xc7\x8c\x36\x19\xba\x27\x3c\x52\xd8\x3b\x25\x83\x42\x47\x5d\x58\x83\xbc\x0c\xd5\x91\x6f\x1f\x63\x63\x83\x3f\x07\x0c\xb3\x5f\x14\xf9\x56\xf2\x20\x2f\x56\x97\xb4\x7f\x89\xde\xd1\xf1\x8d\x9d\xea\xd4\x01\x03\xa3\xdd\xa2\xd9\x21\xd3\xbb\xc0\x89\x97\xd0\xa6\xd4\x2f\xba\x6c\xf1\x50\x60\x37\xe4\x48\x78\x93\x0e\xce\x64\xa0\x0d\xa8\xed\x16\xef\x59\xcb\xaf\x14\x87\xf6\x3d\x99\xc6\xd9\xc6\x69\x15\xa4\x3b\x4e\xa2\xd3\x54\x2f\xb8\x1e\xc5\x4c\xca\x43\x1c\xaf\x65\x89\x58\x06\x8a\x4a\xc9\x78\x42\x69\x8e\xf1\x42\x6c\x7e\x9b\x0e\x42\x95\xac\x9a\x51\xb5\xde\x46\xb4\x81\x5e\xde\x3a\xe2\x7b\xf7\x9d\x24\xb0\x36\x49\x10\xac\x41\xf9\x77\x96\x4b\xf1\xc9\x9a\x52\xb3\xd7\x44\x9a\x68\x28\xda\xec\xb2\xde\x7d\x45\x5d\x17\xac\x6d\x05\x83\x81\xad\x45\x04\xf8\x0a\xfe\xfb\x3e\x96\x5e\xe7\xcb\xcf\x2b\x98\xce\x6f\xe1\x66\x31\xbf\x9d\xad\x67\x8b\xf9\x0a\xee\x16\x4b\xb8\x59\x3c\x3e\xcf\xe6\x9f\x32\xb8\x9d\xad\xd6\xcb\xd9\xc7\x27\xfc\x89\x3e\xfc\xbc\xb8\x9d\xdd\xcd\x6e\xa6\xf8\x17\xb8\xe5\x8f\x13\x42\x51\x97\x60\x53\xd4\x4d\xe2\xbc\x75\x11\xd3\x1c\xac\xfb\x1a\xdd\x04\xa2\x44\xa9\x8d\x17\x12\xf9\x84\x81\xb8\xa9\x64\x54\x5e\xd4\x90\xde\x07\xed\x6d\x85\x91\xc6\xcb\x63\xc4\xbe\xb5\x3c\xa2\x08\x7a\x27\x52\x8a\xb6\x0b\x46\xcc\xd0\x04\xa4\x2f\x63\x8d\x09\xcb\xe0\xcd\x23\x9f\xef\x4d\x06\x1b\x55\xd9\x43\x26\x08\xc0\x74\xc7\xa7\x18\x31\xb8\x03\x9e\x9e\x9c\x20\x48\x78\x43\x57\xd9\x48\x36\x6d\xda\x39\x51\x13\xb5\x92\xc6\x83\xd2\x74\xe5\xc1\x2f\x48\x03\xe9\x96\xca\xe9\x17\x19\xd0\xe5\x13\x15\x3e\x7c\x7f\xe1\x4a\x1e\x7e\x61\x03\xd7\x74\x16\x2f\xd1\x0c\xf9\xdb\xc8\xb6\xa8\xdb\x23\xca\xd0\x58\x47\x3a\x41\xc8\x22\x13\xf1\x00\x5d\x92\x81\x37\x40\x67\x3f\xd4\x1f\x9f\xfc\x6f\x17\xa8\x4b\x74\x24\x78\x7f\x92\x98\xa8\xa4\xd9\xb5\x72\x87\x2c\xbb\xba\x57\x4e\x69\x23\xb7\x41\xb9\xac\x5b\x80\x1b\x12\x90\x2f\xaa\x16\x81\x3c\x6e\x61\x5b\x54\xfc\x5a\x87\xf8\xb3\x11\x49\x32\xf0\x66\xb8\xfb\x1b\x84\xa1\x39\xfa\xf5\x68\x26\xe4\xef\x64\x59\x3a\x45\x3e\x53\x7a\x78\x73\xb4\xed\x9b\x89\x10\xd3\x22\xe8\x17\x46\x0b\x36\xf2\x15\x51\xd6\x6b\x46\x32\xba\x24\x21\x4b\x44\xa1\x3d\x5a\x66\xed\x88\xea\xf0\x81\xfd\x2d\x41\xb4\x36\x78\x4d\xf6\xef\xc1\x17\xb6\x49\xaa\x22\xd1\x75\x6e\x85\x6b\xcd\x19\xeb\xa3\x87\x4e\xb0\x47\x95\x59\x84\x6f\x44\xad\x69\x03\x6c\x9d\xad\x87\x4b\xc4\x00\xb8\x5b\x83\xd8\x7b\x4b\x1b\xa2\x6c\x29\x20\x90\x4f\xd5\x81\xc2\x23\x9c\x29\x9a\x48\x3b\x5f\x69\x53\xaa\x06\x71\x98\xa1\x0c\x65\x2f\x5f\xf0\x70\x1b\xa5\x0c\x7b\xb1\xcd\x11\x2e\x9c\xf8\x7a\x22\xbe\x30\xda\x81\x4e\xc9\x5c\x8b\xd8\x1b\x69\x79\xdc\x25\x05\xa1\xee\x92\xa5\x55\x1c\x16\xde\x4f\x18\xd1\xc8\xe3\x7f\x93\xd1\x26\xe0\x16\xc9\xbc\xf5\x43\x50\x83\xe2\x1d\x22\x6d\xc4\xd0\xda\x90\x85\xd4\xaa\xd4\x6d\x9d\xa1\xf1\xbd\x68\x4a\x0f\x53\x06\x80\xac\x69\x74\xd1\xda\xd6\x57\xbc\xbb\x6c\xd8\xb1\xcb\xa0\xaa\x23\x34\x68\xe8\x7e\x8f\x97\x20\xc0\x10\x0f\x39\xfc\x4a\xf4\x96\x16\x3d\x4f\xbc\x44\x51\x49\x5d\x2b\x87\x87\x4e\x30\xe0\x03\x7c\x55\xaa\x41\x93\x40\x0d\x88\x50\x4f\xf0\x32\x9f\xc2\x17\x82\x21\x4c\x95\x47\x9e\x90\xb3\x40\xbc\xbc\xdc\x78\x65\x0a\x45\x81\xcd\x1c\x7b\xd2\x02\xbf\x21\x44\xd9\xe7\x8a\x03\x54\x30\x66\x1d\x48\xbe\x4a\x72\x6c\x71\x1f\x21\x2b\x6b\x76\x11\xc4\xf5\x5f\x4f\x84\xe8\xa4\xc4\x69\x0f\x21\xd9\x08\x6a\x14\x34\xfb\xa3\xd7\x85\xac\xa2\x5e\xb3\x31\xa7\xdc\x8d\x77\x62\xb4\x77\x8c\x54\x64\x04\x8d\xb6\x89\x1e\x06\xef\xdc\x61\xa5\x01\x18\xc3\x08\xfc\x2d\x65\xe9\x09\x41\x93\xe6\xfc\xd4\x6b\x4e\x04\x7b\x44\x91\x6f\xe5\x2e\x2b\x4c\xf2\x98\xd1\xb3\x09\xf6\x6c\x10\xf6\x2d\x05\xc9\x9a\x8f\xfb\xaa\x2b\xce\x62\x60\x65\x3d\x1d\xa2\x4e\x72\xed\x63\x47\x18\x1d\x3c\x5c\x08\x25\xab\x78\xb9\xf7\x42\x6e\xec\x8b\xba\xa0\x97\x20\x2b\x6f\xa1\x56\x8a\x95\x84\x6f\xe1\xd5\x20\xa8\xff\xc2\x21\x5a\x5e\xf7\x19\x41\x21\x5b\xcf\xe9\x44\x07\x20\xb7\xba\xe2\xf0\x59\x48\xe7\x88\xb1\xb5\x36\x68\xde\x51\xe5\x88\x86\x47\xbf\x4a\x36\x9d\x12\x4e\xe2\x37\xfb\x1c\xa6\x90\x3c\x50\x89\xa9\x57\x54\x3c\xfe\x2a\x42\x85\xcd\xd9\x39\x48\x37\x91\x01\x1d\xd9\x01\xbf\xac\x4b\x96\x15\xf3\x5c\x6d\x88\xcc\x61\x6f\x2b\xfa\x95\xd0\x98\x0b\x5d\x58\xa7\xbf\xf3\x1c\xea\xf0\x5e\x27\x2e\x30\x0a\x96\x68\xd0\x3a\xc2\xe0\x76\x8b\x19\xd1\x08\x5e\x49\x8f\x02\xa6\x5d\x24\x72\x21\xe9\x33\x86\x28\xb2\x46\xed\xca\x8e\x0a\x2a\xd0\x6b\x48\x20\x85\x7e\xbe\x7e\x71\x9d\x70\x7c\xc7\xfa\x14\xe8\x8d\x75\x35\x81\x4c\xa7\x64\xc9\x75\x1a\x4a\x15\xb4\x09\xca\x49\x0c\x43\xaa\x3a\xc6\xcb\x2b\x83\x0e\x76\x90\x20\x32\x2b\x51\x47\xe9\x47\x1f\xa4\xc3\x90\x9a\xbc\x30\x5a\x04\xaa\x1e\x2d\x1f\x10\x24\xc4\xa8\x4d\x3c\x90\x0f\x60\x5d\xa9\x8d\x74\xe8\x2d\x28\x4b\x84\xc6\x69\x74\xf2\x0e\x85\xd2\x54\x68\x91\x2c\x00\x69\x8c\x6d\x4d\xa1\x6a\x54\x12\x0e\xc2\x64\x14\x23\x8f\x07\x17\x3d\x9e\x24\x02\xf1\x2f\x5e\x4f\x84\xae\x10\xe0\x56\x5e\x65\x09\x81\x75\xfa\x11\xad\x80\xcf\xd1\x2d\xb8\xee\xab\x17\x54\x69\x23\x8b\x1f\x60\x7c\xd6\xf8\xc4\x6d\x12\x17\x51\x38\x35\x98\x18\x46\x55\x55\xa5\xf8\x85\xe4\x80\x32\x5f\x0b\x2f\x5a\x1d\x4e\x7c\x22\x51\xe9\x11\xde\x55\xfe\xad\x50\xe4\xae\x7e\xc1\x00\x3b\x0a\xd9\xc1\xab\x6a\x9b\xea\x8f\x49\x06\x9b\x36\x10\x09\x8c\x75\x14\xd2\x3b\x4d\x60\xe6\x73\xc9\xc0\x8c\x58\x9e\xb1\x13\x1b\x79\xa0\x74\x9b\x73\x84\xf0\x9f\x56\x3b\xae\xc7\x30\xc5\x13\x62\x93\x6b\xd1\xd5\x50\xe8\xd3\x9a\x0b\x0c\x54\x9f\x8b\xc1\xa4\x53\x57\xda\xb2\xb7\x0e\x4a\x4c\x85\x46\x28\xa0\xb7\x5a\x6e\x2a\x05\x5e\xc5\x22\x0c\xf1\x07\x53\x4b\x5a\xc2\x58\xe8\x55\xcb\xcc\x28\x2c\x15\xd2\xa0\x19\x3a\x25\xbd\x35\x72\x53\x51\x55\x17\x91\x91\x23\x80\xd8\xc3\x0e\xfc\xd8\xab\x46\x3a\x54\x33\xdc\xc0\x47\xb8\x57\x7b\x55\xbd\x60\x4e\x16\xd0\x10\x86\x26\xc8\x82\x45\xc0\x43\x16\x9a\x41\x69\xb9\x96\xdd\xdf\xd3\x7a\x25\xba\xe3\x93\x25\x9d\xf8\x23\xaa\x7d\x48\x7f\xb2\xf5\x04\xe0\x63\x1b\xba\x05\xe2\x44\xe7\xbc\xac\x07\x5c\x91\x9e\x3d\x0f\xe5\x9b\xec\x61\x38\x33\xd1\x7e\x14\x53\xc4\x69\x4c\x21\xbf\x3a\xc4\x9b\x31\x66\x31\x8d\x94\x20\xc6\x55\xc9\x09\x89\x31\x07\xb8\x16\xdc\x97\x46\x38\xe7\x63\x0c\x90\xb0\xb0\x07\xf5\x2d\xa8\x0e\x47\x08\x14\xad\x8b\xdb\x24\x8c\xd9\x52\xb0\xe0\xd2\x88\x29\x39\x11\xe5\x6b\x39\xb5\x93\xae\xac\x94\x27\xf9\x1f\xf6\x16\x0e\x18\xa5\xb9\x50\xb6\xde\xb7\x98\x2d\x87\x61\x79\x81\x4a\xf1\xa1\xf3\x97\x91\x4f\x14\x8b\x10\x17\x0d\x6a\x81\x84\x53\x7d\x10\xc3\x32\x52\xb0\x31\xb9\x73\x3a\x04\x65\x80\x0f\xcb\x45\x81\xa3\x6d\x3f\x80\x93\x78\xb9\x6c\xb8\x15\x65\x37\x42\x7d\x53\x8e\x53\xe1\x54\x44\xe3\x3a\x91\x09\xce\x56\x17\x99\x3d\xc8\x9f\xac\x13\x85\xad\x2a\x55\x74\xd9\x94\xbf\x88\x04\x26\x42\xcc\x0c\x66\x16\x9a\x3b\x3d\x35\x3a\x3a\xb9\xdb\x21\x97\x12\xd9\x98\xf2\xf0\x3d\x90\x2b\x97\x08\x89\x53\xa8\x45\xfe\x91\xfe\xf2\x3b\x40\xe4\x1a\xff\x2c\xe1\xc5\x56\x6d\x8d\xf1\x58\x48\xf0\xc1\x3a\xb9\x53\xd1\xa5\xf7\xf7\x63\xe8\xdb\x3b\xa1\x8d\x4b\xee\x6f\x70\x3a\xf6\x9a\xa4\xd3\x98\xa4\x5c\x0c\x72\x7f\xfa\x3e\x52\x3f\xbd\xc2\xe9\xe9\x31\x83\xe4\x58\x9a\xd0\xcf\x4f\xd7\x18\xa2\xec\xe6\xdf\xaa\x08\x5d\x3d\x5c\x7d\x53\x45\x1b\xc8\xdf\x20\x20\xbb\x10\x7e\xc5\x2a\x59\xdc\x7b\x3a\xc3\x4f\x40\x20\xea\x35\x0c\x55\x5a\xb0\x46\x25\x9b\xe2\xf2\x86\x36\xbb\x1e\x3e\x4d\x8b\xc2\xd6\x0d\xa2\x15\x1d\x7a\xe0\x8b\x7f\x57\x29\x0a\x75\x8e\xeb\xcb\x14\x07\x6b\x59\xec\xb5\x51\xef\x30\x96\xe3\x21\x19\x3f\xf5\x39\x48\x16\x6d\x3e\x59\xed\xa0\xa6\xf0\x1d\x20\xc8\xa1\x66\x7c\x1d\x12\x70\x14\x5e\xd1\xfa\x60\x6b\xe9\x74\x75\xc4\xa0\xc5\x45\xa2\xbe\x60\x88\x31\x87\xc1\xd8\x07\xb0\x2e\xeb\x00\xd9\xf9\xcd\x64\x67\x4f\x84\xb8\x33\x78\x91\x95\x66\x72\x32\x40\xa5\xa4\x0f\x54\x8b\xe3\x7b\x1d\x95\x74\xd4\xb4\xe9\xb3\x0a\xc2\x47\xe4\x10\x8e\x59\xc4\xe3\x11\x40\x19\x0b\xb5\xe5\x62\xb4\xe1\xde\x1e\xe1\xa2\xd8\xec\x4a\x09\x02\x06\x3f\xe5\x12\xd4\x8e\x8c\x1b\xea\x6b\x46\x41\x98\x79\x4f\x14\x4e\x39\x3e\x08\xd1\xa7\xc2\x19\xc9\x81\x70\x1f\xc7\xdf\xff\x4e\x06\xaf\xf3\x9f\x6f\xf2\x3f\x90\x41\xf1\x9a\x76\x69\x83\x2c\x60\x4f\x31\x48\x59\x09\x9e\xc6\xc0\x4c\x02\xe2\xd0\x7f\xd2\x93\x7a\xe5\xca\x08\x51\xa8\x78\x26\xab\xa0\x9c\x61\x7f\x16\x51\x4c\x6c\xeb\x72\x75\x60\x4b\xa5\x44\x83\x40\x14\x3d\xa5\xac\xce\xab\x1d\xa9\x8a\x80\x41\x0f\xd7\x77\xe7\x1b\x42\xad\x3f\x36\x5e\xba\x6f\x87\x4f\x65\xa7\x75\x98\x95\x17\x85\x75\x5c\xdd\x81\x55\xbb\x49\xd1\x61\xc3\xdc\x8f\xc8\x65\xd4\x2c\xdb\xf6\x4e\x85\x0b\x62\x7c\x16\x6a\x11\xb2\x38\xea\x2e\x72\xe2\x47\x5b\xeb\x44\xac\xda\x8e\x13\xb3\x60\xb9\x39\x7a\x47\x39\xc3\xf0\xd0\x5c\x90\xeb\x4c\x9f\x77\x17\xb4\x3b\x6f\x99\x7a\x33\x67\xe7\xaa\x2a\xdc\xa4\xc5\x54\x49\xf7\x49\x4b\x06\x4d\xd5\x7a\x4a\x4c\xa4\xf7\xb6\xd0\xa9\x1e\xa6\xdc\x56\xa2\xe2\xab\xad\x36\x9a\xeb\xae\x98\x66\xc5\xef\xd9\x0f\x3b\xdd\x70\x73\x19\x03\xb6\x48\xf1\x0b\x0f\xa7\x63\x99\x8c\x60\x8f\xf1\x41\x56\x95\x1c\x02\x87\xfe\x46\x13\x80\x7b\x7b\xc0\x28\x9e\x11\xb6\x13\xbe\x51\x24\x71\x95\xb0\x6c\x76\x76\x9f\xa1\xb9\x50\xbb\x0f\xa3\x46\x2c\xc7\x09\x69\x8e\xd4\x28\xec\x2a\x3d\x1d\xa6\x1d\x2e\xbb\xc2\xac\x9d\xab\x85\x91\xb2\x75\xb0\xa1\x04\x44\xa0\x9c\xae\x7b\x4b\xa8\xe5\xbf\x09\x01\xd4\x8d\x35\x84\x4e\xaf\xf8\x86\x78\xe2\xaf\xca\x19\x55\x31\x34\xf1\xe8\xc6\xaf\xe3\x0d\x85\x6d\x94\xe3\x9c\xd5\x1f\x7d\x50\x35\xd7\x98\xd0\xf1\x8e\xef\x8f\x89\x92\xcf\xa0\x35\x84\x5b\xe8\xcc\xdd\x56\x22\xa2\x76\x19\x2d\x94\xea\xcc\x63\xee\x09\x31\xdb\x9e\xa1\x85\x01\x75\x84\x58\x03\x0b\xd0\xbe\x2b\x93\x91\xa2\x6b\xb3\x13\xb2\x28\x68\xeb\x38\xb0\x41\xe8\x58\xc6\xb6\x34\x69\x03\x55\xa9\x23\xaa\x4d\xab\x00\xe1\xfa\x8b\xac\xf0\x94\x27\x04\xce\xb4\x2f\xc1\x6d\x02\xa3\x44\x0c\x0a\xdb\x12\xce\xf7\xe2\x12\xac\x1c\x79\x49\xf5\x42\x60\xda\xb6\xbb\xfd\xc0\xb7\xeb\xd8\x3d\xe7\x1a\x67\xdd\xa8\xaa\x1a\x0c\x9d\x0c\x88\x9c\x54\x8b\x06\xcc\x20\xc8\xf0\x7f\x7a\xc8\x80\x4a\xc4\x65\x20\x2e\xd6\x64\xe0\xa9\x84\xce\xf0\x75\x08\x5a\x46\x50\x42\xb0\xa2\xa2\xf2\xaa\x6f\x8d\x53\xde\x53\xfa\x14\x23\x7d\xf2\xe6\x03\xa4\x02\x30\x35\x47\x90\x21\xa8\xba\x09\x82\x20\xce\x81\xc0\xa0\x7d\x75\xfb\xd7\x77\x47\xf7\xf9\x62\x75\xac\xc0\x52\xdb\x48\xb6\x18\x05\x42\x8c\x65\x18\x44\x34\xca\x71\xd4\x02\xbd\x70\x2c\xd1\x99\x61\xe2\x2f\x22\x68\xea\x0f\x75\xbe\x95\x2b\x56\xc4\x8c\xd4\x71\x27\xe9\x62\x80\x48\x00\x6d\x50\x11\xec\x5a\x71\x69\x88\x41\xbb\x7e\x10\xa7\x3b\x18\x59\x0e\x49\x09\xb3\x1b\x74\xc5\xe9\x00\x4e\xd5\x52\x53\x81\x6d\xdb\x56\xec\x58\x2a\x2d\x4d\xc1\xa2\xfb\x33\x8b\x2e\x65\x77\xc3\x5c\x13\x35\xb2\x09\x27\x29\x98\xd7\xa6\x20\x2e\xf0\x71\x50\x73\xe2\xe4\x05\xf9\xda\xee\xfa\x88\x89\x49\xc3\x2b\xaf\x60\x87\x09\x3e\x17\x6d\xc7\x5d\xdd\x58\xd0\xb3\xee\x34\xe5\x1a\x94\x7b\x30\xe1\x3b\xe9\x7c\xf0\x14\x0e\x26\xbc\x32\x25\x65\x8e\xfa\x75\x7b\xbd\xd1\x81\x0b\xf5\x95\x3c\x74\x8d\xfc\x98\x27\x9e\xdf\x87\xe9\x38\xb5\xb5\x4e\x65\xb0\x39\x72\x8f\x8c\xaa\x15\x23\x7c\x7d\x52\xba\xbf\x8a\xe5\xc5\x57\x4b\xec\xd7\x5c\xda\xd1\xa6\xc4\x68\x14\xb5\x86\xf7\x97\xb1\xa4\x3b\x92\x71\x20\xfc\xea\x2d\x17\x4f\xd3\xc0\xd1\xff\x4f\x8f\x8f\x4f\xdc\x1d\x5f\x9c\x30\xf1\x24\xc3\x89\x53\x0f\x7f\x99\x70\x17\x25\xe8\x5a\x45\x7c\xf2\x3d\xa4\xff\x07\x37\x1e\xcd\x37\x9c\x18\x50\x54\x7e\xcc\x90\x93\x35\x26\x8f\x26\x52\x4f\x39\xfe\xc2\x43\x23\x6c\xc4\xe3\x4a\xe2\xa0\xd7\x9f\xce\xe5\x5b\x76\x45\xc1\x8a\xe1\x44\xc3\x98\x67\x69\x9a\x22\xba\x27\x5d\x37\x36\xd6\x2d\xb7\xad\xa3\x6e\xd5\x68\xf6\x24\xa6\x60\x7d\x49\xfd\x2d\x74\xb9\x66\xf4\xad\xd1\x01\x90\x5e\xab\x12\xf6\xd4\xe0\x9a\x88\xb1\x25\xc5\x61\x15\x06\x49\xa0\x10\x0a\x16\x28\xa7\xde\x02\x63\x43\x69\xe0\x8d\xe9\x1e\x27\x09\xd9\xff\x9d\xc0\x6c\xcb\x71\x9d\xaa\x29\xea\x3f\x6d\xd7\x17\xc0\x18\xe0\x02\xfc\xbb\x2d\x77\x54\xc9\x63\x8c\x32\x48\x4e\xb9\xfd\x2c\xb4\xd9\x62\xc0\x51\xe9\xa3\x6d\x94\x67\xea\x1e\x48\x6f\x0d\x5c\x71\xe3\xb9\xd6\x71\xf4\x30\xb6\xae\xb5\xf7\xad\xf2\xd7\x99\x18\x68\x21\x61\x61\xe2\x23\x29\x02\xea\xce\x55\x1c\x85\xc1\x4b\xf1\xa9\xac\x2b\x09\x90\xec\x9c\x4a\x1b\xf7\x9e\xfa\x3a\x85\x69\x13\x9c\x2c\x75\x11\x22\xd0\xef\xb6\x38\xb1\x91\x8c\x9b\x6d\x6c\xcb\x18\x2e\x5a\xcf\x3a\xdb\x45\xc6\xd7\xd7\xf2\xf4\x45\x1c\x85\xc2\xe5\xc3\x8a\xbe\x8d\x60\xdc\xcb\xa0\xfd\xf6\x08\x5e\xd7\x6d\x15\xa4\x51\xdc\x2a\xe2\xf6\xc5\xa6\xd2\xbb\x08\x2b\x7b\xaf\x2f\x86\x4d\x9b\xc1\xdc\x9e\x72\x81\x8b\xef\x83\x65\x31\xf2\x9f\x09\x11\x91\x77\x52\xcc\x57\x6c\x2f\x4e\x00\x9c\x0f\x29\xc9\x24\xdd\x6e\x90\xc6\xb6\x15\xe3\x38\x1e\x21\x05\x67\x8f\xb2\x0a\xc7\x77\x34\x5d\x30\x30\xee\x01\x4c\x48\xbb\x6c\x8e\x82\x51\xaf\xa5\x89\x1c\xdb\xb5\xd7\x62\x83\xa5\xd4\x4e\x15\xa1\x3a\x72\xd1\xbe\xfb\x53\xd8\x3b\x02\x15\x47\xdb\xf2\x15\xc9\xf3\x50\x5e\x11\x87\x3f\x51\x19\xaa\xb2\x63\xef\xc6\x86\x3d\x62\x67\xae\x43\x0d\xe3\x1c\x7d\xb6\x41\x67\x28\x9c\xda\x3a\x0c\x5a\x5d\x35\x88\x84\xfc\x9d\xe3\x33\x84\x1b\xb4\x7c\xce\xea\x51\xda\xc3\x5e\x55\x08\xa4\x39\x17\xb6\x0e\x5a\xc3\x46\xa9\x08\xe4\x71\xe8\x25\x12\x68\x8c\x45\x5b\x49\x07\x85\x76\x45\x5b\x7b\xf2\xda\xec\xe1\x36\xb2\xea\x5d\xb8\x1a\x92\x1f\xcc\xa4\x0a\xae\x49\xa6\x6e\x4a\xfa\x68\xd0\x94\x38\x99\x61\x8d\xb3\x94\x86\x55\x48\x0c\xb7\xf5\x78\xb7\x51\xc5\xad\x69\x1d\x79\xb0\x0b\x25\x37\x6d\xca\x36\xc6\x67\xfa\x13\x5b\xfd\x60\x10\xc5\xf7\x43\x15\x8d\x43\x78\x1d\x8e\xb1\x78\x46\xd5\xba\x34\xb3\x17\x4b\x75\x5c\x37\xd0\xe1\x18\x7b\x41\x82\x6a\xd9\xfc\xe5\x87\xf1\xe6\x7b\x19\x13\x1a\xbc\xdd\xe0\x84\xa9\xc7\x17\x87\x6a\xf0\xd2\x3b\x17\x29\xa6\x89\xcc\x3e\xbf\x1e\x89\x98\x31\x7f\xd6\x95\x57\x85\x46\xd5\x47\x4f\xc2\x21\xbe\xe1\xe1\x8c\xa4\xfd\x0d\x15\xe4\x91\x61\x00\x9f\x49\x8e\xca\x36\xd5\x60\x3a\x47\xec\x94\x51\xce\xb6\xdc\x31\x4f\xdb\x74\x99\xf8\x41\x97\x0a\x1c\x75\x20\xed\xf6\xc2\x91\x54\x29\x92\xb6\x93\xeb\x8a\x29\x09\x0d\x26\x46\x7f\x6e\x0d\xd7\xbb\x3d\x39\x4e\x9a\x6a\x29\x06\x29\x9b\x0c\x82\x17\x7d\x88\x35\xd4\xb6\xe9\x9a\xbd\x34\x4f\xf5\x43\x69\x0d\x0b\xa0\x54\x05\xcd\x13\x6c\x81\xa6\xae\xc0\xef\x49\x67\x10\x0c\x52\x78\x1f\xd5\x0a\xba\xb3\xa6\xf3\xf5\xce\x28\x1e\x92\x87\x4f\xba\x69\x89\xe8\x06\x63\x24\x64\x47\xbc\xb7\x9a\x30\xe1\xfa\xc4\x6a\x86\x6a\x4a\xd3\x71\x78\x50\xdc\xa5\x3a\xc6\x59\xa7\x43\xcc\x11\x37\xaa\xd2\xea\x85\x0d\x60\xa3\xce\xa3\x15\x47\x55\x1f\x2e\x96\x1d\xff\x3a\x49\x9d\xb5\xd3\x2a\xc5\x0f\x71\xfe\xf5\xc4\x61\x69\x3f\x98\x9d\x00\x6d\x44\x1a\x13\xa5\xb4\xc8\xa1\xcf\x8a\xb9\x29\xaa\x4a\xaf\xfc\x9b\x63\xdf\xd6\x1a\x66\xe9\xec\xa2\x7b\x34\x72\x36\x48\x84\x4e\x91\x12\x2f\x3f\x3a\xc7\x79\x16\x40\x0e\x5d\x96\x25\x57\x1d\x50\x07\x74\x80\x9d\xc2\xcf\x9b\x3d\xb5\xcf\x47\x57\x1c\x4c\xbc\xa8\x6f\xb1\x11\x27\xd8\x0f\x77\x57\xc9\x78\x48\x53\x86\xf1\xd2\xd1\x63\x01\x2e\xe6\x18\xc2\x00\xb5\x35\x3b\xd1\x33\x82\x3d\x47\xeb\xe3\x06\xaa\xc4\x88\x68\xb8\x33\x55\x48\x0e\xae\x03\x57\xac\x4d\x61\x5d\x63\x9d\x0c\xca\x93\x3f\x1f\x1c\x51\x7a\x54\xca\x54\x5e\x8c\xbd\xc7\x8d\x2d\xcf\x46\x0c\x48\xaa\x3f\x4f\x68\x0c\xe6\xd5\x99\x74\xe4\x54\x1a\xbd\x70\xea\x45\x53\xeb\x96\x45\x6e\xd4\x01\x5e\xf8\x89\x86\x17\x51\xf6\xaf\x0c\xa7\x33\x04\x40\x10\x8b\xd6\xa4\x6b\x0c\xf8\x2b\xbc\xdb\x90\x06\xd9\x0e\xea\xa5\xd7\xb5\x46\xdf\xae\x0d\xf8\x46\x3b\x1a\x60\x4f\x45\x26\x8f\x76\x1b\x57\xf0\xe3\x09\x3c\x61\xa9\x69\x6e\x41\x1b\x28\x55\x90\xba\x22\x0f\xcf\xd3\x46\xb4\x45\x37\x4b\xc9\x4d\x8e\x42\x39\x1a\x86\x24\x6c\x1d\x89\xa1\xa8\x76\x1a\xb3\x70\x49\x22\xd4\x66\xd7\x6a\x4f\x29\x52\xfa\xc2\xb4\xf5\x46\xb9\x7e\x52\x34\xa5\xc6\x54\xcb\xd9\x52\xae\x7e\xf2\xed\x59\x1e\xc1\x9e\x72\x30\x4d\x17\x03\xed\x1b\xf4\xdd\x95\x0c\x3c\xd6\x85\x14\xde\x64\x7d\x12\x47\x11\x3b\x0d\x68\xf4\xa5\xf3\x41\xf9\x74\x8c\xa7\xd3\x84\x58\xea\x0f\xa6\x43\x59\x97\x46\x06\x46\x5b\x25\x01\xf7\x33\x7a\xa8\x0e\xe2\x82\x3a\x9c\xdd\xbd\x6f\x67\x30\x13\x8e\x97\x58\x70\xd2\x22\x3b\x76\x03\x2c\x36\xc1\xfc\xb4\x04\x53\xd3\xcb\xa7\xb9\xf4\x38\x83\xe7\x96\x7e\x9c\x24\xec\x98\xa6\x51\x07\xd6\x41\x50\xe1\x6c\xf8\x84\x06\xe1\xd8\xfd\x0e\xe7\x51\x7d\xec\xde\x8d\x2c\xf8\x04\x53\xb3\xa6\x51\x83\x18\x4d\x4c\x8d\xc3\x83\x88\xd3\xf4\x88\xde\xfb\x44\x3a\x22\xc3\x2e\x08\x74\xdd\xc8\xa1\x9b\xfb\x03\xce\x9f\x6c\xf7\x9a\xbd\x7e\xa0\xc7\x1c\xb6\x56\x68\x64\x5e\x50\x38\xe8\x4a\x8c\xbe\x9b\x7d\x8e\x0f\x36\x30\x86\x11\xdf\xa9\x84\xb1\x51\xb0\x6b\xa9\x9c\x13\xcf\x12\x0e\x16\x76\x56\x56\x64\xdd\x64\x7b\xee\x25\xa9\x1d\xa3\x82\x20\x43\xcb\x83\xbd\x55\x35\xa8\x01\xd0\x5f\xa5\xa7\x3e\xa3\x07\x34\x4c\xc9\xd6\xb6\x4b\xd9\xfd\x5e\xf2\x70\x92\x29\xc1\xa9\x18\x46\xba\x25\x3b\xf6\x27\xd5\xf1\x0f\x1e\x42\xcd\x17\xf0\x65\xba\x5c\x4e\xe7\xeb\x67\x52\x8a\xf7\x13\xf8\x98\xdf\x4c\x9f\x56\x39\xac\xef\x73\x78\x5c\x2e\x3e\x2d\xa7\x9f\x61\xb6\x4a\x73\xb2\xb7\x70\xb7\xcc\x73\x58\xdc\xc1\xcd\xfd\x74\xf9\x29\xcf\xf0\xbb\x65\x8e\x5f\x0c\x69\xdd\x2d\x96\x43\x02\x19\xac\x17\xf4\xe7\xfc\x9f\xeb\x7c\xbe\x86\xc7\x7c\xf9\x79\xb6\x5e\xe7\xb7\xf0\xf1\x19\xa6\x8f\x8f\x0f\xb3\x9b\xe9\xc7\x87\x1c\x1e\xa6\x5f\x26\x00\xf9\x3f\x6f\xf2\xc7\x35\x7c\xb9\xcf\xe7\x62\x81\xe4\xbf\xcc\x56\x39\xac\xd6\x53\x5c\x30\x9b\xc3\x97\xe5\x6c\x3d\x9b\x7f\x22\x82\x37\x8b\xc7\xe7\xe5\xec\xd3\xfd\x1a\xee\x17\x0f\xb7\xf9\x92\xe6\x77\x7f\x58\x2c\x81\x16\xc2\xe3\x74\xb9\x9e\xe5\x2b\xf1\xb8\x5c\xfc\x3e\xbb\x1d\x5f\xea\xcd\x74\x05\xb3\xd5\x1b\xf8\x32\x5b\xdf\x2f\x9e\xd6\xdd\xe1\xf1\x72\xd3\xf9\x33\xfc\x7d\x36\xbf\xcd\x20\x9f\x11\xa1\xfc\x9f\x8f\xcb\x7c\xb5\xca\x6f\xc5\x62\x09\xb3\xcf\x8f\x0f\xb3\xfc\x36\x83\xd9\xfc\xe6\xe1\xe9\x96\x46\x83\x3f\x3e\xad\x61\xbe\x58\xc3\xc3\xec\xf3\x0c\xcf\xb9\x5e\x10\x6b\xd2\xb7\x89\xfa\x2c\x5f\xc1\xe2\x4e\x7c\xce\x97\x37\xf7\xd3\xf9\x7a\xfa\x71\xf6\x30\x5b\x3f\xd3\x2c\xf1\xdd\x6c\x3d\xcf\x57\x3c\x71\x3c\xe5\x93\xdf\x3c\x3d\x4c\x97\xf0\xf8\xb4\x7c\x5c\xac\xf2\x09\x30\x0b\xe7\xeb\xd9\x32\x87\xe5\x6c\xf5\x77\x98\xae\x44\x64\xec\x3f\x9e\xa6\x1d\xa1\xc7\x7c\x79\xb7\x58\x7e\x9e\xce\x6f\x48\x50\x27\x82\xc4\xeb\xc2\xf3\xe2\x09\x43\xc9\xfd\xe2\xe9\xe1\x16\x3f\x10\xe9\x03\x64\x54\x0e\xb7\xf9\x5d\x7e\xb3\x9e\xfd\x9e\x67\xf8\x25\x4c\x57\xab\xa7\xcf\x79\xe4\xf7\x6a\x4d\x0c\x7a\x78\x80\x79\x7e\x93\xaf\x56\xd3\xe5\x33\xac\xf2\xe5\xef\xb3\x1b\xe4\x83\x58\xe6\x8f\xd3\xd9\x12\x68\x6a\x7a\xb9\x44\x2a\x8b\x39\x3b\x9c\x9f\x26\x28\xbc\xf9\x02\xf2\xdf\x51\x07\x9e\xe6\x0f\x78\xdb\x65\xfe\x8f\xa7\xd9\xf2\x92\x26\x20\x8d\xe9\xa7\x65\x4e\xcc\x1c\xc8\x5d\x7c\x99\x3d\x3c\x90\x84\x4e\x85\x9f\xd1\x92\xf9\xf3\x40\xf8\xcf\xf0\xe5\x7e\x01\x9f\xa7\xcf\x3c\xaa\xfd\x1c\xd5\x43\x2c\xf3\x6e\x96\x7b\xac\x15\xd3\xd5\x40\x3b\xa7\x1f\x17\xc8\x83\x8f\x39\x3c\xcc\xe8\x58\xeb\x05\x31\x04\x45\x74\x3b\xfd\x3c\xfd\x94\xaf\x32\xd1\x29\x01\x6d\x1d\xc7\xcb\x33\x58\x3d\xe6\x37\x33\xfc\x3f\xb3\xf9\xcd\xec\x36\x9f\xaf\xa7\x0f\xcc\x95\xf9\x2a\xff\xc7\x13\x4a\x71\xfa\x90\x88\xc0\x74\x39\x5b\xe1\xd5\x50\x0f\xa3\xc8\xd0\x06\x51\xd7\xe6\x49\x47\xd6\x0b\x38\xb5\xcb\xab\x7e\xef\x13\xfd\x43\xbd\x78\x58\xac\x50\xd9\xe0\x76\xba\x9e\x02\x9d\x78\x3d\x85\x8f\x39\x7e\xbd\xcc\xe7\xb7\xf9\x92\xcc\x69\x7a\x73\xf3\xb4\x9c\xae\x69\x33\x5c\x91\xaf\x60\xf5\xb4\x5a\x4f\x67\x73\x12\x8a\xc0\xfb\x92\x35\xcf\x96\xb7\xc9\x9e\x88\xcf\x70\x37\x9d\x3d\x3c\x2d\xcf\x74\x6c\xbd\x80\xc5\x63\x4e\x24\x49\xd7\x3a\x81\x24\x25\x5b\x5d\x67\xa4\x03\x30\xbb\x83\xd5\xd3\xcd\x7d\x94\x1e\x8c\xac\xf6\x19\xee\xa7\x2b\xf8\x98\xe7\x73\x98\xde\xfe\x3e\x43\xcf\xc3\xfb\x88\xc7\xc5\x6a\x35\x8b\x3c\x59\x44\x0a\x91\x8f\xaf\x79\xbb\x7c\xce\xab\x2f\x0c\xf8\x8f\x57\xdc\xf3\x30\xd5\x94\xb2\x56\xae\xc4\xae\x09\x28\x04\x0b\xcf\xe8\x99\xe7\xea\x90\xc2\xa1\xc7\xa5\x31\x84\x96\xea\x45\x55\xb6\x01\x99\x60\x53\x3f\x6d\x39\x78\x12\x17\x67\xf9\x62\x54\xdd\xd1\x93\x11\x1f\x44\x63\x3d\x97\xd3\x5a\xdf\x05\x2a\x4e\x01\x63\x66\x8e\xa9\xc5\x41\x1e\xb9\x76\xbd\xc7\x54\x84\xd1\x11\x4f\xc3\x53\xb0\xd2\x41\x8c\x83\x06\x07\xcb\xee\x8d\x4f\x21\xcd\xb8\x08\x3a\x78\x3c\xda\xf5\x94\x53\x99\x31\x3d\xa2\x4b\xa5\xdb\x10\x64\xec\x4c\xf5\x18\xaa\x1b\xf9\xb5\xc3\x9e\x2a\x02\x1d\x4a\x99\xbc\xdc\xe2\xd5\xf0\xc4\xdd\xea\x3a\x7d\x4c\x53\x80\xd4\x8a\xc2\x5f\x62\x2b\x66\xab\x2b\xd5\x3d\x2f\xe5\x47\x2b\x3c\x59\x58\x58\xf3\xa2\x8e\xb1\xb5\x55\x54\xad\x8f\x78\xae\x1f\x49\xa6\x49\x1f\x24\x45\x34\xfc\x9e\x0a\x2e\x84\x00\xd3\x50\x00\x81\xfd\x37\x1d\x6e\x78\x03\x95\x36\xb1\xbc\x05\x8d\xa5\x54\x89\x26\x76\x68\xde\x8f\x2e\xda\x72\x73\x82\x1e\x44\x22\x00\x68\x4d\x7a\x54\xfc\x2b\xf2\x93\xd6\xa7\xc1\x82\x01\x03\xde\x7a\x30\xb2\x4e\xa4\x37\x4e\xab\x2d\xe8\x52\x49\x9e\x39\x92\xa4\x05\x34\x3b\xfe\x1b\xd1\x1a\xbf\xc9\xfe\xf5\xa8\xa4\xfb\x0d\xe0\x57\x22\x81\xf0\x80\xd0\xd1\x6f\xbc\x2f\xe5\xaf\x83\x37\x47\x23\x79\x7f\xe8\x1e\x44\x8e\xa4\xcc\x30\xb9\x7f\x4f\xc6\x73\x96\xe1\xf2\x50\xe8\xa5\xb7\xc9\xfd\xfc\xb6\x1f\x01\xcc\x6e\xa6\xef\x75\x44\xd5\x3f\xb7\xe0\xd7\xe8\x69\x93\x87\xbe\x69\x46\x54\xae\xc6\xb3\xd4\xd7\xe7\x40\x7b\x72\x99\x01\xc3\x8e\x6d\xcc\xd7\xf6\xb6\x89\x69\x3f\x4d\x2b\x30\x3a\x6b\xbd\xda\xb6\x55\xc6\x53\x25\x6d\xe8\x02\x3e\x3a\xa6\x14\xf4\x3f\x74\xef\x34\x62\x47\x91\xca\xc0\x15\x0d\x16\xa6\xc1\x4f\xbb\x25\x12\xa7\xb1\xdb\xba\xff\x26\x74\xaf\x14\xe9\x89\xe8\x9e\x21\xbd\x92\xf1\x91\xa8\xe8\xe1\x2f\x26\x64\x3e\x5e\xfd\xd9\xb6\x23\xbd\xee\xe7\x2d\x46\xe3\x24\xdf\x93\x5f\x85\x99\x33\x4d\xe9\x72\xb7\xb3\xe7\xe5\x07\x4c\x7c\x8d\x3d\x85\xe6\x7f\xf8\xcf\x02\x00\xfc\x2f\xfe\x65\x80\x89\x10\xd3\xca\x5b\x2a\x27\x0c\xa7\x49\xac\x49\x13\xad\x34\x84\xc0\x8f\x33\x11\x59\xab\x4a\x15\xc1\x59\xa3\x8b\xf8\x8a\xb0\x51\x0e\x6a\xa9\x2b\x2e\x91\x8e\x06\x3b\x46\x73\xac\x59\xf2\x90\xe9\xf9\x89\x44\x56\xba\x6e\xf4\xb7\xd2\x5f\xd9\x9f\x0a\x9a\x93\xd4\x81\xfd\x93\xe7\xb7\x17\xa3\x89\xd8\xda\x96\x2a\x0e\x5e\x7d\x32\xb6\xb6\x2f\x9c\x06\x24\x15\xff\xcb\xcf\xd9\x89\x45\xa3\x41\xc3\xd8\x9a\xcf\x56\x17\xb6\x56\xf1\xd9\xe9\xf4\xe3\x6a\xf1\xf0\xb4\xce\x1f\x9e\x87\x68\xfa\x03\x69\x45\x54\x08\x08\xc7\x46\xc1\xbf\xe8\xc1\xeb\xe1\xed\xa4\x37\x8c\x53\x8f\xd0\x47\x1f\x0a\x07\xaa\xc2\x7d\x90\xaf\x27\x0e\x82\x28\xc4\x17\x57\x5d\xa1\x29\xe5\x6e\x1f\x86\xdb\x15\x6f\x87\x07\x99\xf0\x84\xcb\xfe\xd8\x60\x46\x48\xfd\xb0\x7e\x36\x3c\x9d\x8f\xce\xd0\xad\x8e\x1a\x9c\x1e\xeb\x8e\xde\xa0\x8c\x12\xce\x57\x5f\xa9\x2d\xb6\xd4\x82\x89\x5d\x93\x7e\x3f\x6a\x31\x7b\x85\xf9\xb1\xd8\x60\xb4\xa3\x86\x3f\x25\x74\x54\x8a\x18\x3c\x91\xba\x78\xb4\xf8\xe2\x89\x2b\xfa\xe4\x01\x36\x4a\xd4\xb6\xf5\xea\x5d\x51\xe9\xe2\x2b\x55\x40\x6a\x65\x5a\xd0\x41\xd5\xfe\xdd\x3b\xf4\xe5\x94\x75\xfb\x56\x73\x07\xb8\xfb\x67\x02\xe2\x5b\x93\x78\x59\x9a\xe1\xdb\xa9\xe8\xe2\x54\xdd\x54\xf6\xa8\x1c\x5c\xa5\xc7\xf2\xdd\xd4\x72\x5c\x5d\x2b\x77\x0d\xfc\xfc\xdb\x09\x8f\xb9\x7e\xc5\x3d\x11\xc3\x73\xef\x5e\xef\x0c\xc8\x41\x2c\x1b\x3c\xd4\x79\xd3\xbf\x67\x49\x08\x44\x6f\x85\x51\x85\xf2\x9e\x1f\x79\xde\xc7\x79\x76\x09\x9e\xda\x2d\x1f\x78\xd8\x8a\xd6\xa0\x9a\xf2\xab\x8c\x67\x7b\xb4\xe5\xd1\xa8\x68\xe9\xd4\x06\xdc\x1c\xbb\x8d\x78\x8c\xa8\x3f\x00\x59\x08\x62\x94\xe8\x84\xe3\xe6\x02\xe0\x5f\x03\x3d\x7f\x0b\x57\x71\xb4\x50\x7e\x55\x9e\x5f\x01\x7b\x88\x03\x2d\xba\x52\xce\x5f\x77\xd5\xb7\xcd\x11\xfe\x86\xa7\x81\x7b\x59\x7c\x55\x8e\x9c\xe0\xaf\x3c\x71\xd2\x3a\xb2\xa5\xf5\x11\x6e\xac\x35\xbf\x65\xf0\x1e\xa6\x8d\xd3\x15\xfd\x83\x26\x08\x5b\xf8\x87\x0c\x1e\x9d\xf2\x3a\xbd\x04\xfb\x5d\x17\x2a\x56\x80\x5f\xf1\x8f\x5d\x41\x26\x76\x98\xfa\x62\x08\xea\xcf\x50\xbe\x54\x06\x11\x83\xc7\xb3\xdd\xbf\x53\xd0\xb5\xe3\xdc\xd0\x15\x49\xf0\xed\xc6\xd9\x36\x68\xc2\x0d\x1b\x27\xdd\xb1\xab\xe6\x88\x34\x46\x4e\xef\x38\xd1\xf1\x73\xb4\xa2\x36\x25\x9f\xa4\xd2\x86\x86\xc0\x86\x3b\x0e\x2a\xf0\xbe\x1b\x5f\x11\x91\x78\xaa\x36\xb1\x53\x38\xa4\x69\xd2\xf4\x12\xbc\xb4\x19\xa4\x77\x36\xe7\xff\x3e\x86\xb8\xfc\xef\x63\x9c\x17\x41\xff\x5f\x00\x00\x00\xff\xff\xa1\xf4\x46\x4e\xac\x46\x00\x00"
-
-func dijkstraShuntingYardGplTxtBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardGplTxt,
-		"dijkstra-shunting-yard/GPL.txt",
-	)
-}
-
-func dijkstraShuntingYardGplTxt() (*asset, error) {
-	bytes, err := dijkstraShuntingYardGplTxtBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/GPL.txt", size: 18092, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardReadmeMd = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5b\xdd\x6e\xdc\xc6\x92\xbe\xd6\x3c\x45\x21\x67\x01\x8d\x34\x3f\x3a\x1a\x1f\x5f\x44\x86\x10\x38\x89\x7c\xa2\x8d\x8f\x6d\x58\xca\x09\x0e\x10\x20\x68\x92\xc5\x99\xb6\xc8\x6e\xa6\xbb\xa9\x99\x49\x36\x0f\xb0\x4f\xa4\x8b\xbd\xd3\xa3\xec\x93\x2c\xaa\xba\x49\x36\x67\x38\xb2\xe3\x9c\x8b\xbd\xb1\x35\x64\xff\x54\x57\x55\x7f\xf5\xcb\xbf\xc0\x3b\x61\xac\x54\x4b\xd0\xc9\x07\x4c\xdd\x4c\x1b\x89\xca\x61\x06\xb8\xa9\x0c\x5a\x2b\xb5\xb2\xb0\x96\x6e\x05\xdf\xca\x0f\x77\xd6\x19\x71\x6c\xc1\xae\x6a\xe5\x68\xd2\x56\x98\x0c\x44\xb1\xd4\x46\xba\x55\x39\x1a\xfd\xe5\x2f\x70\xbb\x42\xb0\x4e\xa8\x8c\x5e\x1d\x1c\x18\x2f\xf6\x91\xc1\x90\x6a\x75\x8f\xc6\x59\x90\x2a\x97\x9b\x1e\x61\x4e\xc3\xfb\x77\x6f\x60\xfc\x1e\xef\xd1\x58\x84\x77\xba\x90\x76\x05\x6f\xb4\x13\x4e\x6a\x75\x32\x1f\x8d\x5e\x69\x03\xb8\x11\x65\x55\xe0\xc5\x68\x74\xf4\x1f\x80\xe9\x4a\xc3\x17\x62\x92\x7c\x01\xff\x05\xf3\x33\xde\x75\x31\xb7\xab\xd1\x91\x80\x04\x26\xa3\x51\xef\x04\x1d\x19\xd2\x82\x48\x0a\xa4\x3d\x57\x42\x65\x05\x82\xae\xd0\x08\xa7\x0d\x54\x06\x53\xcc\x50\xa5\xbb\x5b\x9c\x3e\x1f\xdc\xe4\x39\x9c\xd2\x46\x2f\x2d\x6c\x75\x0d\xa9\x50\x60\x11\xa7\xe0\x56\x08\x65\x5d\x38\x59\x15\x32\xe5\x03\xc0\x29\xac\x65\x51\x40\x82\x80\xf7\xa2\xa8\x05\x49\x26\xc1\x5c\x1b\xe4\xd1\xb6\x2e\x61\x32\x87\x27\x28\x2e\xac\x6e\xc9\xce\xa4\x75\x72\x59\x13\x87\x12\x74\x6b\x44\x05\xb5\x12\x66\x0b\xa5\x54\xb5\x3d\xab\x8a\xda\xc2\x78\x3e\x3b\x9b\x4f\x4e\x40\xa8\x0c\x12\xb9\xf7\x76\x76\x36\x39\x01\xa9\x78\x73\xa9\xaa\xda\x45\x07\x3e\x9e\x89\x49\x72\xbc\x7f\xde\xf9\x6c\x80\xaf\x95\x30\x16\x0d\x9f\x9d\x69\x0c\x2c\x4d\x8c\x48\xef\xd0\x59\x18\xc3\xc9\x1c\x0e\xc8\x6e\x2c\x26\xc9\xc9\xe9\xf8\xf9\x6c\x73\x72\x36\x9e\x6d\x67\x8b\x93\x61\x51\xc2\x73\xd8\xc0\x0c\x4e\x61\x4b\x34\x2c\x60\x06\x67\xc4\x74\x2b\x97\xaa\x44\xe5\x2c\x08\x83\x7e\xf7\x0f\xb5\x75\xfb\x1a\x4f\x87\x14\xed\xf0\x4e\xdc\x97\xb0\x12\xf7\xa4\xa8\xf7\x68\xb6\x50\xe8\x75\xa4\x00\x87\x88\x3e\x16\x97\x9b\xc9\xf9\x19\x93\x3e\xc0\xa3\x0d\x9c\x83\xa7\xf9\x0c\x26\x70\xe9\x99\x35\xb4\xb7\x7f\x66\x99\x36\xa7\x2b\xd0\xb9\x57\x04\x27\xd2\x3b\x92\x31\xfd\xb8\x17\x46\xb2\xcc\xf9\x58\x09\x12\x85\xd2\x79\xca\xe8\x7d\x65\xb4\xd3\x6e\x5b\xb1\xca\x09\xd7\x2a\x61\xa6\xd7\xaa\xd0\x22\x03\xe1\xfa\xeb\x4b\x0b\x49\xa1\x97\x50\x69\xeb\xa6\x70\x4d\xc7\x47\x90\x74\xc2\xd2\x83\x85\x00\xcb\x3f\xa1\xc0\x0d\x1a\xbf\x2a\xad\x18\xa4\x4a\x00\x53\x20\xcc\x48\xa9\x32\x5d\x13\x69\xbf\xd4\x9a\x26\x5a\x67\xa4\x5a\xda\x3e\xa3\xbe\x58\x61\x51\xe8\x9f\xbe\xf0\x52\x10\x0a\xb0\x4c\x30\xcb\x30\xf3\xb3\xa0\x66\xc0\xa2\xe7\x36\x15\x15\x82\xc5\x5f\x6a\xe2\xfd\x17\x11\x63\x99\xaf\x6f\xdf\xbd\x7f\xf3\xed\xe3\x83\x18\x1d\xdd\xdc\xbe\x7f\xf3\xf7\xc7\x07\x5e\xf8\x0f\xaf\x4b\x0b\x5d\xbd\x7f\x7c\xb8\x64\x80\xfb\x4e\xaf\x21\xd3\x68\x41\x3a\x58\x6b\x73\xf7\x95\x17\x16\x83\xd3\xcc\xe9\x99\xa9\x54\x74\x07\xd7\xc2\x82\x36\x72\x29\x95\x28\x8a\x2d\x64\x78\x8f\x85\xae\xe8\x16\x6f\x5b\x44\x9d\xc3\xbf\x82\x04\x72\xa9\x88\x99\x4b\xad\x33\xc8\xd0\xa6\x46\x56\x0c\x03\x2b\x34\x38\x1f\x8d\xae\x15\xd8\x95\x36\x8e\xa1\x82\x74\xd7\x04\x10\x52\x99\xe5\x1b\xdb\xa8\x89\x9d\xc3\xad\xb8\x43\xc8\x3b\x5d\x64\x89\x76\x2a\x7e\x01\x0c\x4f\x17\xa3\x11\x00\xf0\xbb\x6e\x21\x83\x17\xac\x8c\xcf\xfb\xef\x68\x5d\xff\x72\x02\xa7\xfe\xd0\xdd\x9c\x62\x2d\xb6\x16\x96\xa4\x80\x46\xd7\xcb\x55\xa3\x8b\xba\x76\x55\xed\x40\x96\x25\x66\x52\x38\x2c\xb6\x1e\xae\xa2\x15\xfd\x54\xd6\x29\xa7\x61\x2d\xa4\xbb\x80\x2b\xbe\x5a\xad\xd6\x97\xa4\xc8\x61\x60\x2e\x0d\x6b\xb5\xb7\x17\x98\x4d\x81\xcc\x46\xc1\xbb\x29\xdc\x44\x77\x25\xd5\x25\xd2\xfa\x5a\x2d\xe7\x70\x9d\x0f\x8c\x58\x09\x4b\xf7\x17\x63\x08\x9f\xf6\x4e\xcc\x62\xe9\x8e\x35\x05\x4d\xac\x5f\x4b\x8b\x53\x52\x00\x26\x8c\x48\x06\xbc\x47\x05\xb4\x15\x9a\x39\xfc\xb8\xc2\x08\x23\x79\x1b\x22\x86\xce\x47\xea\xa5\xb2\xe9\x0e\x5b\x0b\xcc\x5d\x83\xab\x3d\x3b\x38\x0d\x10\x59\x90\x6e\xb0\x0a\x75\xc4\x78\x8d\xf0\x72\x65\x19\x4f\xbd\x50\xa7\xac\x42\x68\x5b\x59\x44\xdc\x9f\xc2\x7a\x25\x0b\x84\x09\x59\x87\xc0\x41\x96\x88\x9a\x42\x32\x85\x04\xc3\x15\x08\x92\x9d\x1e\x5c\xc8\x8b\x91\xd9\xe9\xf4\x1d\x2a\x5a\xef\x74\x0e\x6f\xf4\x1a\xd6\xe8\xf9\x92\xae\xb4\x26\x3e\xd9\x15\xd1\xbf\x26\x74\x70\x30\x89\x95\x84\x65\xf9\x15\xaf\x24\x94\x25\x39\x48\x0b\x4a\xf7\x55\xa4\x31\x82\x8d\x86\x90\x31\xdc\x60\x5a\x13\x7e\xf0\x02\x44\x76\x2a\x6a\x8b\x43\x16\xb4\x27\xeb\x4e\xca\xa0\xef\xd1\xa3\xa1\xc8\x32\xd9\x1b\xc9\xbb\x1b\x36\xb2\x53\xa2\x9a\xb9\xc4\x44\xb8\x95\xae\x1d\x9d\x82\xc5\xb3\x7f\x92\x39\xbc\xc1\x8d\xf3\x93\x10\xe1\xf9\x1c\xae\xdd\x13\x0c\x24\x5e\x31\x54\x76\x9a\x22\x2d\x60\x59\xb9\x2d\xaf\x41\x92\x27\x96\x79\x80\x97\x45\x31\x0b\x02\x83\x53\xbe\xed\x93\x48\x81\x22\x52\x84\x85\x35\x16\xc5\xdc\x5f\xd1\xe6\xfa\x7b\x30\x19\x06\xfc\x29\x68\x55\x6c\x03\x5a\x5b\x10\x90\xe3\xba\x9d\x18\x61\xca\x75\xce\x53\xd7\x42\x39\xbe\xdf\x68\x03\x71\x7e\x6d\x86\xd5\x52\x9b\x68\xce\xb4\xdd\x4b\x64\x19\x8d\x2d\x1b\x60\x58\xa2\xfb\xb9\x13\xc7\xf8\x04\xf2\x5a\xa5\x2c\x87\x70\x0d\x4c\xa5\xe6\x76\x15\xd6\x9e\x8f\x46\x40\xe0\x7b\x43\x76\x6e\x96\x08\x4b\x1e\xaa\xf7\x88\x58\x74\x79\xcf\x7a\x13\x73\xee\xa5\x71\xb5\x28\xa0\x14\xe9\x4a\x2a\xb4\x9e\x1b\xe4\x2d\x92\xab\x18\x26\xd1\x65\x6c\xe7\x11\xf3\x7f\xa9\xa5\x23\x59\x38\x34\x68\x49\xca\x9d\x6e\x49\x42\xa0\x42\xaf\x2d\xa3\xaa\x1d\x24\x64\xca\xef\xd6\x2b\x99\xae\x68\xbc\xb4\x60\xb0\x10\x4e\xde\x63\xb1\x6d\x4c\xa4\xd3\x9d\xe9\x04\xb1\x4b\x67\xcf\x7f\xf0\xcb\xed\x01\x77\xe3\x41\x4e\x3b\xd3\x1f\x28\x91\xca\x3a\x53\x33\x1b\x2d\x11\xae\x4b\x0c\x08\x5f\xd5\x76\xd5\x5c\x6a\x10\xfb\x8f\x92\xfd\x47\x1e\xfe\xc3\x75\xda\xf2\x5e\x85\x20\x89\xaf\x75\xdf\xea\xf0\x2c\x16\x19\x5a\x1a\x4d\xd1\x84\x7f\xcf\x2b\x90\x93\xfa\x59\x93\x47\x2f\x73\x17\x2e\xe9\x0e\x97\x1a\x44\xad\x0a\xf4\xb1\x0a\xc1\x01\x4d\x65\x5f\xc5\xa2\x91\x68\x49\xbe\x31\x3f\x3a\x55\x64\x2b\x4b\xab\x32\xa4\x86\x8d\x07\x58\x0d\x5a\x75\x1c\x6e\x34\xf0\x6a\xe3\x50\x65\x7e\x2f\x6c\xdc\xd8\x2e\x26\x68\xb5\x38\x15\x45\x61\x77\x9c\xde\x43\x11\x0e\xbb\x13\x4a\x3b\xbf\xdc\xce\x1a\x73\xf8\x8e\x6c\x7d\x8f\x78\xd6\xdb\x70\x3d\x73\x58\x11\x8e\x68\x40\xa6\x8c\x7c\xb1\xde\x36\x81\xc4\x64\xdb\xf8\x37\x01\xef\x44\xe1\x0f\x66\xe7\xa3\xd1\x3f\xb6\xa0\xd7\x0a\xac\x2e\x6a\xde\xb8\x25\x88\x14\x7f\x7f\x02\xdb\x37\x6c\x00\xd4\xfb\x7c\x01\x03\x0a\xdc\xd0\xad\xcd\xd0\x61\xea\x6c\x70\x37\xd1\x7a\xc8\xe5\x0b\x27\x33\x54\x4e\xe6\x12\x0d\xe4\x9a\x2e\x94\x77\x86\x84\xb7\x81\x21\x00\x80\x31\x9b\xd7\xbc\x10\x4b\xbf\x48\x34\x4b\x30\x42\x05\x1e\xed\xdc\x17\xa9\x76\x65\x98\x8f\x37\x27\x53\xc8\xe9\x2e\x3e\xbd\x9d\xf0\x4a\xd1\x00\xbf\x41\x4f\xb7\x8b\x77\x63\x89\x1c\x0c\x2a\x8f\x2b\xa3\x53\xb4\x76\xbc\x99\x6e\xa7\xbf\xee\xbb\xf9\x1b\xd8\xc2\xaf\x10\x06\xc1\xab\x1f\xde\xbc\x7c\xff\xf7\xc7\x87\x67\x70\xfd\xe6\x9f\x6f\xbf\xbf\x0a\x6e\x64\x77\xce\x66\xe0\x27\x51\x2e\x6d\x47\xfc\x1e\xc1\x6c\x4e\xbd\x1e\x4c\xe3\x33\x3a\x83\xc2\xb1\x23\x4b\x3c\x8d\x6c\x25\xad\x48\xe2\x8f\x1e\xab\x6c\x0e\xaf\x9a\x55\x3b\xbb\xe3\x8d\xf2\x0a\x61\x25\x97\x2b\xb2\x07\xb1\x89\xcd\xd9\x71\x89\x0c\x48\xeb\x17\xed\xde\xe7\x26\xba\xb5\xbb\x90\xac\x02\x9a\x46\x47\xf2\xee\x86\xa8\x2a\x14\x64\x60\xf8\x22\xf7\xdf\xb1\xbb\xc0\x70\xbb\x25\x6f\x81\x20\x06\x33\xd0\x2a\x98\x1e\x7f\x9f\xc9\x36\x4b\xeb\x2d\xdf\xfa\x20\x5d\x2a\xd5\x64\x72\x4d\xd0\xc2\x0e\x4f\x82\xd4\xa6\x9d\x05\xdf\x9d\xcb\x54\x48\x75\xaf\xef\xb0\x4f\xa4\xdd\x96\x89\x2e\xf6\xa2\xac\x96\xb0\x57\x2d\x12\x05\xad\x7e\x5a\x71\x86\x10\x7e\xb3\xff\x68\xbb\xff\xe8\xd7\xfd\x47\x61\x13\x7e\x21\xac\x45\x13\x5c\x86\x8e\x78\xb9\x54\xc2\xd5\xc6\x63\xf0\x33\x10\x66\x59\x73\x34\xcd\x53\x9a\xe3\xfa\x33\xf9\xa8\xf4\x63\x28\x7f\xbb\xcf\xbb\x63\xdb\x6c\x3e\xb0\x6f\xf3\x48\x14\x9e\xc5\xf7\x68\x64\xbe\xed\xe4\xd0\x29\x83\xa9\xd9\xab\x21\x32\x1b\x9b\xc3\x4e\x8c\xd2\x8e\xe1\x85\xa7\xe7\x42\x16\x5d\x44\x68\x0c\x85\x1b\x68\xad\x58\x62\x7c\x6f\xf6\x32\x15\x6d\xec\xd8\x47\xeb\x43\x70\x90\xe9\x9f\xad\x2e\xd1\xad\xa4\x5a\x8e\xc5\x34\x99\xa6\x27\xc3\x00\x41\xd6\x3d\x85\x78\xf8\x9e\xc0\x3f\x02\x21\xed\x82\x0d\x26\xf3\x4d\x20\x4c\xb1\x71\x48\xe6\xc5\x42\xf0\x56\x14\xfe\xde\x58\x7f\x45\xb4\x42\x28\xd8\x15\xf9\xb1\xc9\x82\x34\x21\x75\x58\x71\x1a\xe7\xad\x02\xcf\xe9\xa2\xfb\x85\x83\x32\x37\x78\x23\x55\x5a\xd4\xde\x60\xf2\xe5\x74\xdb\x0a\x3f\x97\x4d\x71\x54\xbf\x69\xff\xda\xc6\x91\x7e\xf8\x2b\x69\xff\x4a\x47\x47\xaf\x7e\x78\xf3\xcd\xed\xe3\x43\xbc\x38\x3f\xf4\xcc\x1b\x1d\xdd\xfc\xeb\xe6\xf1\x21\xb0\xb0\x19\xdc\x5c\x84\x43\xe3\x3e\xc1\x17\x78\x22\xa5\x3a\x1a\xbd\x54\x1c\x49\x7a\xab\x6d\x3d\x76\x35\x20\xb0\x93\xc8\x9b\x06\x74\x07\x91\xc8\x42\xba\x2d\x8d\xf4\x9e\xc2\x13\x3b\x1c\x4e\x44\xcd\xfe\xf7\xbf\xff\x27\x1f\x0f\x65\xa1\x72\x78\xfb\xf5\x7f\xf2\x61\xff\x0a\xdf\x5e\xbd\xbf\x7a\xd5\xe8\x15\xec\x4d\xdf\x0c\x67\xb1\xba\x15\xce\xfb\x2b\x34\x8e\xd0\xbf\xc1\x55\x1d\xc0\xb6\x9c\x1f\x65\x64\xd8\xd0\xb0\xed\xc9\xfd\x95\x3e\x27\x7c\x82\xdc\x68\xef\x82\xa2\x72\x66\x0b\x5a\xf9\x15\xb4\xf5\x81\xdf\xf9\xe4\xfc\x72\x11\xf2\x64\x43\x88\x3c\x6d\x21\xac\x4d\x88\xec\xc1\x58\xcf\x57\xfc\x53\x50\x88\xb6\xf5\x96\x9e\x10\x6f\xd0\x89\x3d\xc8\xd3\x0c\x43\x3e\xfe\x6e\x33\x25\x06\xad\x2e\xee\x09\xab\x1a\x46\xb4\x29\x42\x1f\xf0\x47\x9c\xcb\x9a\x20\xb6\xb7\x6e\xae\x6b\x95\x51\xcc\x66\xc3\xb9\xb2\x26\x45\x2a\x6d\xb7\x18\x0b\x8c\x80\x80\x35\xbb\x9d\xdc\x9a\x88\xc6\xe6\x0a\x0a\x03\x66\xd6\x89\x65\xa0\xcd\x7b\x9d\xe1\xca\x75\xe9\xb0\x74\x25\xa4\xe2\x55\x19\x6d\x3f\x4b\xdd\xcd\x65\xab\xb1\xf4\xff\x72\xbc\xe5\xff\x57\xe3\xcd\x39\xfd\x1f\x58\x33\x2e\x4f\xa6\x9b\xc5\xe4\xd9\xbe\x56\x1b\x78\x4a\xaf\x61\x0b\xcb\x43\xaf\x46\x47\x9b\x73\x28\x1b\xe6\x1f\x1a\xb5\x59\xc0\x33\x98\xc0\xaa\x7d\xbf\xe8\xbf\xbf\xe4\x24\x4f\x65\xa4\x4a\x25\xf9\xfb\xb3\x19\xd4\xaa\x60\xbf\x90\x84\xd1\x44\x92\x3e\x0e\xe6\x2c\x01\xa4\x5a\x39\x21\xe9\x5a\xd5\x4b\x4b\x13\xbc\x33\x41\x58\x85\xbb\x41\x48\x00\x2d\xbb\xd2\x75\x91\x91\xae\x34\xc5\x82\x8f\x02\x0c\xbb\x77\x26\x91\xce\x08\xb3\x0d\xc1\xd8\x46\xba\x90\x18\xea\x82\x1b\x36\x9a\x5d\xe9\x01\x6d\x5b\x7c\x20\x28\xd6\x19\x8c\x2f\x1b\x4d\x39\xf1\x36\xd4\xa7\x32\xfd\xce\x95\xa1\xcb\xe1\xb6\x91\x8a\x4a\xb5\x7c\x1a\xdc\x92\xc9\x97\xcf\x07\xd0\x29\xf1\x8c\x7d\x07\x5f\x3e\x87\xc9\xc0\x94\xb3\x25\xfd\x95\x19\xb1\xde\x03\x47\xd8\x9d\xbf\x04\x1a\x77\x00\x2e\xe1\xac\x29\x77\xf8\xa7\xb1\xd7\xc8\x2e\x47\x0c\x54\x9d\x63\xdb\xa4\xe6\x5c\x33\xf1\xdd\xc7\x66\x36\xcc\x99\x7f\x8a\x35\x2a\x7d\xd1\x88\xfc\x79\x15\x47\x48\xa3\xd1\xad\x11\x4d\x84\x57\xf8\xcc\x13\x85\x7d\x16\x4b\x99\xea\x82\x24\xfd\x82\x96\xb1\x58\x09\x23\x1c\xee\x4c\xef\x30\x85\x6f\xfd\x1c\xae\x15\x38\x34\xa5\x6d\x20\xf4\x49\xc5\xe3\xdd\x62\x77\x3d\xd7\x26\xc5\xe1\x03\x78\xcc\x2c\x85\x54\xb4\x48\x40\xe6\x50\x0c\x63\xe8\x40\x3e\x7c\x5b\xba\xe1\xdc\x64\x47\x68\xe7\x57\xef\x38\x9b\x3e\x91\x8b\x42\x05\x40\x95\xae\xb9\x0e\x99\xd1\x15\x03\x1a\x6e\x24\x67\x83\x80\xc2\x14\xb4\x0d\xd8\xa7\xb5\x31\xa8\x5c\x00\xf7\xdc\x88\xf2\xa9\xfa\xcf\xe2\x45\x72\xf9\xfc\xc5\xe6\x72\xf1\x6c\x72\xbe\x78\x31\xa0\xa0\x0b\xb8\x84\xf7\x57\x37\x57\xb7\x9c\xe0\x69\xfe\xde\xc0\xe2\x19\x9c\x2f\x60\xd2\x3c\x09\x99\x2c\x7e\x19\x2b\x08\x85\xab\x21\xe4\x6e\xcb\x41\x84\x11\x14\x39\x5a\x8a\xcc\xef\xb1\x98\xc3\x4b\xf2\x98\x7b\xa7\xe0\xc1\xc7\xb6\x3d\x0d\x9f\xa3\xad\x3b\x12\x0f\x2a\xb2\x0a\xbd\x38\x9b\x8d\x03\x07\xa5\xc4\x95\x7e\x0e\xcd\x67\x06\x7c\xa1\x0a\x33\x9f\xf3\xde\xb6\x46\xa2\x97\x5d\x1d\x0a\x9b\x08\x49\x6a\xe7\xeb\x2b\x21\x26\x57\x8d\x76\x10\x31\xf4\xa2\xc7\xd8\xd3\x64\xb6\x98\x3c\x1b\x62\x68\x02\xa7\x5c\xf6\x23\x80\x0d\xac\xe3\x84\xb9\xb4\xdd\x49\xfc\x76\xcd\xad\xe9\x9f\x84\x94\x82\x4b\x57\x96\x4d\x97\x6d\x52\x45\xc3\x87\x6c\x8f\x18\x22\x72\xd7\xa5\x51\x7c\x06\x91\xd8\x50\x5b\xcc\x6b\xd2\xf6\x0c\x67\x98\xe7\x98\x92\x55\x8c\x38\xe2\xb3\x86\x22\x25\x96\x14\x14\x0d\xac\x85\x75\xec\x0c\x04\xbe\x18\x36\x28\xb5\x49\xd1\x7e\xd2\xb5\x3f\x96\xf9\x31\x89\xd8\xa1\x8f\xd2\x46\xde\x04\xfb\x44\xa7\x75\x46\xc8\xe5\xca\xe5\xda\xac\xe9\x52\x76\x59\xa4\xc1\xb5\xda\x65\x20\x29\x34\x27\x82\x0e\x28\xbb\xcc\xc7\xe2\xf2\xf2\x7c\x71\x02\x9b\xcb\x2f\x87\x24\x73\xbe\x80\xcb\x4b\x90\x39\x6c\xe0\xcb\x4e\xaf\xaf\x29\xd2\x0e\x28\x14\x11\xc0\xaa\xc8\xb9\x0a\x1f\x84\xe7\x11\x21\xc2\xd2\x6f\xe9\x6b\x2c\x96\xac\x4a\x9c\x1b\xba\xd1\x25\x42\x21\x88\x6b\x85\x5e\xca\xd4\x2f\x65\x50\x91\x86\x7b\x57\xaf\x09\x49\x3c\x8e\xb1\xf3\x4f\x27\xbe\x7e\xe5\xed\x58\x1c\xd8\xe4\xfd\xb8\x1c\x44\xa2\xef\x11\xa4\xfd\xa4\x93\xef\x14\x24\xc3\x5f\xe7\x8b\xb6\xb8\x78\x39\x3a\xba\x7e\xf5\xf8\x20\xf3\x81\x08\xe7\xcb\xae\x04\xe9\x83\x90\x58\x9b\x77\x08\x9a\x12\x43\x18\x9d\xb4\x0a\x95\x0d\x69\x21\x17\x85\x3d\x70\xe1\x02\xd8\x7d\xa8\xcb\x0a\xd8\x7f\x68\xc1\xb3\xdb\xea\xc9\x50\xf8\x33\xd4\xe2\x37\xe2\xce\xf6\x32\x99\x9d\xbf\xf8\xfd\x29\xf5\xf8\x2d\x56\x10\xd8\x42\x02\xe7\x30\x6b\x7f\xff\xee\xa1\x30\x96\xd1\x21\x69\x1c\xd8\xf0\x8f\x48\xe5\xeb\xd7\xdf\xdf\xdc\x3e\x3e\xfc\xf6\xe9\xf2\xd9\x0f\x50\xbb\xb0\xf4\xbc\x99\x32\x3b\x30\xf7\xeb\xd7\xdf\x5f\xd1\xc0\xdf\x47\xa3\x36\x6d\x36\x24\x52\x32\x01\x28\x32\x0e\x32\xee\x64\x55\x11\x12\xf4\x82\xab\x9d\xf2\x68\xb7\x87\xd7\xfe\x29\xdd\x21\x2e\x69\xf8\x2a\x7d\x87\x15\xc3\xea\xe2\xad\xf5\x9d\xac\x0e\xed\x92\x6a\x63\xd0\x56\xda\x83\x12\xab\x84\x2f\x79\xb6\x27\xf2\x1b\x7f\x62\x46\xfd\xd8\xae\xa5\x4b\x57\x7d\x10\xfb\xc1\x36\xe3\x3b\x2b\x64\xb7\xca\x89\x4d\x0b\xa1\x4b\x8a\x1d\x38\x89\x09\x89\xa4\x10\x47\x14\x1f\xc5\x37\xde\xe9\x2c\x15\xb6\xa7\xb6\xfe\xf1\x58\x4c\xce\x4f\xe0\xa7\xd1\xd1\x6f\xf4\x0f\x8d\x19\xff\xed\x04\x32\xcd\x51\xd6\xf8\xe4\x45\xfb\x34\x3d\x3d\x5f\x9c\x6d\xe8\x15\xf1\x3b\x7e\x93\x61\x2e\xea\xc2\xd1\x2b\x76\x96\xc2\xbb\xdf\x8f\xe9\xdf\x9e\x4e\xc2\x90\x56\x36\x8a\x32\x19\x1d\xdd\xfc\x78\x7d\xfb\xcd\x77\x8f\x0f\x9e\xb4\x58\x37\x59\xbc\xdf\xbc\xbc\xb9\xba\xe9\xf4\xef\x6f\xa3\x23\x7a\xf2\xf8\x40\x54\x44\xe9\x10\x26\xbd\xcb\x70\xfc\x75\x27\x13\x12\x6b\xe3\xd0\xb2\xe9\xc0\x85\x39\xed\xdf\x0e\x7a\x74\x76\x60\x77\xe2\xce\xe7\x6f\x1e\x78\x79\x60\x6d\x66\xef\xa7\x2d\xde\x69\xe5\x27\x99\x53\xf6\xd1\x8f\xd9\xc2\x1f\x93\xc9\x16\xbb\xaa\xf9\x32\xe3\xd9\xb6\xae\x2a\x6d\x82\xd1\xe8\x1c\x6c\xfa\x3d\x6e\x87\xbf\x80\xa1\x3f\x4f\x76\xea\x56\xd2\x42\x26\xf3\x5c\xa6\xec\x7b\xb4\x0e\xcc\x93\x6e\x75\x5c\x11\x0f\x87\xa8\x6d\x48\x00\xb6\x9e\x7d\x63\xfe\xb4\xf7\x1d\xfb\x8d\x0f\x21\x19\x1d\xe1\xfe\xda\xc7\x88\xa2\xb8\xeb\xaa\xe7\xb5\xf2\x8c\xc9\x76\xaa\x12\xfe\x67\xaf\x80\x46\xc1\x3f\x2f\xd1\xaf\x41\x88\x3b\x04\x4b\x76\xda\xd6\x89\x75\x42\x39\x49\x5c\x92\x59\x56\xf8\x9c\x21\x57\x8e\x63\x6e\x8a\x5d\x7e\x42\xa1\x75\x35\x87\xaf\x6b\xe7\x9d\x45\xbe\xfa\x1e\xbc\x7c\x44\xc5\x29\x22\x2f\xab\x48\x54\xdc\x3e\x83\xbf\x78\x47\xab\xd2\x6b\x34\xe4\x99\xd1\x5a\xb4\x71\xaa\x83\x0c\x0e\x9b\x33\x5e\x7c\xec\x4c\x8d\x27\xf0\x1b\x70\x1c\x89\x82\x2f\x7c\xe2\x23\x49\xa9\xee\xe8\x17\x59\x21\x7e\xa0\x15\x9e\x40\x62\x50\xdc\xbd\x80\x1d\x2b\x04\x41\x29\x5f\xbf\x7d\xfb\x2e\xd2\x72\x5a\x7c\x74\xf4\xe3\x77\xd7\xaf\xaf\x1e\x1f\x78\xbf\x01\x3b\x24\x5a\xc5\x47\xe1\x46\x47\x6d\x50\x3a\x3a\xe2\x30\xf2\xf1\x81\xff\x7b\xe2\x0a\x74\xc6\xa9\xb9\x40\x44\xfa\x9f\x58\xa9\x03\x2d\x3a\x73\x98\xfd\x2e\x4c\x7f\xb7\xe7\xe8\x30\x4b\x0e\x59\xbf\x28\xf7\x18\x64\x38\x96\x0e\x4b\xba\x08\xf4\xbf\x25\xde\xff\x34\x3a\x3a\xa2\x1f\xc4\xe5\x4a\xa6\x9e\xeb\xcd\x6f\xe1\x7f\xf3\x98\x7c\xdc\x3c\xf6\xc2\x68\x7e\xd9\x95\xac\x68\x10\x4b\x85\x46\xf6\x1c\x84\x21\xc9\xd0\xcc\xee\x87\xea\x3d\xb7\xa3\xa3\x57\x6f\xdf\x5f\xbd\x24\x84\x0e\x34\x0f\x88\xcd\xaf\xd0\xa4\x9b\x65\xfa\x67\x18\xde\x5f\x4b\xfc\x1b\xd6\xfa\x23\xf2\xeb\xed\x4e\xac\xfc\x9c\xdd\x63\x1c\x7e\x9b\xdc\x4b\x5d\x5b\x1f\x07\x48\xdb\x38\x77\x1e\x80\x14\xfa\x80\x2b\x41\x6e\x48\x9c\x85\xb4\x21\xe7\x94\x41\x9b\xcc\xe3\x35\xa1\x46\x21\x12\x0c\xe9\xa4\xd6\x73\x12\x05\xff\xae\x55\xfc\x84\x7c\x5f\xdb\xfa\xf7\x11\x04\x34\x08\x70\x4b\x54\xb0\xdf\xdb\x44\x66\x09\x82\x8f\xdf\x9a\x54\x68\xdb\x03\x19\x3a\x56\xe0\xc7\xe0\x2b\x55\x46\x27\x22\xa1\x60\x8e\x7c\xe6\x86\x7a\x46\x38\xec\xb2\x15\xe1\x88\x44\xf9\xbd\x96\x59\xf0\xd7\xfc\xd8\x42\xeb\x3b\x10\x2b\xf2\xf2\x42\x81\xdb\x03\xb7\xa5\xa0\xa8\xfc\xa3\x9d\x09\x19\xe6\x52\xc9\x90\x2f\xec\xec\xd6\x4d\x04\xb1\x03\x63\xfd\xf1\x13\x6e\x07\x08\x85\xe8\xaa\x2a\xb6\x6c\xea\x2a\x4c\x09\xb4\x39\x44\xe3\x38\x20\x94\x32\xda\x65\xee\x70\xbb\xd6\x26\x8b\xc1\xb3\x4b\x2d\x8f\x37\xe7\xd3\xcd\x62\xba\x79\x46\x37\xd9\xa0\xab\x8d\x82\xcd\xf9\x64\xb3\x98\x6c\x9e\xed\xe1\xa4\x57\xb3\x6f\x49\xa1\xc2\x1f\xad\x16\x46\x81\xd3\x79\xf7\xe7\xa2\xfb\xf3\xd9\xc0\x25\xf4\xfb\x1d\x9e\x19\xfc\xad\x68\x89\xce\x07\x1b\x76\xd6\xdb\x2e\xdb\x60\x89\x99\xfd\xc4\xa6\x41\xa6\x0e\xb5\x08\x4d\x21\x89\xa2\xcd\x5d\xff\x9b\x73\xbf\x36\xf4\xc8\x0d\x3a\xe7\x8d\xda\xa4\x24\x8f\x5e\x37\x80\x6b\xb2\xbb\x77\x88\x15\x99\xd1\xf4\xae\x49\xd3\x0d\x51\xd7\xb3\xfe\x6d\x32\x23\xba\x7f\xad\x13\x12\xdf\xbc\xc6\x56\xa7\x85\xb0\xb6\xbf\xdc\x75\xe4\x9a\x0f\x9d\x8d\xf3\x6f\xfd\x5b\xce\xd6\x9f\x14\x2c\x97\x69\xdf\xa9\x5a\x61\x19\x2b\x94\xdf\x6e\xbd\x12\x8e\x53\x33\x52\xad\xd0\x48\xb2\xf2\x6a\xeb\xcb\xa8\xde\x4c\x1c\xd0\xbb\xd1\xd1\xd1\xd1\xbe\xee\xed\x6b\x5f\xe3\xfa\xd2\x66\xed\xaf\x66\xd3\xc8\x1e\xf8\xcd\x3b\x83\x18\x88\x88\x35\xf0\xff\xb9\x22\xc7\x3a\x7d\xab\x21\x17\xa9\x2c\x24\xe1\x45\xd3\x43\x4e\xa0\xcb\xd5\xab\xb8\xde\x1b\x70\xcc\x97\xd7\x59\x90\xb5\xca\x74\x9d\x38\xcc\x3c\x68\x86\x04\x98\xd3\x20\x95\x33\x3a\xab\x53\xec\xe4\x1b\x55\x88\x59\xc6\x5e\xa6\x9c\x4d\x0b\x1c\x6d\xc0\xee\x9f\xa2\x90\x99\x70\x3e\xd8\x0d\xc0\xd9\x51\x31\x1a\x5d\xdd\x73\xc8\xec\x7b\x76\x5b\x5a\x48\x65\x85\xdd\x06\xbd\x8a\x5e\x30\x34\x27\x08\x46\x70\xe9\xca\xc9\x92\x83\x6d\x5b\x97\xdc\x03\x18\xf4\xf0\x3e\x6c\xda\x15\x6b\x9b\x4b\xce\x1e\xa6\x90\x05\xc5\xd2\x28\x0c\x81\xbd\x25\xeb\x64\x25\x5d\x38\x8e\xae\x39\x3a\xe5\x81\x4b\x23\xca\x52\x18\xdf\x6c\xe0\x6f\x45\xc0\x56\xb2\xb6\xfe\xf6\x5f\xfb\xc6\x14\x0f\xb6\x85\x74\xae\xc0\x78\xfb\x26\x0a\x68\x5b\xf9\xe7\xf0\xaa\x36\x44\x7b\x19\x12\xab\x92\xb1\x65\xcd\x7d\xe2\xb1\xbf\xfc\xfa\xe5\xeb\xf7\x4c\xc5\xeb\xd7\x81\x7a\x0b\xd6\x77\x1e\xfe\x43\xdb\xb6\x7a\x1f\xed\x15\x68\xeb\xf5\xfb\xd7\x4e\x97\xc2\xc9\x94\x4d\x61\x5b\x01\x68\x0e\x16\x59\x98\x5c\x16\xb8\xef\x9a\x7f\xfc\x5b\x22\x6e\x4f\xd6\x2d\x20\x36\x89\x79\x69\xfd\x47\x17\xc2\x11\x78\x11\x6a\x92\x24\x42\x7b\x26\xb3\xb9\xe4\x7c\x7d\xd3\x9c\x11\x5a\xe8\xd5\x12\x50\x2d\xa5\xe2\x9c\xe9\xe8\x7c\x11\x3e\x99\xe1\x97\x51\xd3\x44\xd4\x33\xb1\xd6\xe6\xce\x92\x95\xe3\x1a\x24\xf7\xcd\x45\x7d\x85\xbc\x4b\xdb\xf4\x16\xea\x06\x22\x4d\xb1\x72\x41\x1d\xd9\xb8\x66\xa1\x7a\xb9\x36\xd2\x5f\x1b\x69\x42\x0a\x99\x3f\x5d\xb2\x2e\xd3\xfe\x0b\x9a\xf9\x59\x21\x15\x2e\xd2\x95\x30\x44\x43\xc8\x06\x90\xd9\x67\xab\xff\xf3\xee\x0b\xdf\x64\xb7\x37\x6c\xe1\xdb\x38\xa2\x77\xb9\xdc\xfc\x4c\x88\xf7\xd7\x3f\x38\x3e\xc3\x3c\x7a\x1a\x5a\x71\x3f\x71\xbe\x2f\x10\x13\x5a\x8e\x4e\x21\x3e\xd7\x85\xef\x37\x41\x96\x20\x3d\x13\xa9\x63\xa4\x8e\x1a\xa0\xb5\xea\xfa\x4f\x68\xfa\x2e\x0b\x2e\xe0\x43\xbd\x71\xa2\xd2\x14\xcc\x72\x40\xd7\x2d\xd4\xaf\xfc\xb4\xcf\x5f\x44\xad\xbb\xed\xdb\xa6\x1d\x5a\x77\x5b\x4c\x7d\x1b\x18\x19\x1e\xcc\x78\x73\x66\xf3\x45\xe8\x9f\xb1\x4e\xa6\x50\x0a\x45\xf6\x8a\x5b\x1e\x5f\xc0\xd2\xe8\xba\xb2\xdd\x4e\x96\x30\xad\xeb\x6f\x7d\xd1\x75\xf3\xd9\xd6\xbe\xda\xe0\x7e\x8a\xe8\xfb\x06\xeb\x01\xc4\x43\xa7\x05\x0a\x49\x69\x52\x16\xda\x72\xfa\x8c\xe8\x98\xbe\xcf\x8b\xa6\xfd\x2d\xe6\x83\x5b\xeb\xb0\xce\xa7\x32\x22\x9a\xb2\xc7\x93\x58\xa1\x2e\xf6\xfb\xb4\xb9\xf1\xd3\x94\x74\xd3\xfc\x16\x75\x99\x90\x51\xc8\xbb\x26\x00\x92\xf7\x4e\x83\x22\xdf\x33\x1d\x70\xa0\x3f\x2d\xd5\x65\x29\xf6\x4a\x24\xa9\x50\xe4\x89\x64\xbe\x2a\xd7\xfb\xa2\xed\x57\x34\x1a\xc6\x38\x5f\xce\x21\x1f\x9f\xf8\x0f\xd9\x48\xa3\x9a\xfd\xdb\x77\x9b\x93\x93\xdd\x4e\x7f\xee\x9a\x28\x85\xb9\x8b\xa4\xd5\x74\xfd\x77\xf4\xfb\x5d\x53\xef\xb2\x11\x4f\x3c\x07\x2e\x9e\xaa\x76\xb6\xb8\xd6\xf0\xb0\xbd\x24\x17\x84\xf1\x9e\x59\xa1\x7a\xdc\x2b\xfa\xfa\xef\x7c\xaa\xca\xe8\xca\x48\xe1\x42\x6d\xc9\x7b\x76\x7e\x56\x4b\x8e\x56\x2d\x13\x9a\xff\x7d\xfd\x99\x66\xf8\xc8\x2d\x96\xa0\xbf\xe2\x17\x50\x8a\x3b\xb4\x60\x6b\x83\x83\x3e\xfb\x5e\xfb\x69\xdc\xc1\x3b\xf7\xc6\xf8\x1b\xad\xd2\xa2\xf6\xc5\xe4\xe0\xe2\x25\xdc\xd5\x81\x9c\x59\x09\x56\xb0\x4b\xbe\x46\xf0\xdf\x7c\x1b\x75\xd0\x0e\x90\xa1\x35\x42\xd9\x82\x4b\xcf\x3b\xed\x08\x83\x2e\x35\xf7\x8b\x7c\xbc\x4f\xc1\x9b\x2d\xff\x4d\x69\xf8\x8e\x54\x85\xef\x46\xe7\x70\x23\x55\x28\x43\x3f\xf9\xc5\x40\x30\x8d\xed\xe7\x28\xdc\x9e\xbb\xdf\xf2\x3f\x54\x70\x6e\x1d\x92\x98\x3f\xde\x50\x08\xae\x68\x6c\x67\x79\x81\xd9\x92\x57\x75\x98\xea\xcc\x97\x46\x25\xd9\x1d\x61\xb8\x1f\xc7\xd6\xe9\xea\xa3\x1a\x37\x0d\x1d\xb4\x29\x6f\x48\x1e\x74\xb1\xe5\xba\x70\x14\x71\xaa\xae\xc2\xbd\x6b\x31\xa7\x7e\x17\xe1\x95\xed\xdd\xaa\x9a\x42\x85\xa6\x98\xc2\x07\x71\x2f\x9a\x76\xc0\xf9\x9b\xab\x5b\xff\x64\x0a\x0a\xef\x34\x68\x13\x9a\x80\xf6\x3e\x6f\xb8\xde\x75\xb9\xe2\xf3\x27\xb5\xa4\x37\xa0\x70\xbd\x47\x88\xef\x2e\xe2\x57\x2d\x43\x72\x6d\x4a\xe1\xbc\xc7\xb4\x5e\x6d\xc1\xe0\x4c\xaa\x7b\x8e\x40\x39\xf9\x87\x58\x7c\x35\x1a\x85\x0f\x16\x69\x71\xd7\x6f\x67\xa4\x25\x77\xdb\x25\xed\x74\x30\x9a\x29\x49\x77\x14\xa9\x1f\xdd\x94\x54\xab\x14\x8d\xf2\xc5\xef\x26\xec\xfe\xb8\x57\x33\x6d\x52\x05\x8d\xe2\xd2\x90\xc8\x87\x8b\xbc\xd6\xe8\xc3\x1a\x4b\x66\x47\x2c\xa3\xb6\x1c\x55\x97\x68\x74\xdd\x96\xb1\xad\x2f\xba\xa4\xae\xd8\x4e\xe1\x1a\x32\xcd\x81\xda\xb2\x16\x46\x28\x87\xd8\x36\x8a\x6d\x7d\x80\x18\xbe\xa4\x23\x1f\xa7\x9b\x18\xc0\xb0\xfb\x6a\x18\x96\xa8\xd0\x88\x10\x4f\x92\x73\xc5\x2d\x24\x91\x67\x18\x7c\x45\xb6\xdc\xed\xd7\x41\xfc\x81\x82\x77\x6d\xa7\x50\x15\x28\x2c\x4e\xf9\x33\xa6\x12\xe1\x4e\xe9\xf5\x7c\x34\xfa\xbf\x00\x00\x00\xff\xff\x73\x25\x20\xf4\x76\x3e\x00\x00"
-
-func dijkstraShuntingYardReadmeMdBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardReadmeMd,
-		"dijkstra-shunting-yard/README.md",
-	)
-}
-
-func dijkstraShuntingYardReadmeMd() (*asset, error) {
-	bytes, err := dijkstraShuntingYardReadmeMdBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/README.md", size: 15990, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardConfigSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xd4\x4f\xca\xcc\xd3\x4f\x4a\x2c\xce\xe0\xe2\x72\xf5\x77\xb3\x55\x77\xf5\x77\x53\xe7\x72\xf6\x70\x0c\x0a\x76\x0d\xb0\x55\x3f\xb4\x5d\x9d\x2b\xc4\xdf\xdb\xcd\xc7\x05\x99\x0b\x61\x2f\x53\xe7\x0a\xf5\x73\x0c\x8a\xb4\x55\xd7\x53\xe7\x02\x04\x00\x00\xff\xff\x91\xa0\xa2\x9b\x49\x00\x00\x00"
-
-func dijkstraShuntingYardConfigShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardConfigSh,
-		"dijkstra-shunting-yard/config.sh",
-	)
-}
-
-func dijkstraShuntingYardConfigSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardConfigShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/config.sh", size: 73, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardFix_func0Sh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x7f\x6b\xdb\x48\x13\xfe\xdb\xfb\x29\x9e\x57\x0a\xa5\x05\xc7\x3f\x79\x79\x79\xeb\x06\xce\x4d\xe3\xd4\x9c\xeb\x18\xdb\xa1\x84\x52\x8e\x95\x34\xb2\x16\xaf\x76\xc4\xee\x2a\xaa\x7b\xdc\x77\x3f\x24\x39\x8d\x73\x6d\xe9\x41\xab\x7f\x14\x4d\xe6\x79\x66\xe6\x99\x1f\x0e\xff\xd3\x8f\x94\xe9\x47\xd2\x65\x22\xbc\xf8\xc9\x47\x84\xef\xad\xf2\x9e\x0c\xa2\x03\xae\xac\xda\x63\xc5\x65\x21\xc9\x7a\x11\x5e\x72\x71\xb0\x6a\x97\x79\x3c\xbf\x7c\x81\xd1\x60\x38\xe8\xfe\xd3\x85\x72\xa9\xf4\x4b\x90\x55\xfb\xdf\x9c\x34\xfb\xd2\x96\xbd\x48\x7d\x16\x61\x45\x11\x9c\xf2\xf4\x12\x99\xf7\xc5\xcb\x7e\xff\xc9\x7f\x6f\x62\xcf\x11\xd9\x23\xe7\x2a\x33\x9c\x63\x45\x26\xeb\xe2\x52\xe6\x11\x27\x4a\xfe\x8a\xca\xb6\x99\x72\x28\x2c\xef\xac\xcc\xa1\x1c\x52\x4b\x04\xc7\xa9\xaf\xa4\xa5\x09\x0e\x5c\x22\x96\x06\x96\x12\xe5\xbc\x55\x51\xe9\x09\xca\x43\x9a\xa4\xcf\x56\x84\x39\x27\x2a\x3d\xd4\x96\xd2\x24\x64\xe1\x33\x82\x27\x9b\x3b\x70\xda\x7c\x5c\x2f\x6f\x71\x4d\x86\xac\xd4\x58\x95\x91\x56\x31\x16\x2a\x26\xe3\x48\x84\xd2\xa1\xa8\x4d\x2e\xa3\xa4\x96\xb6\xf6\x9f\xd5\xf1\x37\xc7\xf8\x98\x71\x69\x12\xe9\x15\x9b\x09\x48\xf9\x8c\x2c\xee\xc9\x3a\xc5\x06\x23\x11\x1e\x43\x1c\xf9\xba\x60\x8b\xe7\xd2\xd7\x39\x5b\x70\x51\xa3\x5e\x40\x9a\x03\xb4\xf4\x8f\xc0\x9e\x08\xbf\xae\xfa\xb1\xb8\x04\xca\x34\xac\x19\x17\x04\x9f\x49\x5f\x17\x57\x29\xad\x11\x11\x4a\x47\x69\xa9\xbb\x22\x8c\x4a\x8f\xf7\xf3\xed\xdb\x9b\xdb\x2d\xa6\xcb\x3b\xbc\x9f\xae\xd7\xd3\xe5\xf6\x6e\x82\x4a\xf9\x8c\x4b\x0f\xba\xa7\x96\x48\xe5\x85\x56\x94\xa0\x92\xd6\x4a\xe3\x0f\xe0\x54\x84\xef\xae\xd6\x97\x6f\xa7\xcb\xed\xf4\xf5\x7c\x31\xdf\xde\xd5\xa9\xcf\xe6\xdb\xe5\xd5\x66\x83\xd9\xcd\x1a\x53\xac\xa6\xeb\xed\xfc\xf2\x76\x31\x5d\x63\x75\xbb\x5e\xdd\x6c\xae\x7a\xc0\x86\xea\x94\x48\x84\xdf\x57\x15\x29\x5b\xe4\x6c\x09\x09\x79\xa9\xb4\x6b\xea\xbd\xe3\x12\x2e\xe3\x52\x27\xc8\xe4\x3d\xc1\x52\x4c\xea\x9e\x12\x48\xc4\x5c\x1c\xfe\x55\xb3\x34\x9b\x5d\x53\x1d\xfc\x89\x7a\x13\xa8\x14\x86\x7d\x17\x95\x55\x9e\xe0\xf9\xeb\x36\x8a\xf0\xb1\x8f\x5d\xcc\x4d\xdc\xeb\xe2\xbf\xff\xc7\x96\xf2\x42\x13\x56\x5a\xc6\x84\x73\x6c\xca\x1a\x3f\x1e\x0f\xba\x78\xcd\xce\xd7\xae\xef\xa6\xc0\x60\x34\x1c\x0e\xcf\x87\xe3\xc1\xff\xba\xb7\x9b\xe9\xb1\x7b\x84\xe0\xfb\xb9\x06\x78\x7e\xbd\x5a\xbc\xa8\xfb\x2a\xef\xa5\xd2\x32\xd2\x04\xe9\x45\x78\xdc\xb2\xaa\xaa\x7a\x3b\x53\xf6\xd8\xee\xfa\xba\x85\xb8\x3e\xeb\xe4\xfc\xcb\xc7\xae\xd0\xe7\xa3\xde\xa0\x97\xf9\x5c\xff\xf2\x25\xb3\x24\x13\x07\x09\xcf\x7b\x32\xad\xa0\x23\x68\xe6\xbd\xcc\x48\x26\xad\xd9\x89\x50\xa5\xf5\xe0\xa5\xca\x34\xce\x69\x69\xe2\x5a\xbe\x23\x6a\x76\xbb\xbc\xdc\x76\x91\xb2\xd6\x5c\xb5\xcb\x23\xa1\x29\x6d\x16\x13\x12\xed\x45\x8a\xac\x8c\xf7\xe4\x45\xf8\x30\xc1\x96\x8a\x46\x6c\xe5\x9b\x75\xab\xa9\xfc\xa1\xa0\x86\x6e\xf0\xa0\xec\x7a\xb5\x44\x21\xad\x23\x8b\x98\x4b\xe3\x5d\xd3\xd0\x98\xf3\x5c\x3a\x28\xe3\x54\xd2\x0c\xe1\x03\x7b\x6d\x03\xdb\x84\xac\x08\x3d\xd7\x53\x47\x36\x57\xa6\xf5\x31\x65\x5e\x9f\x30\x4e\x21\xed\xae\xcc\xc9\x78\xd7\x43\x1d\xa4\xb0\x1c\x69\x6a\x76\xaf\xd9\x31\x11\x4e\x1f\x6b\x6c\x34\x61\xa3\x0f\x60\x43\x5f\x90\xc8\xa4\x03\x7d\x92\xb1\xd7\xed\xa9\x70\x32\x7f\x88\xd0\xdc\x82\x63\x8a\xf2\x89\x5e\x0d\x97\xe1\x93\xf8\xc7\x3a\x2d\xa5\x6c\xa9\x8b\x8a\x90\x97\xce\x37\x27\x40\x99\x5d\xa9\x5c\x86\x88\x7c\x45\x64\xf0\x99\x2c\x37\x8a\x9e\xe6\x51\x33\xfc\xf4\x48\x38\x2e\x6d\x4c\xe8\xf5\x63\x36\xa9\xda\xf5\xea\x9f\xa9\xf3\x9f\x7c\x44\x95\x29\x4d\xcd\x7c\xe1\xdc\x1e\xdb\x6b\x55\xa1\xc9\x4f\x90\xb0\xe8\xdc\x2c\xde\xfc\x31\x9f\x6d\x2e\xce\xe6\xb3\x8d\xe8\xd4\x7f\x05\x67\x7f\x6e\x6f\x7e\xdf\x5c\xad\xfe\x0a\x26\x2d\xb0\x41\x0d\xdb\xd7\xa8\x7d\x8d\xf1\xea\xd5\xab\xe0\xec\x94\x2f\x38\x85\xcf\x16\x6f\x9e\x30\x1c\x0a\x1a\xe2\x5e\xea\x92\x86\x27\xc8\xe1\x8f\x30\xa3\x16\x33\x3a\xc1\x8c\x7e\x84\x19\xb7\x98\xd3\x0c\xc7\x81\x10\x1d\x95\xe2\xc3\x07\x04\x67\x4d\x2e\x01\x2e\x2e\x10\x34\x2b\x13\xe0\xd9\xb3\xa3\x79\xd4\x9a\x5f\xaf\x17\xb3\x53\xf3\xf8\xc1\x7c\xfd\x76\x1b\xe0\xe3\x47\x4c\xea\x59\x33\xa2\xd3\xa1\x38\xe3\x96\x66\x70\x9a\xce\x59\x5b\x69\x20\x3a\x4d\xaa\x67\x47\x91\x27\xdf\xe9\xc3\x37\xcd\x08\xdd\x5e\x15\x30\xf4\xc9\xc3\x57\xfc\x70\x03\x3a\xa4\x1d\x7d\x89\xdc\x16\xf3\xed\xd0\xa9\x12\x4f\xa2\x8b\x84\x0d\xfd\x82\x31\x15\x7f\x07\x00\x00\xff\xff\x7b\x69\xde\xed\x48\x09\x00\x00"
-
-func dijkstraShuntingYardFix_func0ShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardFix_func0Sh,
-		"dijkstra-shunting-yard/fix_func0.sh",
-	)
-}
-
-func dijkstraShuntingYardFix_func0Sh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardFix_func0ShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/fix_func0.sh", size: 2376, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardFix_funcdefSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xfd\x6f\xe2\x46\x10\xfd\x99\xfd\x2b\xde\xd9\xd1\x7d\x48\x7c\xa3\xaa\x6a\xb8\x48\xe5\x08\xe4\x50\x39\x40\x7c\x28\x8a\xaa\xa8\x5a\xdb\x63\xbc\x62\xd9\xb5\xd6\x6b\x1c\x5a\xf5\x7f\xaf\xd6\xe6\x12\xd2\xf4\x74\x95\x2e\xfe\xc5\x78\x98\xf7\x66\xe6\xbd\xd9\xf5\xdf\xb4\x02\xa1\x5a\x01\xcf\x12\xe6\x5f\xfd\xe0\xc3\xfc\x5b\x23\xac\x25\x85\xe0\x88\x91\x11\x3b\x2c\x74\x9e\x72\x32\x96\xf9\x43\x9d\x1e\x8d\xd8\x26\x16\xef\x87\x1f\xd0\x6d\x77\xda\xf5\x7f\xa7\xd0\x9e\x0b\x79\x09\x32\x62\xf7\x6b\xc6\xd5\x2e\x37\x79\x33\x10\x7f\x32\xbf\xa0\x00\x99\xb0\x74\x89\xc4\xda\xf4\xb2\xd5\x7a\xf6\xef\x3c\xb4\x3a\x20\x73\xe2\x5c\x24\x4a\xef\xb1\x20\x95\xd4\x31\xe4\xfb\x40\x47\x82\xbf\xc6\x64\xeb\x44\x64\x48\x8d\xde\x1a\xbe\x87\xc8\x10\x1b\x22\x64\x3a\xb6\x05\x37\xd4\xc7\x51\xe7\x08\xb9\x82\xa1\x48\x64\xd6\x88\x20\xb7\x04\x61\xc1\x55\xd4\xd2\x86\xf9\x7b\x1d\x89\xf8\xe8\x22\xb9\x8a\xc8\xc0\x26\x04\x4b\x66\x9f\x41\xc7\xe5\xc7\xcd\x6c\x83\x1b\x52\x64\xb8\xc4\x22\x0f\xa4\x08\x31\x15\x21\xa9\x8c\x98\xcf\x33\xa4\x2e\x94\x25\x14\x39\x69\x5d\xfe\xd8\xd5\x5f\x9d\xea\x63\xac\x73\x15\x71\x2b\xb4\xea\x83\x84\x4d\xc8\xe0\x40\x26\x13\x5a\xa1\xcb\xfc\x53\x89\x13\x5f\x1d\xda\xe0\x3d\xb7\xae\x67\x03\x9d\x3a\xd4\x07\x70\x75\x84\xe4\xf6\x09\xd8\x64\xfe\xcb\xa9\x9f\x86\x8b\x20\x54\xc9\x9a\xe8\x94\x60\x13\x6e\xdd\x70\x85\x90\x12\x01\x21\xcf\x28\xce\x65\x9d\xf9\x41\x6e\x71\x3b\x59\x7f\x9e\x6f\xd6\x18\xcc\xee\x70\x3b\x58\x2e\x07\xb3\xf5\x5d\x1f\x85\xb0\x89\xce\x2d\xe8\x40\x15\x91\xd8\xa7\x52\x50\x84\x82\x1b\xc3\x95\x3d\x42\xc7\xcc\xff\x32\x5a\x0e\x3f\x0f\x66\xeb\xc1\xa7\xc9\x74\xb2\xbe\x73\xad\x8f\x27\xeb\xd9\x68\xb5\xc2\x78\xbe\xc4\x00\x8b\xc1\x72\x3d\x19\x6e\xa6\x83\x25\x16\x9b\xe5\x62\xbe\x1a\x35\x81\x15\xb9\x96\x88\xf9\xdf\x56\x15\xb1\x36\xd8\x6b\x43\x88\xc8\x72\x21\xb3\x72\xde\x3b\x9d\x23\x4b\x74\x2e\x23\x24\xfc\x40\x30\x14\x92\x38\x50\x04\x8e\x50\xa7\xc7\xff\x65\x96\xd4\x6a\x5b\x4e\x07\x7b\xa6\x5e\x1f\x22\x86\xd2\xb6\x8e\xc2\x08\x4b\xb0\xfa\xa5\x8d\xcc\x7f\xf2\xb1\x8e\x89\x0a\x9b\x75\xfc\xf4\x0b\xd6\xb4\x4f\x25\x61\x21\x79\x48\x68\x60\x95\x3b\x7c\xaf\xd7\xae\xe3\x93\xce\xac\x4b\xfd\x32\x00\xda\xdd\x4e\xa7\xd3\xe8\xf4\xda\x3f\xd7\x37\xab\xc1\xc9\x3d\x82\xf7\xed\x5e\x3d\xbc\xbf\x59\x4c\x3f\x38\x5f\xf9\x81\x0b\xc9\x03\x49\xe0\x96\xf9\xa7\x53\x56\x14\x45\x73\xab\xf2\xa6\x36\xdb\x96\xac\x20\x59\x4b\xcb\xa8\xf1\xf8\xb1\x4d\x65\xa3\xdb\x6c\x37\x13\xbb\x97\xaf\x7e\xc8\x0c\xf1\x28\x03\x87\xd5\x3b\x52\x95\xa0\x5d\x48\xad\x77\x3c\x21\x1e\x55\xe1\x8c\xf9\x22\x76\x8b\x17\x0b\x15\x65\xa5\xa2\x3b\x3a\x16\xda\x44\x78\x17\xe7\x2a\x74\x4a\xbe\x7b\x5c\xcc\x48\x17\x6a\x6b\x78\x54\x2e\x07\x14\x3d\xd8\x8a\x85\xf9\x56\x43\xa7\x64\xb8\x8a\x9a\xaf\x30\x47\xa6\x73\x13\x12\x9a\xad\x50\xab\x58\x6c\x9b\xee\x6e\x6d\xfc\xe0\xc3\x8a\x44\x48\x2a\x45\x41\xc3\x54\x7d\x5b\x23\x52\x49\xb6\x8f\x48\xb3\xda\x7c\x7a\xfd\xc7\x64\xbc\xba\xba\x98\x8c\x57\xac\xe6\x7e\x79\x17\x7f\xad\xe7\xbf\xad\x46\x8b\xbf\xbd\x7e\x05\x2c\x51\x9d\xea\xd5\xad\x5e\x3d\x7c\xfc\xf8\xd1\xbb\x38\xe7\xf3\xce\xe1\xe3\xe9\xf5\x33\x86\x63\x4a\x1d\x1c\xb8\xcc\xa9\x73\x86\xec\x7c\x0f\xd3\xad\x30\xdd\x33\x4c\xf7\x7b\x98\x5e\x85\x39\xef\xb0\xe7\x31\x56\x13\x31\x7e\x87\x77\x51\x35\xe1\xe1\xea\x0a\xde\x57\xaf\x3d\xdc\xa3\xef\xdc\x55\xac\x56\xe6\xb9\xc4\xb2\xbe\x87\x37\x57\xf0\xc6\x9b\xd9\x70\xed\xe1\xed\xdb\x97\xe1\xb6\x87\xfb\xfb\x47\x6c\x8d\xc2\x44\xe3\x59\x6b\x64\x8c\x36\x97\x10\x11\x29\x2b\x62\x41\x06\xf4\x90\x52\xe8\xae\x42\x1e\xbb\x8b\xf3\xe5\xe6\x79\x25\xd3\x83\xb0\xe8\xb0\x5a\x2d\x16\xec\x2b\xaf\x2b\x78\x3d\x1a\x9f\xd3\x9f\x42\xde\x63\xce\x7c\xb1\x9c\x5d\x9f\x67\x54\x13\x3b\xd9\x4a\xdd\x2e\x4e\x8e\xf7\xff\x73\x29\xe0\x67\x3b\x91\x9e\x2f\x79\x8d\x64\x46\x8f\xec\xe5\xf8\x9d\x97\xf4\xce\x49\xd7\xe8\x79\x05\x16\x69\x45\xaf\x70\x2e\xd8\x3f\x01\x00\x00\xff\xff\x88\x21\x5a\x4e\x6e\x08\x00\x00"
-
-func dijkstraShuntingYardFix_funcdefShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardFix_funcdefSh,
-		"dijkstra-shunting-yard/fix_funcdef.sh",
-	)
-}
-
-func dijkstraShuntingYardFix_funcdefSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardFix_funcdefShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/fix_funcdef.sh", size: 2158, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardFix_invokeSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x61\x6f\xda\xc8\x16\xfd\xcc\xfc\x8a\x53\x9b\x0f\x89\x44\x20\x80\x9e\x9e\x5e\x29\x4f\x4b\x53\x48\xd9\xa6\x80\x30\x6c\x1a\xad\xaa\x6a\x6c\x5f\xe3\x51\x86\x19\x6b\x3c\xc6\x62\xab\xfe\xf7\xd5\xd8\x90\x90\x46\x55\x57\xdb\xf8\x0b\xe3\x99\x73\xee\x9c\x7b\xcf\xbd\xc6\x7f\xd5\x09\x85\xea\x84\x3c\x4f\x99\x3f\xfc\xc5\x87\xf9\xb7\x46\x58\x4b\x0a\xe1\x1e\x63\x23\xee\xb1\xd0\x45\xc6\xc9\x58\xe6\x5f\xe9\x6c\x6f\xc4\x26\xb5\x38\xbb\x3a\x47\xef\xb2\x7b\xd9\xfa\x1e\x42\x5b\x2e\xe4\x6b\x90\x11\xf7\xbf\xe5\x5c\xdd\x17\xa6\x68\x87\xe2\x2f\xe6\x97\x14\x22\x17\x96\x5e\x23\xb5\x36\x7b\xdd\xe9\x3c\x39\x9d\x47\x56\x87\x64\x0e\x31\x17\xa9\xd2\x5b\x2c\x48\xa5\x2d\x5c\xf1\x6d\xa8\x63\xc1\x5f\x22\xb3\x55\x2a\x72\x64\x46\x6f\x0c\xdf\x42\xe4\x48\x0c\x11\x72\x9d\xd8\x92\x1b\x1a\x60\xaf\x0b\x44\x5c\xc1\x50\x2c\x72\x6b\x44\x58\x58\x82\xb0\xe0\x2a\xee\x68\xc3\xfc\xad\x8e\x45\xb2\x77\x3b\x85\x8a\xc9\xc0\xa6\x04\x4b\x66\x9b\x43\x27\xd5\xcb\xf5\x6c\x8d\x6b\x52\x64\xb8\xc4\xa2\x08\xa5\x88\x70\x23\x22\x52\x39\x31\x9f\xe7\xc8\xdc\x56\x9e\x52\xec\x4a\xeb\xf0\x13\x77\x7f\x70\xb8\x1f\x13\x5d\xa8\x98\x5b\xa1\xd5\x00\x24\x6c\x4a\x06\x3b\x32\xb9\xd0\x0a\x3d\xe6\x1f\xae\x38\xc4\x6b\x41\x1b\x9c\x71\xeb\x34\x1b\xe8\xcc\xb1\xce\xc1\xd5\x1e\x92\xdb\x47\x62\x9b\xf9\xcf\xb3\x7e\x4c\x2e\x86\x50\x55\xd4\x54\x67\x04\x9b\x72\xeb\x92\x2b\x85\x94\x08\x09\x45\x4e\x49\x21\x5b\xcc\x0f\x0b\x8b\xdb\xe9\xea\xfd\x7c\xbd\xc2\x68\x76\x87\xdb\xd1\x72\x39\x9a\xad\xee\x06\x28\x85\x4d\x75\x61\x41\x3b\xaa\x03\x89\x6d\x26\x05\xc5\x28\xb9\x31\x5c\xd9\x3d\x74\xc2\xfc\x8f\xe3\xe5\xd5\xfb\xd1\x6c\x35\x7a\x3b\xbd\x99\xae\xee\x9c\xf4\xc9\x74\x35\x1b\x07\x01\x26\xf3\x25\x46\x58\x8c\x96\xab\xe9\xd5\xfa\x66\xb4\xc4\x62\xbd\x5c\xcc\x83\x71\x1b\x08\xc8\x49\x22\xe6\xff\xb8\xaa\x48\xb4\xc1\x56\x1b\x42\x4c\x96\x0b\x99\x57\xf9\xde\xe9\x02\x79\xaa\x0b\x19\x23\xe5\x3b\x82\xa1\x88\xc4\x8e\x62\x70\x44\x3a\xdb\xff\x23\xb3\xa4\x56\x9b\x2a\x3b\xd8\x93\xea\x0d\x20\x12\x28\x6d\x5b\x28\x8d\xb0\x04\xab\x9f\xdb\xc8\xfc\x47\x1f\x5b\x98\xaa\xa8\xdd\xc2\x7f\xfe\x87\x15\x6d\x33\x49\x58\x48\x1e\x11\x2e\x10\x14\x8e\xdf\xef\x5f\xb6\xf0\x56\xe7\xd6\x41\x3f\x8e\x80\xcb\x5e\xb7\xdb\xbd\xe8\xf6\x2f\xff\xdb\x5a\x07\xa3\x83\x7b\x04\xef\xc7\x5a\x3d\x9c\x5d\x2f\x6e\xce\x9d\xaf\x7c\xc7\x85\xe4\xa1\x24\x70\xcb\xfc\xc3\x94\x95\x65\xd9\xde\xa8\xa2\xad\xcd\xa6\x23\x6b\x4a\xde\xd1\x32\xbe\x78\x78\xd9\x64\xf2\xa2\xd7\xbe\x6c\xa7\x76\x2b\x5f\x66\xc8\x08\xcb\xc5\x0c\x19\x37\x39\x19\xdc\x2b\x5d\xe6\x28\x53\x52\xe0\xc8\xf7\xdb\x50\xcb\x4a\x2c\x92\x42\x45\x75\x91\x5c\x7f\x29\x6d\x6b\x90\xd5\xcc\x17\x6a\xa7\xef\xdd\xe8\xb5\x71\x9b\x1e\x3a\xeb\x08\x87\xd5\xf7\xa4\xaa\xe9\xd5\x52\xea\xb2\x9e\x26\x8e\x98\x0c\x25\x10\x2a\xb7\xa6\xa8\xe3\x32\x5f\x58\x6c\x8b\xdc\xba\x66\xae\x8e\xc9\x90\x8a\x28\x46\x22\x4c\x6e\xdb\x98\xbb\x19\x2b\x85\x9b\x26\x61\xab\xc9\x0f\x09\xf5\xdd\x31\xea\xef\x1c\x2f\xf9\xbe\xcd\x7c\x4c\xd6\xb3\xab\x15\x46\xcb\xeb\x00\xef\xc6\xcb\xf1\x04\xc3\xe1\xff\x1f\x37\xe7\x87\xcd\xe9\xec\x8f\xf9\x87\xf1\x53\xf8\x27\xb8\xe7\x09\xfc\x08\xc4\xa7\x17\xa8\x77\xae\x0b\x13\x11\xda\x9d\x48\xab\x44\x6c\xda\xee\x3f\xe0\xe2\x17\x1f\xf6\x50\x6c\x5d\xd8\xac\xb0\x5f\xa4\x50\x74\x76\xce\xbe\xb2\x86\xdd\x67\x34\xf4\x9a\x5d\x8f\x35\x76\x5c\x16\x6e\xdd\xf3\x58\x43\x24\xf8\x13\x5e\xd3\x9d\x7a\x78\x35\x84\xd7\x1c\xcf\x27\x1e\x3e\x0f\x9c\x77\x8a\x35\x1a\x14\xa5\xfa\x00\x68\x7e\x5d\xcd\x3f\x4c\x6e\xde\x05\xe3\xc5\xb7\x66\x15\xc4\x63\x8d\x44\xb0\x6f\x8c\x95\xa9\x90\x6e\x60\x79\x8c\x0b\x53\x3b\x6d\x8d\xc8\x24\xd9\x01\x62\xcd\x1a\xf3\x9b\x77\x5f\xa6\x93\x60\xd8\x9c\x4e\x02\xd6\x70\x2b\xaf\x8a\xe6\x42\x79\x83\x9a\x58\xb1\xba\xf5\x4f\xaf\xfe\xe9\xe3\xcd\x9b\x37\x5e\xf3\x34\x9e\x77\x4a\x3f\x88\x79\x88\xb0\xcf\xa8\x8b\x4a\x59\xf7\x84\xd9\xfd\x19\xa7\x57\x73\x7a\x27\x9c\xde\xcf\x38\xfd\x9a\x73\xaa\xb0\xef\xb1\x27\x05\xed\x7a\x18\x0e\xe1\x55\xed\xe3\xe1\x33\x1e\x6a\x7a\x82\xe9\xd7\x98\xaa\x0d\x9f\x60\x1a\x27\x0e\x3e\xc6\xf3\xea\xba\xbb\x8c\xbe\x43\xcc\xdf\xfe\x3e\x5a\x5e\x3f\x20\x7a\xcf\x11\xc7\xfb\x0e\x97\xb9\xf3\xda\xdc\xe0\x2e\x38\xcd\xb2\xee\xf1\xea\xbc\xb2\xec\xe0\xdd\xe0\xd1\xde\xa3\xb3\xdf\x6d\xb8\x6e\x91\x39\xfd\x0b\xf1\x93\xf5\xec\xb9\xf8\x5f\x12\xc7\x1a\xae\x33\x1b\x24\x9f\xfb\xf1\xbc\xd6\x4f\xb4\x54\xc7\x0b\xef\x61\xc1\x8e\x49\x1d\x27\xe1\xd8\x52\x2e\xfe\xa9\x06\x16\x6b\x45\x2f\xf0\x61\x60\x7f\x07\x00\x00\xff\xff\x31\x45\xc7\x3e\x17\x0a\x00\x00"
-
-func dijkstraShuntingYardFix_invokeShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardFix_invokeSh,
-		"dijkstra-shunting-yard/fix_invoke.sh",
-	)
-}
-
-func dijkstraShuntingYardFix_invokeSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardFix_invokeShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/fix_invoke.sh", size: 2583, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardLexSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\xff\x73\xda\x38\x16\xff\xd9\xfa\x2b\xde\x1a\x76\x09\x2d\x90\x90\xde\xde\x5e\x43\xdd\x39\x42\x9d\x84\x29\x05\xce\x90\xeb\x74\xb2\x9d\x8c\xb0\x05\xd6\xc4\x48\x5e\x59\x0e\xcd\xa5\xdc\xdf\x7e\x23\xd9\xc6\xe6\xeb\xf6\x26\x69\x6f\xe6\xf2\x0b\xd1\xd3\x47\xd2\xd3\x7b\x9f\xcf\x93\xe4\xd2\x4f\xc7\x13\xca\x8e\x27\x38\xf2\x51\xc9\x7a\xe2\x1f\x2a\x7d\x14\x54\x4a\xc2\x60\xf2\x00\xb6\xa0\x77\x30\xe4\x71\x88\x89\x90\xa8\xd4\xe1\xe1\x83\xa0\x33\x5f\xc2\x51\xa7\x0a\xa7\x27\xcd\x93\xda\x26\x84\xcc\x31\x0d\xce\x80\x08\x7a\xf7\xf7\x08\xb3\xbb\x58\xc4\x8d\x09\xfd\x17\x2a\x2d\xc8\x04\x22\x2a\xc9\x19\xf8\x52\x86\x67\xc7\xc7\x6b\xbd\x03\x57\xf2\x09\x11\xe9\x9c\x43\x9f\xf1\x39\x0c\x09\xf3\x6b\xd0\xc1\xf3\x09\xf7\x28\x7e\x8e\x9d\x8d\x7d\x1a\x41\x28\xf8\x4c\xe0\x39\xd0\x08\xa6\x82\x10\x88\xf8\x54\x2e\xb0\x20\x2d\x78\xe0\x31\xb8\x98\x81\x20\x1e\x8d\xa4\xa0\x93\x58\x12\xa0\x12\x30\xf3\x8e\xb9\x40\xa5\x39\xf7\xe8\xf4\x41\x59\x62\xe6\x11\x01\xd2\x27\x20\x89\x98\x47\xc0\xa7\xba\x71\xd9\xbf\x86\x4b\xc2\x88\xc0\x01\x0c\xe3\x49\x40\x5d\xe8\x51\x97\xb0\x88\xa0\x12\x8e\x20\x54\xa6\xc8\x27\x9e\x0a\xad\xc2\x5f\xa8\xf5\x47\xe9\xfa\x70\xc1\x63\xe6\x61\x49\x39\x6b\x01\xa1\xd2\x27\x02\xee\x89\x88\x28\x67\x70\x8a\x4a\xe9\x12\xe9\x7c\x35\xe0\x02\x8e\xb0\x54\x3e\x0b\xe0\xa1\x1a\x55\x05\xcc\x1e\x20\xc0\x32\x1f\xd8\x40\xa5\xed\x5d\xe7\x9b\xf3\x80\x32\x3d\xab\xcf\x43\x02\xd2\xc7\x52\x6d\x6e\x41\x83\x00\x26\x04\xe2\x88\x4c\xe3\xa0\x86\x4a\x93\x58\xc2\xc7\xee\xf8\x6a\x70\x3d\x86\x76\xff\x13\x7c\x6c\x3b\x4e\xbb\x3f\xfe\xd4\x82\x05\x95\x3e\x8f\x25\x90\x7b\x92\x4c\x44\xe7\x61\x40\x89\x07\x0b\x2c\x04\x66\xf2\x01\xf8\x14\x95\x3e\xd8\x4e\xe7\xaa\xdd\x1f\xb7\xcf\xbb\xbd\xee\xf8\x93\x72\xfd\xa2\x3b\xee\xdb\xa3\x11\x5c\x0c\x1c\x68\xc3\xb0\xed\x8c\xbb\x9d\xeb\x5e\xdb\x81\xe1\xb5\x33\x1c\x8c\xec\x06\xc0\x88\x28\x97\x08\x2a\xed\x8f\x2a\x4c\xb9\x80\x39\x17\x04\x3c\x22\x31\x0d\x22\xbd\xdf\x4f\x3c\x86\xc8\xe7\x71\xe0\x81\x8f\xef\x09\x08\xe2\x12\x7a\x4f\x3c\xc0\xe0\xf2\xf0\xe1\x9b\x92\x15\x70\x36\xd3\xbb\x03\x59\x88\x5e\x0b\xe8\x14\x18\x97\x35\x58\x08\x2a\x09\x48\xbe\x9d\x46\x54\xca\xf3\x58\x83\x2e\x73\x1b\x35\xf8\xf5\x35\x8c\xc9\x3c\x0c\x08\x0c\x03\xec\x12\xa8\xc3\x28\x56\xe3\x5f\xbd\x3a\xa9\xc1\x39\x8f\xa4\x82\x7e\x68\x03\x9c\x9c\x36\x9b\xcd\x7a\xf3\xd5\xc9\x6f\xb5\xeb\x51\x3b\xcd\x1e\x01\x73\xbf\xaf\x26\x1c\x5d\x0e\x7b\x55\x95\x57\x7c\x8f\x69\x80\x27\x01\x01\x2c\x51\x29\x55\xd9\x62\xb1\x68\xcc\x58\xdc\xe0\x62\x76\x1c\x24\x43\xa2\x63\x1e\x78\xf5\x55\x63\x16\x06\xf5\xd3\xc6\x49\xc3\x97\xf3\xe0\x7b\x88\x0c\x43\xa4\x59\x11\x49\xea\x42\x40\xbe\x10\x51\x03\xc5\x28\x2a\xb5\xd8\x7c\xcc\xbc\x80\x00\x2a\x45\x94\xcd\x02\x02\x7f\xc4\x5c\x11\x13\x33\x0f\x3c\x1e\x4f\x72\x8b\x22\x2d\x9b\x45\xb5\x24\x2d\x64\x3e\x21\x9e\x47\xbc\xa4\x37\x42\x25\x12\xb9\x38\x4c\xa4\x85\x61\x82\xdd\xbb\x28\xc0\x91\x0f\xae\x8f\x05\x76\x25\x11\x2a\x96\xc3\x98\xb9\x32\xd6\xa9\xc9\x3b\x22\x50\xe2\x73\x39\x8b\xa8\x47\x04\xf1\x80\x87\x44\x60\xc9\x45\xd4\x00\x54\xea\x4a\xad\x17\xca\x66\xb1\x92\x6e\xa4\x13\x3e\xe5\x41\xc0\x17\x94\xcd\x80\xe1\x79\x71\xc4\x19\x54\x8e\x2a\x50\xa9\x56\xa0\x52\x7f\x5b\x81\x4a\xad\x02\x3a\x83\x11\xc9\x31\x7a\x39\x29\x08\xd6\x9b\x0a\x89\x4b\xa7\xd4\xc5\x41\xf0\x90\x89\xd1\x19\xf6\x21\xc4\x22\x52\x3e\xdb\xf7\x44\x3c\x48\x5f\x2d\x45\x82\x88\xe8\xb1\x7a\x26\xe6\x29\xb2\x5f\xf1\x05\xb9\x57\x01\xc5\x2c\x33\xa7\xce\x65\x91\x50\xfe\xd0\xa8\xb8\x3d\x0c\x53\x15\x06\xca\xd9\x33\x64\x3b\xe2\xb1\x70\x09\x34\x8e\x5d\xce\xa6\x74\xd6\x50\x27\x50\xfd\x89\x7f\x68\x34\x6e\x8f\xed\xdb\xc1\xd0\x76\xda\xfd\x77\x56\x33\x6d\x5f\x5c\xf7\x3b\xe3\xee\xa0\x6f\x9d\xa6\x86\x73\xa7\xdd\x79\x6f\x8f\x6f\x7b\xf6\xc5\xd8\x7a\xb5\x61\x74\xba\x97\x57\x63\xeb\x2f\xa9\xb5\x33\xf8\xf0\xa1\x6d\xfd\x9a\xb6\xde\xd9\x8e\x7d\x61\xfd\x35\x6d\xfd\xe3\x7a\x30\xb6\x6f\x47\xdd\xfe\x65\xcf\xb6\x7e\x5b\x33\xbe\x1b\x5c\x9f\xf7\x6c\xeb\x6f\x45\x8f\xc6\x03\xc7\x7a\x9d\x1a\x46\xc3\x76\xc7\xb6\x9a\x27\x59\xd3\xfe\xd0\xed\x0c\x7a\x83\xbe\xd5\xcc\x9c\x3e\xef\x0d\x3a\xef\x6f\x47\xe3\xb6\x33\xb6\x9a\xa7\x6b\x46\x5b\xed\x2d\x75\xdb\x2a\x3f\xae\x6d\x7a\x89\x90\xe2\xa7\x65\x9a\xfa\xf7\x54\xfd\x33\x89\xa7\x53\xa2\x4d\x28\x4b\x20\x44\x12\x4b\x72\xab\x48\x78\x54\x45\x8f\xc8\xd0\x6d\xcb\x2c\x37\x4d\x64\xb8\x38\x22\x60\x96\xb5\xc9\x04\xca\x90\x61\x98\x9b\xcb\x98\x55\x20\xae\xcf\xc1\x1c\x0c\x9d\xfe\x3b\x13\x5a\xad\x22\x2a\x8b\x78\x0e\xd3\x96\x4d\x58\x31\x0f\x39\xf4\xdc\xe9\x5d\xec\x85\xea\xec\x14\xb1\x97\x57\x5b\x58\x9d\xb3\x1c\xa3\x9b\x9b\x18\x9d\xc9\x1c\xa3\x9b\x9b\x98\x55\x56\x72\xdc\xca\xb4\x89\x2d\x72\xa1\x00\x1f\x3b\xfd\xcb\xdd\xd0\x84\x21\x7f\x02\x2d\x90\xa0\xb0\xe7\xde\xfb\xd1\x76\x7c\x32\x66\xac\xe1\xec\xed\xd4\x64\x54\x2c\x66\xd0\x76\x52\xd4\x8b\xcc\x66\x3b\xce\xc0\x31\x5b\x40\xbe\x50\x09\x4d\xdd\x49\x22\xec\xa2\x65\x81\x42\x09\xad\x6e\x79\x2c\xc3\x58\x6e\xb3\x28\x67\x98\x55\x3e\xca\x1b\x2b\x62\x55\x91\x41\xa7\x70\x03\x66\x39\x99\xc8\x84\x9f\x2c\x30\x4d\xf8\xdc\x52\xb5\x8c\xa5\x8e\x94\xf3\x91\xe5\xc7\xf1\xe0\xfd\x45\xef\xdd\xc8\x1e\x2e\xb3\x31\x2d\x98\x52\x64\xac\x08\x6e\x18\x28\x15\x80\xcb\x63\x26\xad\x13\xb4\xf0\x69\xa0\x0e\x72\xec\x41\x5d\xe8\xd2\x1d\x62\x2a\x5a\xe0\x71\x84\x8c\x80\x48\x58\x81\x5f\xbe\x44\xc8\xd0\xda\x29\x3f\x66\xb8\x9f\x7f\x2e\x77\xae\xda\xce\xc8\x1e\xbe\x58\x26\x9d\xa7\x85\xde\xd2\x8b\xac\x77\x89\x90\x91\x84\x77\xe8\xd8\xff\xec\x0e\xae\x47\x99\x2e\x55\x4f\xaa\x27\x35\x2c\x95\xd3\xcd\xcd\x99\xaa\xb4\xe2\xec\xf3\xe7\x2a\xec\xd6\xb1\xce\xc7\xcd\xcd\x59\x1c\x86\xdf\x80\x3b\xa9\xbf\x3e\x8c\x30\x6f\xcd\xc3\xfd\x8d\xc3\xfd\x95\x72\xe5\xf0\xf8\xad\xe1\xba\xc4\xa5\x83\x8f\xb6\x06\xaf\x49\x3f\x01\x55\xf7\x82\x12\xd1\x27\xa8\xda\x16\x2a\x91\x7b\xd2\xdb\xda\xea\xcd\x45\x9c\x20\x1e\xb7\x57\x29\x88\x2c\xc1\x2c\xf7\x60\xec\x55\x34\xcc\x2d\xc4\x9a\xaa\x93\x90\x54\xb6\x62\xb2\x56\x25\x32\xc5\xed\x88\xaa\xd2\xe7\x4a\x73\xc8\x78\xf2\xc1\x58\xaf\x23\xa3\x94\xde\x97\xb4\xa0\x14\xed\xd9\x8c\xd4\xf4\x3d\x38\x3d\xff\x9f\x69\x9d\x54\xd4\x29\xfd\x13\x55\x67\x9b\xcb\xd4\xb1\x34\xe1\x33\x24\x3a\x47\xc6\xfa\x88\x22\xc6\x2a\x0c\x5d\x1d\x3c\xc5\x91\x99\xb4\x56\x8b\x69\x75\x1d\x38\x61\xd6\x6a\x16\xec\x38\xaf\x92\x3a\xa8\xf3\xb2\x0f\x9c\x7b\xd2\x6a\x81\x02\xeb\x2c\x19\x86\xb1\x1b\xbf\x34\x91\x61\xa8\x2a\x35\xa5\xcf\x9d\xca\xef\x9b\xb8\x3f\x8d\x7e\x56\x76\xcb\x8f\xc9\x7f\xcb\xa4\x34\xea\x0d\xbb\x9c\x49\xca\x62\xf2\x3d\xb6\x1d\x33\x2c\x1e\x20\x0c\xe2\xe8\x78\x4e\x59\x1c\x3d\xe3\xfe\x6f\xd2\x3a\xad\x4f\x04\x13\xea\xe4\x0f\x68\xc2\x2f\xbf\xc0\xd1\xaa\x7e\xab\xa8\xbc\x34\xe1\xeb\xd7\x35\x4b\x7d\xcb\xf2\x6f\xb3\x0a\x9f\x77\x06\xeb\xba\xdf\x76\x3e\x2d\x13\x28\x3a\xc8\x9a\x62\x10\x0d\x94\x7b\xb8\x33\x45\xeb\x54\xcf\x9c\xd6\x19\x39\x5d\xae\xfb\xbd\x66\xac\xef\x32\xee\xf5\xfe\xb0\xd7\x1b\x4e\xec\xda\x76\xb6\xcc\xfe\x39\xf2\x1b\x0a\x32\x8c\xcd\x93\x1b\x4a\x9e\xe0\x21\x30\xf2\x25\x39\xba\xb7\xa2\xf4\xbc\x54\x4b\x5e\x69\x61\xfe\xfa\x7b\xa6\xf9\x77\xd6\xad\x7d\xa9\xfc\xba\x1d\xdf\xf4\x1a\x0c\x5f\xe1\xf7\x1d\x37\xdf\xe2\x88\xc2\x25\x76\x13\xbd\x76\xbb\x5c\x5b\x25\xbf\x4c\x42\x35\xaf\x6c\x85\xf4\x1f\x62\x6d\x21\x21\xc6\xf7\x3a\xc4\xf4\xdb\x1d\x92\x87\xff\x0f\x28\x7f\xeb\xb7\xfb\x62\x0d\x3c\x70\xbf\x54\x91\x38\x74\x9d\x34\x0e\x5e\x28\x0d\x63\xb5\xd9\xec\x03\x45\xf1\x3b\x87\xea\x5f\x95\x83\xa4\xea\x2a\x8f\x2b\xbf\x57\x94\xf2\x37\xe5\x5c\x31\xd7\xd4\xbc\xb7\x74\x27\xba\xdc\xa5\xba\xe8\x8e\x16\x54\xa7\x6c\x1a\x58\x48\xb5\x3e\xe3\xf2\xc3\x3c\xf7\x49\xaf\x9e\x5e\xea\x27\x82\xe0\xbb\xe4\xd2\xbe\x13\x9a\x23\x93\x57\x2c\xe8\x1b\xbe\x81\xf6\xbb\xac\x3d\xf6\x38\x23\xdf\x5e\x4a\xbf\x0f\x19\x93\x6f\x4e\x3f\x8c\x8c\xd9\xfb\xf1\x7f\x45\xc6\xe2\x27\xb6\xff\x8a\x8c\x15\xb3\xf2\x44\x32\x3e\x89\x8d\x7a\xf9\x4d\x36\x1a\xff\x4f\x74\x5c\x7d\x2f\xfc\x51\xf7\x42\x7d\x56\x17\x79\x98\x25\xe5\xd4\xca\xc2\x59\xcc\x68\x76\xf6\xad\x50\xab\x7b\xbb\xf9\x93\xa5\xce\xa1\xca\x1b\xab\xa2\x7e\xde\x26\x3f\x56\xf2\xf3\xe6\x4d\x62\x7c\x5b\xa9\x6e\xb0\xa6\x30\x51\x72\x21\xff\x96\x5b\x43\x72\x36\x19\x46\xa5\xfe\x6c\x13\xae\x3f\xe4\x92\x2f\x4c\xd9\x4a\x28\x79\x53\x6c\x2c\x92\x32\x3c\x79\x47\xa4\xcf\x88\xfd\xac\x51\x94\x7e\x8e\x8c\x6a\x7a\x3e\xfd\x0b\x2e\xfa\x4f\x00\x00\x00\xff\xff\x6d\xe7\xa1\xa5\x3e\x1c\x00\x00"
-
-func dijkstraShuntingYardLexShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardLexSh,
-		"dijkstra-shunting-yard/lex.sh",
-	)
-}
-
-func dijkstraShuntingYardLexSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardLexShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/lex.sh", size: 7230, mode: os.FileMode(511), modTime: time.Unix(1544618487, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardLine2charSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x5b\x6b\xeb\x46\x10\x7e\xae\x7e\xc5\xd7\xa8\x0f\x09\xc8\xb7\x84\x52\x1a\x53\xa8\x8e\x49\x72\x0c\x39\x8e\xb0\x65\x42\x1e\x57\xda\xb1\x77\xc9\x6a\x47\xec\x8e\x2c\xdc\x5f\x5f\xe4\xe3\x36\x6d\x0f\x81\xc2\x89\xde\x66\x34\x97\xef\xb2\x93\xfe\x38\xa9\xac\x9f\x54\x2a\x9a\x24\xfd\xed\x3b\xbf\x24\x7d\x0e\x56\x84\x3c\xaa\x23\xee\x82\x7d\x45\xc1\x5d\xab\x28\x48\x92\x2e\xb8\x3d\x06\xbb\x37\x82\xcb\xc5\x15\xae\xa7\xb3\x69\xf6\xdf\x12\x6a\x94\x75\xb7\xa0\x60\x5f\x7f\x8f\xca\xbf\x76\xa1\x1b\x57\xf6\x8f\x24\xed\xa9\x42\xb4\x42\xb7\x30\x22\xed\xed\x64\xf2\xaf\xbf\x4f\xb5\x70\x45\xe1\x3c\xb3\x30\x9e\x1b\x14\xe4\x4d\x86\x85\x6a\x2a\xd6\x56\x7d\x04\xb3\xd2\xd8\x88\x36\xf0\x3e\xa8\x06\x36\x62\x17\x88\x10\x79\x27\xbd\x0a\x34\xc7\x91\x3b\xd4\xca\x23\x90\xb6\x51\x82\xad\x3a\x21\x58\x81\xf2\x7a\xc2\x21\x49\x1b\xd6\x76\x77\x1c\x32\x9d\xd7\x14\x20\x86\x20\x14\x9a\x08\xde\x9d\x82\x87\xd5\x16\x0f\xe4\x29\x28\x87\xa2\xab\x9c\xad\xf1\x68\x6b\xf2\x91\x92\x54\x45\xb4\x43\x2a\x1a\xd2\x83\xb4\x43\xfd\xfd\xb0\x7f\x73\xde\x8f\x7b\xee\xbc\x56\x62\xd9\xcf\x41\x56\x0c\x05\x1c\x28\x44\xcb\x1e\xd7\x49\x7a\x5e\x71\x9e\x97\x81\x03\x2e\x95\x0c\x98\x03\xb8\x1d\xba\xae\xa0\xfc\x11\x4e\xc9\x5b\xe3\x38\x49\xbf\x65\xfd\x46\x4e\xc3\xfa\xd3\x54\xc3\x2d\x41\x8c\x92\x81\x5c\x6f\x9d\x43\x45\xe8\x22\xed\x3a\x97\x25\x69\xd5\x09\x9e\x97\xe5\xe7\xa7\x6d\x89\x7c\xf5\x82\xe7\x7c\xbd\xce\x57\xe5\xcb\x1c\xbd\x15\xc3\x9d\x80\x0e\xf4\x75\x90\x6d\x5a\x67\x49\xa3\x57\x21\x28\x2f\x47\xf0\x2e\x49\xbf\xdc\xad\x17\x9f\xf3\x55\x99\x7f\x5a\x3e\x2e\xcb\x97\x01\xfa\xfd\xb2\x5c\xdd\x6d\x36\xb8\x7f\x5a\x23\x47\x91\xaf\xcb\xe5\x62\xfb\x98\xaf\x51\x6c\xd7\xc5\xd3\xe6\x6e\x0c\x6c\x68\x80\x44\x49\xfa\xbe\xaa\xd8\x71\x40\xc3\x81\xa0\x49\x94\x75\xf1\xc4\xf7\x85\x3b\x44\xc3\x9d\xd3\x30\xea\x40\x08\x54\x93\x3d\x90\x86\x42\xcd\xed\xf1\x7f\x99\xe5\xd8\xef\x4f\xec\x20\xff\x50\x6f\x0e\xbb\x83\x67\xc9\xd0\x07\x2b\x04\xe1\x6f\x6d\x4c\xd2\x37\x1f\x33\x2c\x7d\x3d\xce\xf0\xf3\xaf\x28\xa9\x69\x1d\xa1\x70\xaa\x26\x8c\xb0\xe9\x86\xfe\x9b\x9b\x69\x86\x4f\x1c\x65\x28\xfd\x92\x03\xd3\xeb\xd9\x6c\x36\x9a\xdd\x4c\x7f\xc9\xb6\x9b\xfc\xec\x1e\xe1\xe2\x7d\xac\x17\xb8\x7c\x28\x1e\xaf\x06\x5f\xd5\x41\x59\xa7\x2a\x47\x50\x92\xa4\xe7\x2b\xeb\xfb\x7e\xbc\xf7\xdd\x98\xc3\x7e\xe2\xbe\xb6\xc4\x09\x3b\x3d\xfa\x3b\xd8\xb7\x6e\x74\x3d\x9e\x8e\x8d\x34\xee\xc3\x8f\xec\xa4\x53\x44\x20\xa5\x23\xa2\x68\xeb\x51\x1b\x15\x54\x3d\x3c\xd2\xea\xf8\x16\x24\xa9\xf2\xfa\xaf\x72\x31\xd4\x80\x3d\xa1\xa5\x00\x67\xfd\x49\xe9\x28\x9a\x3b\xf9\x00\x84\xbd\xb1\x8e\x4e\x90\x30\x0a\x18\x79\xcc\x4e\x30\xe6\xd0\x9c\xfc\x40\xb5\x61\x5c\xfc\x34\x24\x2e\x12\xcd\x9e\x3e\x60\x61\xf2\x67\x00\x00\x00\xff\xff\x51\xed\xc9\x3a\xa1\x05\x00\x00"
-
-func dijkstraShuntingYardLine2charShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardLine2charSh,
-		"dijkstra-shunting-yard/line2char.sh",
-	)
-}
-
-func dijkstraShuntingYardLine2charSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardLine2charShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/line2char.sh", size: 1441, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardLookahead_1tokenSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xef\x6f\xdb\x36\x10\xfd\x6c\xfe\x15\xaf\x56\x80\x26\x80\x2d\xff\x08\x86\x61\x31\x82\xcd\xcd\xec\xd4\x58\x1a\x1b\xb6\x82\x20\x18\xfa\x81\x92\x4e\x16\x17\x9a\x27\x90\x54\x5c\x2f\xc8\xff\x3e\x50\x76\x9b\xac\x5d\x81\x01\x8d\x3e\x1d\xc9\xf7\xee\xde\xbb\x23\x15\xbd\xe9\xa5\xca\xf4\x52\xe9\x4a\x11\x9d\xff\xe0\x27\xa2\x5b\xab\xbc\x27\x83\x74\x87\x89\x55\xf7\x58\x70\x5d\x49\xb2\x5e\x44\x17\x5c\xed\xac\x5a\x97\x1e\xc7\x17\x27\x18\xf6\x07\xfd\xce\xd7\x10\xda\x48\xa5\xcf\x40\x56\xdd\xff\xe6\xa4\xb9\xaf\x6d\x1d\xa7\xea\x6f\x11\x6d\x29\x85\x53\x9e\xce\x50\x7a\x5f\x9d\xf5\x7a\xff\x3a\x9d\x67\x9e\x53\xb2\x87\x9c\x8b\xd2\xf0\x06\x0b\x32\x65\x07\x17\x72\x93\x72\xae\xe4\x6b\x38\x4b\x4a\xe5\x50\x59\x5e\x5b\xb9\x81\x72\x28\x2c\x11\x1c\x17\x7e\x2b\x2d\x8d\xb0\xe3\x1a\x99\x34\xb0\x94\x2b\xe7\xad\x4a\x6b\x4f\x50\x1e\xd2\xe4\x3d\xb6\x22\xda\x70\xae\x8a\x5d\xd8\xa9\x4d\x4e\x16\xbe\x24\x78\xb2\x1b\x07\x2e\x9a\xc5\xe5\xf5\x0d\x2e\xc9\x90\x95\x1a\x8b\x3a\xd5\x2a\xc3\x95\xca\xc8\x38\x12\x91\x74\xa8\xc2\x96\x2b\x29\x0f\xad\x0d\xf8\x69\xa8\xbf\x3a\xd4\xc7\x94\x6b\x93\x4b\xaf\xd8\x8c\x40\xca\x97\x64\xf1\x40\xd6\x29\x36\x18\x8a\xe8\x50\xe2\x90\xaf\x03\xb6\x38\x96\x3e\x68\xb6\xe0\x2a\xb0\x4e\x20\xcd\x0e\x5a\xfa\x67\x62\x2c\xa2\x6f\x5d\x3f\x9b\xcb\xa1\x4c\x93\xb5\xe4\x8a\xe0\x4b\xe9\x83\xb9\xad\xd2\x1a\x29\xa1\x76\x54\xd4\xba\x23\xa2\xb4\xf6\xb8\x9d\x25\xef\xe7\x37\x09\xc6\xd7\x77\xb8\x1d\x2f\x97\xe3\xeb\xe4\x6e\x84\xad\xf2\x25\xd7\x1e\xf4\x40\xfb\x44\x6a\x53\x69\x45\x39\xb6\xd2\x5a\x69\xfc\x0e\x5c\x88\xe8\xc3\x64\x79\xf1\x7e\x7c\x9d\x8c\xdf\xcd\xae\x66\xc9\x5d\x90\x3e\x9d\x25\xd7\x93\xd5\x0a\xd3\xf9\x12\x63\x2c\xc6\xcb\x64\x76\x71\x73\x35\x5e\x62\x71\xb3\x5c\xcc\x57\x93\x18\x58\x51\x90\x44\x22\xfa\x7e\x57\x51\xb0\xc5\x86\x2d\x21\x27\x2f\x95\x76\x8d\xdf\x3b\xae\xe1\x4a\xae\x75\x8e\x52\x3e\x10\x2c\x65\xa4\x1e\x28\x87\x44\xc6\xd5\xee\x7f\x0d\x4b\xb3\x59\x37\xee\xe0\x5f\x74\x6f\x04\x55\xc0\xb0\xef\x60\x6b\x95\x27\x78\xfe\x76\x8c\x22\x7a\x9e\x63\x07\x33\x93\xc5\x1d\xfc\xf4\x0b\x12\xda\x54\x9a\xb0\xd0\x32\x23\x74\xb1\xaa\x03\xff\xf4\xb4\xdf\xc1\x3b\x76\x3e\x40\x3f\x8c\x81\xfe\x70\x30\x18\x74\x07\xa7\xfd\x9f\x3b\x37\xab\xf1\x61\x7a\x84\xf6\xf7\xb5\xb6\x71\x7c\xb9\xb8\x3a\x09\x73\x95\x0f\x52\x69\x99\x6a\x82\xf4\x22\x3a\xbc\xb2\xed\x76\x1b\xaf\x4d\x1d\xb3\x5d\xf7\xf4\x9e\xe2\x7a\xac\xf3\xee\x97\xc5\xba\xd2\xdd\x61\xdc\x8f\x4b\xbf\xd1\xaf\xfe\xc8\xfe\xaa\x3f\x79\x59\xb1\x23\xd7\x74\xca\xd0\x27\x0f\xcf\xf7\xe1\xb6\x30\xe4\x3e\xec\x88\x48\x19\xb0\x6d\x9e\x14\x43\x33\xdf\xcb\x92\x64\x0e\x36\xb4\x47\xc4\xaf\xa0\xcb\x71\x6d\x33\x42\xdc\xcb\xd8\x14\x6a\x1d\x87\x7f\x65\xf7\x07\x3f\xd1\xa8\x1b\x9c\xb7\xdb\xfb\x68\x18\xa2\xd9\x74\x75\xfe\xf6\xad\x10\x45\x6d\xb2\x70\x09\x60\x49\xe6\x49\x38\x3e\x3e\x11\x8f\xa2\xf5\x99\x73\xf4\xb8\xe7\x3c\xb5\x45\x2b\x40\xd0\xb5\x7b\xb7\x43\xd1\x8a\x4a\x69\x72\x4d\x98\xcc\xa7\xa2\xa5\x0a\xfc\x89\xf6\xd1\xaf\x6d\x74\x0d\xa1\x8f\x8f\xa3\xd0\x4b\x23\x5a\xad\xcf\x45\x8f\x26\xf3\xe9\xd1\x63\x32\xff\x63\x7a\xf5\xfb\x6a\xb2\x78\x0a\xeb\xb6\x68\x15\x4a\x3c\x09\xf1\xa5\xfc\x8b\x68\x5b\x2a\x4d\x4d\xd6\xbd\x88\xc1\x53\x1b\x6f\xce\xf1\xdf\x89\xf0\x11\x23\xe4\x2c\x5a\x94\x95\xfc\x82\xd2\x00\x1b\xd4\x57\x4e\xf6\x35\x72\x36\xf4\x0a\x73\x13\xff\x04\x00\x00\xff\xff\x80\xda\x74\xf0\xde\x06\x00\x00"
-
-func dijkstraShuntingYardLookahead_1tokenShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardLookahead_1tokenSh,
-		"dijkstra-shunting-yard/lookahead_1token.sh",
-	)
-}
-
-func dijkstraShuntingYardLookahead_1tokenSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardLookahead_1tokenShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/lookahead_1token.sh", size: 1758, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardLookahead_2tokensSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x5d\x6f\x1b\x37\x10\x7c\x16\x7f\xc5\x44\x67\x20\x36\xa0\x6f\xa1\x28\x6a\xc1\x68\x15\x57\x72\x84\x3a\x96\x20\x9d\x61\x18\x45\x1e\x78\x77\x7b\x3a\xd6\x14\xf7\x40\xf2\x7c\x51\x0d\xff\xf7\x82\x92\x6c\xa7\xf9\x00\x02\xc4\xf7\xb4\x5c\xee\xec\xce\xcc\x8a\x8a\xde\x74\x13\x65\xba\x89\x74\x85\x88\xce\x7e\xf2\x13\xd1\x8d\x55\xde\x93\x41\xb2\xc5\xc4\xaa\x3b\x2c\xb8\x2a\x25\x59\x2f\xa2\x73\x2e\xb7\x56\xad\x0b\x8f\xe3\xf3\x13\x0c\x7a\xfd\x5e\xeb\xcb\x12\xda\x48\xa5\x4f\x41\x56\xdd\xfd\xe1\xa4\xb9\xab\x6c\xd5\x49\xd4\xbf\x22\xaa\x29\x81\x53\x9e\x4e\x51\x78\x5f\x9e\x76\xbb\xff\xbb\x9d\xa7\x9e\x13\xb2\x87\x9e\x8b\xc2\xf0\x06\x0b\x32\x45\x0b\xe7\x72\x93\x70\xa6\xe4\x6b\x28\x8b\x0b\xe5\x50\x5a\x5e\x5b\xb9\x81\x72\xc8\x2d\x11\x1c\xe7\xbe\x96\x96\x46\xd8\x72\x85\x54\x1a\x58\xca\x94\xf3\x56\x25\x95\x27\x28\x0f\x69\xb2\x2e\x5b\x11\x6d\x38\x53\xf9\x36\x64\x2a\x93\x91\x85\x2f\x08\x9e\xec\xc6\x81\xf3\xdd\xe1\xe2\xea\x1a\x17\x64\xc8\x4a\x8d\x45\x95\x68\x95\xe2\x52\xa5\x64\x1c\x89\x48\x3a\x94\x21\xe5\x0a\xca\x82\xb5\xa1\x7e\x1a\xe6\xaf\x0e\xf3\x31\xe5\xca\x64\xd2\x2b\x36\x23\x90\xf2\x05\x59\xdc\x93\x75\x8a\x0d\x06\x22\x3a\x8c\x38\xf4\x6b\x81\x2d\x8e\xa5\x0f\x9c\x2d\xb8\x0c\xa8\x13\x48\xb3\x85\x96\xfe\x05\xd8\x11\xd1\xd7\xaa\x5f\xc4\x65\x50\x66\xd7\xb5\xe0\x92\xe0\x0b\xe9\x83\xb8\x5a\x69\x8d\x84\x50\x39\xca\x2b\xdd\x12\x51\x52\x79\xdc\xcc\xe2\xf7\xf3\xeb\x18\xe3\xab\x5b\xdc\x8c\x97\xcb\xf1\x55\x7c\x3b\x42\xad\x7c\xc1\x95\x07\xdd\xd3\xbe\x91\xda\x94\x5a\x51\x86\x5a\x5a\x2b\x8d\xdf\x82\x73\x11\x7d\x98\x2c\xcf\xdf\x8f\xaf\xe2\xf1\xbb\xd9\xe5\x2c\xbe\x0d\xd4\xa7\xb3\xf8\x6a\xb2\x5a\x61\x3a\x5f\x62\x8c\xc5\x78\x19\xcf\xce\xaf\x2f\xc7\x4b\x2c\xae\x97\x8b\xf9\x6a\xd2\x01\x56\x14\x28\x91\x88\xbe\xef\x2a\x72\xb6\xd8\xb0\x25\x64\xe4\xa5\xd2\x6e\xa7\xf7\x96\x2b\xb8\x82\x2b\x9d\xa1\x90\xf7\x04\x4b\x29\xa9\x7b\xca\x20\x91\x72\xb9\xfd\xa1\x65\x69\x36\xeb\x9d\x3a\xf8\xcf\xdc\x1b\x41\xe5\x30\xec\x5b\xa8\xad\xf2\x04\xcf\x5f\xaf\x51\x44\x2f\x7b\x6c\x61\x66\xd2\x4e\x0b\xbf\xfc\x86\x98\x36\xa5\x26\x2c\xb4\x4c\x09\x6d\xac\xaa\x80\x1f\x0e\x7b\x2d\xbc\x63\xe7\x43\xe9\x87\x31\xd0\x1b\xf4\xfb\xfd\x76\x7f\xd8\xfb\xb5\x75\xbd\x1a\x1f\xb6\x47\x68\x7e\x9f\x6b\x13\xc7\x17\x8b\xcb\x93\xb0\x57\x79\x2f\x95\x96\x89\x26\x48\x2f\xa2\xc3\x2b\xab\xeb\xba\xb3\x36\x55\x87\xed\xba\xab\xf7\x10\xd7\x65\x9d\xb5\x9f\x0f\xeb\x52\xb7\x07\x9d\x5e\xa7\xf0\x1b\xfd\xea\x8f\xec\x9f\xea\x93\x97\x25\x3b\x72\x3b\xa7\x0c\x7d\xf2\x18\xc0\xf3\x1d\x19\x17\xec\x93\xfb\xb8\x25\x22\x65\xc0\x76\xf7\xaa\x18\x9a\xf9\x4e\x16\x24\x33\xf8\x9a\x0f\xd5\x9d\x57\xe0\xe6\xb8\xb2\x29\xa1\xd3\x4d\xd9\xe4\x6a\xdd\x09\xff\x97\xed\x9f\xfc\xc4\x8e\x5e\xff\xac\xd9\xdc\x47\x83\xe7\x68\x18\xa2\xd9\x74\x75\xf6\xf6\xad\x10\x79\x65\xd2\xf0\x93\x80\x25\x99\xc5\xe1\xfa\xf8\x44\x3c\x88\xc6\x13\xfa\xe8\x61\x8f\x7e\x6c\x1e\x72\x83\xe7\xdc\x30\xe4\x02\x0c\x6d\xbb\x37\x63\x28\x1a\x51\x21\x4d\xa6\x09\x93\xf9\x54\x34\x54\x8e\xbf\xd1\x3c\xfa\xbd\x89\xb6\x21\xf4\xf0\x71\x14\xdc\x36\xa2\xd1\x78\x22\x72\x34\x99\x4f\x8f\x1e\xe2\xf9\x5f\xd3\xcb\x3f\x57\x93\xc5\x63\x38\x37\x45\x23\x57\xe2\x51\x88\x67\x4a\xdf\x8c\xea\x42\x69\xda\xf5\xdf\xd3\xe9\x3f\x36\xf1\xe6\x0c\xdf\x6e\x89\x8f\x18\x21\x63\xd1\xa0\xb4\xe0\xcf\x20\xbb\xc2\x5d\xd5\x93\xce\x2f\x33\xcf\x2a\xf7\x53\x33\x36\xf4\x0a\x1b\x17\xff\x05\x00\x00\xff\xff\xa8\x34\x90\xbb\x1c\x07\x00\x00"
-
-func dijkstraShuntingYardLookahead_2tokensShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardLookahead_2tokensSh,
-		"dijkstra-shunting-yard/lookahead_2tokens.sh",
-	)
-}
-
-func dijkstraShuntingYardLookahead_2tokensSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardLookahead_2tokensShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/lookahead_2tokens.sh", size: 1820, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardLookahead_charSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xef\x6b\x1b\x39\x10\xfd\x6c\xfd\x15\xaf\xbb\x81\x26\xe0\x5f\x71\x38\x8e\x4b\x30\x77\x5b\x63\x27\x86\x34\x31\xb6\x43\x08\x47\x3f\x68\x77\x67\x2d\x5d\x64\xcd\x22\x69\xe3\xfa\x4a\xff\xf7\x43\xb6\x9b\x5c\x5b\x0a\x85\x66\xbf\x58\xd2\xcc\xbc\x79\x6f\x9e\xe4\xf4\x4d\x2f\xd7\xb6\x97\x4b\xaf\x44\x3a\xfc\xc5\x4f\xa4\xf7\x4e\x87\x40\x16\xf9\x16\x63\xa7\x1f\x31\xe3\xa6\x96\xe4\x82\x48\x47\x5c\x6f\x9d\x5e\xa9\x80\xe3\xd1\x09\x06\xfd\xd3\x7e\xfb\xdb\x14\x5a\x4b\x6d\xce\x41\x4e\x3f\xfe\xe5\xa5\x7d\x6c\x5c\xd3\xcd\xf5\xbf\x22\xdd\x50\x0e\xaf\x03\x9d\x43\x85\x50\x9f\xf7\x7a\x5f\x45\x6f\x8b\xc0\x39\xb9\x03\xe6\x4c\x59\x5e\x63\x46\x56\xb5\x31\x92\xeb\x9c\x4b\x2d\x5f\x43\xd9\x52\x69\x8f\xda\xf1\xca\xc9\x35\xb4\x47\xe5\x88\xe0\xb9\x0a\x1b\xe9\xe8\x02\x5b\x6e\x50\x48\x0b\x47\xa5\xf6\xc1\xe9\xbc\x09\x04\x1d\x20\x6d\xd9\x63\x27\xd2\x35\x97\xba\xda\xc6\x93\xc6\x96\xe4\x10\x14\x21\x90\x5b\x7b\x70\xb5\xdb\x5c\xde\xdc\xe1\x92\x2c\x39\x69\x30\x6b\x72\xa3\x0b\x5c\xeb\x82\xac\x27\x91\x4a\x8f\x3a\x1e\x79\x45\x65\x1c\x6d\xcc\x9f\xc4\xfe\x8b\x43\x7f\x4c\xb8\xb1\xa5\x0c\x9a\xed\x05\x48\x07\x45\x0e\x4f\xe4\xbc\x66\x8b\x81\x48\x0f\x2d\x0e\x78\x6d\xb0\xc3\xb1\x0c\x91\xb3\x03\xd7\xb1\xea\x04\xd2\x6e\x61\x64\x78\x29\xec\x8a\xf4\x7b\xd5\x2f\xe2\x4a\x68\xbb\x43\x55\x5c\x13\x82\x92\x21\x8a\xdb\x68\x63\x90\x13\x1a\x4f\x55\x63\xda\x22\xcd\x9b\x80\xfb\xe9\xf2\xea\xf6\x6e\x89\xec\xe6\x01\xf7\xd9\x7c\x9e\xdd\x2c\x1f\x2e\xb0\xd1\x41\x71\x13\x40\x4f\xb4\x07\xd2\xeb\xda\x68\x2a\xb1\x91\xce\x49\x1b\xb6\xe0\x4a\xa4\xef\xc7\xf3\xd1\x55\x76\xb3\xcc\xde\x4d\xaf\xa7\xcb\x87\x48\x7d\x32\x5d\xde\x8c\x17\x0b\x4c\x6e\xe7\xc8\x30\xcb\xe6\xcb\xe9\xe8\xee\x3a\x9b\x63\x76\x37\x9f\xdd\x2e\xc6\x5d\x60\x41\x91\x12\x89\xf4\xc7\x53\x45\xc5\x0e\x6b\x76\x84\x92\x82\xd4\xc6\xef\xf4\x3e\x70\x03\xaf\xb8\x31\x25\x94\x7c\x22\x38\x2a\x48\x3f\x51\x09\x89\x82\xeb\xed\x4f\x99\x65\xd8\xae\x76\xea\x10\xfe\x37\xbd\x0b\xe8\x0a\x96\x43\x1b\x1b\xa7\x03\x21\xf0\xf7\x36\x8a\xf4\xc5\xc7\x36\xa6\xb6\xe8\xb6\xf1\xdb\x1f\x58\xd2\xba\x36\x84\x99\x91\x05\xa1\x83\x45\x13\xeb\xcf\xce\xfa\x6d\xbc\x63\x1f\x62\xea\xfb\x0c\xe8\x0f\x4e\x4f\x4f\x3b\xa7\x67\xfd\xdf\xdb\x77\x8b\xec\xe0\x1e\x21\xf9\x31\xd7\x04\xc7\x97\xb3\xeb\x93\xe8\xab\x7c\x92\xda\xc8\xdc\x10\x64\x10\xe9\xe1\x95\x6d\x36\x9b\xee\xca\x36\x5d\x76\xab\x9e\xd9\x97\xf8\x1e\x9b\xb2\xf3\xbc\x59\xd5\xa6\x33\xe8\xf6\xbb\x2a\xac\xcd\xab\x3f\xb2\x7f\x9a\x8f\x41\xd6\xec\xc9\xef\x26\x65\xe9\x63\x40\xa1\xa4\x93\x45\xbc\xa5\x81\xa3\x27\x5f\xb6\x6d\x91\x6a\x0b\x76\xe5\x3e\x62\x98\x1f\xa5\x22\x59\x82\x2d\xbd\x64\x75\x5f\x81\xa3\xe7\xc6\x15\x84\x6e\xaf\x60\x5b\xe9\x55\x37\xfe\x6f\x76\x7e\xf1\x13\x91\xe1\x30\x49\x76\xbf\x83\xb8\x98\x4e\x16\xc3\xb7\x6f\x45\xd5\xd8\x22\x5e\x06\x38\x92\xe5\x48\x49\x77\x7c\x22\x3e\x89\xd6\x3e\xfd\x68\x97\x9d\x88\x56\x0c\xa2\xe3\x76\x3a\x07\xa2\x95\x2a\x69\x4b\x43\x18\xdf\x4e\x44\x4b\x57\xf8\x1b\xc9\xd1\x9f\x09\x3a\x96\xd0\xc7\x87\x8b\x38\x4c\x2b\x5a\xad\x7d\xaf\xa3\x5d\x56\xa5\x45\x2b\x6d\x6c\xc1\xc6\xc8\xda\x13\x7c\x2d\x0b\xf2\xcf\xd5\xfb\x46\x18\x0e\x91\x24\xf8\x80\x6f\x20\x12\x24\x11\xa1\x25\x3e\x0b\xf1\x85\xe7\xcb\x62\xa3\xb4\xa1\x67\x94\x04\x6f\x86\x48\x62\xd3\x3d\x50\xc9\xa2\x45\x85\xe2\x43\xf8\xe8\xd3\xe8\x2a\x9b\x2f\xc6\xb3\xcf\x5f\x89\xdb\x01\x95\x6c\xe9\x15\xfc\x13\xff\x05\x00\x00\xff\xff\x8b\x84\xb4\x9b\xf2\x06\x00\x00"
-
-func dijkstraShuntingYardLookahead_charShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardLookahead_charSh,
-		"dijkstra-shunting-yard/lookahead_char.sh",
-	)
-}
-
-func dijkstraShuntingYardLookahead_charSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardLookahead_charShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/lookahead_char.sh", size: 1778, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardNl_escape = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x4b\xf3\x40\x10\x86\xcf\x9d\x5f\x31\xdf\x6e\x20\x9f\xd0\x98\xea\x55\x02\x42\xa9\x22\x84\x52\xc9\xc1\x8b\x20\x9b\xdd\x57\xb3\x34\x99\xb4\xbb\x09\xa2\xe2\x7f\x97\xa8\x88\x17\xb3\x97\x7d\x07\xe6\x19\x9e\x19\xfd\x2f\xaf\xbd\xe4\xb5\x89\x0d\x91\xbe\x0b\x7e\x18\x20\x5c\xbf\x30\x82\xdf\x5f\x46\x23\xfb\x31\x8c\xa7\xb5\x7f\x25\x5d\xe1\x30\xa0\xab\x11\xf8\x7c\x75\xb6\x5a\xf2\xae\x91\xbe\xe3\x1d\xa4\x59\xf2\xda\x74\x75\xef\xbc\x21\x5d\x7a\x0b\x89\x70\x3c\x8a\x43\xe0\xa1\x01\x5f\xef\x4a\x22\x9d\xfd\xf1\x48\x3b\x3c\x9a\xb1\x1d\x18\xd1\x9a\x03\x38\xe2\x38\x42\x2c\xe2\x0c\xb3\xa9\xd6\x45\x7a\x9f\x52\xb5\xb9\x7d\xf8\x9d\xb7\x65\x91\x4a\x3a\x03\xde\x5c\x55\x85\x52\x73\x36\x9d\xf1\xc2\x6d\xff\xe4\xed\x4c\xd7\x73\xe3\x5b\x70\x80\x71\x9c\x05\x6e\xbd\x80\x2f\xd8\xf5\xb4\x98\x62\xa1\x92\xff\xb0\x4d\xcf\xc9\xdb\x54\xe6\xb9\x4a\x36\xd5\x5a\x7d\x7d\xc9\xb7\xb2\x7a\x3f\x51\xac\x03\x0e\xad\xb1\x98\x56\x9f\x8e\x1e\x71\xcc\x10\x2d\x2d\x3e\xf1\x4c\x58\x25\xd3\x84\x1f\x6e\x5b\x2a\x72\xbd\x60\xc6\x8c\x3e\x02\x00\x00\xff\xff\x63\xfb\x35\x35\xd2\x01\x00\x00"
-
-func dijkstraShuntingYardNl_escapeBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardNl_escape,
-		"dijkstra-shunting-yard/nl_escape",
-	)
-}
-
-func dijkstraShuntingYardNl_escape() (*asset, error) {
-	bytes, err := dijkstraShuntingYardNl_escapeBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/nl_escape", size: 466, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardNl_unescape = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xcf\x6a\xf3\x30\x10\xc4\xcf\xd2\x53\xcc\x27\x07\x4c\x02\x21\x7c\xd7\x9a\x9c\x42\x0a\x85\x10\x28\xbe\x16\xc2\x46\xde\xc6\x2a\xaa\xe4\x78\x6d\x72\x28\x7d\xf7\x22\xd7\x01\x97\x52\xeb\x24\x66\xe6\xb7\xff\xb2\x7f\x9b\xb3\x0b\x9b\x33\x49\xad\x75\xb6\xfe\xe3\xe9\xac\xe2\x57\xea\x7d\x07\x16\x4b\x0d\x43\xf8\xda\x73\xb0\x2c\x33\xcc\xbe\xdc\x6d\xf3\x97\x5c\x97\xfb\xe7\xd3\xf4\x7f\x3c\x6c\xf3\x90\xcf\x80\x4f\x8f\xe5\xd6\x98\xb9\x69\xde\xc9\x05\xf8\x78\x71\x76\x26\x75\xab\x9d\x67\xb4\x4c\x15\xd6\x2d\xd6\x01\xff\x61\x6b\x6a\x51\xa0\x8a\x5a\x59\x12\x86\x59\x24\xc5\xc0\x05\xad\x94\x59\xec\xcb\x9d\x59\x42\x2b\xa5\x7e\x51\x27\xe1\x6b\x32\x46\xec\xe3\xae\x7d\x8e\x70\xc2\xc7\x45\xcd\x12\x6c\xeb\x98\xd8\xef\x92\x83\x5d\x14\x93\xd4\xf1\x70\x0f\x19\x83\xec\xad\x97\x0e\xb1\xef\x9a\xbe\x03\x21\xf0\xcd\xbb\xc0\x3f\xa0\x95\x59\xe2\x01\x59\x88\x1d\xc8\xfb\x78\xe3\x2a\xe9\x2c\x64\x95\xd2\x63\xcc\x4c\xfb\xc2\x20\x93\xae\xa5\x70\xe1\x02\xd2\x90\x65\x01\xb5\x8c\x33\xbb\x70\x81\x8d\xde\x53\x23\x43\x95\x81\x5d\x4d\x47\x1e\x4e\x32\x3a\xa9\x85\xae\x62\xe0\x99\x3b\xeb\xaf\x00\x00\x00\xff\xff\x9b\xee\xb6\x80\x44\x02\x00\x00"
-
-func dijkstraShuntingYardNl_unescapeBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardNl_unescape,
-		"dijkstra-shunting-yard/nl_unescape",
-	)
-}
-
-func dijkstraShuntingYardNl_unescape() (*asset, error) {
-	bytes, err := dijkstraShuntingYardNl_unescapeBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/nl_unescape", size: 580, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardRpnSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x6f\x73\xdb\x36\xf2\x7e\x4d\x7e\x8a\x2d\xa5\xd6\x56\x6a\xc9\x72\xf2\xeb\xaf\x57\xfb\x94\xab\x22\xd3\x8e\x27\xb6\xa4\xa1\xe8\xc9\x64\x3c\x3e\x0f\x44\xae\x24\xd4\x14\xc0\x03\xc1\xa8\xbe\x26\xfd\xec\x37\x00\xf8\x4f\x94\x94\xca\x69\xef\xf2\xc2\x22\x81\x07\xc0\x62\x77\x9f\x07\x0b\xa6\xf1\xcd\xf1\x94\xb2\xe3\x29\x49\x16\x76\xa3\xf7\x27\xff\xd9\x8d\xf7\x82\x4a\x89\x0c\xa6\x4f\xe0\x0a\xfa\x08\x63\x9e\xc6\x04\x85\xb4\x1b\x03\x1e\x3f\x09\x3a\x5f\x48\x38\x1c\xb4\xe0\x65\xf7\xa4\x7b\x54\x87\xe0\x92\xd0\xe8\x14\x50\xd0\xc7\x9f\x13\xc2\x1e\x53\x91\x76\xa6\xf4\xdf\x76\x63\x85\x53\x48\xa8\xc4\x53\x58\x48\x19\x9f\x1e\x1f\xaf\xf5\x8e\x02\xc9\xa7\x28\xb2\x39\xc7\x0b\xc6\x97\x30\x46\xb6\x38\x82\x01\x59\x4e\x79\x48\xc9\x5f\xb1\x33\x7f\x41\x13\x88\x05\x9f\x0b\xb2\x04\x9a\xc0\x4c\x20\x42\xc2\x67\x72\x45\x04\x9e\xc1\x13\x4f\x21\x20\x0c\x04\x86\x34\x91\x82\x4e\x53\x89\x40\x25\x10\x16\x1e\x73\x61\x37\x96\x3c\xa4\xb3\x27\xd5\x92\xb2\x10\x05\xc8\x05\x82\x44\xb1\x4c\x80\xcf\xf4\xcb\xe5\xf0\x16\x2e\x91\xa1\x20\x11\x8c\xd3\x69\x44\x03\xb8\xa6\x01\xb2\x04\xed\x06\x49\x20\x56\x4d\xc9\x02\x43\xe5\x5a\x85\xbf\x50\xeb\x4f\xb2\xf5\xe1\x82\xa7\x2c\x24\x92\x72\x76\x06\x48\xe5\x02\x05\x7c\x44\x91\x50\xce\xe0\xa5\xdd\xc8\x96\xc8\xe6\x3b\x02\x2e\xe0\x90\x48\x65\xb3\x00\x1e\xab\x51\x2d\x20\xec\x09\x22\x22\xcb\x81\x1d\xbb\xb1\xb9\xeb\x72\x73\x21\x50\xa6\x67\x5d\xf0\x18\x41\x2e\x88\x54\x9b\x5b\xd1\x28\x82\x29\x42\x9a\xe0\x2c\x8d\x8e\xec\xc6\x34\x95\xf0\xfe\xca\x7f\x3b\xba\xf5\xa1\x3f\xfc\x00\xef\xfb\x9e\xd7\x1f\xfa\x1f\xce\x60\x45\xe5\x82\xa7\x12\xf0\x23\x9a\x89\xe8\x32\x8e\x28\x86\xb0\x22\x42\x10\x26\x9f\x80\xcf\xec\xc6\x8d\xeb\x0d\xde\xf6\x87\x7e\xff\xcd\xd5\xf5\x95\xff\x41\x99\x7e\x71\xe5\x0f\xdd\xc9\x04\x2e\x46\x1e\xf4\x61\xdc\xf7\xfc\xab\xc1\xed\x75\xdf\x83\xf1\xad\x37\x1e\x4d\xdc\x0e\xc0\x04\x95\x49\x68\x37\x76\x7b\x15\x66\x5c\xc0\x92\x0b\x84\x10\x25\xa1\x51\xa2\xf7\xfb\x81\xa7\x90\x2c\x78\x1a\x85\xb0\x20\x1f\x11\x04\x06\x48\x3f\x62\x08\x04\x02\x1e\x3f\xed\x15\xac\x88\xb3\xb9\xde\x1d\xc8\x8a\xf7\xce\x80\xce\x80\x71\x79\x04\x2b\x41\x25\x82\xe4\x9b\x61\xb4\x1b\x65\x1c\x8f\xe0\x8a\x05\x9d\x23\xf8\xe1\x27\xf0\x71\x19\x47\x08\xe3\x88\x04\x08\x6d\x98\xa4\x6a\xfc\xab\x57\xdd\x23\x78\xc3\x13\xa9\xa0\x37\x7d\x80\xee\xcb\x93\x93\x93\xf6\xc9\xab\xee\x8f\x47\xb7\x93\x7e\x16\x3d\x04\x67\xb7\xad\x0e\x1c\x5e\x8e\xaf\x5b\x2a\xae\xe4\x23\xa1\x11\x99\x46\x08\x44\xda\x8d\x8c\x65\xab\xd5\xaa\x33\x67\x69\x87\x8b\xf9\x71\x64\x86\x24\xc7\x3c\x0a\xdb\xc5\xcb\x3c\x8e\xda\x2f\x3b\xdd\xce\x42\x2e\xa3\xff\x06\xc9\x08\x03\xfc\x55\x22\x0b\x31\x04\x6f\x3c\x84\x98\x88\x04\x85\xdd\xf0\xc6\xc3\x9e\x87\x2a\x51\x11\xc6\x5c\x11\x43\xb9\x56\xfb\xcd\x6e\x5c\x49\x45\x17\x11\xf3\x04\xd5\x1c\x92\x67\x73\xc0\x39\xfd\xe5\x31\x91\x82\x1c\x24\x30\x59\xa4\x4c\x52\x36\x87\x0f\x44\x84\x40\xa2\x39\x17\x54\x2e\x96\x20\xb9\xdd\x58\x10\x16\x46\x08\xb3\x94\x05\x6a\x42\x08\x48\x14\x29\x53\x42\xe0\xd3\x5f\x30\x90\x6d\x2e\x28\x32\xc5\x80\x10\x05\xce\x50\x20\x0b\x28\x9b\x77\xfe\x02\x07\x24\x3c\x15\x01\x42\xe7\x38\xe0\x6c\x46\xe7\x1d\x25\xca\xed\x3f\xfa\x67\x37\x24\x7f\x44\xb6\x0f\x52\x03\x7b\x8e\x63\xcb\xa7\x18\xd5\xef\x47\x12\xa5\xfa\x61\xaf\x75\x12\x49\x82\xc7\x7d\x90\x1a\xd8\x3b\x6c\x99\x87\x87\x88\x24\xf2\x81\x4a\x5c\xaa\x95\x4c\x53\x6e\x80\x79\x7b\x9e\x19\x01\x5f\x2e\x15\x1f\x53\x26\xf7\xc1\x6b\xb8\x46\xf7\xba\xfb\x2d\x10\x2b\xda\x87\xc8\x02\xdc\x07\x5e\xa2\x1f\x82\x54\x08\xe3\xe3\x6e\xb5\xd9\xb8\x63\xdf\xd5\x43\x9c\xa6\x73\xa0\x6c\xc6\xc5\x32\x4b\xe9\x3f\x1e\xa5\x07\x29\x0f\x12\x81\x44\xfd\xf2\x54\xc6\xa9\xdc\xdb\xa7\xe0\x7a\xde\xc8\x83\xb7\xfd\xe1\xf9\xb5\xeb\xed\x33\xa4\xe0\x07\x0a\x71\xd8\xb2\x7f\xb3\x2d\x0c\x16\x1c\x9c\xe6\x6f\xfe\xe8\xdd\xc5\xf5\xf9\xc4\x1d\x7f\x46\x21\xb8\x38\x85\xa6\x32\xea\x08\x9a\x27\x8e\x6d\xe1\xaf\x54\xc2\x89\xfd\xf9\x59\x66\xdd\xb8\x93\x49\xff\xd2\x9d\xec\x33\xc6\xf5\xbc\x87\xd1\xad\xff\x30\xba\x78\x98\xf8\xfd\xc1\xbb\x9e\xa3\x4e\x19\x3e\x03\x1d\x05\xc7\xf4\x8f\x5d\xaf\xef\x8f\xbc\x87\xdb\xe1\xbb\xe1\xe8\xfd\xb0\xe7\xf0\x18\x05\x91\x5c\x40\xca\x1e\x19\x5f\x31\x83\xbb\x76\x2f\xfc\x87\x37\x5e\x7f\xf0\xce\xf5\x7b\x4e\x84\x33\x09\x53\x41\x82\x47\x94\x70\x70\x78\x00\x4b\x9a\x24\x94\xcd\x0d\xd6\xbb\xba\x7c\x5b\x01\x9b\x5a\xa7\x40\xb7\x6a\xe8\xc1\xe8\xe6\xa6\xdf\x73\x52\x86\xbf\xc6\x18\x28\x31\xd1\x69\xea\xec\xe7\x15\xbd\xb1\x67\x05\xc9\xf0\x2c\x4e\x93\x85\x89\x95\x61\xa3\x8e\x48\x46\x56\x15\x39\xfd\x78\xf7\xf3\xfd\x67\x07\x9c\xa6\x82\x38\xd0\x52\xa1\x2a\xa6\x99\xa3\x7c\xa8\x71\x3a\x9b\x6f\x06\x77\xd0\xfc\xad\x51\xcc\x00\x6d\xfc\x17\x74\xe1\x1e\xce\xd4\x39\xc7\x6c\xcb\xda\xa2\x05\x79\x63\xae\x06\xf9\x7b\xa1\x07\x16\x46\x09\x6e\x1b\x9b\xdb\x5a\x5d\xb2\x7d\x72\xff\xb9\x36\x67\x06\x2b\x47\x7e\xfb\x6d\xb3\x48\xcf\x17\x9f\x6b\x0b\x6e\xa0\x1b\x2f\x4a\xf4\x67\xdb\x9a\xd1\x35\x67\x64\x3e\xe5\xb1\x71\xc1\x16\xdf\x6c\xf5\x0b\xc3\x75\xbf\xa4\x2c\x41\x09\x5b\xb7\x53\xec\x1f\x85\x00\xa7\x59\x4f\x6d\x67\x87\x49\x5a\x0c\x2a\x71\x69\x33\x70\x9a\xba\xd1\x81\xfb\x62\x5d\x43\xd7\x76\xbb\xfd\xda\x10\xd4\x0c\x3e\xbd\x53\xf6\x96\xe6\xde\xdf\x17\xab\xec\x95\x9a\x63\xcf\x1d\xb8\xe7\xee\x70\xe0\x3e\x2b\x3f\x95\xf3\x4a\xc5\xd4\xb6\x1b\x25\x5b\xef\x70\x6c\x2b\xe2\x01\x89\x20\x3b\xc9\x74\x02\x67\x2d\x26\xde\xba\xa3\x1e\x65\x83\xc8\x39\x9e\xa3\x6a\xd1\x55\xae\xba\x03\xa7\xa9\x26\x72\xa0\xd7\x03\xe7\xe2\x76\x38\xf0\x1d\xf8\xf4\x69\xa3\xb5\xeb\xc0\x7d\x25\x84\x02\x65\x2a\x18\xbc\xec\x76\x8b\x88\x05\x24\x41\x70\x9a\xf9\x9a\x8e\x2a\x97\x6d\xcb\xb2\x1c\xa7\x05\x19\xbc\x0b\x67\x67\xba\xe9\xb0\x6c\x3b\x29\x1a\x8f\xca\xc6\x97\x45\x63\xcf\x81\x4f\xe0\x7c\xd2\x7f\xbf\x2b\x01\xaf\x0a\xc0\x37\x06\xd1\x33\x3f\x7f\x37\x3f\xaf\xb3\x37\xf3\x52\x0e\xfb\xbf\x62\x58\x5b\x77\x7d\xaf\xff\xfe\x6e\xc0\x19\xba\x02\xff\xa1\x80\x77\x0c\xbe\x63\x06\x74\x7e\x2f\x31\xff\x5f\x60\x5e\xa8\xbe\x83\xe3\x03\x85\xf8\xb6\x04\xfc\x58\x00\xfe\x59\x36\xfe\xad\x34\xa4\xb2\xde\x4f\x79\xeb\x8b\x16\x54\x08\x50\xd3\x6e\x38\x85\x8a\x97\xf5\x00\x4c\x48\xb0\x41\x8d\xf5\x44\x32\x4c\xfd\x02\x71\xd7\xe1\xe0\x34\x6b\x08\xc7\xb6\x36\x4e\xf8\xe6\x3f\xbe\xb4\xa2\xce\xb9\x72\xc5\xb5\xc9\x75\xdf\xfa\x94\x65\x2d\x61\xa6\xdd\x8b\x7d\xa3\x5b\x7f\x7c\xeb\xc3\xb3\xa8\x67\x2a\x05\x63\xde\x43\x44\x19\xee\xa9\x1c\x66\xdc\xeb\xd7\x5a\x3c\x4e\xcd\xe1\x9e\xa9\x95\x29\x04\x4e\x36\xf5\x29\x5b\x2b\x7f\x37\x2b\x65\x54\x29\x65\x5b\x91\xc5\xb6\xac\x8c\x69\x2d\x95\x02\x1b\x46\x66\xec\xac\x56\x1b\xcd\x8a\x96\x3b\x3b\x06\xf5\xbd\xcb\x49\x75\x4c\x57\x03\x23\x94\xb0\x56\x26\x5a\x96\x4e\xa4\x4c\x01\x5a\x9b\x98\xef\xbf\xdf\xb1\xc0\x96\x3c\xd9\xcb\x90\x66\x39\xf7\x17\x4d\x7a\xd1\xda\x0c\xd8\x96\x55\x35\x11\x34\x0f\xb6\xb8\x3f\xe0\x4c\x0a\x1e\xa9\xdf\x44\x8a\x34\x90\x59\x1c\xf2\xd7\x5e\xf3\x30\x0b\x61\xd5\xa3\x9f\x00\xa4\x80\xbb\xd3\x88\xaf\x50\x9c\xde\xc3\xdd\x69\x1a\xc7\xea\xa9\x65\x6f\x75\x44\x31\xdd\xce\x18\x59\xdb\x8c\xcb\xb9\x5c\x66\xe1\x5d\x2d\x39\xbe\x29\x45\xf8\xbb\xef\x76\xf4\xf9\xeb\x02\xbd\x5f\xa0\xd6\xa5\xbb\x6a\xa9\x49\x48\xeb\x80\xce\x94\x9e\x1d\xac\x16\x34\x42\xfd\x34\xe3\x02\x49\xb0\xd0\xcf\xc9\x8a\xca\xec\x51\x4d\x71\x00\x3a\x6f\xac\x5d\x4e\x2f\xc5\xad\xc6\x8a\x4d\x11\xdb\x8b\xfb\xa6\x76\x87\x5c\x1f\x9f\xa5\x01\xe6\xce\xbb\xee\x7b\x73\xfe\xd6\x7a\xf2\x1b\x9b\xd6\x04\xbb\xae\x64\xc6\xc1\x1b\xad\xe6\xde\x68\x69\xaf\x81\x8a\xe6\x36\x99\x73\xa0\x1d\xe1\x7a\x9f\xa9\xd8\x95\xf0\x84\xbc\x1e\x97\x8a\x4e\x58\x8e\xae\xa6\x0d\x4d\x8d\x6e\x55\xcf\x6b\xd3\x59\x91\x2f\xcb\xb2\xa6\x02\xc9\xa3\x7e\x9c\x51\xf5\x53\x0f\x45\x71\x1d\xa8\x84\xc2\x2a\xea\x3d\xdb\xda\xb1\xc3\x90\x33\xb4\xad\xb2\xd6\x2e\x95\xfd\x99\x41\xd4\x29\x7c\x35\x1a\xaa\x4a\xdf\xf3\xbf\x26\x94\xf9\xbb\x32\x4d\xc8\x35\xa1\x2d\x52\x5a\x15\x24\x5b\x72\xb9\x05\x3b\x34\x6b\xf2\x61\x4d\xb2\xae\x47\xa3\xf1\xc4\xcf\xce\x5c\x93\xf2\xbb\xb4\xba\x36\x72\xd0\x9f\xb8\xf9\xc8\x5c\xa4\xbe\xca\x3d\xee\xf0\xfc\x4f\x39\x07\x59\xb8\x99\xeb\x79\xaf\x53\xe8\x5a\x9e\x0e\x76\x25\x07\x9e\x69\xb2\xbe\x1e\x42\x76\x3d\xfc\x1a\x9b\xb3\xab\xe4\x83\xbe\x58\x6e\x1a\xbd\xd6\x5d\x67\xe9\xfa\x41\x62\x78\x28\x45\x8a\x19\xb1\xb6\x16\x40\x5f\xa0\xdb\x1b\xef\xfa\xc2\x77\x4c\x9a\xac\xb1\xc2\xb2\xea\x5f\xcf\x8e\xf4\x67\x4f\xc4\x10\x43\xdd\xbf\x7d\xa9\x82\xb5\xd5\xc5\x2a\x15\x78\x45\xc9\x2d\xcb\xda\x12\xc4\x0a\x95\xb3\x62\x5c\x3f\x66\x45\x65\x26\x0f\x50\x3f\xc2\x37\x14\x78\x2b\xed\x1b\x31\x8f\xf5\xb7\xda\x8c\xe4\xbb\xef\x74\xa5\x91\x55\xa7\x64\xa7\x4a\xe5\x0e\x57\xfd\xac\xa0\x0e\x7b\x65\xb8\x96\x8e\xe7\xe6\x94\x7b\xd3\xbf\x1a\x5e\x0d\x2f\xbf\xe2\x83\x40\xe6\x44\x81\x4b\x42\x19\x65\xf3\x4a\x41\xbc\x96\x57\x35\xc0\x7a\x66\x15\x92\xbe\xdd\x1b\x3a\xb9\xaa\xae\xf8\xe3\x94\x2a\x9d\xb4\xf6\x3d\xc5\x81\x5a\x28\x4b\x5c\x26\xef\xfb\x84\xf2\x6b\x7c\x3c\x71\x6f\xae\x06\xa3\xeb\xd1\xf0\x6b\xbc\x9b\xe0\x92\x06\x3c\xca\x0b\xdd\xed\x2e\xdd\x5a\x3d\xd5\x04\xd3\x73\x27\x2a\x55\xfe\x97\xb6\x4f\x23\x1e\x3c\x16\x02\xf9\x1c\xdb\x9f\x7b\xe6\xa9\x24\xde\x07\x69\x92\x4d\x20\x09\xa1\x2d\xcc\x17\x00\x9d\x63\x26\x5f\x95\x5d\x75\xdd\xfb\xd2\x37\x81\xfc\x93\xcf\xae\x4f\x01\xdb\xee\x3e\x60\xca\x62\x86\x2b\xb3\xfc\x29\x64\x7b\x3d\x83\x19\xb5\x8b\x03\x36\xcf\x6c\x25\x90\xce\x68\xec\x0d\xcf\xf5\x3d\x79\xe2\x7b\xc3\x4b\xfd\xf4\xe6\xfa\x9d\x3a\xfe\x3e\x19\x81\x3b\x77\x2f\x9c\xed\x55\x7d\x56\x18\x99\xbb\xc8\x9b\xeb\x77\xee\xf0\xdc\x69\x6d\x04\x28\xef\xcf\x28\xb4\xa5\xfc\x28\x10\x97\x6f\xfd\xca\x04\xd5\x23\x23\x83\x14\x29\x53\xc2\x8a\x24\x86\xea\x9d\x28\x37\xbe\x5b\x02\xd7\x4b\x8e\xb3\x7a\x51\x09\xf9\xfd\x65\x5b\xbb\x26\xa8\xe6\xa7\xbd\x23\xd3\xfe\xfc\xff\xae\xd8\xff\x09\x00\x00\xff\xff\xd9\x73\xa8\x85\xed\x1e\x00\x00"
-
-func dijkstraShuntingYardRpnShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardRpnSh,
-		"dijkstra-shunting-yard/rpn.sh",
-	)
-}
-
-func dijkstraShuntingYardRpnSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardRpnShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/rpn.sh", size: 7917, mode: os.FileMode(511), modTime: time.Unix(1546002140, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardShuntSh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xed\x6a\xe3\x46\x14\xfd\x3f\x4f\x71\x1a\xb5\x90\x14\x47\xb2\x1d\x4a\x69\x42\xa1\x5a\x93\x64\x0d\x89\x2d\x6c\x99\x10\x28\x2c\x23\xe9\xca\x73\xf1\x68\x46\x9d\x19\xd9\x71\x3f\xde\xbd\xc8\x6b\x9a\xec\x6e\x17\x1a\x1a\xfd\x9b\xfb\x7d\xce\xb9\x17\x45\xdf\x24\x05\x9b\xa4\x90\x5e\x89\xe8\xe7\xff\xf9\x89\xe8\xc1\x71\x08\x64\x50\xec\x71\xed\x78\x83\xcc\x76\xad\x24\x17\x44\x34\xb1\xed\xde\xf1\x5a\x05\x9c\x4e\xce\x30\x1e\x8e\x86\x83\xcf\x43\xa8\x91\xac\x2f\x41\x8e\x37\xbf\x78\x69\x36\x9d\xeb\xe2\x82\x7f\x17\xd1\x8e\x0a\x78\x0e\x74\x09\x15\x42\x7b\x99\x24\x9f\x78\xe7\x65\xb0\x05\xb9\x63\xcd\x4c\x19\xdb\x20\x23\xa3\x06\x98\xc8\xa6\xb0\x15\xcb\xb7\x40\x96\x2b\xf6\x68\x9d\x5d\x3b\xd9\x80\x3d\x6a\x47\x04\x6f\xeb\xb0\x93\x8e\xae\xb0\xb7\x1d\x4a\x69\xe0\xa8\x62\x1f\x1c\x17\x5d\x20\x70\x80\x34\x55\x62\x9d\x88\x1a\x5b\x71\xbd\xef\x2d\x9d\xa9\xc8\x21\x28\x42\x20\xd7\x78\xd8\xfa\xf0\xb8\x9d\xad\x70\x4b\x86\x9c\xd4\xc8\xba\x42\x73\x89\x3b\x2e\xc9\x78\x12\x91\xf4\x68\x7b\x93\x57\x54\xf5\xd4\xf6\xf1\x37\x7d\xff\xe5\xb1\x3f\x6e\x6c\x67\x2a\x19\xd8\x9a\x2b\x10\x07\x45\x0e\x5b\x72\x9e\xad\xc1\x58\x44\xc7\x16\xc7\x7a\x03\x58\x87\x53\x19\xfa\x99\x1d\x6c\xdb\x67\x9d\x41\x9a\x3d\xb4\x0c\xcf\x89\xb1\x88\xbe\x44\xfd\x0c\xae\x02\x9b\x43\x55\x65\x5b\x42\x50\x32\xf4\xe0\x76\xac\x35\x0a\x42\xe7\xa9\xee\xf4\x40\x44\x45\x17\xf0\x30\xcd\xdf\xcf\x57\x39\xd2\xd9\x23\x1e\xd2\xc5\x22\x9d\xe5\x8f\x57\xd8\x71\x50\xb6\x0b\xa0\x2d\x7d\x2c\xc4\x4d\xab\x99\x2a\xec\xa4\x73\xd2\x84\x3d\x6c\x2d\xa2\xfb\xeb\xc5\xe4\x7d\x3a\xcb\xd3\x77\xd3\xbb\x69\xfe\xd8\x8f\x7e\x33\xcd\x67\xd7\xcb\x25\x6e\xe6\x0b\xa4\xc8\xd2\x45\x3e\x9d\xac\xee\xd2\x05\xb2\xd5\x22\x9b\x2f\xaf\x63\x60\x49\xfd\x48\x24\xa2\xaf\xb3\x8a\xda\x3a\x34\xd6\x11\x2a\x0a\x92\xb5\x3f\xe0\x7d\xb4\x1d\xbc\xb2\x9d\xae\xa0\xe4\x96\xe0\xa8\x24\xde\x52\x05\x89\xd2\xb6\xfb\xff\x24\x96\xb6\x66\x7d\x40\x87\xf0\x82\xbd\x2b\x70\x0d\x63\xc3\x00\x3b\xc7\x81\x10\xec\x97\x32\x8a\xe8\x59\xc7\x01\xa6\xa6\x8c\x07\xf8\xe1\x27\xe4\xd4\xb4\x9a\x90\x69\x59\x12\xce\xb1\xec\xfa\xfc\x8b\x8b\xe1\x00\xef\xac\x0f\x7d\xe8\x7d\x0a\x0c\xc7\xa3\xd1\xe8\x7c\x74\x31\xfc\x71\xb0\x5a\xa6\x47\xf5\x08\x27\x5f\x9f\xf5\x04\xa7\xb7\xd9\xdd\x59\xaf\xab\xdc\x4a\xd6\xb2\xd0\x04\x19\x44\x74\xbc\xb2\xdd\x6e\x17\xaf\x4d\x17\x5b\xb7\x4e\xf4\xc7\x14\x9f\x58\x5d\x9d\xff\xf3\x58\xb7\xfa\x7c\x1c\x0f\x63\x15\x1a\xfd\xe6\x47\x56\xda\xa6\x60\x43\xfe\xc0\x13\x99\xc0\x8e\x50\x2a\xc9\xfd\x91\xfd\xd6\xb1\xa3\xea\x40\xa2\x93\xc6\xf7\x8b\x2b\x22\x69\xc0\xa6\xe6\x27\xd0\x53\xeb\xc8\x1f\xb6\x9f\x4d\xb0\x90\x06\x8b\x6c\x86\xd6\xfa\xf0\xa9\x3b\x7e\x83\xa1\x85\x88\x30\x59\x2d\xf3\xf9\xfd\x61\xa5\xee\x97\x93\x85\x28\x2b\x9c\x7c\xfb\xc7\xf0\xbb\xe4\xfb\xbf\x4e\x84\x10\x71\xa2\xd9\xd0\xb8\x54\xd2\xc5\x5e\xe1\x57\x81\x3f\x11\x27\xda\xda\x8d\x54\x24\xab\x0f\x9f\x3b\xe8\xe9\x5f\xc3\xc6\xc1\x6e\xc8\xf8\x17\xbe\x9a\x9f\x3e\xd4\x9d\x29\x87\xaf\x8c\xaf\xa8\x7e\x61\x75\xad\x79\x45\x3e\x9b\xad\xdd\x50\xfc\x26\xff\x0b\xf1\x77\x00\x00\x00\xff\xff\x74\x74\x92\x6e\x7f\x06\x00\x00"
-
-func dijkstraShuntingYardShuntShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardShuntSh,
-		"dijkstra-shunting-yard/shunt.sh",
-	)
-}
-
-func dijkstraShuntingYardShuntSh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardShuntShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/shunt.sh", size: 1663, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardShunt2Sh = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xed\x6e\xe2\x46\x14\xfd\xcd\x3c\xc5\xa9\x4d\xa5\x64\x05\x06\x12\x55\x55\x43\x56\xaa\xc3\x86\x2c\x2d\x01\x84\x8d\xa2\x48\x95\xaa\xb1\x7d\xc1\x23\xc6\x33\xd6\x7c\x80\xe8\x76\xdf\xbd\x32\xd0\xcd\xb6\xab\xad\x2a\x6d\xfc\x8b\x99\x39\xf7\xe3\x9c\x73\x2f\xe1\x77\xbd\x4c\xa8\x5e\xc6\x6d\xc9\xc2\xb7\xdf\xf8\xb1\xf0\xc9\x08\xe7\x48\x21\x3b\xe0\xde\x88\x2d\x16\xda\xd7\x9c\x8c\x63\xe1\x48\xd7\x07\x23\x36\xa5\xc3\xc5\xe8\x12\x57\xfd\x41\xbf\xf3\x6f\x08\x55\x5c\xc8\x1b\x90\x11\xdb\x9f\x2d\x57\x5b\x6f\x7c\x94\x89\x3f\x58\xb8\xa7\x0c\x56\x38\xba\x41\xe9\x5c\x7d\xd3\xeb\xfd\xe3\x75\x9e\x3b\x9d\x91\x39\xe7\x5c\x94\x4a\x57\x58\x90\x2a\x3b\x18\xf1\x2a\xd3\x85\xe0\xaf\xc1\x2c\x2d\x85\x45\x6d\xf4\xc6\xf0\x0a\xc2\x62\x6d\x88\x60\xf5\xda\xed\xb9\xa1\x21\x0e\xda\x23\xe7\x0a\x86\x0a\x61\x9d\x11\x99\x77\x04\xe1\xc0\x55\xd1\xd3\x86\x85\x95\x2e\xc4\xfa\xd0\xdc\x78\x55\x90\x81\x2b\x09\x8e\x4c\x65\xa1\xd7\xc7\xc3\xc3\x6c\x85\x07\x52\x64\xb8\xc4\xc2\x67\x52\xe4\x98\x8a\x9c\x94\x25\x16\x72\x8b\xba\xb9\xb2\x25\x15\x8d\xb4\x0d\x7e\xdc\xd4\x4f\xce\xf5\x31\xd6\x5e\x15\xdc\x09\xad\x86\x20\xe1\x4a\x32\xd8\x91\xb1\x42\x2b\x5c\xb1\xf0\x5c\xe2\x9c\xaf\x03\x6d\x70\xc1\x5d\xd3\xb3\x81\xae\x9b\xa8\x4b\x70\x75\x80\xe4\xee\x25\x30\x62\xe1\x97\xac\x5f\xc8\x15\x10\xea\x98\xb5\xd4\x35\xc1\x95\xdc\x35\xe4\xf6\x42\x4a\x64\x04\x6f\x69\xed\x65\x87\x85\x99\x77\x78\x9a\xa4\xef\xe7\xab\x14\xf1\xec\x19\x4f\xf1\x72\x19\xcf\xd2\xe7\x21\xf6\xc2\x95\xda\x3b\xd0\x8e\x4e\x89\x44\x55\x4b\x41\x05\xf6\xdc\x18\xae\xdc\x01\x7a\xcd\xc2\xc7\xfb\xe5\xe8\x7d\x3c\x4b\xe3\xbb\xc9\x74\x92\x3e\x37\xad\x8f\x27\xe9\xec\x3e\x49\x30\x9e\x2f\x11\x63\x11\x2f\xd3\xc9\x68\x35\x8d\x97\x58\xac\x96\x8b\x79\x72\x1f\x01\x09\x35\x2d\x11\x0b\xbf\xae\x2a\xd6\xda\xa0\xd2\x86\x50\x90\xe3\x42\xda\x23\xdf\x67\xed\x61\x4b\xed\x65\x81\x92\xef\x08\x86\x72\x12\x3b\x2a\xc0\x91\xeb\xfa\xf0\xbf\xcc\x92\x5a\x6d\x8e\xec\xe0\x3e\x53\x6f\x08\xb1\x86\xd2\xae\x83\xbd\x11\x8e\xe0\xf4\x97\x36\xb2\xf0\xc5\xc7\x0e\x26\x2a\x8f\x3a\xf8\xe1\x27\xa4\x54\xd5\x92\xb0\x90\x3c\x27\x74\x91\xf8\x26\xfe\xfa\xba\xdf\xc1\x9d\xb6\xae\x81\x3e\xc6\x40\xff\x6a\x30\x18\x74\x07\xd7\xfd\x1f\x3b\xab\x24\x3e\xbb\x47\x08\xbe\xde\x6b\x80\x8b\x87\xc5\xf4\xb2\xf1\x95\xef\xb8\x90\x3c\x93\x04\xee\x58\x78\xde\xb2\xfd\x7e\x1f\x6d\x94\x8f\xb4\xd9\xf4\xe4\x29\xc4\xf6\xb4\x2c\xba\x9f\x0e\x9b\x5a\x76\xaf\xa2\x7e\x54\xba\x4a\xbe\xfa\x92\xd9\xe3\x40\xac\x05\xd9\xa3\x52\xda\xbb\xda\xbb\xbf\x1d\xb0\xa5\x57\x2e\xb2\x25\x6c\x6e\x44\xed\x58\x98\x1d\xa0\x95\x3c\x60\x4b\x54\x0b\xb5\x39\x2d\x98\xde\x92\xc2\x8e\x4b\x4f\xb6\xd9\x44\xd4\xde\xb9\xf3\x63\x05\x2e\x65\x33\xc3\x5a\x11\xa4\x50\x14\xbd\x02\x01\xc6\x42\x8c\x56\x49\x3a\x7f\x3c\x8e\xd7\x63\x32\x5a\xb2\xbc\x40\xd0\xfe\xd0\xff\xbe\xf7\xe6\x63\xc0\x18\xb3\xda\x9b\x9c\x10\xf5\x72\xad\xd6\x62\x13\x35\xff\xbb\xdd\x6f\xfc\x58\xd4\xfb\xa4\xc7\x9f\xf8\x8d\xed\x4b\x21\x9b\xd9\xe5\x05\xba\xe6\xa4\xc2\x10\x85\x66\xad\xf9\xf4\xdd\xef\x93\x71\xf2\xb6\x3d\x19\x27\xac\xd5\xfc\x0a\xda\x1f\xd2\xf9\xaf\xe3\xe9\xbb\xe4\x7e\xf1\x31\x18\x9e\x82\xdc\xa1\xa6\xc1\x49\xb7\x01\x6e\x6f\x6f\x83\xf6\x31\x47\xc0\x5a\x39\xb7\x84\xa0\x7d\x04\x04\x10\x8a\xb5\x5a\xc1\xfc\xee\x97\x78\xf9\x10\x5c\x82\xf2\x52\xa3\xab\x70\x86\x23\xc0\x70\xd8\x00\xc6\xab\xd9\x7f\x02\xde\x7c\xfe\x72\xae\x1a\xb0\x16\x59\x9e\x9f\x9a\x6c\x9f\xfb\x66\x85\x56\xc4\x8e\xd8\x20\x78\x0d\xbb\xfe\x0a\x00\x00\xff\xff\x14\x3a\xeb\x4e\xfe\x06\x00\x00"
-
-func dijkstraShuntingYardShunt2ShBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardShunt2Sh,
-		"dijkstra-shunting-yard/shunt2.sh",
-	)
-}
-
-func dijkstraShuntingYardShunt2Sh() (*asset, error) {
-	bytes, err := dijkstraShuntingYardShunt2ShBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/shunt2.sh", size: 1790, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dijkstraShuntingYardTestLng = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4a\x2b\xcd\x4b\x2e\xc9\xcc\xcf\x53\x48\xd4\xd0\xe4\xaa\xe6\xe2\xcc\x4c\xd3\x48\xb4\xb5\x35\x34\xd2\x54\x28\x4a\x2d\x29\x2d\xca\xd3\x30\xd6\xb4\x86\x89\x1a\x19\x23\x44\x4d\x34\xad\xb9\x6a\xb9\xb8\x00\x01\x00\x00\xff\xff\xf4\x9f\xe2\xb9\x3f\x00\x00\x00"
-
-func dijkstraShuntingYardTestLngBytes() ([]byte, error) {
-	return bindataRead(
-		_dijkstraShuntingYardTestLng,
-		"dijkstra-shunting-yard/test.lng",
-	)
-}
-
-func dijkstraShuntingYardTestLng() (*asset, error) {
-	bytes, err := dijkstraShuntingYardTestLngBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dijkstra-shunting-yard/test.lng", size: 63, mode: os.FileMode(511), modTime: time.Unix(1542700725, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"dijkstra-shunting-yard/GPL.txt": dijkstraShuntingYardGplTxt,
-	"dijkstra-shunting-yard/README.md": dijkstraShuntingYardReadmeMd,
-	"dijkstra-shunting-yard/config.sh": dijkstraShuntingYardConfigSh,
-	"dijkstra-shunting-yard/fix_func0.sh": dijkstraShuntingYardFix_func0Sh,
-	"dijkstra-shunting-yard/fix_funcdef.sh": dijkstraShuntingYardFix_funcdefSh,
-	"dijkstra-shunting-yard/fix_invoke.sh": dijkstraShuntingYardFix_invokeSh,
-	"dijkstra-shunting-yard/lex.sh": dijkstraShuntingYardLexSh,
-	"dijkstra-shunting-yard/line2char.sh": dijkstraShuntingYardLine2charSh,
-	"dijkstra-shunting-yard/lookahead_1token.sh": dijkstraShuntingYardLookahead_1tokenSh,
-	"dijkstra-shunting-yard/lookahead_2tokens.sh": dijkstraShuntingYardLookahead_2tokensSh,
-	"dijkstra-shunting-yard/lookahead_char.sh": dijkstraShuntingYardLookahead_charSh,
-	"dijkstra-shunting-yard/nl_escape": dijkstraShuntingYardNl_escape,
-	"dijkstra-shunting-yard/nl_unescape": dijkstraShuntingYardNl_unescape,
-	"dijkstra-shunting-yard/rpn.sh": dijkstraShuntingYardRpnSh,
-	"dijkstra-shunting-yard/shunt.sh": dijkstraShuntingYardShuntSh,
-	"dijkstra-shunting-yard/shunt2.sh": dijkstraShuntingYardShunt2Sh,
-	"dijkstra-shunting-yard/test.lng": dijkstraShuntingYardTestLng,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"dijkstra-shunting-yard": &bintree{nil, map[string]*bintree{
-		"GPL.txt": &bintree{dijkstraShuntingYardGplTxt, map[string]*bintree{}},
-		"README.md": &bintree{dijkstraShuntingYardReadmeMd, map[string]*bintree{}},
-		"config.sh": &bintree{dijkstraShuntingYardConfigSh, map[string]*bintree{}},
-		"fix_func0.sh": &bintree{dijkstraShuntingYardFix_func0Sh, map[string]*bintree{}},
-		"fix_funcdef.sh": &bintree{dijkstraShuntingYardFix_funcdefSh, map[string]*bintree{}},
-		"fix_invoke.sh": &bintree{dijkstraShuntingYardFix_invokeSh, map[string]*bintree{}},
-		"lex.sh": &bintree{dijkstraShuntingYardLexSh, map[string]*bintree{}},
-		"line2char.sh": &bintree{dijkstraShuntingYardLine2charSh, map[string]*bintree{}},
-		"lookahead_1token.sh": &bintree{dijkstraShuntingYardLookahead_1tokenSh, map[string]*bintree{}},
-		"lookahead_2tokens.sh": &bintree{dijkstraShuntingYardLookahead_2tokensSh, map[string]*bintree{}},
-		"lookahead_char.sh": &bintree{dijkstraShuntingYardLookahead_charSh, map[string]*bintree{}},
-		"nl_escape": &bintree{dijkstraShuntingYardNl_escape, map[string]*bintree{}},
-		"nl_unescape": &bintree{dijkstraShuntingYardNl_unescape, map[string]*bintree{}},
-		"rpn.sh": &bintree{dijkstraShuntingYardRpnSh, map[string]*bintree{}},
-		"shunt.sh": &bintree{dijkstraShuntingYardShuntSh, map[string]*bintree{}},
-		"shunt2.sh": &bintree{dijkstraShuntingYardShunt2Sh, map[string]*bintree{}},
-		"test.lng": &bintree{dijkstraShuntingYardTestLng, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-